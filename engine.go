@@ -17,6 +17,7 @@ package sqle
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/dolthub/go-mysql-server/memory"
 
@@ -34,6 +35,8 @@ type Config struct {
 	VersionPostfix string
 	// Auth used for authentication and authorization.
 	Auth auth.Auth
+	// QueryLogger, if set, is notified of the statements the engine executes.
+	QueryLogger QueryLogger
 }
 
 // Engine is a SQL engine.
@@ -44,6 +47,7 @@ type Engine struct {
 	ProcessList       sql.ProcessList
 	MemoryManager     *sql.MemoryManager
 	BackgroundThreads *sql.BackgroundThreads
+	QueryLogger       QueryLogger
 }
 
 type ColumnWithRawDefault struct {
@@ -66,6 +70,10 @@ func New(a *analyzer.Analyzer, cfg *Config) *Engine {
 		sql.FunctionN{
 			Name: "version",
 			Fn:   function.NewVersion(versionPostfix),
+		},
+		sql.FunctionN{
+			Name: "current_query_fingerprint",
+			Fn:   function.NewQueryFingerprint(parse.Fingerprint),
 		})
 	a.Catalog.RegisterFunction(function.GetLockingFuncs(ls)...)
 
@@ -77,6 +85,11 @@ func New(a *analyzer.Analyzer, cfg *Config) *Engine {
 		au = cfg.Auth
 	}
 
+	var queryLogger QueryLogger
+	if cfg != nil {
+		queryLogger = cfg.QueryLogger
+	}
+
 	return &Engine{
 		Analyzer:          a,
 		MemoryManager:     sql.NewMemoryManager(sql.ProcessMemory),
@@ -84,6 +97,7 @@ func New(a *analyzer.Analyzer, cfg *Config) *Engine {
 		Auth:              au,
 		LS:                ls,
 		BackgroundThreads: sql.NewBackgroundThreads(),
+		QueryLogger:       queryLogger,
 	}
 }
 
@@ -133,6 +147,36 @@ func (e *Engine) QueryNodeWithBindings(
 	parsed sql.Node,
 	bindings map[string]sql.Expression,
 ) (sql.Schema, sql.RowIter, error) {
+	if e.QueryLogger == nil {
+		analyzed, iter, err := e.queryNodeWithBindings(ctx, query, parsed, bindings)
+		if err != nil {
+			return nil, nil, err
+		}
+		return analyzed.Schema(), iter, nil
+	}
+
+	entry := QueryLogEntry{Query: query, Bindings: bindings, Start: time.Now()}
+	e.QueryLogger.QueryStarted(ctx, query, bindings)
+
+	analyzed, iter, err := e.queryNodeWithBindings(ctx, query, parsed, bindings)
+	if err != nil {
+		entry.Duration = time.Since(entry.Start)
+		entry.Err = err
+		logFinishedQuery(ctx, e.QueryLogger, entry, analyzed)
+		return nil, nil, err
+	}
+
+	return analyzed.Schema(), &queryLoggingIter{childIter: iter, logger: e.QueryLogger, entry: entry, analyzed: analyzed}, nil
+}
+
+// queryNodeWithBindings executes the query given with the bindings provided, and returns its analyzed plan along with
+// its rows. The plan is returned once the query is analyzed, even if it then fails.
+func (e *Engine) queryNodeWithBindings(
+	ctx *sql.Context,
+	query string,
+	parsed sql.Node,
+	bindings map[string]sql.Expression,
+) (sql.Node, sql.RowIter, error) {
 	var (
 		analyzed sql.Node
 		iter     sql.RowIter
@@ -170,19 +214,19 @@ func (e *Engine) QueryNodeWithBindings(
 
 	iter, err = analyzed.RowIter(ctx, nil)
 	if err != nil {
-		return nil, nil, err
+		return analyzed, nil, err
 	}
 
 	autoCommit, err := isSessionAutocommit(ctx)
 	if err != nil {
-		return nil, nil, err
+		return analyzed, nil, err
 	}
 
 	if autoCommit {
 		iter = transactionCommittingIter{iter, transactionDatabase}
 	}
 
-	return analyzed.Schema(), iter, nil
+	return analyzed, iter, nil
 }
 
 const (
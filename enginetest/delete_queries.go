@@ -137,6 +137,24 @@ var DeleteTests = []WriteQueryTest{
 		SelectQuery:         "SELECT * FROM mytable;",
 		ExpectedSelect:      []sql.Row{{int64(1), "first row"}, {int64(2), "second row"}, {int64(3), "third row"}},
 	},
+	{
+		WriteQuery:          "DELETE mytable FROM mytable JOIN othertable ON mytable.i >= othertable.i2 WHERE othertable.i2 > 1;",
+		ExpectedWriteResult: []sql.Row{{sql.NewOkResult(2)}},
+		SelectQuery:         "SELECT * FROM mytable;",
+		ExpectedSelect:      []sql.Row{{int64(1), "first row"}},
+	},
+	{
+		WriteQuery:          "DELETE o FROM mytable m JOIN othertable o ON m.i = o.i2 WHERE m.s = 'first row';",
+		ExpectedWriteResult: []sql.Row{{sql.NewOkResult(1)}},
+		SelectQuery:         "SELECT * FROM othertable ORDER BY i2;",
+		ExpectedSelect:      []sql.Row{{"second", int64(2)}, {"first", int64(3)}},
+	},
+	{
+		WriteQuery:          "DELETE mytable, othertable FROM mytable JOIN othertable ON mytable.i = othertable.i2 WHERE mytable.i = 3;",
+		ExpectedWriteResult: []sql.Row{{sql.NewOkResult(2)}},
+		SelectQuery:         "SELECT (SELECT COUNT(*) FROM mytable), (SELECT COUNT(*) FROM othertable);",
+		ExpectedSelect:      []sql.Row{{int64(2), int64(2)}},
+	},
 }
 
 var SpatialDeleteTests = []WriteQueryTest{
@@ -169,6 +187,10 @@ var DeleteErrorTests = []GenericErrorQueryTest{
 		Name:  "invalid column",
 		Query: "DELETE FROM mytable WHERE z = 'dne';",
 	},
+	{
+		Name:  "unknown table in multi-table delete",
+		Query: "DELETE badtable FROM mytable JOIN othertable ON mytable.i = othertable.i2;",
+	},
 	{
 		Name:  "missing binding",
 		Query: "DELETE FROM mytable WHERE i = ?;",
@@ -84,6 +84,22 @@ func TestTracing(t *testing.T) {
 	enginetest.TestTracing(t, enginetest.NewDefaultMemoryHarness())
 }
 
+func TestQueryTag(t *testing.T) {
+	enginetest.TestQueryTag(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestQueryFingerprint(t *testing.T) {
+	enginetest.TestQueryFingerprint(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestQueryLogger(t *testing.T) {
+	enginetest.TestQueryLogger(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestSlowQueryLogger(t *testing.T) {
+	enginetest.TestSlowQueryLogger(t, enginetest.NewDefaultMemoryHarness())
+}
+
 func TestCurrentTimestamp(t *testing.T) {
 	enginetest.TestCurrentTimestamp(t, enginetest.NewDefaultMemoryHarness())
 }
@@ -196,6 +212,7 @@ func TestShowProcessList(t *testing.T) {
 
 	p := sqle.NewProcessList()
 	sess := sql.NewBaseSessionWithClientServer("0.0.0.0:3306", sql.Client{Address: addr, User: "foo"}, 1)
+	sess.SetCurrentDatabase("foo")
 	ctx := sql.NewContext(context.Background(), sql.WithPid(1), sql.WithSession(sess), sql.WithProcessList(p))
 
 	ctx, err := p.AddProcess(ctx, "SELECT foo")
@@ -217,8 +234,10 @@ func TestShowProcessList(t *testing.T) {
 	p.UpdateTableProgress(1, "b", 2)
 	p.UpdateTableProgress(2, "foo", 1)
 
+	idleAddr := "127.0.0.1:34568"
+	p.AddConnection(2, idleAddr)
+
 	n := plan.NewShowProcessList()
-	n.Database = "foo"
 
 	iter, err := n.RowIter(ctx, nil)
 	require.NoError(err)
@@ -235,11 +254,37 @@ a (4/5 partitions)
 b (2/6 partitions)
 `, "SELECT foo"},
 		{int64(1), "foo", addr, "foo", "Query", int64(0), "\nfoo (1/2 partitions)\n", "SELECT bar"},
+		{int64(2), "", idleAddr, "", "Sleep", int64(0), "", nil},
 	}
 
 	require.ElementsMatch(expected, rows)
 }
 
+func TestInformationSchemaProcessList(t *testing.T) {
+	require := require.New(t)
+	e := enginetest.NewEngine(t, enginetest.NewDefaultMemoryHarness())
+	defer e.Close()
+
+	addr := "127.0.0.1:34567"
+	idleAddr := "127.0.0.1:34568"
+
+	p := sqle.NewProcessList()
+	p.AddConnection(1, addr)
+	p.AddConnection(2, idleAddr)
+
+	query := "SELECT id, user, host, db, command, state, info FROM information_schema.processlist ORDER BY id"
+	sess := sql.NewBaseSessionWithClientServer("0.0.0.0:3306", sql.Client{Address: addr, User: "foo"}, 1)
+	sess.SetCurrentDatabase("mydb")
+	ctx := sql.NewContext(context.Background(), sql.WithPid(1), sql.WithSession(sess), sql.WithProcessList(p))
+	ctx, err := p.AddProcess(ctx, query)
+	require.NoError(err)
+
+	enginetest.TestQueryWithContext(t, ctx, e, query, []sql.Row{
+		{uint64(1), "foo", addr, "mydb", "Query", "executing", query},
+		{uint64(2), "", idleAddr, nil, "Sleep", nil, nil},
+	}, nil, nil)
+}
+
 // TODO: this was an analyzer test, but we don't have a mock process list for it to use, so it has to be here
 func TestTrackProcess(t *testing.T) {
 	require := require.New(t)
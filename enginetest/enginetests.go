@@ -33,6 +33,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/analyzer"
 	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
 	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation/window"
 	"github.com/dolthub/go-mysql-server/sql/information_schema"
 	"github.com/dolthub/go-mysql-server/sql/parse"
@@ -235,6 +236,104 @@ func extractQueryNode(node sql.Node) sql.Node {
 	}
 }
 
+// TestExplainAnalyze runs queries with EXPLAIN ANALYZE and asserts that the nodes of their plans are annotated with the
+// rows they returned and the number of times they were run.
+func TestExplainAnalyze(t *testing.T, harness Harness) {
+	engine := NewEngine(t, harness)
+	defer engine.Close()
+
+	tests := []struct {
+		query string
+		// rootRows is the annotation of the root node of the plan, and lines are annotations of other lines
+		rootRows string
+		lines    []string
+	}{
+		{
+			query:    "EXPLAIN ANALYZE SELECT s FROM mytable",
+			rootRows: "(actual rows=3 loops=1 ",
+			lines:    []string{"Table(mytable) (estimated rows=3) (actual rows=3 loops=1 "},
+		},
+		{
+			query:    "EXPLAIN ANALYZE SELECT a.i, b.i FROM mytable a CROSS JOIN mytable b",
+			rootRows: "(actual rows=9 loops=1 ",
+			lines:    []string{"CrossJoin (estimated rows=9) (actual rows=9 loops=1 ", "(actual rows=9 loops=3 "},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			ctx := NewContextWithEngine(harness, engine)
+			_, iter, err := engine.Query(ctx, tt.query)
+			require.NoError(t, err)
+			rows, err := sql.RowIterToRows(ctx, iter)
+			require.NoError(t, err)
+			require.NotEmpty(t, rows)
+
+			var lines []string
+			for _, row := range rows {
+				lines = append(lines, row[0].(string))
+			}
+			assert.Contains(t, lines[0], tt.rootRows)
+			description := strings.Join(lines, "\n")
+			for _, l := range tt.lines {
+				assert.Contains(t, description, l)
+			}
+		})
+	}
+}
+
+// TestShowCreateTableRoundTrip asserts that the statement given by SHOW CREATE TABLE recreates the same table.
+func TestShowCreateTableRoundTrip(t *testing.T, harness Harness) {
+	e := NewEngine(t, harness)
+	defer e.Close()
+
+	RunQuery(t, e, harness, "CREATE TABLE rt_parent (id int PRIMARY KEY)")
+	RunQuery(t, e, harness, "CREATE TABLE rt_child ("+
+		"a int NOT NULL AUTO_INCREMENT, "+
+		"b varchar(20) COLLATE utf8mb4_general_ci NOT NULL DEFAULT 'x', "+
+		"c decimal(10,2) DEFAULT 1.5, "+
+		"d datetime DEFAULT (NOW()), "+
+		"e int, "+
+		"f bigint unsigned COMMENT 'it''s', "+
+		"parent_id int, "+
+		"PRIMARY KEY (b, a), "+
+		"UNIQUE KEY uk (c, e), "+
+		"KEY idx_e (e), "+
+		"KEY idx_parent (parent_id), "+
+		"CONSTRAINT fk_parent FOREIGN KEY (parent_id) REFERENCES rt_parent (id) ON DELETE CASCADE, "+
+		"CONSTRAINT chk_e CHECK (e > 0))")
+
+	showCreate := func() string {
+		ctx := NewContext(harness)
+		_, iter, err := e.Query(ctx, "SHOW CREATE TABLE rt_child")
+		require.NoError(t, err)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		return rows[0][1].(string)
+	}
+
+	created := showCreate()
+	for _, s := range []string{
+		"`a` int NOT NULL AUTO_INCREMENT",
+		"`b` varchar(20) collate utf8mb4_general_ci NOT NULL DEFAULT",
+		"`d` datetime DEFAULT (NOW())",
+		"COMMENT 'it''s'",
+		"PRIMARY KEY (`b`,`a`)",
+		"UNIQUE KEY `uk` (`c`,`e`)",
+		"KEY `idx_e` (`e`)",
+		"CONSTRAINT `fk_parent` FOREIGN KEY (`parent_id`) REFERENCES `rt_parent` (`id`) ON DELETE CASCADE",
+		"CONSTRAINT `chk_e` CHECK ((`e` > 0))",
+		"ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin",
+	} {
+		assert.Contains(t, created, s)
+	}
+
+	RunQuery(t, e, harness, "DROP TABLE rt_child")
+	RunQuery(t, e, harness, created)
+	assert.Equal(t, created, showCreate())
+}
+
 func TestOrderByGroupBy(t *testing.T, harness Harness) {
 	require := require.New(t)
 
@@ -604,6 +703,9 @@ func TestReplaceInto(t *testing.T, harness Harness) {
 		}
 		TestQuery(t, harness, e, insertion.SelectQuery, insertion.ExpectedSelect, nil, insertion.Bindings)
 	}
+	for _, script := range ReplaceScripts {
+		TestScript(t, harness, script)
+	}
 }
 
 func TestReplaceIntoErrors(t *testing.T, harness Harness) {
@@ -730,12 +832,12 @@ func TestTruncate(t *testing.T, harness Harness) {
 		RunQuery(t, e, harness, "CREATE TABLE t1 (pk BIGINT PRIMARY KEY, v1 BIGINT, INDEX(v1))")
 		RunQuery(t, e, harness, "INSERT INTO t1 VALUES (1,1), (2,2), (3,3)")
 		TestQuery(t, harness, e, "SELECT * FROM t1 ORDER BY 1", []sql.Row{{int64(1), int64(1)}, {int64(2), int64(2)}, {int64(3), int64(3)}}, nil, nil)
-		TestQuery(t, harness, e, "TRUNCATE t1", []sql.Row{{sql.NewOkResult(3)}}, nil, nil)
+		TestQuery(t, harness, e, "TRUNCATE t1", []sql.Row{{sql.NewOkResult(0)}}, nil, nil)
 		TestQuery(t, harness, e, "SELECT * FROM t1 ORDER BY 1", []sql.Row(nil), nil, nil)
 
 		RunQuery(t, e, harness, "INSERT INTO t1 VALUES (4,4), (5,5)")
 		TestQuery(t, harness, e, "SELECT * FROM t1 WHERE v1 > 0 ORDER BY 1", []sql.Row{{int64(4), int64(4)}, {int64(5), int64(5)}}, nil, nil)
-		TestQuery(t, harness, e, "TRUNCATE TABLE t1", []sql.Row{{sql.NewOkResult(2)}}, nil, nil)
+		TestQuery(t, harness, e, "TRUNCATE TABLE t1", []sql.Row{{sql.NewOkResult(0)}}, nil, nil)
 		TestQuery(t, harness, e, "SELECT * FROM t1 ORDER BY 1", []sql.Row(nil), nil, nil)
 	})
 
@@ -753,7 +855,7 @@ func TestTruncate(t *testing.T, harness Harness) {
 		RunQuery(t, e, harness, "CREATE TRIGGER trig_t3 BEFORE DELETE ON t3 FOR EACH ROW INSERT INTO t3i VALUES (old.pk, old.v1)")
 		RunQuery(t, e, harness, "INSERT INTO t3 VALUES (1,1), (3,3)")
 		TestQuery(t, harness, e, "SELECT * FROM t3 ORDER BY 1", []sql.Row{{int64(1), int64(1)}, {int64(3), int64(3)}}, nil, nil)
-		TestQuery(t, harness, e, "TRUNCATE t3", []sql.Row{{sql.NewOkResult(2)}}, nil, nil)
+		TestQuery(t, harness, e, "TRUNCATE t3", []sql.Row{{sql.NewOkResult(0)}}, nil, nil)
 		TestQuery(t, harness, e, "SELECT * FROM t3 ORDER BY 1", []sql.Row{}, nil, nil)
 		TestQuery(t, harness, e, "SELECT * FROM t3i ORDER BY 1", []sql.Row{}, nil, nil)
 	})
@@ -762,7 +864,7 @@ func TestTruncate(t *testing.T, harness Harness) {
 		RunQuery(t, e, harness, "CREATE TABLE t4 (pk BIGINT AUTO_INCREMENT PRIMARY KEY, v1 BIGINT)")
 		RunQuery(t, e, harness, "INSERT INTO t4(v1) VALUES (5), (6)")
 		TestQuery(t, harness, e, "SELECT * FROM t4 ORDER BY 1", []sql.Row{{int64(1), int64(5)}, {int64(2), int64(6)}}, nil, nil)
-		TestQuery(t, harness, e, "TRUNCATE t4", []sql.Row{{sql.NewOkResult(2)}}, nil, nil)
+		TestQuery(t, harness, e, "TRUNCATE t4", []sql.Row{{sql.NewOkResult(0)}}, nil, nil)
 		TestQuery(t, harness, e, "SELECT * FROM t4 ORDER BY 1", []sql.Row(nil), nil, nil)
 		RunQuery(t, e, harness, "INSERT INTO t4(v1) VALUES (7)")
 		TestQuery(t, harness, e, "SELECT * FROM t4 ORDER BY 1", []sql.Row{{int64(1), int64(7)}}, nil, nil)
@@ -1009,6 +1111,12 @@ func TestComplexIndexQueries(t *testing.T, harness Harness) {
 	}
 }
 
+func TestForeignKeys(t *testing.T, harness Harness) {
+	for _, script := range ForeignKeyTests {
+		TestScript(t, harness, script)
+	}
+}
+
 func TestTriggers(t *testing.T, harness Harness) {
 	for _, script := range TriggerTests {
 		TestScript(t, harness, script)
@@ -2476,6 +2584,8 @@ func TestChecksOnInsert(t *testing.T, harness Harness) {
 	AssertErr(t, e, harness, "INSERT INTO t1 (a,b,c) VALUES (2,2,' ABC')", sql.ErrCheckConstraintViolated)
 
 	RunQuery(t, e, harness, "INSERT INTO t1 VALUES (2,2,'ABC')")
+	AssertErr(t, e, harness, "INSERT INTO t1 VALUES (2,2,'ABC') ON DUPLICATE KEY UPDATE b = 0", sql.ErrCheckConstraintViolated)
+	AssertErr(t, e, harness, "INSERT INTO t1 VALUES (2,2,'ABC') ON DUPLICATE KEY UPDATE c = 'abc'", sql.ErrCheckConstraintViolated)
 	RunQuery(t, e, harness, "INSERT INTO t1 (a,b) VALUES (4,NULL)")
 
 	TestQuery(t, harness, e, `SELECT * FROM t1`, []sql.Row{
@@ -2899,6 +3009,71 @@ func TestWindowFunctions(t *testing.T, harness Harness) {
 		{5, "s"},
 	}, nil, nil)
 
+	TestQuery(t, harness, e, `SELECT a, sum(b) over (partition by c order by a) FROM t1 order by a`, []sql.Row{
+		{0, 0.0},
+		{1, 1.0},
+		{2, 2.0},
+		{3, 2.0},
+		{4, 3.0},
+		{5, 6.0},
+	}, nil, nil)
+
+	TestQuery(t, harness, e, `SELECT a, avg(b) over (order by b) FROM t1 order by a`, []sql.Row{
+		{0, 0.0},
+		{1, 0.5},
+		{2, 0.8},
+		{3, 0.0},
+		{4, 0.5},
+		{5, 1.1666666666666667},
+	}, nil, nil)
+
+	TestQuery(t, harness, e, `SELECT a, count(a) over (partition by b) FROM t1 order by a`, []sql.Row{
+		{0, 2},
+		{1, 2},
+		{2, 1},
+		{3, 2},
+		{4, 2},
+		{5, 1},
+	}, nil, nil)
+
+	TestQuery(t, harness, e, `SELECT a, sum(b) over (partition by c order by a rows between 1 preceding and current row) FROM t1 order by a`, []sql.Row{
+		{0, 0.0},
+		{1, 1.0},
+		{2, 2.0},
+		{3, 2.0},
+		{4, 1.0},
+		{5, 4.0},
+	}, nil, nil)
+
+	TestQuery(t, harness, e, `SELECT a, sum(b) over (partition by c order by a rows between current row and unbounded following) FROM t1 order by a`, []sql.Row{
+		{0, 6.0},
+		{1, 1.0},
+		{2, 6.0},
+		{3, 4.0},
+		{4, 4.0},
+		{5, 3.0},
+	}, nil, nil)
+
+	TestQuery(t, harness, e, `SELECT a, sum(b) over (partition by c order by a rows 2 preceding) FROM t1 order by a`, []sql.Row{
+		{0, 0.0},
+		{1, 1.0},
+		{2, 2.0},
+		{3, 2.0},
+		{4, 3.0},
+		{5, 4.0},
+	}, nil, nil)
+
+	TestQuery(t, harness, e, `SELECT a, count(a) over (partition by c order by b range between 1 preceding and 1 following) FROM t1 order by a`, []sql.Row{
+		{0, 3},
+		{1, 1},
+		{2, 3},
+		{3, 3},
+		{4, 4},
+		{5, 2},
+	}, nil, nil)
+
+	AssertErr(t, e, harness, "SELECT a, sum(b) over (partition by c order by b range between interval 1 day preceding and current row) FROM t1", aggregation.ErrInvalidWindowFrameOffset)
+	AssertErr(t, e, harness, "SELECT a, sum(b) over w FROM t1", sql.ErrUnknownWindowName)
 	AssertErr(t, e, harness, "SELECT a, lag(a, -1) over (partition by c) FROM t1", window.ErrInvalidLagOffset)
 	AssertErr(t, e, harness, "SELECT a, lag(a, 's') over (partition by c) FROM t1", window.ErrInvalidLagOffset)
 
@@ -3198,6 +3373,10 @@ func TestWarnings(t *testing.T, harness Harness) {
 			`,
 			Expected: nil,
 		},
+		{
+			Query:    `SHOW COUNT(*) WARNINGS`,
+			Expected: []sql.Row{{int64(3)}},
+		},
 	}
 
 	e := NewEngine(t, harness)
@@ -3375,6 +3554,128 @@ func TestTracing(t *testing.T, harness Harness) {
 	require.Equal(expectedSpans, spanOperations)
 }
 
+func TestQueryTag(t *testing.T, harness Harness) {
+	e := NewEngine(t, harness)
+	defer e.Close()
+
+	ctx := NewContext(harness)
+	TestQueryWithContext(t, ctx, e, "SELECT current_query_tag()", []sql.Row{{nil}}, nil, nil)
+
+	ctx = NewContext(harness).WithQueryTag("trace-1234")
+	TestQueryWithContext(t, ctx, e, "SELECT current_query_tag()", []sql.Row{{"trace-1234"}}, nil, nil)
+	TestQueryWithContext(t, ctx, e, "SELECT i, current_query_tag() FROM mytable WHERE i = 1", []sql.Row{{int64(1), "trace-1234"}}, nil, nil)
+}
+
+func TestQueryFingerprint(t *testing.T, harness Harness) {
+	e := NewEngine(t, harness)
+	defer e.Close()
+
+	q := "SELECT current_query_fingerprint() FROM mytable WHERE i IN (1, 2)"
+	ctx := NewContext(harness).WithQuery(q)
+	fingerprint := "select current_query_fingerprint() from mytable where i in (?)"
+	TestQueryWithContext(t, ctx, e, q, []sql.Row{{fingerprint}, {fingerprint}}, nil, nil)
+
+	q = "SELECT current_query_fingerprint() FROM mytable WHERE i IN (3)"
+	ctx = NewContext(harness).WithQuery(q)
+	TestQueryWithContext(t, ctx, e, q, []sql.Row{{fingerprint}}, nil, nil)
+}
+
+// recordingQueryLogger is a sqle.QueryLogger recording the statements it's notified of.
+type recordingQueryLogger struct {
+	started  []string
+	finished []sqle.QueryLogEntry
+}
+
+func (l *recordingQueryLogger) QueryStarted(_ *sql.Context, query string, _ map[string]sql.Expression) {
+	l.started = append(l.started, query)
+}
+
+func (l *recordingQueryLogger) QueryFinished(_ *sql.Context, entry sqle.QueryLogEntry) {
+	l.finished = append(l.finished, entry)
+}
+
+// recordingSlowQueryLogger is a sqle.SlowQueryLogger recording the slow statements it's notified of.
+type recordingSlowQueryLogger struct {
+	recordingQueryLogger
+	slow  []sqle.QueryLogEntry
+	plans []string
+}
+
+func (l *recordingSlowQueryLogger) SlowQuery(_ *sql.Context, entry sqle.QueryLogEntry, plan string) {
+	l.slow = append(l.slow, entry)
+	l.plans = append(l.plans, plan)
+}
+
+func TestQueryLogger(t *testing.T, harness Harness) {
+	require := require.New(t)
+	e := NewEngine(t, harness)
+	defer e.Close()
+
+	logger := &recordingQueryLogger{}
+	e.QueryLogger = logger
+	ctx := NewContext(harness)
+
+	queries := []string{
+		"SELECT i FROM mytable ORDER BY i",
+		"INSERT INTO mytable VALUES (10, 'ten'), (11, 'eleven')",
+		"SELECT * FROM doesnotexist",
+	}
+	for _, query := range queries {
+		_, iter, err := e.Query(ctx, query)
+		if err == nil {
+			_, err = sql.RowIterToRows(ctx, iter)
+			require.NoError(err)
+		}
+	}
+
+	require.Equal(queries, logger.started)
+	require.Len(logger.finished, 3)
+
+	selectEntry := logger.finished[0]
+	require.Equal(queries[0], selectEntry.Query)
+	require.Equal(3, selectEntry.RowsReturned)
+	require.Equal(uint64(0), selectEntry.RowsAffected)
+	require.NoError(selectEntry.Err)
+	require.False(selectEntry.Start.IsZero())
+
+	insertEntry := logger.finished[1]
+	require.Equal(queries[1], insertEntry.Query)
+	require.Equal(0, insertEntry.RowsReturned)
+	require.Equal(uint64(2), insertEntry.RowsAffected)
+	require.NoError(insertEntry.Err)
+
+	errorEntry := logger.finished[2]
+	require.Equal(queries[2], errorEntry.Query)
+	require.True(sql.ErrTableNotFound.Is(errorEntry.Err))
+}
+
+func TestSlowQueryLogger(t *testing.T, harness Harness) {
+	require := require.New(t)
+	e := NewEngine(t, harness)
+	defer e.Close()
+
+	logger := &recordingSlowQueryLogger{}
+	e.QueryLogger = logger
+	ctx := NewContext(harness)
+
+	for _, query := range []string{
+		"SET long_query_time = 0.1",
+		"SELECT SLEEP(0.2)",
+		"SELECT i FROM mytable ORDER BY i",
+	} {
+		_, iter, err := e.Query(ctx, query)
+		require.NoError(err)
+		_, err = sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+	}
+
+	require.Len(logger.finished, 3)
+	require.Len(logger.slow, 1)
+	require.Equal("SELECT SLEEP(0.2)", logger.slow[0].Query)
+	require.True(logger.slow[0].Duration > 100*time.Millisecond)
+	require.Contains(strings.ToLower(logger.plans[0]), "sleep")
+}
+
 func TestCurrentTimestamp(t *testing.T, harness Harness) {
 	e := NewEngine(t, harness)
 	defer e.Close()
@@ -3979,9 +4280,9 @@ func TestColumnDefaults(t *testing.T, harness Harness) {
 		TestQuery(t, harness, e, "SHOW CREATE TABLE t29", []sql.Row{{"t29", "CREATE TABLE `t29` (\n" +
 			"  `pk` bigint NOT NULL,\n" +
 			"  `v1y` bigint,\n" +
-			"  `v2` bigint DEFAULT ((v1y + 1)),\n" +
+			"  `v2` bigint DEFAULT ((`v1y` + 1)),\n" +
 			"  PRIMARY KEY (`pk`)\n" +
-			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4"}}, nil, nil)
+			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}}, nil, nil)
 	})
 
 	t.Run("Add multiple columns same ALTER", func(t *testing.T) {
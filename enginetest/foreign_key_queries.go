@@ -0,0 +1,321 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+var ForeignKeyTests = []ScriptTest{
+	{
+		Name: "inserting and updating child rows",
+		SetUpScript: []string{
+			"CREATE TABLE parent (id INT PRIMARY KEY, v INT);",
+			"CREATE TABLE child (id INT PRIMARY KEY, pid INT, CONSTRAINT fk_child FOREIGN KEY (pid) REFERENCES parent(id));",
+			"INSERT INTO parent VALUES (1, 1), (2, 2);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "INSERT INTO child VALUES (1, 1), (2, NULL);",
+				Expected: []sql.Row{{sql.NewOkResult(2)}},
+			},
+			{
+				Query:       "INSERT INTO child VALUES (3, 3);",
+				ExpectedErr: sql.ErrForeignKeyChildViolation,
+			},
+			{
+				Query:       "UPDATE child SET pid = 3 WHERE id = 1;",
+				ExpectedErr: sql.ErrForeignKeyChildViolation,
+			},
+			{
+				Query:    "UPDATE child SET pid = 2 WHERE id = 1;",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				Query:    "SELECT * FROM child ORDER BY id;",
+				Expected: []sql.Row{{1, 2}, {2, nil}},
+			},
+		},
+	},
+	{
+		Name: "deleting and updating parent rows with RESTRICT",
+		SetUpScript: []string{
+			"CREATE TABLE parent (id INT PRIMARY KEY, v INT);",
+			"CREATE TABLE child (id INT PRIMARY KEY, pid INT, CONSTRAINT fk_child FOREIGN KEY (pid) REFERENCES parent(id) ON DELETE RESTRICT ON UPDATE RESTRICT);",
+			"INSERT INTO parent VALUES (1, 1), (2, 2);",
+			"INSERT INTO child VALUES (1, 1);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "DELETE FROM parent WHERE id = 1;",
+				ExpectedErr: sql.ErrForeignKeyParentViolation,
+			},
+			{
+				Query:       "UPDATE parent SET id = 3 WHERE id = 1;",
+				ExpectedErr: sql.ErrForeignKeyParentViolation,
+			},
+			{
+				Query:    "UPDATE parent SET v = 10 WHERE id = 1;",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				Query:    "DELETE FROM parent WHERE id = 2;",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT * FROM parent;",
+				Expected: []sql.Row{{1, 10}},
+			},
+		},
+	},
+	{
+		Name: "cascading deletes and updates",
+		SetUpScript: []string{
+			"CREATE TABLE parent (id INT PRIMARY KEY, v INT);",
+			"CREATE TABLE child (id INT PRIMARY KEY, pid INT, CONSTRAINT fk_child FOREIGN KEY (pid) REFERENCES parent(id) ON DELETE CASCADE ON UPDATE CASCADE);",
+			"CREATE TABLE grandchild (id INT PRIMARY KEY, cid INT, CONSTRAINT fk_grandchild FOREIGN KEY (cid) REFERENCES child(id) ON DELETE CASCADE);",
+			"INSERT INTO parent VALUES (1, 1), (2, 2);",
+			"INSERT INTO child VALUES (1, 1), (2, 1), (3, 2);",
+			"INSERT INTO grandchild VALUES (1, 1), (2, 3);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "UPDATE parent SET id = 10 WHERE id = 1;",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				Query:    "SELECT * FROM child ORDER BY id;",
+				Expected: []sql.Row{{1, 10}, {2, 10}, {3, 2}},
+			},
+			{
+				Query:    "DELETE FROM parent WHERE id = 10;",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT * FROM child ORDER BY id;",
+				Expected: []sql.Row{{3, 2}},
+			},
+			{
+				Query:    "SELECT * FROM grandchild ORDER BY id;",
+				Expected: []sql.Row{{2, 3}},
+			},
+		},
+	},
+	{
+		Name: "setting child keys to NULL",
+		SetUpScript: []string{
+			"CREATE TABLE parent (id INT PRIMARY KEY, v INT);",
+			"CREATE TABLE child (id INT PRIMARY KEY, pid INT, CONSTRAINT fk_child FOREIGN KEY (pid) REFERENCES parent(id) ON DELETE SET NULL ON UPDATE SET NULL);",
+			"INSERT INTO parent VALUES (1, 1), (2, 2);",
+			"INSERT INTO child VALUES (1, 1), (2, 2);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "DELETE FROM parent WHERE id = 1;",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "UPDATE parent SET id = 3 WHERE id = 2;",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				Query:    "SELECT * FROM child ORDER BY id;",
+				Expected: []sql.Row{{1, nil}, {2, nil}},
+			},
+		},
+	},
+	{
+		Name: "failed statements don't write to the tables referencing the ones written to",
+		SetUpScript: []string{
+			"CREATE TABLE parent (id INT PRIMARY KEY, v INT);",
+			"CREATE TABLE child (id INT PRIMARY KEY, pid INT, CONSTRAINT fk_child FOREIGN KEY (pid) REFERENCES parent(id) ON DELETE CASCADE);",
+			"CREATE TABLE grandchild (id INT PRIMARY KEY, cid INT, CONSTRAINT fk_grandchild FOREIGN KEY (cid) REFERENCES child(id));",
+			"INSERT INTO parent VALUES (1, 1), (2, 2);",
+			"INSERT INTO child VALUES (1, 1), (2, 2);",
+			"INSERT INTO grandchild VALUES (1, 2);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "DELETE FROM parent;",
+				ExpectedErr: sql.ErrForeignKeyParentViolation,
+			},
+			{
+				Query:    "SELECT * FROM parent ORDER BY id;",
+				Expected: []sql.Row{{1, 1}, {2, 2}},
+			},
+			{
+				Query:    "SELECT * FROM child ORDER BY id;",
+				Expected: []sql.Row{{1, 1}, {2, 2}},
+			},
+		},
+	},
+	{
+		Name: "self-referential foreign keys",
+		SetUpScript: []string{
+			"CREATE TABLE tree (id INT PRIMARY KEY, pid INT, CONSTRAINT fk_tree FOREIGN KEY (pid) REFERENCES tree(id) ON DELETE CASCADE);",
+			"INSERT INTO tree VALUES (1, NULL), (2, 1), (3, 2), (4, 4), (5, NULL);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "INSERT INTO tree VALUES (6, 7);",
+				ExpectedErr: sql.ErrForeignKeyChildViolation,
+			},
+			{
+				Query:    "DELETE FROM tree WHERE id = 1;",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT * FROM tree ORDER BY id;",
+				Expected: []sql.Row{{4, 4}, {5, nil}},
+			},
+		},
+	},
+	{
+		Name: "cascades deeper than 15 foreign keys",
+		SetUpScript: []string{
+			"CREATE TABLE tree (id INT PRIMARY KEY, pid INT, CONSTRAINT fk_tree FOREIGN KEY (pid) REFERENCES tree(id) ON DELETE CASCADE);",
+			"INSERT INTO tree VALUES (1, NULL), (2, 1), (3, 2), (4, 3), (5, 4), (6, 5), (7, 6), (8, 7), (9, 8), (10, 9), (11, 10), (12, 11), (13, 12), (14, 13), (15, 14), (16, 15), (17, 16);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "DELETE FROM tree WHERE id = 1;",
+				ExpectedErr: sql.ErrForeignKeyCascadeDepth,
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM tree;",
+				Expected: []sql.Row{{int64(17)}},
+			},
+			{
+				Query:    "DELETE FROM tree WHERE id = 2;",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT * FROM tree;",
+				Expected: []sql.Row{{1, nil}},
+			},
+		},
+	},
+	{
+		Name: "foreign_key_checks disables enforcement",
+		SetUpScript: []string{
+			"CREATE TABLE parent (id INT PRIMARY KEY, v INT);",
+			"CREATE TABLE child (id INT PRIMARY KEY, pid INT, CONSTRAINT fk_child FOREIGN KEY (pid) REFERENCES parent(id) ON DELETE CASCADE);",
+			"INSERT INTO parent VALUES (1, 1);",
+			"INSERT INTO child VALUES (1, 1);",
+			"SET foreign_key_checks = 0;",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "INSERT INTO child VALUES (2, 2);",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "DELETE FROM parent;",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT * FROM child ORDER BY id;",
+				Expected: []sql.Row{{1, 1}, {2, 2}},
+			},
+		},
+	},
+	{
+		Name: "deleting copies of a row of a keyless table",
+		SetUpScript: []string{
+			"CREATE TABLE parent (id INT PRIMARY KEY, v INT);",
+			"CREATE TABLE child (pid INT, v INT, KEY pid_idx (pid), CONSTRAINT fk_child FOREIGN KEY (pid) REFERENCES parent(id) ON DELETE CASCADE);",
+			"INSERT INTO parent VALUES (1, 1), (2, 2);",
+			"INSERT INTO child VALUES (1, 1), (1, 1), (2, 2), (2, 2);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "DELETE FROM child WHERE pid = 1;",
+				Expected: []sql.Row{{sql.NewOkResult(2)}},
+			},
+			{
+				Query:    "DELETE FROM parent WHERE id = 2;",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM child;",
+				Expected: []sql.Row{{int64(0)}},
+			},
+		},
+	},
+	{
+		Name: "multi-table DELETE",
+		SetUpScript: []string{
+			"CREATE TABLE parent (id INT PRIMARY KEY, v INT);",
+			"CREATE TABLE child (id INT PRIMARY KEY, pid INT, CONSTRAINT fk_child FOREIGN KEY (pid) REFERENCES parent(id) ON DELETE RESTRICT);",
+			"CREATE TABLE cascaded (id INT PRIMARY KEY, pid INT, CONSTRAINT fk_cascaded FOREIGN KEY (pid) REFERENCES parent(id) ON DELETE CASCADE);",
+			"CREATE TABLE other (id INT PRIMARY KEY);",
+			"INSERT INTO parent VALUES (1, 1), (2, 2), (3, 3);",
+			"INSERT INTO child VALUES (1, 1), (2, 2);",
+			"INSERT INTO cascaded VALUES (1, 1), (3, 3);",
+			"INSERT INTO other VALUES (1), (2), (3);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "DELETE parent FROM parent JOIN other ON parent.id = other.id WHERE other.id = 1;",
+				ExpectedErr: sql.ErrForeignKeyParentViolation,
+			},
+			{
+				Query:    "DELETE c, p FROM parent p JOIN child c ON p.id = c.pid WHERE p.id = 1;",
+				Expected: []sql.Row{{sql.NewOkResult(2)}},
+			},
+			{
+				Query:    "DELETE parent FROM parent JOIN other ON parent.id = other.id WHERE other.id = 3;",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT * FROM parent ORDER BY id;",
+				Expected: []sql.Row{{2, 2}},
+			},
+			{
+				Query:    "SELECT * FROM child ORDER BY id;",
+				Expected: []sql.Row{{2, 2}},
+			},
+			{
+				Query:    "SELECT * FROM cascaded ORDER BY id;",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name: "UPDATE JOIN",
+		SetUpScript: []string{
+			"CREATE TABLE parent (id INT PRIMARY KEY, v INT);",
+			"CREATE TABLE child (id INT PRIMARY KEY, pid INT, CONSTRAINT fk_child FOREIGN KEY (pid) REFERENCES parent(id) ON UPDATE CASCADE);",
+			"CREATE TABLE other (id INT PRIMARY KEY, v INT);",
+			"INSERT INTO parent VALUES (1, 1), (2, 2);",
+			"INSERT INTO child VALUES (1, 1), (2, 2);",
+			"INSERT INTO other VALUES (1, 10), (2, 20);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "UPDATE child JOIN other ON child.id = other.id SET child.pid = other.v WHERE other.id = 1;",
+				ExpectedErr: sql.ErrForeignKeyChildViolation,
+			},
+			{
+				Query:    "UPDATE parent JOIN other ON parent.id = other.id SET parent.id = other.v WHERE other.id = 1;",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				Query:    "SELECT * FROM child ORDER BY id;",
+				Expected: []sql.Row{{1, 10}, {2, 2}},
+			},
+		},
+	},
+}
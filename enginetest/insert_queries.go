@@ -732,6 +732,31 @@ var InsertScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "alter auto_increment value below the largest value",
+		SetUpScript: []string{
+			`create table auto (
+				pk int auto_increment,
+				c0 int,
+				primary key(pk)
+			);`,
+			"insert into auto values (NULL,10), (NULL,20), (5,50)",
+			"alter table auto auto_increment = 2;",
+			"insert into auto values (NULL,60)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "select * from auto order by 1",
+				Expected: []sql.Row{
+					{1, 10}, {2, 20}, {5, 50}, {6, 60},
+				},
+			},
+			{
+				Query:    "select last_insert_id()",
+				Expected: []sql.Row{{6}},
+			},
+		},
+	},
 	{
 		Name: "auto increment on tinyint",
 		SetUpScript: []string{
@@ -1052,6 +1077,31 @@ var InsertScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "ON DUPLICATE KEY UPDATE with a unique key collision",
+		SetUpScript: []string{
+			"CREATE TABLE test (pk int PRIMARY KEY, u varchar(10), n int, UNIQUE KEY (u))",
+			"INSERT INTO test VALUES (1, 'one', 1), (2, 'two', 2), (3, NULL, 3)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "INSERT INTO test VALUES (10, 'one', 10) ON DUPLICATE KEY UPDATE n = VALUES(n)",
+				Expected: []sql.Row{{sql.NewOkResult(2)}},
+			},
+			{
+				Query:    "INSERT INTO test VALUES (20, 'two', 2) ON DUPLICATE KEY UPDATE n = VALUES(n)",
+				Expected: []sql.Row{{sql.NewOkResult(0)}},
+			},
+			{
+				Query:    "INSERT INTO test VALUES (30, NULL, 30) ON DUPLICATE KEY UPDATE n = VALUES(n)",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT * FROM test ORDER BY pk",
+				Expected: []sql.Row{{1, "one", 10}, {2, "two", 2}, {3, nil, 3}, {30, nil, 30}},
+			},
+		},
+	},
 }
 
 var InsertErrorTests = []GenericErrorQueryTest{
@@ -1257,40 +1307,44 @@ var InsertIgnoreScripts = []ScriptTest{
 			},
 		},
 	},
-}
-
-var InsertBrokenScripts = []ScriptTest{
-	// TODO: Support unique keys and FK violations in memory implementation
 	{
-		Name: "Test that INSERT IGNORE INTO works with unique keys",
+		Name: "Test that INSERT IGNORE works with FK Violations",
 		SetUpScript: []string{
-			"CREATE TABLE mytable(pk int PRIMARY KEY, value varchar(10) UNIQUE)",
-			"INSERT INTO mytable values (1,'one')",
+			"CREATE TABLE t1 (id INT PRIMARY KEY, v int);",
+			"CREATE TABLE t2 (id INT PRIMARY KEY, v2 int, CONSTRAINT mfk FOREIGN KEY (v2) REFERENCES t1(id));",
+			"INSERT INTO t1 values (1,1)",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query: "INSERT IGNORE INTO mytable VALUES (2, 'one')",
+				Query: "INSERT IGNORE INTO t2 VALUES (1,2);",
 				Expected: []sql.Row{
 					{sql.OkResult{RowsAffected: 0}},
 				},
-				ExpectedWarning: mysql.ERDupEntry,
+				ExpectedWarning: mysql.ErNoReferencedRow2,
+			},
+			{
+				Query:    "SELECT * FROM t2",
+				Expected: []sql.Row{},
 			},
 		},
 	},
+}
+
+var InsertBrokenScripts = []ScriptTest{
+	// TODO: Support unique keys in memory implementation
 	{
-		Name: "Test that INSERT IGNORE works with FK Violations",
+		Name: "Test that INSERT IGNORE INTO works with unique keys",
 		SetUpScript: []string{
-			"CREATE TABLE t1 (id INT PRIMARY KEY, v int);",
-			"CREATE TABLE t2 (id INT PRIMARY KEY, v2 int, CONSTRAINT mfk FOREIGN KEY (v2) REFERENCES t1(id));",
-			"INSERT INTO t1 values (1,1)",
+			"CREATE TABLE mytable(pk int PRIMARY KEY, value varchar(10) UNIQUE)",
+			"INSERT INTO mytable values (1,'one')",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				Query: "INSERT IGNORE INTO t2 VALUES (1,2);",
+				Query: "INSERT IGNORE INTO mytable VALUES (2, 'one')",
 				Expected: []sql.Row{
 					{sql.OkResult{RowsAffected: 0}},
 				},
-				ExpectedWarning: mysql.ErNoReferencedRow2,
+				ExpectedWarning: mysql.ERDupEntry,
 			},
 		},
 	},
@@ -74,6 +74,38 @@ var LoadDataScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "Load data into named columns in a different order",
+		SetUpScript: []string{
+			"create table loadtable(c1 longtext, pk int primary key, c2 int)",
+			"LOAD DATA INFILE './testdata/test2.csv' INTO TABLE loadtable FIELDS TERMINATED BY ',' IGNORE 1 LINES (pk, c1)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select * from loadtable ORDER BY pk",
+				Expected: []sql.Row{{"hi", int8(1), nil}, {"hello", int8(2), nil}},
+			},
+		},
+	},
+	{
+		Name: "Load data reports a summary",
+		SetUpScript: []string{
+			"create table loadtable(pk int primary key)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "LOAD DATA INFILE './testdata/test1.txt' INTO TABLE loadtable FIELDS ENCLOSED BY '\"'",
+				Expected: []sql.Row{{sql.OkResult{
+					RowsAffected: 4,
+					Info:         plan.LoadDataInfo{Records: 4},
+				}}},
+			},
+			{
+				Query:    "select * from loadtable ORDER BY pk",
+				Expected: []sql.Row{{int8(1)}, {int8(2)}, {int8(3)}, {int8(4)}},
+			},
+		},
+	},
 }
 
 var LoadDataErrorScripts = []ScriptTest{
@@ -332,6 +332,14 @@ func TestReadOnlyDatabases(t *testing.T) {
 	enginetest.TestReadOnlyDatabases(t, enginetest.NewMemoryHarness("default", 1, testNumPartitions, true, mergableIndexDriver))
 }
 
+func TestExplainAnalyze(t *testing.T) {
+	enginetest.TestExplainAnalyze(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestShowCreateTableRoundTrip(t *testing.T) {
+	enginetest.TestShowCreateTableRoundTrip(t, enginetest.NewDefaultMemoryHarness())
+}
+
 func TestColumnAliases(t *testing.T) {
 	enginetest.TestColumnAliases(t, enginetest.NewDefaultMemoryHarness())
 }
@@ -427,6 +435,10 @@ func TestComplexIndexQueries(t *testing.T) {
 	enginetest.TestComplexIndexQueries(t, harness)
 }
 
+func TestForeignKeys(t *testing.T) {
+	enginetest.TestForeignKeys(t, enginetest.NewDefaultMemoryHarness())
+}
+
 func TestTriggers(t *testing.T) {
 	enginetest.TestTriggers(t, enginetest.NewDefaultMemoryHarness())
 }
@@ -889,6 +889,34 @@ var ProcedureCallTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "OUT and INOUT params must be variables",
+		SetUpScript: []string{
+			"CREATE PROCEDURE p1(IN x BIGINT, OUT y BIGINT) SET y = x",
+			"CREATE PROCEDURE p2(INOUT x BIGINT) SET x = x + 1",
+			"CREATE PROCEDURE p3(INOUT x BIGINT) CALL p2(x)",
+			"SET @a = 5",
+			"CALL p3(@a)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "CALL p1(1, 2)",
+				ExpectedErr: sql.ErrCallOutParamNotVariable,
+			},
+			{
+				Query:       "CALL p2(@@autocommit)",
+				ExpectedErr: sql.ErrCallOutParamNotVariable,
+			},
+			{
+				Query:       "CALL p2(@a + 1)",
+				ExpectedErr: sql.ErrCallOutParamNotVariable,
+			},
+			{
+				Query:    "SELECT @a",
+				Expected: []sql.Row{{int64(6)}},
+			},
+		},
+	},
 }
 
 var ProcedureDropTests = []ScriptTest{
@@ -1126,4 +1154,28 @@ var ProcedureShowStatus = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "information_schema routines and parameters",
+		SetUpScript: []string{
+			"CREATE PROCEDURE p1(IN a BIGINT, OUT b VARCHAR(20), INOUT c INT) COMMENT 'hi' DETERMINISTIC READS SQL DATA SELECT a",
+			"CREATE definer=`user` PROCEDURE p2() SQL SECURITY INVOKER SELECT 7",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SELECT routine_schema, routine_name, routine_type, routine_definition, is_deterministic, sql_data_access, security_type, routine_comment, definer FROM information_schema.routines ORDER BY routine_name",
+				Expected: []sql.Row{
+					{"mydb", "p1", "PROCEDURE", "SELECT a", "YES", "READS SQL DATA", "DEFINER", "hi", ""},
+					{"mydb", "p2", "PROCEDURE", "SELECT 7", "NO", "CONTAINS SQL", "INVOKER", "", "user"},
+				},
+			},
+			{
+				Query: "SELECT specific_name, ordinal_position, parameter_mode, parameter_name, data_type, routine_type FROM information_schema.parameters ORDER BY specific_name, ordinal_position",
+				Expected: []sql.Row{
+					{"p1", uint64(1), "IN", "a", "bigint", "PROCEDURE"},
+					{"p1", uint64(2), "OUT", "b", "varchar(20)", "PROCEDURE"},
+					{"p1", uint64(3), "INOUT", "c", "int", "PROCEDURE"},
+				},
+			},
+		},
+	},
 }
@@ -41,7 +41,7 @@ var SpatialQueryTests = []QueryTest{
 				"  `i` bigint NOT NULL,\n" +
 				"  `p` point NOT NULL,\n" +
 				"  PRIMARY KEY (`i`)\n" +
-				") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+				") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin",
 		}},
 	},
 	{
@@ -52,7 +52,7 @@ var SpatialQueryTests = []QueryTest{
 				"  `i` bigint NOT NULL,\n" +
 				"  `l` linestring NOT NULL,\n" +
 				"  PRIMARY KEY (`i`)\n" +
-				") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+				") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin",
 		}},
 	},
 	{
@@ -63,7 +63,7 @@ var SpatialQueryTests = []QueryTest{
 				"  `i` bigint NOT NULL,\n" +
 				"  `p` polygon NOT NULL,\n" +
 				"  PRIMARY KEY (`i`)\n" +
-				") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+				") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin",
 		}},
 	},
 	{
@@ -148,6 +148,50 @@ var SpatialQueryTests = []QueryTest{
 		Query:    `SELECT ST_Y(POINT(1,2),99.9)`,
 		Expected: []sql.Row{{sql.Point{X: 1, Y: 99.9}}},
 	},
+	{
+		Query:    `SELECT ST_AREA(POLYGON(LINESTRING(POINT(0,0),POINT(0,2),POINT(2,2),POINT(2,0),POINT(0,0))))`,
+		Expected: []sql.Row{{4.0}},
+	},
+	{
+		Query:    `SELECT ST_AREA(POINT(1,2))`,
+		Expected: []sql.Row{{0.0}},
+	},
+	{
+		Query:    `SELECT ST_LENGTH(LINESTRING(POINT(0,0),POINT(3,4)))`,
+		Expected: []sql.Row{{5.0}},
+	},
+	{
+		Query:    `SELECT ST_LENGTH(POINT(1,2))`,
+		Expected: []sql.Row{{0.0}},
+	},
+	{
+		Query:    `SELECT ST_ASWKT(MULTIPOINT(POINT(1,2),POINT(3,4)))`,
+		Expected: []sql.Row{{"MULTIPOINT((1 2),(3 4))"}},
+	},
+	{
+		Query:    `SELECT ST_ASWKT(ST_GEOMFROMTEXT('MULTILINESTRING((1 2,3 4),(5 6,7 8))'))`,
+		Expected: []sql.Row{{"MULTILINESTRING((1 2,3 4),(5 6,7 8))"}},
+	},
+	{
+		Query:    `SELECT ST_AREA(ST_GEOMFROMTEXT('MULTIPOLYGON(((0 0,0 2,2 2,2 0,0 0)),((3 3,3 4,4 4,4 3,3 3)))'))`,
+		Expected: []sql.Row{{5.0}},
+	},
+	{
+		Query:    `SELECT ST_ASWKT(GEOMETRYCOLLECTION(POINT(1,2),LINESTRING(POINT(1,2),POINT(3,4))))`,
+		Expected: []sql.Row{{"GEOMETRYCOLLECTION(POINT(1 2),LINESTRING(1 2,3 4))"}},
+	},
+	{
+		Query:    `SELECT ST_SRID(ST_GEOMFROMTEXT('MULTIPOINT(1 2,3 4)', 4326))`,
+		Expected: []sql.Row{{uint32(4326)}},
+	},
+	{
+		Query:    `SELECT ST_LATITUDE(ST_GEOMFROMTEXT('POINT(45 90)', 4326)), ST_LONGITUDE(ST_GEOMFROMTEXT('POINT(45 90)', 4326))`,
+		Expected: []sql.Row{{45.0, 90.0}},
+	},
+	{
+		Query:    `SELECT ST_ASWKT(ST_LONGITUDE(ST_GEOMFROMTEXT('POINT(45 90)', 4326), 10))`,
+		Expected: []sql.Row{{"POINT(45 10)"}},
+	},
 	{
 		Query:    `SELECT ST_X(p) from point_table`,
 		Expected: []sql.Row{{1.0}},
@@ -952,6 +996,26 @@ var QueryTests = []QueryTest{
 			{12},
 		},
 	},
+	{
+		Query:    "WITH mytable as (select 10 as i) SELECT i FROM mytable",
+		Expected: []sql.Row{{10}},
+	},
+	{
+		Query: "WITH mytable as (select 10 as i) SELECT i FROM mydb.mytable ORDER BY i",
+		Expected: []sql.Row{
+			{int64(1)},
+			{int64(2)},
+			{int64(3)},
+		},
+	},
+	{
+		Query: "WITH mytable as (select i+1 as i FROM mytable) SELECT i FROM mytable ORDER BY i",
+		Expected: []sql.Row{
+			{int64(2)},
+			{int64(3)},
+			{int64(4)},
+		},
+	},
 	{
 		Query: "WITH mt as (select i,s FROM mytable) SELECT s,i FROM mt UNION SELECT s, i FROM mt;",
 		Expected: []sql.Row{
@@ -1031,6 +1095,13 @@ var QueryTests = []QueryTest{
 			{"third row"},
 		},
 	},
+	{
+		Query: "SELECT i FROM mytable WHERE i + 0 IN (SELECT CONVERT(i2, UNSIGNED) FROM othertable WHERE i2 < 3) ORDER BY i",
+		Expected: []sql.Row{
+			{int64(1)},
+			{int64(2)},
+		},
+	},
 	{
 		Query: "SELECT mytable.s FROM mytable WHERE mytable.i = (SELECT othertable.i2 FROM othertable WHERE othertable.s2 = 'second')",
 		Expected: []sql.Row{
@@ -1251,6 +1322,14 @@ var QueryTests = []QueryTest{
 		Query:    "SELECT i + 1 FROM mytable;",
 		Expected: []sql.Row{{int64(2)}, {int64(3)}, {int64(4)}},
 	},
+	{
+		Query:    "SELECT i + 1, (i + 1) * 2 FROM mytable WHERE i + 1 > 2 ORDER BY i;",
+		Expected: []sql.Row{{int64(3), int64(6)}, {int64(4), int64(8)}},
+	},
+	{
+		Query:    "SELECT i + 1 AS x, COUNT(i + 1) FROM mytable WHERE i + 1 > 2 GROUP BY i + 1 ORDER BY x;",
+		Expected: []sql.Row{{int64(3), int64(1)}, {int64(4), int64(1)}},
+	},
 	{
 		Query:    "SELECT i div 2 FROM mytable order by 1;",
 		Expected: []sql.Row{{int64(0)}, {int64(1)}, {int64(1)}},
@@ -1455,6 +1534,18 @@ var QueryTests = []QueryTest{
 		Query:    "SELECT * from mytable where (i = 1 & false) IN (true)",
 		Expected: []sql.Row{},
 	},
+	{
+		Query:    "SELECT 1 << 2, 5 & 3, 5 | 3, 5 ^ 3, ~0, -1 >> 60, 1 << 64",
+		Expected: []sql.Row{{uint64(4), uint64(1), uint64(7), uint64(6), uint64(18446744073709551615), uint64(15), uint64(0)}},
+	},
+	{
+		Query:    "SELECT i FROM mytable WHERE i & 2 = 2 ORDER BY i",
+		Expected: []sql.Row{{int64(2)}, {int64(3)}},
+	},
+	{
+		Query:    "SELECT BIT_COUNT(7), BIT_COUNT(-1), BIT_COUNT(NULL)",
+		Expected: []sql.Row{{int64(3), int64(64), nil}},
+	},
 	{
 		Query:    `SELECT * FROM mytable WHERE i in (2*i)`,
 		Expected: []sql.Row{},
@@ -1888,6 +1979,22 @@ var QueryTests = []QueryTest{
 		Query:    "SELECT i FROM niltable WHERE i2 IS NOT NULL ORDER BY 1",
 		Expected: []sql.Row{{int64(2)}, {int64(4)}, {int64(6)}},
 	},
+	{
+		Query:    "SELECT i, i2 FROM niltable ORDER BY i2, i",
+		Expected: []sql.Row{{int64(1), nil}, {int64(3), nil}, {int64(5), nil}, {int64(2), int64(2)}, {int64(4), int64(4)}, {int64(6), int64(6)}},
+	},
+	{
+		Query:    "SELECT i, i2 FROM niltable ORDER BY i2 DESC, i",
+		Expected: []sql.Row{{int64(6), int64(6)}, {int64(4), int64(4)}, {int64(2), int64(2)}, {int64(1), nil}, {int64(3), nil}, {int64(5), nil}},
+	},
+	{
+		Query:    "SELECT i, i2 FROM niltable WHERE i2 IS NULL OR i2 > 3 ORDER BY i2, i",
+		Expected: []sql.Row{{int64(1), nil}, {int64(3), nil}, {int64(5), nil}, {int64(4), int64(4)}, {int64(6), int64(6)}},
+	},
+	{
+		Query:    "SELECT i, i2 FROM niltable WHERE i2 IS NULL OR i2 > 3 ORDER BY i2 DESC, i",
+		Expected: []sql.Row{{int64(6), int64(6)}, {int64(4), int64(4)}, {int64(1), nil}, {int64(3), nil}, {int64(5), nil}},
+	},
 	{
 		Query:    "select i from datetime_table where date_col = date('2019-12-31T12:00:00')",
 		Expected: []sql.Row{{1}},
@@ -2242,6 +2349,18 @@ var QueryTests = []QueryTest{
 		Query:    `SELECT INET_ATON("notanipaddress")`,
 		Expected: []sql.Row{{nil}},
 	},
+	{
+		Query:    `SELECT INET_ATON("127.1"), INET_ATON("::ffff:1.2.3.4"), INET_NTOA(3232235777), INET_NTOA(-1)`,
+		Expected: []sql.Row{{uint64(2130706433), nil, "192.168.1.1", nil}},
+	},
+	{
+		Query:    `SELECT HEX(INET6_ATON("::ffff:1.2.3.4")), INET6_NTOA(INET6_ATON("::ffff:1.2.3.4"))`,
+		Expected: []sql.Row{{"00000000000000000000FFFF01020304", "::ffff:1.2.3.4"}},
+	},
+	{
+		Query:    `SELECT INET6_NTOA(INET6_ATON("2001:0DB8:0000:0000:0000:0000:0000:0001"))`,
+		Expected: []sql.Row{{"2001:db8::1"}},
+	},
 	{
 		Query:    `SELECT INET_NTOA("spaghetti")`,
 		Expected: []sql.Row{{"0.0.0.0"}},
@@ -2298,6 +2417,18 @@ var QueryTests = []QueryTest{
 		Query:    `SELECT INET6_NTOA("notanipaddress")`,
 		Expected: []sql.Row{{nil}},
 	},
+	{
+		Query:    `SELECT UNCOMPRESS(COMPRESS("hello world")), UNCOMPRESSED_LENGTH(COMPRESS(REPEAT("a", 1000)))`,
+		Expected: []sql.Row{{"hello world", uint64(1000)}},
+	},
+	{
+		Query:    `SELECT UNCOMPRESS(UNHEX("01000000789C4B040000620062"))`,
+		Expected: []sql.Row{{"a"}},
+	},
+	{
+		Query:    `SELECT COMPRESS(""), UNCOMPRESS(""), UNCOMPRESS("not compressed"), UNCOMPRESS(NULL)`,
+		Expected: []sql.Row{{"", "", nil, nil}},
+	},
 	{
 		Query:    `SELECT IS_IPV4("10.0.1.10")`,
 		Expected: []sql.Row{{true}},
@@ -2310,6 +2441,14 @@ var QueryTests = []QueryTest{
 		Query:    `SELECT IS_IPV4("notanipaddress")`,
 		Expected: []sql.Row{{false}},
 	},
+	{
+		Query:    `SELECT IS_IPV4("010.0.1.10"), IS_IPV4("10.1"), IS_IPV4("::ffff:10.0.1.10"), IS_IPV4(NULL)`,
+		Expected: []sql.Row{{false, false, false, nil}},
+	},
+	{
+		Query:    `SELECT IS_IPV4_MAPPED(INET6_ATON("::ffff:10.0.1.10")), IS_IPV4_COMPAT(INET6_ATON("::ffff:10.0.1.10"))`,
+		Expected: []sql.Row{{true, false}},
+	},
 	{
 		Query:    `SELECT IS_IPV6("10.0.1.10")`,
 		Expected: []sql.Row{{false}},
@@ -2629,6 +2768,18 @@ var QueryTests = []QueryTest{
 			{2},
 		},
 	},
+	{
+		Query: "SELECT t1.i FROM mytable t1 STRAIGHT_JOIN mytable t2 on t1.i = t2.i + 1 where t1.i = 2 and t2.i = 1",
+		Expected: []sql.Row{
+			{2},
+		},
+	},
+	{
+		Query: "SELECT /*+ JOIN_FIXED_ORDER() */ t1.i FROM mytable t1 JOIN mytable t2 on t1.i = t2.i + 1 where t1.i = 2 and t2.i = 1",
+		Expected: []sql.Row{
+			{2},
+		},
+	},
 	{
 		Query: "SELECT /*+ JOIN_ORDER(t1) */ t1.i FROM mytable t1 JOIN mytable t2 on t1.i = t2.i + 1 where t1.i = 2 and t2.i = 1",
 		Expected: []sql.Row{
@@ -3152,6 +3303,12 @@ var QueryTests = []QueryTest{
 			{int32(1234567890)},
 		},
 	},
+	{
+		Query: `SELECT COALESCE(NULL, 1234567890, 'example')`,
+		Expected: []sql.Row{
+			{string("1234567890")},
+		},
+	},
 	{
 		Query: "SELECT concat(s, i) FROM mytable",
 		Expected: []sql.Row{
@@ -3169,12 +3326,20 @@ var QueryTests = []QueryTest{
 	{
 		Query: `SELECT RAND(100)`,
 		Expected: []sql.Row{
-			{float64(0.8165026937796166)},
+			{float64(0.17353134804734155)},
 		},
 	},
 	{
 		Query:    `SELECT RAND(i) from mytable order by i`,
-		Expected: []sql.Row{{0.6046602879796196}, {0.16729663442585624}, {0.7199826688373036}},
+		Expected: []sql.Row{{0.40540353712197724}, {0.6555866465490187}, {0.9057697559760601}},
+	},
+	{
+		Query:    `SELECT i, RAND(3) from mytable order by i`,
+		Expected: []sql.Row{{int64(1), 0.9057697559760601}, {int64(2), 0.37307905813034536}, {int64(3), 0.14808605345719125}},
+	},
+	{
+		Query:    `SELECT '5abc' = 5, 'abc' = 0, '1.5' = 1, i FROM mytable WHERE i = '2'`,
+		Expected: []sql.Row{{true, true, false, int64(2)}},
 	},
 	{
 		Query: `SELECT RAND(100) = RAND(100)`,
@@ -3188,6 +3353,18 @@ var QueryTests = []QueryTest{
 			{false},
 		},
 	},
+	{
+		Query:    `SELECT COUNT(*) FROM (SELECT i FROM mytable ORDER BY RAND(7) LIMIT 2) t`,
+		Expected: []sql.Row{{int64(2)}},
+	},
+	{
+		Query:    `SELECT LENGTH(RANDOM_BYTES(16)), RANDOM_BYTES(16) = RANDOM_BYTES(16), RANDOM_BYTES(NULL)`,
+		Expected: []sql.Row{{int32(16), false, nil}},
+	},
+	{
+		Query:    `SELECT BENCHMARK(1000, MD5('abc')), BENCHMARK(-1, 1), BENCHMARK(NULL, 1)`,
+		Expected: []sql.Row{{int32(0), nil, nil}},
+	},
 	{
 		Query: "SELECT SIN(i) from mytable order by i limit 1",
 		Expected: []sql.Row{
@@ -3374,6 +3551,14 @@ var QueryTests = []QueryTest{
 		Query:    "SELECT i FROM mytable UNION SELECT i FROM mytable UNION ALL SELECT i FROM mytable;",
 		Expected: []sql.Row{{int64(1)}, {int64(2)}, {int64(3)}, {int64(1)}, {int64(2)}, {int64(3)}},
 	},
+	{
+		Query:    "SELECT i FROM mytable UNION SELECT i+1 FROM mytable UNION SELECT i+2 FROM mytable;",
+		Expected: []sql.Row{{int64(1)}, {int64(2)}, {int64(3)}, {int64(4)}, {int64(5)}},
+	},
+	{
+		Query:    "SELECT i FROM mytable UNION (SELECT i+1 FROM mytable UNION ALL SELECT i+1 FROM mytable);",
+		Expected: []sql.Row{{int64(1)}, {int64(2)}, {int64(3)}, {int64(4)}},
+	},
 	{
 		Query: "SELECT i FROM mytable UNION SELECT s FROM mytable;",
 		Expected: []sql.Row{
@@ -3425,11 +3610,8 @@ var QueryTests = []QueryTest{
 		},
 	},
 	{
-		Query: `SELECT s FROM mytable WHERE s LIKE '%D ROW'`,
-		Expected: []sql.Row{
-			{"second row"},
-			{"third row"},
-		},
+		Query:    `SELECT s FROM mytable WHERE s LIKE '%D ROW'`,
+		Expected: []sql.Row{},
 	},
 	{
 		Query: `SELECT SUBSTRING(s, -3, 3) AS s FROM mytable WHERE s LIKE '%d row' GROUP BY 1`,
@@ -3596,7 +3778,7 @@ var QueryTests = []QueryTest{
 				"  `c4` tinyint NOT NULL,\n" +
 				"  `c5` tinyint NOT NULL,\n" +
 				"  PRIMARY KEY (`pk1`,`pk2`)\n" +
-				") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+				") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin",
 		}},
 	},
 	{
@@ -3791,6 +3973,20 @@ var QueryTests = []QueryTest{
 			{nil},
 		},
 	},
+	{
+		Query: `SELECT CASE i WHEN 1 THEN i ELSE 2.5 END FROM mytable ORDER BY i`,
+		Expected: []sql.Row{
+			{float64(1)},
+			{2.5},
+			{2.5},
+		},
+	},
+	{
+		Query: `SELECT CASE NULL WHEN NULL THEN 'null' ELSE 'not null' END`,
+		Expected: []sql.Row{
+			{"not null"},
+		},
+	},
 	{
 		Query: "SHOW COLLATION WHERE `Collation` IN ('binary', 'utf8_general_ci', 'utf8mb4_0900_ai_ci')",
 		Expected: []sql.Row{
@@ -4125,11 +4321,15 @@ var QueryTests = []QueryTest{
 	},
 	{
 		Query:    `SELECT GREATEST(1, 2, "3", 4)`,
-		Expected: []sql.Row{{float64(4)}},
+		Expected: []sql.Row{{"4"}},
 	},
 	{
 		Query:    `SELECT GREATEST(1, 2, "9", "foo999")`,
-		Expected: []sql.Row{{float64(9)}},
+		Expected: []sql.Row{{"foo999"}},
+	},
+	{
+		Query:    `SELECT GREATEST('10', 9), GREATEST(10, 9), GREATEST(10, 9.5), GREATEST(10, NULL)`,
+		Expected: []sql.Row{{"9", int64(10), float64(10), nil}},
 	},
 	{
 		Query:    `SELECT GREATEST("aaa", "bbb", "ccc")`,
@@ -4137,7 +4337,7 @@ var QueryTests = []QueryTest{
 	},
 	{
 		Query:    `SELECT GREATEST(i, s) FROM mytable`,
-		Expected: []sql.Row{{float64(1)}, {float64(2)}, {float64(3)}},
+		Expected: []sql.Row{{"first row"}, {"second row"}, {"third row"}},
 	},
 	{
 		Query:    "select abs(-i) from mytable order by 1",
@@ -4285,11 +4485,15 @@ var QueryTests = []QueryTest{
 	},
 	{
 		Query:    `SELECT LEAST(1, 2, "3", 4)`,
-		Expected: []sql.Row{{float64(1)}},
+		Expected: []sql.Row{{"1"}},
 	},
 	{
 		Query:    `SELECT LEAST(1, 2, "9", "foo999")`,
-		Expected: []sql.Row{{float64(1)}},
+		Expected: []sql.Row{{"1"}},
+	},
+	{
+		Query:    `SELECT LEAST('10', 9), LEAST(10, 9), LEAST(10, 9.5), LEAST(10, NULL)`,
+		Expected: []sql.Row{{"10", int64(9), float64(9.5), nil}},
 	},
 	{
 		Query:    `SELECT LEAST("aaa", "bbb", "ccc")`,
@@ -4297,7 +4501,7 @@ var QueryTests = []QueryTest{
 	},
 	{
 		Query:    `SELECT LEAST(i, s) FROM mytable`,
-		Expected: []sql.Row{{float64(1)}, {float64(2)}, {float64(3)}},
+		Expected: []sql.Row{{"1"}, {"2"}, {"3"}},
 	},
 	{
 		Query:    `SELECT LEAST(CAST("1920-02-03 07:41:11" AS DATETIME), CAST("1980-06-22 14:32:56" AS DATETIME))`,
@@ -4425,6 +4629,14 @@ var QueryTests = []QueryTest{
 		Query:    `SELECT 1 FROM mytable GROUP BY i HAVING i > 1`,
 		Expected: []sql.Row{{int8(1)}, {int8(1)}},
 	},
+	{
+		Query:    `SELECT MEDIAN(i) FROM mytable`,
+		Expected: []sql.Row{{float64(2)}},
+	},
+	{
+		Query:    `SELECT MEDIAN(i) FROM mytable GROUP BY i HAVING MEDIAN(i) > 1`,
+		Expected: []sql.Row{{float64(2)}, {float64(3)}},
+	},
 	{
 		Query:    `SELECT avg(i) FROM mytable GROUP BY i HAVING avg(i) > 1`,
 		Expected: []sql.Row{{float64(2)}, {float64(3)}},
@@ -5598,6 +5810,14 @@ var QueryTests = []QueryTest{
 		Query:    "SELECT 2.0 + CAST(5 AS DECIMAL)",
 		Expected: []sql.Row{{float64(7)}},
 	},
+	{
+		Query:    "SELECT CAST('abcdef' AS CHAR(3)), CONVERT('xyz', BINARY(4))",
+		Expected: []sql.Row{{"abc", "xyz\x00"}},
+	},
+	{
+		Query:    "SELECT CONVERT('abc' USING utf8mb4)",
+		Expected: []sql.Row{{"abc"}},
+	},
 	{
 		Query:    "SELECT (CASE WHEN i THEN i ELSE 0 END) as cases_i from mytable",
 		Expected: []sql.Row{{int64(1)}, {int64(2)}, {int64(3)}},
@@ -5868,6 +6088,14 @@ var QueryTests = []QueryTest{
 			{"first", 3, 3},
 		},
 	},
+	{
+		Query: `SELECT s2, i2, i FROM mytable LEFT JOIN (SELECT * FROM othertable) othertable ON i2 = i AND i2 > 1 ORDER BY i`,
+		Expected: []sql.Row{
+			{nil, nil, 1},
+			{"second", 2, 2},
+			{"first", 3, 3},
+		},
+	},
 	{
 		Query: `SELECT lefttable.i, righttable.s
 			FROM (SELECT * FROM mytable) lefttable
@@ -6315,7 +6543,7 @@ var KeylessQueries = []QueryTest{
 	{
 		Query: "SHOW CREATE TABLE keyless",
 		Expected: []sql.Row{
-			{"keyless", "CREATE TABLE `keyless` (\n  `c0` bigint,\n  `c1` bigint\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4"},
+			{"keyless", "CREATE TABLE `keyless` (\n  `c0` bigint,\n  `c1` bigint\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
 		},
 	},
 }
@@ -6608,6 +6836,22 @@ var VersionedQueries = []QueryTest{
 			{int64(3), "third row, 2"},
 		},
 	},
+	{
+		Query: "SELECT a.i, a.s, b.s FROM myhistorytable AS OF '2019-01-01' a JOIN myhistorytable AS OF '2019-01-02' b ON a.i = b.i ORDER BY a.i",
+		Expected: []sql.Row{
+			{int64(1), "first row, 1", "first row, 2"},
+			{int64(2), "second row, 1", "second row, 2"},
+			{int64(3), "third row, 1", "third row, 2"},
+		},
+	},
+	{
+		Query: "SELECT *  FROM mydb.myhistorytable AS OF '2019-01-01' ORDER BY i",
+		Expected: []sql.Row{
+			{int64(1), "first row, 1"},
+			{int64(2), "second row, 1"},
+			{int64(3), "third row, 1"},
+		},
+	},
 	{
 		Query: "SELECT *  FROM myhistorytable ORDER BY i",
 		Expected: []sql.Row{
@@ -6616,6 +6860,28 @@ var VersionedQueries = []QueryTest{
 			{int64(3), "third row, 2"},
 		},
 	},
+	{
+		Query: "SHOW CREATE TABLE myhistorytable",
+		Expected: []sql.Row{
+			{"myhistorytable", "CREATE TABLE `myhistorytable` (\n" +
+				"  `i` bigint NOT NULL,\n" +
+				"  `s` text NOT NULL,\n" +
+				"  PRIMARY KEY (`i`)\n" +
+				") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin WITH SYSTEM VERSIONING"},
+		},
+	},
+	{
+		Query: "SELECT table_type FROM information_schema.tables WHERE table_schema = 'mydb' AND table_name = 'myhistorytable'",
+		Expected: []sql.Row{
+			{"SYSTEM VERSIONED"},
+		},
+	},
+	{
+		Query: "SELECT table_type FROM information_schema.tables WHERE table_schema = 'mydb' AND table_name = 'mytable'",
+		Expected: []sql.Row{
+			{"BASE TABLE"},
+		},
+	},
 	{
 		Query: "SHOW TABLES AS OF '2019-01-02' LIKE 'myhistorytable'",
 		Expected: []sql.Row{
@@ -6943,19 +7209,19 @@ var InfoSchemaQueries = []QueryTest{
 	{
 		Query: `SHOW INDEXES FROM mytaBLE`,
 		Expected: []sql.Row{
-			{"mytable", 0, "PRIMARY", 1, "i", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 0, "mytable_s", 1, "s", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 1, "mytable_i_s", 1, "i", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 1, "mytable_i_s", 2, "s", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 0, "PRIMARY", 1, "i", "A", 3, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 0, "mytable_s", 1, "s", "A", 3, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 1, "mytable_i_s", 1, "i", "A", nil, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 1, "mytable_i_s", 2, "s", "A", nil, nil, nil, "", "BTREE", "", "", "YES", nil},
 		},
 	},
 	{
 		Query: `SHOW KEYS FROM mytaBLE`,
 		Expected: []sql.Row{
-			{"mytable", 0, "PRIMARY", 1, "i", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 0, "mytable_s", 1, "s", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 1, "mytable_i_s", 1, "i", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
-			{"mytable", 1, "mytable_i_s", 2, "s", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 0, "PRIMARY", 1, "i", "A", 3, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 0, "mytable_s", 1, "s", "A", 3, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 1, "mytable_i_s", 1, "i", "A", nil, nil, nil, "", "BTREE", "", "", "YES", nil},
+			{"mytable", 1, "mytable_i_s", 2, "s", "A", nil, nil, nil, "", "BTREE", "", "", "YES", nil},
 		},
 	},
 	{
@@ -6967,7 +7233,7 @@ var InfoSchemaQueries = []QueryTest{
 				"  PRIMARY KEY (`i`),\n" +
 				"  KEY `mytable_i_s` (`i`,`s`),\n" +
 				"  UNIQUE KEY `mytable_s` (`s`)\n" +
-				") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4"},
+				") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
 		},
 	},
 	{
@@ -6979,7 +7245,7 @@ var InfoSchemaQueries = []QueryTest{
 				"  `b` varchar(20),\n" +
 				"  PRIMARY KEY (`pk`),\n" +
 				"  CONSTRAINT `fk1` FOREIGN KEY (`a`,`b`) REFERENCES `mytable` (`i`,`s`) ON DELETE CASCADE\n" +
-				") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4"},
+				") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
 		},
 	},
 	{
@@ -7084,6 +7350,32 @@ var InfoSchemaScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "describe table with composite primary key and auto_increment column",
+		SetUpScript: []string{
+			"create table composite (a int not null auto_increment, b varchar(10) not null, c int, d int, e int, f datetime default (now()), primary key (a, b), unique key (c), unique key (d, e), key (e))",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "describe composite;",
+				Expected: []sql.Row{
+					{"a", "int", "NO", "PRI", "", "auto_increment"},
+					{"b", "varchar(10)", "NO", "PRI", "", ""},
+					{"c", "int", "YES", "UNI", "", ""},
+					{"d", "int", "YES", "MUL", "", ""},
+					{"e", "int", "YES", "MUL", "", ""},
+					{"f", "datetime", "YES", "", "(NOW())", "DEFAULT_GENERATED"},
+				},
+			},
+			{
+				Query: "show full columns from composite where Field in ('a', 'f');",
+				Expected: []sql.Row{
+					{"a", "int", nil, "NO", "PRI", "", "auto_increment", "", ""},
+					{"f", "datetime", nil, "YES", "", "(NOW())", "DEFAULT_GENERATED", "", ""},
+				},
+			},
+		},
+	},
 	{
 		Name: "information_schema.table_constraints ignores non-unique indexes",
 		SetUpScript: []string{
@@ -7099,6 +7391,84 @@ var InfoSchemaScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "show index lists the columns of each index in order",
+		SetUpScript: []string{
+			"CREATE TABLE idx_tbl (pk int primary key, a int, b varchar(20), c int NOT NULL)",
+			"CREATE UNIQUE INDEX idx_a on idx_tbl(a) COMMENT 'unique a'",
+			"CREATE INDEX idx_c_b on idx_tbl(c, b)",
+			"INSERT INTO idx_tbl VALUES (1, 1, 'one', 1), (2, 2, 'two', 1)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SHOW INDEX FROM idx_tbl",
+				Expected: []sql.Row{
+					{"idx_tbl", 0, "PRIMARY", 1, "pk", "A", 2, nil, nil, "", "BTREE", "", "", "YES", nil},
+					{"idx_tbl", 0, "idx_a", 1, "a", "A", 2, nil, nil, "YES", "BTREE", "", "unique a", "YES", nil},
+					{"idx_tbl", 1, "idx_c_b", 1, "c", "A", nil, nil, nil, "", "BTREE", "", "", "YES", nil},
+					{"idx_tbl", 1, "idx_c_b", 2, "b", "A", nil, nil, nil, "YES", "BTREE", "", "", "YES", nil},
+				},
+			},
+			{
+				Query: "SHOW KEYS FROM idx_tbl WHERE Key_name = 'idx_c_b'",
+				Expected: []sql.Row{
+					{"idx_tbl", 1, "idx_c_b", 1, "c", "A", nil, nil, nil, "", "BTREE", "", "", "YES", nil},
+					{"idx_tbl", 1, "idx_c_b", 2, "b", "A", nil, nil, nil, "YES", "BTREE", "", "", "YES", nil},
+				},
+			},
+		},
+	},
+	{
+		Name: "show table status and information_schema.tables report row counts and sizes",
+		SetUpScript: []string{
+			"CREATE TABLE status_tbl (pk bigint primary key auto_increment, a bigint)",
+			"INSERT INTO status_tbl (a) VALUES (1), (2), (3), (4)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SHOW TABLE STATUS LIKE 'status_tbl'",
+				Expected: []sql.Row{
+					{"status_tbl", "InnoDB", "10", "Fixed", uint64(4), uint64(16), uint64(64), uint64(0), int64(0), int64(0), int64(5), nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+				},
+			},
+			{
+				Query: "SELECT table_rows, avg_row_length, data_length, `auto_increment` FROM information_schema.tables WHERE table_name = 'status_tbl'",
+				Expected: []sql.Row{
+					{uint64(4), uint64(16), uint64(64), int64(5)},
+				},
+			},
+			{
+				Query:    "DELETE FROM status_tbl WHERE a > 2",
+				Expected: []sql.Row{{sql.NewOkResult(2)}},
+			},
+			{
+				Query: "SELECT table_rows FROM information_schema.tables WHERE table_name = 'status_tbl'",
+				Expected: []sql.Row{
+					{uint64(2)},
+				},
+			},
+		},
+	},
+	{
+		Name: "information_schema.statistics lists the columns of each index in order",
+		SetUpScript: []string{
+			"CREATE TABLE stats_tbl (pk int primary key, a int, b varchar(20), c int NOT NULL)",
+			"CREATE UNIQUE INDEX stats_a on stats_tbl(a)",
+			"CREATE INDEX stats_c_b on stats_tbl(c, b)",
+			"INSERT INTO stats_tbl VALUES (1, 1, 'one', 1), (2, 2, 'two', 1)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SELECT table_name, non_unique, index_name, seq_in_index, column_name, collation, cardinality, nullable, index_type FROM information_schema.statistics where table_name='stats_tbl' ORDER BY index_name, seq_in_index",
+				Expected: []sql.Row{
+					{"stats_tbl", int64(0), "PRIMARY", int64(1), "pk", "A", int64(2), "", "BTREE"},
+					{"stats_tbl", int64(0), "stats_a", int64(1), "a", "A", int64(2), "YES", "BTREE"},
+					{"stats_tbl", int64(1), "stats_c_b", int64(1), "c", "A", nil, "", "BTREE"},
+					{"stats_tbl", int64(1), "stats_c_b", int64(2), "b", "A", nil, "YES", "BTREE"},
+				},
+			},
+		},
+	},
 	{
 		Name: "information_schema.key_column_usage ignores non-unique indexes",
 		SetUpScript: []string{
@@ -7147,6 +7517,33 @@ var InfoSchemaScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "information_schema.referential_constraints lists foreign keys with their rules",
+		SetUpScript: []string{
+			"CREATE TABLE ptable (pk int primary key, test_score int, height int)",
+			"CREATE UNIQUE INDEX myindex on ptable(test_score, height)",
+			"CREATE TABLE ctable (pk int primary key, a int, b int, c int, " +
+				"CONSTRAINT fk_a FOREIGN KEY (a) REFERENCES ptable(pk) ON DELETE CASCADE, " +
+				"CONSTRAINT fk_bc FOREIGN KEY (b, c) REFERENCES ptable(test_score, height) ON UPDATE SET NULL)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SELECT * FROM information_schema.referential_constraints where table_name='ctable' ORDER BY constraint_name",
+				Expected: []sql.Row{
+					{"def", "mydb", "fk_a", "def", "mydb", "PRIMARY", "NONE", "NO ACTION", "CASCADE", "ctable", "ptable"},
+					{"def", "mydb", "fk_bc", "def", "mydb", "myindex", "NONE", "SET NULL", "NO ACTION", "ctable", "ptable"},
+				},
+			},
+			{
+				Query: "SELECT constraint_name, constraint_type, enforced FROM information_schema.table_constraints where table_name='ctable' ORDER BY constraint_name",
+				Expected: []sql.Row{
+					{"PRIMARY", "PRIMARY KEY", "YES"},
+					{"fk_a", "FOREIGN KEY", "YES"},
+					{"fk_bc", "FOREIGN KEY", "YES"},
+				},
+			},
+		},
+	},
 }
 
 var ExplodeQueries = []QueryTest{
@@ -7335,19 +7732,22 @@ var errorQueries = []QueryErrorTest{
 			SELECT i, s FROM mt1`,
 		ExpectedErr: sql.ErrColumnCountMismatch,
 	},
-	// TODO: this results in a stack overflow, need to check for this
-	// {
-	// 	Query: `WITH mt1 as (select i,s FROM mt2), mt2 as (select i,s from mt1)
-	// 		SELECT i, s FROM mt1`,
-	// 	ExpectedErr: sql.ErrColumnCountMismatch,
-	// },
-	// TODO: related to the above issue, CTEs are only allowed to mentioned previously defined CTEs (to prevent cycles).
-	//  This query works, but shouldn't
-	// {
-	// 	Query: `WITH mt1 as (select i,s FROM mt2), mt2 as (select i,s from mytable)
-	// 		SELECT i, s FROM mt1`,
-	// 	ExpectedErr: sql.ErrColumnCountMismatch,
-	// },
+	{
+		Query: `WITH mt1 as (select i,s FROM mt2), mt2 as (select i,s from mt1)
+			SELECT i, s FROM mt1`,
+		ExpectedErr: sql.ErrTableNotFound,
+	},
+	// CTEs are only allowed to mention previously defined CTEs
+	{
+		Query: `WITH mt1 as (select i,s FROM mt2), mt2 as (select i,s from mytable)
+			SELECT i, s FROM mt1`,
+		ExpectedErr: sql.ErrTableNotFound,
+	},
+	{
+		Query: `WITH mt1 as (select i,s FROM mytable where i in (select i from mt2)), mt2 as (select i,s from mytable)
+			SELECT i, s FROM mt1`,
+		ExpectedErr: sql.ErrTableNotFound,
+	},
 	{
 		Query: `WITH mt1 as (select i,s FROM mytable), mt2 as (select i+1, concat(s, '!') from mytable)
 			SELECT mt1.i, mt2.s FROM mt1 join mt2 on mt1.i = mt2.i;`,
@@ -7522,7 +7922,7 @@ var errorQueries = []QueryErrorTest{
 	},
 	{
 		Query:       `SELECT pk, (SELECT concat(pk, pk) FROM one_pk WHERE pk < opk.pk ORDER BY 1 DESC LIMIT 1) as strpk FROM one_pk opk where strpk > "0" ORDER BY 2`,
-		ExpectedErr: sql.ErrColumnNotFound,
+		ExpectedErr: sql.ErrAliasInWhere,
 	},
 	{
 		Query:       `CREATE TABLE test (pk int, primary key(pk, noexist))`,
@@ -7752,69 +8152,69 @@ var ShowTableStatusQueries = []QueryTest{
 	{
 		Query: `SHOW TABLE STATUS FROM mydb`,
 		Expected: []sql.Row{
-			{"auto_increment_tbl", "InnoDB", "10", "Fixed", uint64(3), uint64(16), uint64(48), uint64(0), int64(0), int64(0), int64(4), nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"mytable", "InnoDB", "10", "Fixed", uint64(3), uint64(88), uint64(264), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"one_pk_three_idx", "InnoDB", "10", "Fixed", uint64(8), uint64(32), uint64(256), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"one_pk_two_idx", "InnoDB", "10", "Fixed", uint64(8), uint64(24), uint64(192), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"othertable", "InnoDB", "10", "Fixed", uint64(3), uint64(65540), uint64(196620), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"tabletest", "InnoDB", "10", "Fixed", uint64(3), uint64(65540), uint64(196620), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"bigtable", "InnoDB", "10", "Fixed", uint64(14), uint64(65540), uint64(917560), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"floattable", "InnoDB", "10", "Fixed", uint64(6), uint64(24), uint64(144), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"fk_tbl", "InnoDB", "10", "Fixed", uint64(3), uint64(96), uint64(288), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"niltable", "InnoDB", "10", "Fixed", uint64(6), uint64(32), uint64(192), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"newlinetable", "InnoDB", "10", "Fixed", uint64(5), uint64(65540), uint64(327700), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"people", "InnoDB", "10", "Fixed", uint64(5), uint64(196620), uint64(983100), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"datetime_table", "InnoDB", "10", "Fixed", uint64(3), uint64(32), uint64(96), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"invert_pk", "InnoDB", "10", "Fixed", uint64(3), uint64(24), uint64(72), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
+			{"auto_increment_tbl", "InnoDB", "10", "Fixed", uint64(3), uint64(16), uint64(48), uint64(0), int64(0), int64(0), int64(4), nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"mytable", "InnoDB", "10", "Fixed", uint64(3), uint64(88), uint64(264), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"one_pk_three_idx", "InnoDB", "10", "Fixed", uint64(8), uint64(32), uint64(256), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"one_pk_two_idx", "InnoDB", "10", "Fixed", uint64(8), uint64(24), uint64(192), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"othertable", "InnoDB", "10", "Fixed", uint64(3), uint64(65540), uint64(196620), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"tabletest", "InnoDB", "10", "Fixed", uint64(3), uint64(65540), uint64(196620), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"bigtable", "InnoDB", "10", "Fixed", uint64(14), uint64(65540), uint64(917560), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"floattable", "InnoDB", "10", "Fixed", uint64(6), uint64(24), uint64(144), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"fk_tbl", "InnoDB", "10", "Fixed", uint64(3), uint64(96), uint64(288), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"niltable", "InnoDB", "10", "Fixed", uint64(6), uint64(32), uint64(192), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"newlinetable", "InnoDB", "10", "Fixed", uint64(5), uint64(65540), uint64(327700), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"people", "InnoDB", "10", "Fixed", uint64(5), uint64(196620), uint64(983100), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"datetime_table", "InnoDB", "10", "Fixed", uint64(3), uint64(32), uint64(96), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"invert_pk", "InnoDB", "10", "Fixed", uint64(3), uint64(24), uint64(72), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
 		},
 	},
 	{
 		Query: `SHOW TABLE STATUS LIKE '%table'`,
 		Expected: []sql.Row{
-			{"mytable", "InnoDB", "10", "Fixed", uint64(3), uint64(88), uint64(264), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"othertable", "InnoDB", "10", "Fixed", uint64(3), uint64(65540), uint64(196620), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"bigtable", "InnoDB", "10", "Fixed", uint64(14), uint64(65540), uint64(917560), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"floattable", "InnoDB", "10", "Fixed", uint64(6), uint64(24), uint64(144), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"niltable", "InnoDB", "10", "Fixed", uint64(6), uint64(32), uint64(192), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"newlinetable", "InnoDB", "10", "Fixed", uint64(5), uint64(65540), uint64(327700), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"datetime_table", "InnoDB", "10", "Fixed", uint64(3), uint64(32), uint64(96), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
+			{"mytable", "InnoDB", "10", "Fixed", uint64(3), uint64(88), uint64(264), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"othertable", "InnoDB", "10", "Fixed", uint64(3), uint64(65540), uint64(196620), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"bigtable", "InnoDB", "10", "Fixed", uint64(14), uint64(65540), uint64(917560), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"floattable", "InnoDB", "10", "Fixed", uint64(6), uint64(24), uint64(144), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"niltable", "InnoDB", "10", "Fixed", uint64(6), uint64(32), uint64(192), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"newlinetable", "InnoDB", "10", "Fixed", uint64(5), uint64(65540), uint64(327700), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"datetime_table", "InnoDB", "10", "Fixed", uint64(3), uint64(32), uint64(96), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
 		},
 	},
 	{
 		Query: `SHOW TABLE STATUS FROM mydb LIKE 'othertable'`,
 		Expected: []sql.Row{
-			{"othertable", "InnoDB", "10", "Fixed", uint64(3), uint64(65540), uint64(196620), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
+			{"othertable", "InnoDB", "10", "Fixed", uint64(3), uint64(65540), uint64(196620), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
 		},
 	},
 	{
 		Query: `SHOW TABLE STATUS WHERE Name = 'mytable'`,
 		Expected: []sql.Row{
-			{"mytable", "InnoDB", "10", "Fixed", uint64(3), uint64(88), uint64(264), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
+			{"mytable", "InnoDB", "10", "Fixed", uint64(3), uint64(88), uint64(264), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
 		},
 	},
 	{
 		Query: `SHOW TABLE STATUS`,
 		Expected: []sql.Row{
-			{"auto_increment_tbl", "InnoDB", "10", "Fixed", uint64(3), uint64(16), uint64(48), uint64(0), int64(0), int64(0), int64(4), nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"mytable", "InnoDB", "10", "Fixed", uint64(3), uint64(88), uint64(264), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"one_pk_three_idx", "InnoDB", "10", "Fixed", uint64(8), uint64(32), uint64(256), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"one_pk_two_idx", "InnoDB", "10", "Fixed", uint64(8), uint64(24), uint64(192), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"othertable", "InnoDB", "10", "Fixed", uint64(3), uint64(65540), uint64(196620), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"tabletest", "InnoDB", "10", "Fixed", uint64(3), uint64(65540), uint64(196620), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"bigtable", "InnoDB", "10", "Fixed", uint64(14), uint64(65540), uint64(917560), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"floattable", "InnoDB", "10", "Fixed", uint64(6), uint64(24), uint64(144), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"fk_tbl", "InnoDB", "10", "Fixed", uint64(3), uint64(96), uint64(288), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"niltable", "InnoDB", "10", "Fixed", uint64(6), uint64(32), uint64(192), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"newlinetable", "InnoDB", "10", "Fixed", uint64(5), uint64(65540), uint64(327700), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"people", "InnoDB", "10", "Fixed", uint64(5), uint64(196620), uint64(983100), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"datetime_table", "InnoDB", "10", "Fixed", uint64(3), uint64(32), uint64(96), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
-			{"invert_pk", "InnoDB", "10", "Fixed", uint64(3), uint64(24), uint64(72), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
+			{"auto_increment_tbl", "InnoDB", "10", "Fixed", uint64(3), uint64(16), uint64(48), uint64(0), int64(0), int64(0), int64(4), nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"mytable", "InnoDB", "10", "Fixed", uint64(3), uint64(88), uint64(264), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"one_pk_three_idx", "InnoDB", "10", "Fixed", uint64(8), uint64(32), uint64(256), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"one_pk_two_idx", "InnoDB", "10", "Fixed", uint64(8), uint64(24), uint64(192), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"othertable", "InnoDB", "10", "Fixed", uint64(3), uint64(65540), uint64(196620), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"tabletest", "InnoDB", "10", "Fixed", uint64(3), uint64(65540), uint64(196620), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"bigtable", "InnoDB", "10", "Fixed", uint64(14), uint64(65540), uint64(917560), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"floattable", "InnoDB", "10", "Fixed", uint64(6), uint64(24), uint64(144), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"fk_tbl", "InnoDB", "10", "Fixed", uint64(3), uint64(96), uint64(288), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"niltable", "InnoDB", "10", "Fixed", uint64(6), uint64(32), uint64(192), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"newlinetable", "InnoDB", "10", "Fixed", uint64(5), uint64(65540), uint64(327700), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"people", "InnoDB", "10", "Fixed", uint64(5), uint64(196620), uint64(983100), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"datetime_table", "InnoDB", "10", "Fixed", uint64(3), uint64(32), uint64(96), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
+			{"invert_pk", "InnoDB", "10", "Fixed", uint64(3), uint64(24), uint64(72), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
 		},
 	},
 	{
 		Query: `SHOW TABLE STATUS FROM mydb LIKE 'othertable'`,
 		Expected: []sql.Row{
-			{"othertable", "InnoDB", "10", "Fixed", uint64(3), uint64(65540), uint64(196620), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, nil},
+			{"othertable", "InnoDB", "10", "Fixed", uint64(3), uint64(65540), uint64(196620), uint64(0), int64(0), int64(0), nil, nil, nil, nil, "utf8mb4_0900_bin", nil, nil, ""},
 		},
 	},
 }
@@ -296,7 +296,7 @@ var PlanTests = []QueryPlanTest{
 	{
 		Query: `SELECT mytable.i, selfjoin.i FROM mytable INNER JOIN mytable selfjoin ON mytable.i = selfjoin.i WHERE selfjoin.i IN (SELECT 1 FROM DUAL)`,
 		ExpectedPlan: "Project(mytable.i, selfjoin.i)\n" +
-			" └─ Filter(selfjoin.i IN (Project(1)\n" +
+			" └─ SemiJoin(selfjoin.i IN (Project(1)\n" +
 			"     └─ Table(dual)\n" +
 			"    ))\n" +
 			"     └─ IndexedJoin(mytable.i = selfjoin.i)\n" +
@@ -2042,6 +2042,41 @@ var PlanTests = []QueryPlanTest{
 			"     └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
 			"",
 	},
+	{
+		Query: `SELECT * FROM invert_pk WHERE y >= 0 ORDER BY y, z`,
+		ExpectedPlan: "Filter(invert_pk.y >= 0)\n" +
+			" └─ Projected table access on [x y z]\n" +
+			"     └─ IndexedTableAccess(invert_pk on [invert_pk.y,invert_pk.z,invert_pk.x])\n" +
+			"",
+	},
+	{
+		Query: `SELECT * FROM invert_pk WHERE y >= 0 ORDER BY y DESC`,
+		ExpectedPlan: "Sort(invert_pk.y DESC)\n" +
+			" └─ Filter(invert_pk.y >= 0)\n" +
+			"     └─ Projected table access on [x y z]\n" +
+			"         └─ IndexedTableAccess(invert_pk on [invert_pk.y,invert_pk.z,invert_pk.x])\n" +
+			"",
+	},
+	{
+		Query: `SELECT y, SUM(y) FROM invert_pk WHERE y >= 0 GROUP BY y`,
+		ExpectedPlan: "StreamGroupBy\n" +
+			" ├─ SelectedExprs(invert_pk.y, SUM(invert_pk.y))\n" +
+			" ├─ Grouping(invert_pk.y)\n" +
+			" └─ Filter(invert_pk.y >= 0)\n" +
+			"     └─ Projected table access on [y]\n" +
+			"         └─ IndexedTableAccess(invert_pk on [invert_pk.y,invert_pk.z,invert_pk.x])\n" +
+			"",
+	},
+	{
+		Query: `SELECT MIN(y) FROM invert_pk`,
+		ExpectedPlan: "GroupBy\n" +
+			" ├─ SelectedExprs(MIN(invert_pk.y))\n" +
+			" ├─ Grouping()\n" +
+			" └─ Limit(1)\n" +
+			"     └─ Projected table access on [y]\n" +
+			"         └─ IndexedTableAccess(invert_pk on [invert_pk.y,invert_pk.z,invert_pk.x])\n" +
+			"",
+	},
 }
 
 // Queries where the query planner produces a correct (results) but suboptimal plan.
@@ -159,6 +159,34 @@ var ReplaceQueries = []WriteQueryTest{
 	},
 }
 
+var ReplaceScripts = []ScriptTest{
+	{
+		Name: "REPLACE deletes the rows of every key collision",
+		SetUpScript: []string{
+			"CREATE TABLE test (pk int PRIMARY KEY, u varchar(10), UNIQUE KEY (u))",
+			"INSERT INTO test VALUES (1, 'one'), (2, 'two'), (3, 'three'), (4, NULL)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "REPLACE INTO test VALUES (1, 'two')",
+				Expected: []sql.Row{{sql.NewOkResult(3)}},
+			},
+			{
+				Query:    "REPLACE INTO test VALUES (10, 'three')",
+				Expected: []sql.Row{{sql.NewOkResult(2)}},
+			},
+			{
+				Query:    "REPLACE INTO test VALUES (5, NULL)",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT * FROM test ORDER BY pk",
+				Expected: []sql.Row{{1, "two"}, {4, nil}, {5, nil}, {10, "three"}},
+			},
+		},
+	},
+}
+
 var ReplaceErrorTests = []GenericErrorQueryTest{
 	{
 		Name:  "too few values",
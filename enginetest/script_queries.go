@@ -15,6 +15,8 @@
 package enginetest
 
 import (
+	"time"
+
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql/analyzer"
@@ -55,6 +57,26 @@ type ScriptTestAssertion struct {
 // Unlike other engine tests, ScriptTests must be self-contained. No other tables are created outside the definition of
 // the tests.
 var ScriptTests = []ScriptTest{
+	{
+		Name: "default_week_format is the mode of WEEK without one",
+		SetUpScript: []string{
+			"SET SESSION default_week_format = 1",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT @@session.default_week_format, @@global.default_week_format",
+				Expected: []sql.Row{{int64(1), int64(0)}},
+			},
+			{
+				Query:    "SELECT WEEK('2008-02-20'), WEEK('2008-02-20', 0), WEEK('2008-12-31')",
+				Expected: []sql.Row{{int32(8), int32(7), int32(53)}},
+			},
+			{
+				Query:    "SELECT CONVERT_TZ('2004-01-01 12:00:00', @@time_zone, 'SYSTEM')",
+				Expected: []sql.Row{{time.Date(2004, 1, 1, 12, 0, 0, 0, time.UTC)}},
+			},
+		},
+	},
 	{
 		Name: "failed statements data validation for INSERT, UPDATE",
 		SetUpScript: []string{
@@ -440,6 +462,73 @@ var ScriptTests = []ScriptTest{
 				Query:    `SELECT BIN_TO_UUID(@binuuid)`,
 				Expected: []sql.Row{{"30303131-3232-3333-3434-353536363737"}},
 			},
+			{
+				Query:    `SELECT BIN_TO_UUID(UUID_TO_BIN(@uuid), NULL)`,
+				Expected: []sql.Row{{"6ccd780c-baba-1026-9564-5b8c656024db"}},
+			},
+			{
+				Query:    `SELECT UUID() = UUID(), SUBSTRING(UUID(), 15, 1)`,
+				Expected: []sql.Row{{false, "1"}},
+			},
+		},
+	},
+	{
+		Name: "AES_ENCRYPT and AES_DECRYPT",
+		SetUpScript: []string{
+			"CREATE TABLE secrets (id int primary key, secret varbinary(64))",
+			"INSERT INTO secrets VALUES (1, AES_ENCRYPT('hunter2', 'key'))",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    `SELECT HEX(AES_ENCRYPT('text', 'key'))`,
+				Expected: []sql.Row{{"15E36637363712FC2E699B9C95B75393"}},
+			},
+			{
+				Query:    `SELECT AES_DECRYPT(secret, 'key'), AES_DECRYPT(secret, 'wrong key') FROM secrets`,
+				Expected: []sql.Row{{"hunter2", nil}},
+			},
+		},
+	},
+	{
+		Name: "AES_ENCRYPT and AES_DECRYPT in CBC mode",
+		SetUpScript: []string{
+			"SET block_encryption_mode = 'aes-256-cbc'",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    `SELECT HEX(AES_ENCRYPT('hello world', 'key', '1234567890123456'))`,
+				Expected: []sql.Row{{"C5414249250710BDE50DC8E202AB066C"}},
+			},
+			{
+				Query:    `SELECT AES_DECRYPT(UNHEX('C5414249250710BDE50DC8E202AB066C'), 'key', '1234567890123456')`,
+				Expected: []sql.Row{{"hello world"}},
+			},
+			{
+				Query:       `SELECT AES_ENCRYPT('hello world', 'key')`,
+				ExpectedErr: sql.ErrAesMissingIV,
+			},
+		},
+	},
+	{
+		Name: "named locks are reentrant and RELEASE_ALL_LOCKS counts every acquisition",
+		SetUpScript: []string{
+			"SELECT GET_LOCK('migrations', 0)",
+			"SELECT GET_LOCK('migrations', 0)",
+			"SELECT GET_LOCK('other', 0)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    `SELECT IS_FREE_LOCK('migrations'), IS_USED_LOCK('migrations') = CONNECTION_ID()`,
+				Expected: []sql.Row{{int8(0), true}},
+			},
+			{
+				Query:    `SELECT RELEASE_ALL_LOCKS()`,
+				Expected: []sql.Row{{int32(3)}},
+			},
+			{
+				Query:    `SELECT IS_FREE_LOCK('migrations'), IS_USED_LOCK('migrations')`,
+				Expected: []sql.Row{{int8(1), nil}},
+			},
 		},
 	},
 	{
@@ -525,6 +614,34 @@ var ScriptTests = []ScriptTest{
 				Query:    "select last_insert_id()",
 				Expected: []sql.Row{{2}},
 			},
+			{
+				Query:    "select last_insert_id(10)",
+				Expected: []sql.Row{{10}},
+			},
+			{
+				Query:    "select last_insert_id()",
+				Expected: []sql.Row{{10}},
+			},
+			{
+				Query: "update b set x = last_insert_id(x + 10) where x = 1",
+				Expected: []sql.Row{{sql.OkResult{
+					RowsAffected: 1,
+					InsertID:     11,
+					Info:         plan.UpdateInfo{Matched: 1, Updated: 1},
+				}}},
+			},
+			{
+				Query:    "select last_insert_id()",
+				Expected: []sql.Row{{11}},
+			},
+			{
+				Query:    "select last_insert_id(null)",
+				Expected: []sql.Row{{nil}},
+			},
+			{
+				Query:    "select last_insert_id()",
+				Expected: []sql.Row{{11}},
+			},
 		},
 	},
 	{
@@ -1170,6 +1287,86 @@ var ScriptTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "ENUM values are matched according to the column collation",
+		SetUpScript: []string{
+			"CREATE TABLE test (pk BIGINT PRIMARY KEY, ci ENUM('Small', 'Large') COLLATE utf8mb4_0900_ai_ci, cs ENUM('Small', 'Large') COLLATE utf8mb4_0900_bin);",
+			"INSERT INTO test VALUES (1, 'large', 'Large'), (2, 'SMALL', 1);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT * FROM test ORDER BY ci;",
+				Expected: []sql.Row{{2, "Small", "Small"}, {1, "Large", "Large"}},
+			},
+			{
+				Query:       "INSERT INTO test VALUES (3, 'Small', 'small');",
+				ExpectedErr: sql.ErrConvertingToEnum,
+			},
+			{
+				Query:       "INSERT INTO test VALUES (3, 'Medium', 'Small');",
+				ExpectedErr: sql.ErrConvertingToEnum,
+			},
+		},
+	},
+	{
+		Name: "COALESCE and IFNULL keep the largest scale of DECIMAL arguments",
+		SetUpScript: []string{
+			"CREATE TABLE test (pk BIGINT PRIMARY KEY, a DECIMAL(5,1), b DECIMAL(4,3));",
+			"INSERT INTO test VALUES (1, NULL, 1.25), (2, 12.5, 1.25);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT pk, COALESCE(a, b), IFNULL(a, b) FROM test ORDER BY pk;",
+				Expected: []sql.Row{{1, "1.250", "1.250"}, {2, "12.500", "12.500"}},
+			},
+			{
+				Query:    "SELECT COALESCE(NULL, b) FROM test WHERE pk = 1;",
+				Expected: []sql.Row{{"1.250"}},
+			},
+		},
+	},
+	{
+		Name: "YEAR columns expand two-digit years and work with YEAR()",
+		SetUpScript: []string{
+			"CREATE TABLE test (pk BIGINT PRIMARY KEY, y YEAR);",
+			"INSERT INTO test VALUES (1, 70), (2, '05'), (3, 2155), (4, '0000');",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT pk, y, YEAR(y) FROM test ORDER BY pk;",
+				Expected: []sql.Row{{1, 1970, 1970}, {2, 2005, 2005}, {3, 2155, 2155}, {4, 0, 0}},
+			},
+			{
+				Query:       "INSERT INTO test VALUES (5, 1900);",
+				ExpectedErr: sql.ErrConvertingToYear,
+			},
+		},
+	},
+	{
+		Name: "BIGINT UNSIGNED values above the signed range",
+		SetUpScript: []string{
+			"CREATE TABLE test (pk BIGINT PRIMARY KEY, u BIGINT UNSIGNED);",
+			"INSERT INTO test VALUES (1, 5), (2, 9223372036854775808), (3, 18446744073709551615);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT pk FROM test WHERE u > 9223372036854775807 ORDER BY pk;",
+				Expected: []sql.Row{{2}, {3}},
+			},
+			{
+				Query:    "SELECT pk FROM test WHERE u > 5 ORDER BY pk;",
+				Expected: []sql.Row{{2}, {3}},
+			},
+			{
+				Query:    "SELECT u + -1 FROM test WHERE pk = 3;",
+				Expected: []sql.Row{{uint64(18446744073709551614)}},
+			},
+			{
+				Query:    "SELECT CAST(-1 AS UNSIGNED);",
+				Expected: []sql.Row{{uint64(18446744073709551615)}},
+			},
+		},
+	},
 	{
 		Name: "Slightly more complex example for the Exists Clause",
 		SetUpScript: []string{
@@ -1395,7 +1592,7 @@ var ScriptTests = []ScriptTest{
 						"  PRIMARY KEY (`a`),\n" +
 						"  KEY `t1b` (`b`),\n" +
 						"  CONSTRAINT `ck1` CHECK (`b` LIKE \"%abc%\")\n" +
-						") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4"},
+						") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
 				},
 			},
 			{
@@ -1407,11 +1604,395 @@ var ScriptTests = []ScriptTest{
 						"  PRIMARY KEY (`c`),\n" +
 						"  UNIQUE KEY `t2.d` (`d`),\n" +
 						"  CONSTRAINT `fk1` FOREIGN KEY (`d`) REFERENCES `t1` (`b`)\n" +
-						") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4"},
+						") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
 				},
 			},
 		},
 	},
+	{
+		Name: "LIKE respects the collation of its operands",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int PRIMARY KEY, ci varchar(10) COLLATE utf8mb4_0900_ai_ci, cs varchar(10) COLLATE utf8mb4_0900_bin);",
+			"INSERT INTO t VALUES (1, 'ABC', 'ABC'), (2, 'abc', 'abc'), (3, 'xyz', 'xyz');",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT pk FROM t WHERE ci LIKE 'abc%' ORDER BY pk",
+				Expected: []sql.Row{{1}, {2}},
+			},
+			{
+				Query:    "SELECT pk FROM t WHERE cs LIKE 'abc%' ORDER BY pk",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "SELECT pk FROM t WHERE 'ABC' LIKE ci ORDER BY pk",
+				Expected: []sql.Row{{1}, {2}},
+			},
+			{
+				Query:    "SELECT pk FROM t WHERE 'ABC' LIKE cs ORDER BY pk",
+				Expected: []sql.Row{{1}},
+			},
+		},
+	},
+	{
+		Name: "GROUP BY respects the collation of its columns",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int PRIMARY KEY, ci varchar(10) COLLATE utf8mb4_0900_ai_ci, cs varchar(10) COLLATE utf8mb4_0900_bin);",
+			"INSERT INTO t VALUES (1, 'A', 'A'), (2, 'B', 'B'), (3, 'a', 'a');",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT COUNT(*) FROM (SELECT ci FROM t GROUP BY ci) sq",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM (SELECT cs FROM t GROUP BY cs) sq",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:    "SELECT COUNT(*) AS c FROM t GROUP BY ci ORDER BY c",
+				Expected: []sql.Row{{1}, {2}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM (SELECT ci, cs FROM t GROUP BY ci, cs) sq",
+				Expected: []sql.Row{{3}},
+			},
+		},
+	},
+	{
+		Name: "comparisons, sorting and DISTINCT respect the collation of their operands",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int PRIMARY KEY, ci varchar(10) COLLATE utf8mb4_general_ci, cs varchar(10) COLLATE utf8mb4_bin);",
+			"INSERT INTO t VALUES (1, 'b', 'b'), (2, 'A', 'A'), (3, 'a', 'a'), (4, 'B', 'B');",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT pk FROM t WHERE ci = 'a' ORDER BY pk",
+				Expected: []sql.Row{{2}, {3}},
+			},
+			{
+				Query:    "SELECT pk FROM t WHERE cs = 'a' ORDER BY pk",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:    "SELECT pk FROM t WHERE ci = 'a' COLLATE utf8mb4_bin ORDER BY pk",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:    "SELECT pk FROM t WHERE cs = 'a' COLLATE utf8mb4_unicode_ci ORDER BY pk",
+				Expected: []sql.Row{{2}, {3}},
+			},
+			{
+				Query:    "SELECT pk FROM t ORDER BY ci, pk",
+				Expected: []sql.Row{{2}, {3}, {1}, {4}},
+			},
+			{
+				Query:    "SELECT pk FROM t ORDER BY cs, pk",
+				Expected: []sql.Row{{2}, {4}, {3}, {1}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM (SELECT DISTINCT ci FROM t) sq",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM (SELECT DISTINCT cs FROM t) sq",
+				Expected: []sql.Row{{4}},
+			},
+			{
+				Query:       "SELECT pk FROM t WHERE ci = 'a' COLLATE latin1_bin",
+				ExpectedErr: sql.ErrCollationInvalidForCharSet,
+			},
+		},
+	},
+	{
+		Name: "comparisons and sorting of columns with the default collation are case-sensitive",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int PRIMARY KEY, s varchar(10), txt text, INDEX s_idx (s));",
+			"INSERT INTO t VALUES (1, 'b', 'b'), (2, 'A', 'A'), (3, 'a', 'a'), (4, 'B', 'B');",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT pk FROM t WHERE s = 'a' ORDER BY pk",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:    "SELECT pk FROM t WHERE txt = 'A' ORDER BY pk",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "SELECT pk FROM t WHERE s > 'Z' ORDER BY pk",
+				Expected: []sql.Row{{1}, {3}},
+			},
+			{
+				Query:    "SELECT pk FROM t ORDER BY s, pk",
+				Expected: []sql.Row{{2}, {4}, {3}, {1}},
+			},
+			{
+				Query:    "SELECT pk FROM t ORDER BY txt DESC, pk",
+				Expected: []sql.Row{{1}, {3}, {4}, {2}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM (SELECT DISTINCT s FROM t) sq",
+				Expected: []sql.Row{{4}},
+			},
+		},
+	},
+	{
+		Name: "aliases of the select list can't be used in WHERE by default",
+		SetUpScript: []string{
+			"CREATE TABLE t (a int PRIMARY KEY, b int);",
+			"INSERT INTO t VALUES (1, 1), (2, 5), (3, 10);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "SELECT a + b AS s FROM t WHERE s > 6",
+				ExpectedErr: sql.ErrAliasInWhere,
+			},
+			{
+				Query:    "SELECT a + b AS s FROM t WHERE a + b > 6 ORDER BY s",
+				Expected: []sql.Row{{7}, {13}},
+			},
+		},
+	},
+	{
+		Name: "aliases of the select list in WHERE with aliases_in_where",
+		SetUpScript: []string{
+			"SET aliases_in_where = 1;",
+			"CREATE TABLE t (a int PRIMARY KEY, b int);",
+			"INSERT INTO t VALUES (1, 1), (2, 5), (3, 10);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT a + b AS s FROM t WHERE s > 6 ORDER BY s",
+				Expected: []sql.Row{{7}, {13}},
+			},
+			{
+				Query:    "SELECT a, b * 2 AS d FROM t WHERE d = 10 AND a > 1",
+				Expected: []sql.Row{{2, 10}},
+			},
+			{
+				// Columns of the table take precedence over aliases with the same name
+				Query:    "SELECT a + 10 AS a FROM t WHERE a = 1",
+				Expected: []sql.Row{{11}},
+			},
+			{
+				Query:       "SELECT COUNT(*) AS c FROM t WHERE c > 1",
+				ExpectedErr: sql.ErrColumnNotFound,
+			},
+		},
+	},
+	{
+		Name: "aggregates of a division by zero without ERROR_FOR_DIVISION_BY_ZERO",
+		SetUpScript: []string{
+			"CREATE TABLE t (a int primary key, b int);",
+			"INSERT INTO t VALUES (1, 0), (2, 0), (3, 0);",
+			"SET sql_mode = 'STRICT_TRANS_TABLES';",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:           "SELECT a / b FROM t WHERE a = 1",
+				Expected:        []sql.Row{{nil}},
+				ExpectedWarning: 1365,
+			},
+			{
+				Query:           "SELECT AVG(a / b) FROM t",
+				Expected:        []sql.Row{{nil}},
+				ExpectedWarning: 1365,
+			},
+			{
+				Query:           "SELECT SUM(a / 0) FROM t",
+				Expected:        []sql.Row{{nil}},
+				ExpectedWarning: 1365,
+			},
+			{
+				Query:    "SELECT AVG(a) FROM t WHERE a > 10",
+				Expected: []sql.Row{{nil}},
+			},
+		},
+	},
+	{
+		Name: "aggregates of a division by zero with ERROR_FOR_DIVISION_BY_ZERO",
+		SetUpScript: []string{
+			"CREATE TABLE t (a int primary key, b int);",
+			"INSERT INTO t VALUES (1, 0), (2, 0), (3, 0);",
+			"SET sql_mode = 'STRICT_TRANS_TABLES,ERROR_FOR_DIVISION_BY_ZERO';",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "SELECT a / b FROM t WHERE a = 1",
+				ExpectedErr: sql.ErrDivisionByZero,
+			},
+			{
+				Query:       "SELECT AVG(a / b) FROM t",
+				ExpectedErr: sql.ErrDivisionByZero,
+			},
+			{
+				Query:       "SELECT SUM(a / 0) FROM t",
+				ExpectedErr: sql.ErrDivisionByZero,
+			},
+			{
+				Query:       "SELECT SUM(a MOD b) FROM t",
+				ExpectedErr: sql.ErrDivisionByZero,
+			},
+			{
+				// AVG of no rows isn't a division by zero
+				Query:    "SELECT AVG(a) FROM t WHERE a > 10",
+				Expected: []sql.Row{{nil}},
+			},
+			{
+				Query:    "SELECT AVG(a / 2) FROM t",
+				Expected: []sql.Row{{float64(1)}},
+			},
+		},
+	},
+	{
+		Name: "DECIMAL arithmetic is exact",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, a DECIMAL(5,2), b DECIMAL(5,2));",
+			"INSERT INTO t VALUES (1, 0.10, 0.20), (2, 1.00, 0.00);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT a + b, b - a FROM t WHERE pk = 1",
+				Expected: []sql.Row{{"0.30", "0.10"}},
+			},
+			{
+				Query:           "SELECT a / b FROM t WHERE pk = 2",
+				Expected:        []sql.Row{{nil}},
+				ExpectedWarning: 1365,
+			},
+			{
+				Query:    "SET sql_mode = 'ERROR_FOR_DIVISION_BY_ZERO'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:       "SELECT a / b FROM t WHERE pk = 2",
+				ExpectedErr: sql.ErrDivisionByZero,
+			},
+		},
+	},
+	{
+		Name: "EXPLAIN FORMAT=JSON",
+		SetUpScript: []string{
+			"CREATE TABLE xy (x int primary key, y int);",
+			"INSERT INTO xy VALUES (1, 1), (2, 2), (3, 3);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "EXPLAIN FORMAT=JSON SELECT * FROM xy WHERE y > 1",
+				Expected: []sql.Row{{"" +
+					"{\n" +
+					"  \"type\": \"Filter\",\n" +
+					"  \"condition\": \"(xy.y > 1)\",\n" +
+					"  \"estimated_rows\": 3,\n" +
+					"  \"estimated_cost\": 3,\n" +
+					"  \"children\": [\n" +
+					"    {\n" +
+					"      \"type\": \"ResolvedTable\",\n" +
+					"      \"table\": \"xy\",\n" +
+					"      \"access_type\": \"full_scan\",\n" +
+					"      \"estimated_rows\": 3,\n" +
+					"      \"estimated_cost\": 3,\n" +
+					"      \"decorations\": [\n" +
+					"        \"Projected table access on [x y]\"\n" +
+					"      ]\n" +
+					"    }\n" +
+					"  ]\n" +
+					"}",
+				}},
+			},
+			{
+				Query: "EXPLAIN FORMAT=JSON SELECT a.x FROM xy a JOIN xy b ON a.x = b.x + 1 WHERE a.x = 2 AND b.x = 1",
+				Expected: []sql.Row{{"" +
+					"{\n" +
+					"  \"type\": \"Project\",\n" +
+					"  \"children\": [\n" +
+					"    {\n" +
+					"      \"type\": \"IndexedJoin\",\n" +
+					"      \"join_type\": \"InnerJoin\",\n" +
+					"      \"join_condition\": \"(a.x = (b.x + 1))\",\n" +
+					"      \"children\": [\n" +
+					"        {\n" +
+					"          \"type\": \"Filter\",\n" +
+					"          \"condition\": \"(b.x = 1)\",\n" +
+					"          \"children\": [\n" +
+					"            {\n" +
+					"              \"type\": \"TableAlias\",\n" +
+					"              \"table\": \"b\",\n" +
+					"              \"children\": [\n" +
+					"                {\n" +
+					"                  \"type\": \"IndexedTableAccess\",\n" +
+					"                  \"table\": \"xy\",\n" +
+					"                  \"access_type\": \"index_lookup\",\n" +
+					"                  \"index\": [\n" +
+					"                    \"xy.x\"\n" +
+					"                  ]\n" +
+					"                }\n" +
+					"              ]\n" +
+					"            }\n" +
+					"          ]\n" +
+					"        },\n" +
+					"        {\n" +
+					"          \"type\": \"Filter\",\n" +
+					"          \"condition\": \"(a.x = 2)\",\n" +
+					"          \"children\": [\n" +
+					"            {\n" +
+					"              \"type\": \"TableAlias\",\n" +
+					"              \"table\": \"a\",\n" +
+					"              \"children\": [\n" +
+					"                {\n" +
+					"                  \"type\": \"IndexedTableAccess\",\n" +
+					"                  \"table\": \"xy\",\n" +
+					"                  \"access_type\": \"index_lookup\",\n" +
+					"                  \"index\": [\n" +
+					"                    \"xy.x\"\n" +
+					"                  ]\n" +
+					"                }\n" +
+					"              ]\n" +
+					"            }\n" +
+					"          ]\n" +
+					"        }\n" +
+					"      ]\n" +
+					"    }\n" +
+					"  ]\n" +
+					"}",
+				}},
+			},
+		},
+	},
+	{
+		Name: "multi-table delete of duplicate rows and rows of NULLs",
+		SetUpScript: []string{
+			"CREATE TABLE dup_rows (a BIGINT, b BIGINT);",
+			"INSERT INTO dup_rows VALUES (1,1), (1,1), (2,2);",
+			"CREATE TABLE del_keys (k BIGINT PRIMARY KEY);",
+			"INSERT INTO del_keys VALUES (1), (3);",
+			"CREATE TABLE null_rows (a BIGINT, b BIGINT);",
+			"INSERT INTO null_rows VALUES (NULL,NULL), (5,5);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "DELETE dup_rows FROM dup_rows JOIN del_keys ON dup_rows.a = del_keys.k;",
+				Expected: []sql.Row{{sql.NewOkResult(2)}},
+			},
+			{
+				Query:    "SELECT * FROM dup_rows;",
+				Expected: []sql.Row{{2, 2}},
+			},
+			{
+				Query:    "DELETE null_rows FROM del_keys LEFT JOIN null_rows ON null_rows.a = del_keys.k;",
+				Expected: []sql.Row{{sql.NewOkResult(0)}},
+			},
+			{
+				Query:    "DELETE null_rows FROM del_keys LEFT JOIN null_rows ON null_rows.a IS NULL;",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT * FROM null_rows;",
+				Expected: []sql.Row{{5, 5}},
+			},
+		},
+	},
 }
 
 var CreateCheckConstraintsScripts = []ScriptTest{
@@ -1442,7 +2023,7 @@ var CreateCheckConstraintsScripts = []ScriptTest{
 							"  PRIMARY KEY (`pk`),\n" +
 							"  CONSTRAINT `check1` CHECK ((`pk` = 5)),\n" +
 							"  CONSTRAINT `check11` CHECK ((`pk` < 6))\n" +
-							") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+							") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin",
 					},
 				},
 			},
@@ -1456,7 +2037,7 @@ var CreateCheckConstraintsScripts = []ScriptTest{
 							"  PRIMARY KEY (`pk`),\n" +
 							"  CONSTRAINT `check2` CHECK ((`v` < 5)),\n" +
 							"  CONSTRAINT `check12` CHECK (((`pk` + `v`) = 6))\n" +
-							") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+							") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin",
 					},
 				},
 			},
@@ -1470,7 +2051,7 @@ var CreateCheckConstraintsScripts = []ScriptTest{
 							"  PRIMARY KEY (`pk`),\n" +
 							"  CONSTRAINT `check3` CHECK (((`pk` > 2) AND (`v` < 5))),\n" +
 							"  CONSTRAINT `check13` CHECK ((`pk` BETWEEN 2 AND 100))\n" +
-							") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+							") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin",
 					},
 				},
 			},
@@ -1483,7 +2064,7 @@ var CreateCheckConstraintsScripts = []ScriptTest{
 							"  `v` int,\n" +
 							"  PRIMARY KEY (`pk`),\n" +
 							"  CONSTRAINT `check4` CHECK ((((`pk` > 2) AND (`v` < 5)) AND (`pk` < 9)))\n" +
-							") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+							") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin",
 					},
 				},
 			},
@@ -1496,7 +2077,7 @@ var CreateCheckConstraintsScripts = []ScriptTest{
 							"  `v` int,\n" +
 							"  PRIMARY KEY (`pk`),\n" +
 							"  CONSTRAINT `check5` CHECK (((`pk` > 2) OR ((`v` < 5) AND (`pk` < 9))))\n" +
-							") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+							") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin",
 					},
 				},
 			},
@@ -1509,7 +2090,7 @@ var CreateCheckConstraintsScripts = []ScriptTest{
 							"  `v` int,\n" +
 							"  PRIMARY KEY (`pk`),\n" +
 							"  CONSTRAINT `check6` CHECK ((NOT(`pk`)))\n" +
-							") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+							") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin",
 					},
 				},
 			},
@@ -1522,7 +2103,7 @@ var CreateCheckConstraintsScripts = []ScriptTest{
 							"  `v` int,\n" +
 							"  PRIMARY KEY (`pk`),\n" +
 							"  CONSTRAINT `check7` CHECK ((NOT((`pk` = `v`))))\n" +
-							") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+							") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin",
 					},
 				},
 			},
@@ -1535,7 +2116,7 @@ var CreateCheckConstraintsScripts = []ScriptTest{
 							"  `v` int,\n" +
 							"  PRIMARY KEY (`pk`),\n" +
 							"  CONSTRAINT `check8` CHECK ((((`pk` > 2) OR (`v` < 5)) OR (`pk` < 10)))\n" +
-							") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+							") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin",
 					},
 				},
 			},
@@ -1548,7 +2129,7 @@ var CreateCheckConstraintsScripts = []ScriptTest{
 							"  `v` int,\n" +
 							"  PRIMARY KEY (`pk`),\n" +
 							"  CONSTRAINT `check9` CHECK ((((`pk` + `v`) / 2) >= 1))\n" +
-							") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+							") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin",
 					},
 				},
 			},
@@ -1561,7 +2142,7 @@ var CreateCheckConstraintsScripts = []ScriptTest{
 							"  `v` int,\n" +
 							"  PRIMARY KEY (`pk`),\n" +
 							"  CONSTRAINT `check10` CHECK ((`v` < 5)) /*!80016 NOT ENFORCED */\n" +
-							") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+							") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin",
 					},
 				},
 			},
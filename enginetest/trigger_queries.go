@@ -1996,6 +1996,30 @@ end;`,
 			},
 		},
 	},
+	{
+		Name: "trigger action order is counted per table",
+		SetUpScript: []string{
+			"create table a (x int primary key)",
+			"create table b (y int primary key)",
+			"create trigger a1 before insert on a for each row set new.x = new.x + 1",
+			"create trigger b1 before insert on b for each row set new.y = new.y + 1",
+			"create trigger a2 before insert on a for each row follows a1 set new.x = new.x * 2",
+			"create trigger b2 before insert on b for each row precedes b1 set new.y = new.y * 2",
+			"create trigger a3 after insert on a for each row insert into b values (new.x)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "select trigger_name, event_object_table, action_timing, action_order from information_schema.triggers order by 1",
+				Expected: []sql.Row{
+					{"a1", "a", "BEFORE", int64(1)},
+					{"a2", "a", "BEFORE", int64(2)},
+					{"a3", "a", "AFTER", int64(1)},
+					{"b1", "b", "BEFORE", int64(2)},
+					{"b2", "b", "BEFORE", int64(1)},
+				},
+			},
+		},
+	},
 }
 
 // BrokenTriggerQueries contains trigger queries that should work but do not yet
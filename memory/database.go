@@ -104,9 +104,12 @@ type HistoryDatabase struct {
 	*Database
 	Revisions    map[string]map[interface{}]sql.Table
 	currRevision interface{}
+	// revisionOrder holds the revisions of each table in the order they were added
+	revisionOrder map[string][]interface{}
 }
 
 var _ sql.VersionedDatabase = (*HistoryDatabase)(nil)
+var _ sql.VersionRangeDatabase = (*HistoryDatabase)(nil)
 
 func (db *HistoryDatabase) GetTableInsensitiveAsOf(ctx *sql.Context, tblName string, time interface{}) (sql.Table, bool, error) {
 	table, ok := db.Revisions[strings.ToLower(tblName)][time]
@@ -123,6 +126,47 @@ func (db *HistoryDatabase) GetTableInsensitiveAsOf(ctx *sql.Context, tblName str
 	return db.GetTableInsensitive(ctx, tblName)
 }
 
+// GetTableInsensitiveVersions implements sql.VersionRangeDatabase. Each version of a table is valid from its revision
+// until the revision of the next version. Revisions are compared as strings, which works for dates.
+func (db *HistoryDatabase) GetTableInsensitiveVersions(ctx *sql.Context, tblName string, from, to interface{}, inclusive bool) ([]sql.Table, bool, error) {
+	lowerName := strings.ToLower(tblName)
+	revisions := db.revisionOrder[lowerName]
+
+	// A table without revisions has a single version, valid at all times
+	if len(revisions) == 0 {
+		table, ok, err := db.GetTableInsensitive(ctx, tblName)
+		if err != nil || !ok {
+			return nil, ok, err
+		}
+		return []sql.Table{table}, true, nil
+	}
+
+	var tables []sql.Table
+	for i, revision := range revisions {
+		cmp, err := sql.LongText.Compare(revision, to)
+		if err != nil {
+			return nil, false, err
+		}
+		if cmp > 0 || (cmp == 0 && !inclusive) {
+			break
+		}
+
+		if i+1 < len(revisions) {
+			cmp, err = sql.LongText.Compare(revisions[i+1], from)
+			if err != nil {
+				return nil, false, err
+			}
+			if cmp <= 0 {
+				continue
+			}
+		}
+
+		tables = append(tables, db.Revisions[lowerName][revision])
+	}
+
+	return tables, true, nil
+}
+
 func (db *HistoryDatabase) GetTableNamesAsOf(ctx *sql.Context, time interface{}) ([]string, error) {
 	// TODO: this can't make any queries fail (only used for error messages on table lookup failure), but would be nice
 	//  to support better.
@@ -131,8 +175,9 @@ func (db *HistoryDatabase) GetTableNamesAsOf(ctx *sql.Context, time interface{})
 
 func NewHistoryDatabase(name string) *HistoryDatabase {
 	return &HistoryDatabase{
-		Database:  NewDatabase(name),
-		Revisions: make(map[string]map[interface{}]sql.Table),
+		Database:      NewDatabase(name),
+		Revisions:     make(map[string]map[interface{}]sql.Table),
+		revisionOrder: make(map[string][]interface{}),
 	}
 }
 
@@ -143,7 +188,13 @@ func (db *HistoryDatabase) AddTableAsOf(name string, t sql.Table, asOf interface
 		db.Revisions[strings.ToLower(name)] = make(map[interface{}]sql.Table)
 	}
 
+	if _, ok := db.Revisions[strings.ToLower(name)][asOf]; !ok {
+		db.revisionOrder[strings.ToLower(name)] = append(db.revisionOrder[strings.ToLower(name)], asOf)
+	}
 	db.Revisions[strings.ToLower(name)][asOf] = t
+	if mt, ok := t.(*Table); ok {
+		mt.systemVersioned = true
+	}
 	db.tables[name] = t
 }
 
@@ -37,6 +37,7 @@ type Index struct {
 }
 
 var _ sql.Index = (*Index)(nil)
+var _ sql.OrderedIndex = (*Index)(nil)
 
 func (idx *Index) Database() string                    { return idx.DB }
 func (idx *Index) Driver() string                      { return idx.DriverName }
@@ -67,6 +68,17 @@ func (idx *Index) IndexType() string {
 	return "BTREE" // fake but so are you
 }
 
+// Order implements the interface sql.OrderedIndex. Lookups on the index are read from a single partition that has the
+// matching rows of all partitions sorted by the index expressions.
+func (idx *Index) Order() sql.IndexOrder {
+	return sql.IndexOrderAsc
+}
+
+// NullOrdering implements the interface sql.OrderedIndex.
+func (idx *Index) NullOrdering() sql.NullOrdering {
+	return sql.NullsFirst
+}
+
 // NewLookup implements the interface sql.Index.
 func (idx *Index) NewLookup(ctx *sql.Context, ranges ...sql.Range) (sql.IndexLookup, error) {
 	if idx.CommentStr == CommentPreventingIndexBuilding {
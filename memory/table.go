@@ -52,12 +52,21 @@ type Table struct {
 	// Insert bookkeeping
 	insertPartIdx int
 
+	// Hash and range partitioning: the indexes of the partition key columns, the exclusive upper bounds of the range
+	// partitions, where nil stands for MAXVALUE, and the only partitions to read if the table was pruned
+	partitionColIdxs []int
+	partitionBounds  []interface{}
+	prunedPartitions [][]byte
+
 	// Indexed lookups
 	lookup sql.IndexLookup
 
 	// AUTO_INCREMENT bookkeeping
 	autoIncVal interface{}
 	autoColIdx int
+
+	// systemVersioned is set for the tables of a HistoryDatabase that have revisions
+	systemVersioned bool
 }
 
 var _ sql.Table = (*Table)(nil)
@@ -76,6 +85,11 @@ var _ sql.CheckAlterableTable = (*Table)(nil)
 var _ sql.CheckTable = (*Table)(nil)
 var _ sql.AutoIncrementTable = (*Table)(nil)
 var _ sql.StatisticsTable = (*Table)(nil)
+var _ sql.RowCountTable = (*Table)(nil)
+var _ sql.SystemVersionedTable = (*Table)(nil)
+var _ sql.HashPartitionedTable = (*Table)(nil)
+var _ sql.RangePartitionedTable = (*Table)(nil)
+var _ sql.PrunedPartitionsTable = (*Table)(nil)
 var _ sql.ProjectedTable = (*Table)(nil)
 var _ sql.PrimaryKeyAlterableTable = (*Table)(nil)
 var _ sql.PrimaryKeyTable = (*Table)(nil)
@@ -120,6 +134,73 @@ func NewPartitionedTable(name string, schema sql.PrimaryKeySchema, numPartitions
 	}
 }
 
+// NewHashPartitionedTable creates a new Table with the given name, schema and number of partitions, whose rows are
+// stored in the partition given by the hash of the values of the columns named.
+func NewHashPartitionedTable(name string, schema sql.PrimaryKeySchema, numPartitions int, columns ...string) (*Table, error) {
+	t := NewPartitionedTable(name, schema, numPartitions)
+	for _, col := range columns {
+		idx := partitionColumnIndex(schema, col)
+		if idx < 0 {
+			return nil, sql.ErrKeyColumnDoesNotExist.New(col)
+		}
+		t.partitionColIdxs = append(t.partitionColIdxs, idx)
+	}
+	return t, nil
+}
+
+// NewRangePartitionedTable creates a new Table with the given name and schema, with a partition for each of the bounds
+// given. A row is stored in the first partition whose bound is greater than the value of the column named, like with
+// VALUES LESS THAN. A nil bound, which may only be the last one, stands for MAXVALUE.
+func NewRangePartitionedTable(name string, schema sql.PrimaryKeySchema, column string, bounds ...interface{}) (*Table, error) {
+	idx := partitionColumnIndex(schema, column)
+	if idx < 0 {
+		return nil, sql.ErrKeyColumnDoesNotExist.New(column)
+	}
+	if len(bounds) == 0 {
+		bounds = []interface{}{nil}
+	}
+
+	typ := schema.Schema[idx].Type
+	converted := make([]interface{}, len(bounds))
+	for i, bound := range bounds {
+		if bound == nil {
+			if i != len(bounds)-1 {
+				return nil, sql.ErrRangeNotIncreasing.New()
+			}
+			continue
+		}
+
+		v, err := typ.Convert(bound)
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 {
+			cmp, err := typ.Compare(converted[i-1], v)
+			if err != nil {
+				return nil, err
+			}
+			if cmp >= 0 {
+				return nil, sql.ErrRangeNotIncreasing.New()
+			}
+		}
+		converted[i] = v
+	}
+
+	t := NewPartitionedTable(name, schema, len(bounds))
+	t.partitionColIdxs = []int{idx}
+	t.partitionBounds = converted
+	return t, nil
+}
+
+func partitionColumnIndex(schema sql.PrimaryKeySchema, column string) int {
+	for i, c := range schema.Schema {
+		if strings.EqualFold(c.Name, column) {
+			return i
+		}
+	}
+	return -1
+}
+
 // Name implements the sql.Table interface.
 func (t *Table) Name() string {
 	return t.name
@@ -139,24 +220,46 @@ func (t *Table) GetPartition(key string) []sql.Row {
 	return nil
 }
 
+// indexLookupPartitionKey is the key of the only partition of a table with a lookup on one of its indexes. See
+// indexLookupRows.
+const indexLookupPartitionKey = "__index_lookup__"
+
 // Partitions implements the sql.Table interface.
 func (t *Table) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
+	if _, ok := t.lookup.(*IndexLookup); ok {
+		return &partitionIter{keys: [][]byte{[]byte(indexLookupPartitionKey)}}, nil
+	}
+	return &partitionIter{keys: t.readPartitionKeys()}, nil
+}
+
+// readPartitionKeys returns the keys of the partitions with rows that haven't been pruned, in order.
+func (t *Table) readPartitionKeys() [][]byte {
 	var keys [][]byte
 	for _, k := range t.partitionKeys {
+		if t.prunedPartitions != nil && !containsPartitionKey(t.prunedPartitions, k) {
+			continue
+		}
 		if rows, ok := t.partitions[string(k)]; ok && len(rows) > 0 {
 			keys = append(keys, k)
 		}
 	}
-	return &partitionIter{keys: keys}, nil
+	return keys
 }
 
 // PartitionCount implements the sql.PartitionCounter interface.
 func (t *Table) PartitionCount(ctx *sql.Context) (int64, error) {
-	return int64(len(t.partitions)), nil
+	if _, ok := t.lookup.(*IndexLookup); ok {
+		return 1, nil
+	}
+	return int64(len(t.readPartitionKeys())), nil
 }
 
 // PartitionRows implements the sql.PartitionRows interface.
 func (t *Table) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
+	if lookup, ok := t.lookup.(*IndexLookup); ok && string(partition.Key()) == indexLookupPartitionKey {
+		return t.indexLookupRows(ctx, lookup)
+	}
+
 	rows, ok := t.partitions[string(partition.Key())]
 	if !ok {
 		return nil, sql.ErrPartitionNotFound.New(partition.Key())
@@ -184,6 +287,117 @@ func (t *Table) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.Ro
 	}, nil
 }
 
+// indexLookupRows returns an iterator over the rows of all partitions that match the lookup given, sorted by the
+// expressions of its index with NULL values first. Index implements sql.OrderedIndex, so the rows of a lookup must be
+// returned in index order across all partitions, which is why they are read from a single partition. The matching rows
+// of each partition are sorted on their own, and then merged.
+func (t *Table) indexLookupRows(ctx *sql.Context, lookup *IndexLookup) (sql.RowIter, error) {
+	exprs := lookup.idx.ColumnExpressions()
+
+	var runs [][]sql.Row
+	for _, k := range t.readPartitionKeys() {
+		values, err := lookup.Values(&Partition{key: k})
+		if err != nil {
+			return nil, err
+		}
+		rows, err := sql.RowIterToRows(ctx, &tableIter{rows: t.partitions[string(k)], indexValues: values})
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) == 0 {
+			continue
+		}
+
+		var sortErr error
+		sort.SliceStable(rows, func(i, j int) bool {
+			if sortErr != nil {
+				return false
+			}
+			cmp, err := compareIndexRows(ctx, exprs, rows[i], rows[j])
+			if err != nil {
+				sortErr = err
+				return false
+			}
+			return cmp < 0
+		})
+		if sortErr != nil {
+			return nil, sortErr
+		}
+
+		runs = append(runs, rows)
+	}
+
+	rows, err := mergeIndexRows(ctx, exprs, runs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tableIter{
+		rows:    rows,
+		columns: t.columns,
+		filters: t.filters,
+	}, nil
+}
+
+// mergeIndexRows merges the rows of each partition given, each sorted by the index expressions given, into a single
+// sorted slice. Rows that sort the same are kept in partition order.
+func mergeIndexRows(ctx *sql.Context, exprs []sql.Expression, runs [][]sql.Row) ([]sql.Row, error) {
+	var merged []sql.Row
+	for len(runs) > 0 {
+		next := 0
+		for i := 1; i < len(runs); i++ {
+			cmp, err := compareIndexRows(ctx, exprs, runs[i][0], runs[next][0])
+			if err != nil {
+				return nil, err
+			}
+			if cmp < 0 {
+				next = i
+			}
+		}
+
+		merged = append(merged, runs[next][0])
+		if len(runs[next]) == 1 {
+			runs = append(runs[:next], runs[next+1:]...)
+		} else {
+			runs[next] = runs[next][1:]
+		}
+	}
+	return merged, nil
+}
+
+// compareIndexRows compares two rows by the index expressions given, with NULL values first.
+func compareIndexRows(ctx *sql.Context, exprs []sql.Expression, a, b sql.Row) (int, error) {
+	for _, expr := range exprs {
+		left, err := expr.Eval(ctx, a)
+		if err != nil {
+			return 0, err
+		}
+		right, err := expr.Eval(ctx, b)
+		if err != nil {
+			return 0, err
+		}
+
+		if left == nil || right == nil {
+			if left == nil && right == nil {
+				continue
+			}
+			if left == nil {
+				return -1, nil
+			}
+			return 1, nil
+		}
+
+		cmp, err := expr.Type().Compare(left, right)
+		if err != nil {
+			return 0, err
+		}
+		if cmp != 0 {
+			return cmp, nil
+		}
+	}
+	return 0, nil
+}
+
 func (t *Table) NumRows(ctx *sql.Context) (uint64, error) {
 	var count uint64 = 0
 	for _, rows := range t.partitions {
@@ -193,6 +407,188 @@ func (t *Table) NumRows(ctx *sql.Context) (uint64, error) {
 	return count, nil
 }
 
+// RowCount implements the sql.RowCountTable interface.
+func (t *Table) RowCount(ctx *sql.Context) (uint64, bool) {
+	// Pushed down filters, index lookups and partition pruning restrict the rows returned, so the partition sizes no
+	// longer apply
+	if len(t.filters) > 0 || t.lookup != nil || t.prunedPartitions != nil {
+		return 0, false
+	}
+	count, err := t.NumRows(ctx)
+	if err != nil {
+		return 0, false
+	}
+	return count, true
+}
+
+// PartitionKeyColumns implements the sql.HashPartitionedTable interface.
+func (t *Table) PartitionKeyColumns() []string {
+	names := make([]string, len(t.partitionColIdxs))
+	for i, idx := range t.partitionColIdxs {
+		names[i] = t.schema.Schema[idx].Name
+	}
+	return names
+}
+
+// WithPartitionKey implements the sql.HashPartitionedTable interface.
+func (t *Table) WithPartitionKey(ctx *sql.Context, key sql.Row) (sql.Table, error) {
+	if len(t.partitionColIdxs) == 0 || len(key) != len(t.partitionColIdxs) {
+		return t, nil
+	}
+
+	// Rows are hashed with the values stored, so the key needs the types of the columns to find their partition
+	converted := make(sql.Row, len(key))
+	for i, idx := range t.partitionColIdxs {
+		v, err := t.schema.Schema[idx].Type.Convert(key[i])
+		if err != nil {
+			// A value that can't be stored in the column can't be pruned on
+			return t, nil
+		}
+		converted[i] = v
+	}
+
+	partition, err := t.partitionOfKey(converted)
+	if err != nil {
+		return nil, err
+	}
+
+	nt := *t
+	nt.prunedPartitions = [][]byte{[]byte(partition)}
+	return &nt, nil
+}
+
+// RangePartitionColumn implements the sql.RangePartitionedTable interface.
+func (t *Table) RangePartitionColumn() string {
+	if t.partitionBounds == nil {
+		return ""
+	}
+	return t.schema.Schema[t.partitionColIdxs[0]].Name
+}
+
+// WithPartitionRanges implements the sql.RangePartitionedTable interface.
+func (t *Table) WithPartitionRanges(ctx *sql.Context, ranges []sql.RangeColumnExpr) (sql.Table, error) {
+	if t.partitionBounds == nil {
+		return t, nil
+	}
+
+	typ := t.schema.Schema[t.partitionColIdxs[0]].Type
+	pruned := make([][]byte, 0)
+	for i, key := range t.partitionKeys {
+		partitionRange := t.partitionRange(i, typ)
+		for _, r := range ranges {
+			_, overlaps, err := partitionRange.Overlaps(r)
+			if err != nil {
+				return nil, err
+			}
+			if overlaps {
+				pruned = append(pruned, key)
+				break
+			}
+		}
+	}
+
+	nt := *t
+	nt.prunedPartitions = pruned
+	return &nt, nil
+}
+
+// PrunedPartitions implements the sql.PrunedPartitionsTable interface.
+func (t *Table) PrunedPartitions() ([]string, bool) {
+	if t.prunedPartitions == nil {
+		return nil, false
+	}
+	keys := make([]string, len(t.prunedPartitions))
+	for i, k := range t.prunedPartitions {
+		keys[i] = string(k)
+	}
+	return keys, true
+}
+
+// partitionRange returns the range of values of the partition key stored in the range partition at the index given.
+func (t *Table) partitionRange(i int, typ sql.Type) sql.RangeColumnExpr {
+	upper := t.partitionBounds[i]
+	if i == 0 {
+		if upper == nil {
+			return sql.AllRangeColumnExpr(typ)
+		}
+		return sql.LessThanRangeColumnExpr(upper, typ)
+	}
+
+	lower := t.partitionBounds[i-1]
+	if upper == nil {
+		return sql.GreaterOrEqualRangeColumnExpr(lower, typ)
+	}
+	return sql.CustomRangeColumnExpr(lower, upper, sql.Closed, sql.Open, typ)
+}
+
+func containsPartitionKey(keys [][]byte, key []byte) bool {
+	for _, k := range keys {
+		if bytes.Equal(k, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// insertPartition returns the key of the partition the row given is inserted into: the partition of its partition
+// key for partitioned tables, or the next partition in turn otherwise.
+func (t *Table) insertPartition(row sql.Row) (string, error) {
+	if len(t.partitionColIdxs) == 0 {
+		key := string(t.partitionKeys[t.insertPartIdx])
+		t.insertPartIdx++
+		if t.insertPartIdx == len(t.partitionKeys) {
+			t.insertPartIdx = 0
+		}
+		return key, nil
+	}
+
+	key := make(sql.Row, len(t.partitionColIdxs))
+	for i, idx := range t.partitionColIdxs {
+		key[i] = row[idx]
+	}
+	return t.partitionOfKey(key)
+}
+
+func (t *Table) partitionOfKey(key sql.Row) (string, error) {
+	if t.partitionBounds != nil {
+		return t.rangePartitionOf(key[0])
+	}
+
+	hash, err := sql.HashOf(key)
+	if err != nil {
+		return "", err
+	}
+	return string(t.partitionKeys[hash%uint64(len(t.partitionKeys))]), nil
+}
+
+// rangePartitionOf returns the key of the range partition of the value given. Like in MySQL, NULL values are stored
+// in the first partition.
+func (t *Table) rangePartitionOf(val interface{}) (string, error) {
+	if val == nil {
+		return string(t.partitionKeys[0]), nil
+	}
+
+	typ := t.schema.Schema[t.partitionColIdxs[0]].Type
+	for i, bound := range t.partitionBounds {
+		if bound == nil {
+			return string(t.partitionKeys[i]), nil
+		}
+		cmp, err := typ.Compare(val, bound)
+		if err != nil {
+			return "", err
+		}
+		if cmp < 0 {
+			return string(t.partitionKeys[i]), nil
+		}
+	}
+	return "", sql.ErrNoPartitionForValue.New(val)
+}
+
+// IsSystemVersioned implements the sql.SystemVersionedTable interface.
+func (t *Table) IsSystemVersioned() bool {
+	return t.systemVersioned
+}
+
 func (t *Table) DataLength(ctx *sql.Context) (uint64, error) {
 	var numBytesPerRow uint64 = 0
 	for _, col := range t.schema.Schema {
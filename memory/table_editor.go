@@ -69,6 +69,9 @@ func (t *tableEditor) Insert(ctx *sql.Context, row sql.Row) error {
 	if err := checkRow(t.table.schema.Schema, row); err != nil {
 		return err
 	}
+	if err := t.checkPartition(row); err != nil {
+		return err
+	}
 
 	partitionRow, added, err := t.ea.Get(row)
 	if err != nil {
@@ -127,6 +130,9 @@ func (t *tableEditor) Update(ctx *sql.Context, oldRow sql.Row, newRow sql.Row) e
 	if err := checkRow(t.table.schema.Schema, newRow); err != nil {
 		return err
 	}
+	if err := t.checkPartition(newRow); err != nil {
+		return err
+	}
 
 	err := t.ea.Delete(oldRow)
 	if err != nil {
@@ -157,12 +163,38 @@ func (t *tableEditor) Update(ctx *sql.Context, oldRow sql.Row, newRow sql.Row) e
 	return nil
 }
 
-// SetAutoIncrementValue sets a new AUTO_INCREMENT value
+// SetAutoIncrementValue sets a new AUTO_INCREMENT value. Like in MySQL, a value that isn't greater than every value of
+// the column sets it to the value following the largest one instead.
 func (t *tableEditor) SetAutoIncrementValue(ctx *sql.Context, val interface{}) error {
+	idx := t.table.autoColIdx
+	if idx >= 0 {
+		autoCol := t.table.schema.Schema[idx]
+		for _, p := range t.table.partitions {
+			for _, row := range p {
+				cmp, err := autoCol.Type.Compare(row[idx], val)
+				if err != nil {
+					return err
+				}
+				if cmp >= 0 {
+					val = increment(row[idx])
+				}
+			}
+		}
+	}
+
 	t.table.autoIncVal = val
 	return nil
 }
 
+// checkPartition returns an error if the row given doesn't belong to any partition of a range partitioned table.
+func (t *tableEditor) checkPartition(row sql.Row) error {
+	if t.table.partitionBounds == nil {
+		return nil
+	}
+	_, err := t.table.rangePartitionOf(row[t.table.partitionColIdxs[0]])
+	return err
+}
+
 func (t *tableEditor) pkColumnIndexes() []int {
 	var pkColIdxes []int
 	for _, column := range t.table.schema.Schema {
@@ -356,10 +388,9 @@ func (pke *pkTableEditAccumulator) deleteHelper(ctx *sql.Context, table *Table,
 
 // insertHelper inserts the given row into the given table.
 func (pke *pkTableEditAccumulator) insertHelper(ctx *sql.Context, table *Table, row sql.Row) error {
-	key := string(table.partitionKeys[table.insertPartIdx])
-	table.insertPartIdx++
-	if table.insertPartIdx == len(table.partitionKeys) {
-		table.insertPartIdx = 0
+	key, err := table.insertPartition(row)
+	if err != nil {
+		return err
 	}
 
 	pkColIdxes := pke.pkColumnIndexes()
@@ -495,10 +526,9 @@ func (k *keylessTableEditAccumulator) deleteHelper(ctx *sql.Context, table *Tabl
 
 // insertHelper inserts into a keyless table.
 func (k *keylessTableEditAccumulator) insertHelper(ctx *sql.Context, table *Table, row sql.Row) error {
-	key := string(table.partitionKeys[table.insertPartIdx])
-	table.insertPartIdx++
-	if table.insertPartIdx == len(table.partitionKeys) {
-		table.insertPartIdx = 0
+	key, err := table.insertPartition(row)
+	if err != nil {
+		return err
 	}
 
 	table.partitions[key] = append(table.partitions[key], row)
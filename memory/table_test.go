@@ -335,6 +335,48 @@ func TestIndexed(t *testing.T) {
 	}
 }
 
+func TestIndexLookupOrder(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	schema := sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "pk", Source: "t", Type: sql.Int64, PrimaryKey: true},
+		{Name: "v", Source: "t", Type: sql.Int64, Nullable: true},
+	})
+	table := memory.NewPartitionedTable("t", schema, 3)
+	for i, v := range []interface{}{int64(5), nil, int64(1), int64(5), int64(3), nil, int64(2)} {
+		require.NoError(table.Insert(ctx, sql.NewRow(int64(i), v)))
+	}
+
+	idx := &memory.Index{
+		Tbl:       table,
+		TableName: "t",
+		Name:      "v_idx",
+		Exprs:     []sql.Expression{expression.NewGetFieldWithTable(1, sql.Int64, "t", "v", true)},
+	}
+	lookup, err := idx.NewLookup(ctx, sql.Range{sql.AllRangeColumnExpr(sql.Int64)})
+	require.NoError(err)
+
+	lookupTable := table.WithIndexLookup(lookup)
+	rows := getAllRows(t, lookupTable)
+	require.Equal([]sql.Row{
+		{int64(1), nil},
+		{int64(5), nil},
+		{int64(2), int64(1)},
+		{int64(6), int64(2)},
+		{int64(4), int64(3)},
+		{int64(0), int64(5)},
+		{int64(3), int64(5)},
+	}, rows)
+
+	count, err := table.PartitionCount(ctx)
+	require.NoError(err)
+	require.Equal(int64(3), count)
+	count, err = lookupTable.(sql.PartitionCounter).PartitionCount(ctx)
+	require.NoError(err)
+	require.Equal(int64(1), count)
+}
+
 func getAllRows(t *testing.T, table sql.Table) []sql.Row {
 	var require = require.New(t)
 
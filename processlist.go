@@ -29,20 +29,28 @@ import (
 type ProcessList struct {
 	mu    sync.RWMutex
 	procs map[uint64]*sql.Process
+	// conns holds the sleeping process of each connection, listed while the
+	// connection isn't running any query.
+	conns map[uint32]*sql.Process
 }
 
+var _ sql.ConnectionProcessList = (*ProcessList)(nil)
+
 // NewProcessList creates a new process list.
 func NewProcessList() *ProcessList {
 	return &ProcessList{
 		procs: make(map[uint64]*sql.Process),
+		conns: make(map[uint32]*sql.Process),
 	}
 }
 
-// Processes returns the list of current running processes.
+// Processes returns the list of current running processes, along with the
+// connections sleeping until their next query.
 func (pl *ProcessList) Processes() []sql.Process {
 	pl.mu.RLock()
 	defer pl.mu.RUnlock()
-	var result = make([]sql.Process, 0, len(pl.procs))
+	var result = make([]sql.Process, 0, len(pl.procs)+len(pl.conns))
+	var running = make(map[uint32]bool, len(pl.procs))
 
 	for _, proc := range pl.procs {
 		p := *proc
@@ -51,11 +59,40 @@ func (pl *ProcessList) Processes() []sql.Process {
 			progress[n] = p
 		}
 		result = append(result, p)
+		running[p.Connection] = true
+	}
+
+	for connID, conn := range pl.conns {
+		if !running[connID] {
+			result = append(result, *conn)
+		}
 	}
 
 	return result
 }
 
+// AddConnection adds a new connection from the given address to the list. The
+// connection is listed as sleeping while it's not running any query.
+func (pl *ProcessList) AddConnection(connID uint32, addr string) {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+
+	pl.conns[connID] = &sql.Process{
+		Connection: connID,
+		Host:       addr,
+		Command:    sql.ProcessCommandSleep,
+		StartedAt:  time.Now(),
+	}
+}
+
+// RemoveConnection removes the connection with the given id from the list.
+func (pl *ProcessList) RemoveConnection(connID uint32) {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+
+	delete(pl.conns, connID)
+}
+
 // AddProcess adds a new process to the list given a process type and a query
 func (pl *ProcessList) AddProcess(
 	ctx *sql.Context,
@@ -71,15 +108,24 @@ func (pl *ProcessList) AddProcess(
 	newCtx, cancel := context.WithCancel(ctx)
 	ctx = ctx.WithContext(newCtx)
 
-	pl.procs[ctx.Pid()] = &sql.Process{
+	proc := &sql.Process{
 		Pid:        ctx.Pid(),
 		Connection: ctx.ID(),
+		Host:       ctx.Session.Client().Address,
+		Database:   ctx.GetCurrentDatabase(),
+		User:       ctx.Session.Client().User,
+		Command:    sql.ProcessCommandQuery,
 		Query:      query,
 		Progress:   make(map[string]sql.TableProgress),
-		User:       ctx.Session.Client().User,
 		StartedAt:  time.Now(),
 		Kill:       cancel,
 	}
+	pl.procs[ctx.Pid()] = proc
+
+	if conn, ok := pl.conns[proc.Connection]; ok {
+		conn.User = proc.User
+		conn.Database = proc.Database
+	}
 
 	return ctx, nil
 }
@@ -228,6 +274,9 @@ func (pl *ProcessList) Done(pid uint64) {
 
 	if proc, ok := pl.procs[pid]; ok {
 		proc.Done()
+		if conn, ok := pl.conns[proc.Connection]; ok {
+			conn.StartedAt = time.Now()
+		}
 	}
 
 	delete(pl.procs, pid)
@@ -42,6 +42,8 @@ func TestProcessList(t *testing.T) {
 	expectedProcess := &sql.Process{
 		Pid:        1,
 		Connection: 1,
+		Host:       "127.0.0.1:34567",
+		Command:    sql.ProcessCommandQuery,
 		Progress: map[string]sql.TableProgress{
 			"a": {sql.Progress{Name: "a", Done: 0, Total: 5}, map[string]sql.PartitionProgress{}},
 			"b": {sql.Progress{Name: "b", Done: 0, Total: 6}, map[string]sql.PartitionProgress{}},
@@ -152,3 +154,50 @@ func TestKillConnection(t *testing.T) {
 	require.False(t, killed[2])
 	require.True(t, killed[3])
 }
+
+func TestProcessListConnections(t *testing.T) {
+	require := require.New(t)
+
+	pl := NewProcessList()
+	pl.AddConnection(1, "127.0.0.1:34567")
+	pl.AddConnection(2, "127.0.0.1:34568")
+
+	procs := pl.Processes()
+	require.Len(procs, 2)
+	for _, proc := range procs {
+		require.Equal(sql.ProcessCommandSleep, proc.Command)
+	}
+
+	sess := sql.NewBaseSessionWithClientServer("", sql.Client{Address: "127.0.0.1:34567", User: "foo"}, 1)
+	sess.SetCurrentDatabase("mydb")
+	ctx, err := pl.AddProcess(sql.NewContext(context.Background(), sql.WithPid(1), sql.WithSession(sess)), "SELECT 1")
+	require.NoError(err)
+
+	procs = pl.Processes()
+	require.Len(procs, 2)
+	sortByConnection(procs)
+	require.Equal(sql.ProcessCommandQuery, procs[0].Command)
+	require.Equal("SELECT 1", procs[0].Query)
+	require.Equal(sql.ProcessCommandSleep, procs[1].Command)
+
+	pl.Done(ctx.Pid())
+
+	procs = pl.Processes()
+	require.Len(procs, 2)
+	sortByConnection(procs)
+	require.Equal(sql.ProcessCommandSleep, procs[0].Command)
+	require.Equal("foo", procs[0].User)
+	require.Equal("mydb", procs[0].Database)
+	require.Equal("127.0.0.1:34567", procs[0].Host)
+
+	pl.RemoveConnection(1)
+	procs = pl.Processes()
+	require.Len(procs, 1)
+	require.Equal(uint32(2), procs[0].Connection)
+}
+
+func sortByConnection(slice []sql.Process) {
+	sort.Slice(slice, func(i, j int) bool {
+		return slice[i].Connection < slice[j].Connection
+	})
+}
@@ -0,0 +1,133 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"io"
+	"strings"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// QueryLogger is notified by the engine of the statements it executes, for instance to implement a slow query log.
+type QueryLogger interface {
+	// QueryStarted is called before a statement is parsed and analyzed.
+	QueryStarted(ctx *sql.Context, query string, bindings map[string]sql.Expression)
+	// QueryFinished is called once a statement failed, or once the rows it returned were all read and closed.
+	QueryFinished(ctx *sql.Context, entry QueryLogEntry)
+}
+
+// SlowQueryLogger is a QueryLogger also notified of the statements taking longer than the long_query_time system
+// variable, along with their plan as shown by EXPLAIN.
+type SlowQueryLogger interface {
+	QueryLogger
+	// SlowQuery is called after QueryFinished for the statements that were analyzed and took longer than
+	// long_query_time.
+	SlowQuery(ctx *sql.Context, entry QueryLogEntry, plan string)
+}
+
+// QueryLogEntry describes the execution of a statement.
+type QueryLogEntry struct {
+	// Query is the text of the statement.
+	Query string
+	// Bindings are the values bound to the parameters of the statement, if any.
+	Bindings map[string]sql.Expression
+	// Start is the time the execution of the statement started.
+	Start time.Time
+	// Duration is the time the statement took, including the time spent reading its rows.
+	Duration time.Duration
+	// RowsReturned is the number of rows returned by the statement, not counting the OK result of statements
+	// writing rows.
+	RowsReturned int
+	// RowsAffected is the number of rows affected by the statement.
+	RowsAffected uint64
+	// Err is the error the statement failed with, if any.
+	Err error
+}
+
+// queryLoggingIter is a RowIter wrapper notifying the query logger of an engine once the rows of a statement are
+// closed.
+type queryLoggingIter struct {
+	childIter sql.RowIter
+	logger    QueryLogger
+	entry     QueryLogEntry
+	analyzed  sql.Node
+}
+
+func (i *queryLoggingIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, err := i.childIter.Next(ctx)
+	if err != nil {
+		if err != io.EOF {
+			i.entry.Err = err
+		}
+		return nil, err
+	}
+
+	if sql.IsOkResult(row) {
+		i.entry.RowsAffected += sql.GetOkResult(row).RowsAffected
+	} else {
+		i.entry.RowsReturned++
+	}
+	return row, nil
+}
+
+func (i *queryLoggingIter) Close(ctx *sql.Context) error {
+	err := i.childIter.Close(ctx)
+	if err != nil && i.entry.Err == nil {
+		i.entry.Err = err
+	}
+
+	i.entry.Duration = time.Since(i.entry.Start)
+	logFinishedQuery(ctx, i.logger, i.entry, i.analyzed)
+	return err
+}
+
+// logFinishedQuery notifies the logger given of a finished statement, and of its plan if it's a slow query.
+func logFinishedQuery(ctx *sql.Context, logger QueryLogger, entry QueryLogEntry, analyzed sql.Node) {
+	logger.QueryFinished(ctx, entry)
+
+	slowLogger, ok := logger.(SlowQueryLogger)
+	if !ok || analyzed == nil || !isSlowQuery(ctx, entry.Duration) {
+		return
+	}
+	slowLogger.SlowQuery(ctx, entry, describePlan(ctx, analyzed))
+}
+
+// isSlowQuery returns whether a statement taking the duration given took longer than the long_query_time system
+// variable of the session.
+func isSlowQuery(ctx *sql.Context, duration time.Duration) bool {
+	val, err := ctx.GetSessionVariable(ctx, "long_query_time")
+	if err != nil {
+		return false
+	}
+	longQueryTime, ok := val.(float64)
+	return ok && duration.Seconds() > longQueryTime
+}
+
+// describePlan returns the plan given as shown by EXPLAIN.
+func describePlan(ctx *sql.Context, analyzed sql.Node) string {
+	rows, err := sql.NodeToRows(ctx, plan.NewDescribeQuery("tree", analyzed))
+	if err != nil {
+		return analyzed.String()
+	}
+
+	lines := make([]string, len(rows))
+	for i, row := range rows {
+		lines[i] = row[0].(string)
+	}
+	return strings.Join(lines, "\n")
+}
@@ -43,6 +43,14 @@ func DefaultSessionBuilder(ctx context.Context, c *mysql.Conn, addr string) (sql
 type managedSession struct {
 	session sql.Session
 	conn    *mysql.Conn
+	// prepared are the statements prepared on the connection, by statement id.
+	prepared map[uint32]preparedStatement
+}
+
+// preparedStatement is a statement prepared with COM_STMT_PREPARE, parsed once to be executed any number of times.
+type preparedStatement struct {
+	query  string
+	parsed sql.Node
 }
 
 // SessionManager is in charge of creating new sessions for the given
@@ -97,7 +105,14 @@ func (s *SessionManager) NewSession(ctx context.Context, conn *mysql.Conn) error
 		return err
 	}
 
-	s.sessions[conn.ConnectionID] = &managedSession{session, conn}
+	s.sessions[conn.ConnectionID] = &managedSession{
+		session:  session,
+		conn:     conn,
+		prepared: make(map[uint32]preparedStatement),
+	}
+	if cpl, ok := s.processlist.(sql.ConnectionProcessList); ok {
+		cpl.AddConnection(conn.ConnectionID, session.Client().Address)
+	}
 
 	logger := s.sessions[conn.ConnectionID].session.GetLogger()
 	if logger == nil {
@@ -133,6 +148,38 @@ func (s *SessionManager) session(conn *mysql.Conn) sql.Session {
 	return s.sessions[conn.ConnectionID].session
 }
 
+// setPrepared stores the parsed plan of the statement with the id given prepared on the connection given.
+func (s *SessionManager) setPrepared(conn *mysql.Conn, id uint32, query string, parsed sql.Node) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if sess, ok := s.sessions[conn.ConnectionID]; ok {
+		sess.prepared[id] = preparedStatement{query: query, parsed: parsed}
+	}
+}
+
+// getPrepared returns the parsed plan of the statement with the id given prepared on the connection given, or nil if
+// the statement wasn't prepared with the query given. Statements the client closed since are discarded.
+func (s *SessionManager) getPrepared(conn *mysql.Conn, id uint32, query string) sql.Node {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sess, ok := s.sessions[conn.ConnectionID]
+	if !ok {
+		return nil
+	}
+
+	for preparedID := range sess.prepared {
+		if _, ok := conn.PrepareData[preparedID]; !ok {
+			delete(sess.prepared, preparedID)
+		}
+	}
+
+	stmt, ok := sess.prepared[id]
+	if !ok || stmt.query != query {
+		return nil
+	}
+	return stmt.parsed
+}
+
 // NewContext creates a new context for the session at the given conn.
 func (s *SessionManager) NewContext(conn *mysql.Conn) (*sql.Context, error) {
 	return s.NewContextWithQuery(conn, "")
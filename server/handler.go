@@ -96,24 +96,37 @@ func (h *Handler) ComInitDB(c *mysql.Conn, schemaName string) error {
 	return h.sm.SetDB(c, schemaName)
 }
 
+// ComPrepare parses the statement being prepared, which is then executed without being parsed again, and returns the
+// schema of its rows.
 func (h *Handler) ComPrepare(c *mysql.Conn, query string) ([]*query.Field, error) {
 	ctx, err := h.sm.NewContextWithQuery(c, query)
 	if err != nil {
 		return nil, err
 	}
-	schema, err := h.e.AnalyzeQuery(ctx, query)
+	parsed, err := parse.Parse(ctx, query)
 	if err != nil {
 		return nil, err
 	}
+	// The analyzed plan only gives the schema returned to the client. Executions analyze the parsed plan again once
+	// their values are bound, since the analyzer folds them into the plan and picks indexes from them, and the tables
+	// and session state it resolves the plan with may have changed since the statement was prepared.
+	analyzed, err := h.e.Analyzer.Analyze(ctx, parsed, nil)
+	if err != nil {
+		return nil, err
+	}
+	h.sm.setPrepared(c, c.StatementID, query, parsed)
+
+	schema := analyzed.Schema()
 	if sql.IsOkResultSchema(schema) {
 		return nil, nil
 	}
 	return schemaToFields(schema), nil
 }
 
+// ComStmtExecute executes a prepared statement with the parameters bound by the client.
 func (h *Handler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareData, callback func(*sqltypes.Result) error) error {
-
-	_, err := h.errorWrappedDoQuery(c, prepare.PrepareStmt, MultiStmtModeOff, prepare.BindVars, func(res *sqltypes.Result, more bool) error {
+	parsed := h.sm.getPrepared(c, prepare.StatementID, prepare.PrepareStmt)
+	_, err := h.errorWrappedDoQuery(c, prepare.PrepareStmt, parsed, MultiStmtModeOff, prepare.BindVars, func(res *sqltypes.Result, more bool) error {
 		return callback(res)
 	})
 	return err
@@ -136,9 +149,17 @@ func (h *Handler) ConnectionClosed(c *mysql.Conn) {
 
 	// If connection was closed, kill its associated queries.
 	ctx.ProcessList.Kill(c.ConnectionID)
+	if cpl, ok := ctx.ProcessList.(sql.ConnectionProcessList); ok {
+		cpl.RemoveConnection(c.ConnectionID)
+	}
 	if err := h.e.Analyzer.Catalog.UnlockTables(ctx, c.ConnectionID); err != nil {
 		logrus.Errorf("unable to unlock tables on session close: %s", err)
 	}
+	if h.e.LS != nil {
+		if _, err := h.e.LS.ReleaseAll(ctx); err != nil {
+			logrus.Errorf("unable to release named locks on session close: %s", err)
+		}
+	}
 
 	logrus.WithField(sqle.ConnectionIdLogField, c.ConnectionID).Infof("ConnectionClosed")
 }
@@ -148,7 +169,7 @@ func (h *Handler) ComMultiQuery(
 	query string,
 	callback func(*sqltypes.Result, bool) error,
 ) (string, error) {
-	return h.errorWrappedDoQuery(c, query, MultiStmtModeOn, nil, callback)
+	return h.errorWrappedDoQuery(c, query, nil, MultiStmtModeOn, nil, callback)
 }
 
 // ComQuery executes a SQL query on the SQLe engine.
@@ -157,7 +178,7 @@ func (h *Handler) ComQuery(
 	query string,
 	callback func(*sqltypes.Result, bool) error,
 ) error {
-	_, err := h.errorWrappedDoQuery(c, query, MultiStmtModeOff, nil, callback)
+	_, err := h.errorWrappedDoQuery(c, query, nil, MultiStmtModeOff, nil, callback)
 	return err
 }
 
@@ -273,6 +294,7 @@ var queryLoggingRegex = regexp.MustCompile(`[\r\n\t ]+`)
 func (h *Handler) doQuery(
 	c *mysql.Conn,
 	query string,
+	parsed sql.Node,
 	mode MultiStmtMode,
 	bindings map[string]*query.BindVariable,
 	callback func(*sqltypes.Result, bool) error,
@@ -283,7 +305,6 @@ func (h *Handler) doQuery(
 	}
 
 	var remainder string
-	var parsed sql.Node
 	if mode == MultiStmtModeOn {
 		var prequery string
 		parsed, prequery, remainder, _ = parse.ParseOne(ctx, query)
@@ -515,6 +536,7 @@ func isSessionAutocommit(ctx *sql.Context) (bool, error) {
 func (h *Handler) errorWrappedDoQuery(
 	c *mysql.Conn,
 	query string,
+	parsed sql.Node,
 	mode MultiStmtMode,
 	bindings map[string]*query.BindVariable,
 	callback func(*sqltypes.Result, bool) error,
@@ -524,7 +546,7 @@ func (h *Handler) errorWrappedDoQuery(
 		h.sel.QueryStarted()
 	}
 
-	remainder, err := h.doQuery(c, query, mode, bindings, callback)
+	remainder, err := h.doQuery(c, query, parsed, mode, bindings, callback)
 	err, _, ok := sql.CastSQLError(err)
 
 	var retErr error
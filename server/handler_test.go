@@ -202,6 +202,64 @@ func TestHandlerComPrepare(t *testing.T) {
 	}
 }
 
+func TestHandlerComStmtExecute(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+	dummyConn := &mysql.Conn{ConnectionID: 1, PrepareData: make(map[uint32]*mysql.PrepareData)}
+	handler := NewHandler(
+		e,
+		NewSessionManager(
+			testSessionBuilder,
+			opentracing.NoopTracer{},
+			func(db string) bool { return db == "test" },
+			sql.NewMemoryManager(nil),
+			sqle.NewProcessList(),
+			"foo",
+		),
+		0,
+		false,
+		nil,
+	)
+	handler.NewConnection(dummyConn)
+	require.NoError(handler.ComInitDB(dummyConn, "test"))
+
+	// The connection assigns the statement id and stores the prepared statement before calling ComPrepare
+	stmt := "select c1 from test where c1 > ? order by c1"
+	dummyConn.StatementID = 1
+	prepare := &mysql.PrepareData{StatementID: 1, PrepareStmt: stmt}
+	dummyConn.PrepareData[1] = prepare
+
+	_, err := handler.ComPrepare(dummyConn, stmt)
+	require.NoError(err)
+	require.NotNil(handler.sm.getPrepared(dummyConn, 1, stmt))
+	require.Nil(handler.sm.getPrepared(dummyConn, 1, "select 1"))
+
+	for _, test := range []struct {
+		bound    string
+		expected []string
+	}{
+		{"1005", []string{"1006", "1007", "1008", "1009"}},
+		{"1007", []string{"1008", "1009"}},
+	} {
+		prepare.BindVars = map[string]*query.BindVariable{
+			"v1": {Type: query.Type_INT64, Value: []byte(test.bound)},
+		}
+		var values []string
+		err = handler.ComStmtExecute(dummyConn, prepare, func(res *sqltypes.Result) error {
+			for _, row := range res.Rows {
+				values = append(values, row[0].ToString())
+			}
+			return nil
+		})
+		require.NoError(err)
+		require.Equal(test.expected, values)
+	}
+
+	// Closing the statement discards its plan
+	delete(dummyConn.PrepareData, 1)
+	require.Nil(handler.sm.getPrepared(dummyConn, 1, stmt))
+}
+
 type TestListener struct {
 	Connections int
 	Queries     int
@@ -357,6 +415,46 @@ func TestHandlerKill(t *testing.T) {
 	assertNoConnProcesses(t, e, conn1.ConnectionID)
 }
 
+func TestHandlerReleasesNamedLocksOnClose(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+
+	handler := NewHandler(
+		e,
+		NewSessionManager(
+			func(ctx context.Context, conn *mysql.Conn, addr string) (sql.Session, error) {
+				return sql.NewBaseSessionWithClientServer(addr, sql.Client{Capabilities: conn.Capabilities}, conn.ConnectionID), nil
+			},
+			opentracing.NoopTracer{},
+			func(db string) bool { return db == "test" },
+			e.MemoryManager,
+			e.ProcessList,
+			"foo",
+		),
+		0,
+		false,
+		nil,
+	)
+
+	conn1 := newConn(1)
+	handler.NewConnection(conn1)
+	handler.ComInitDB(conn1, "test")
+
+	err := handler.ComQuery(conn1, "SELECT GET_LOCK('migrations', 0)", func(res *sqltypes.Result, more bool) error {
+		return nil
+	})
+	require.NoError(err)
+
+	state, owner := e.LS.GetLockState("migrations")
+	require.Equal(sql.LockInUse, state)
+	require.Equal(conn1.ConnectionID, owner)
+
+	handler.ConnectionClosed(conn1)
+
+	state, _ = e.LS.GetLockState("migrations")
+	require.Equal(sql.LockFree, state)
+}
+
 func assertNoConnProcesses(t *testing.T, e *sqle.Engine, conn uint32) {
 	t.Helper()
 
@@ -28,9 +28,11 @@ func applyHashIn(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.N
 		}
 
 		e, err := expression.TransformUp(filter.Expression, func(expr sql.Expression) (sql.Expression, error) {
+			// Hashing converts the elements to the type of the left value, which can't hold strings compared as numbers
 			if e, ok := expr.(*expression.InTuple); ok &&
 				hasSingleOutput(e.Left()) &&
-				isStatic(e.Right()) {
+				isStatic(e.Right()) &&
+				!e.ComparesStringsWithNumbers() {
 				return expression.NewHashInTuple(e.Left(), e.Right())
 			}
 			return expr, nil
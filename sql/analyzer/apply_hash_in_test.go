@@ -534,6 +534,29 @@ func TestApplyHashIn(t *testing.T) {
 				child,
 			),
 		},
+		{
+			name: "skip filter comparing strings with numbers",
+			node: plan.NewFilter(
+				expression.NewInTuple(
+					expression.NewGetField(0, sql.Int64, "a", false),
+					expression.NewTuple(
+						expression.NewLiteral("5", sql.LongText),
+						expression.NewLiteral("x", sql.LongText),
+					),
+				),
+				child,
+			),
+			expected: plan.NewFilter(
+				expression.NewInTuple(
+					expression.NewGetField(0, sql.Int64, "a", false),
+					expression.NewTuple(
+						expression.NewLiteral("5", sql.LongText),
+						expression.NewLiteral("x", sql.LongText),
+					),
+				),
+				child,
+			),
+		},
 	}
 
 	runTestCases(t, sql.NewEmptyContext(), tests, NewDefault(sql.NewDatabaseProvider()), getRule("apply_hash_in"))
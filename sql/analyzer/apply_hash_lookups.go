@@ -75,45 +75,31 @@ func applyHashLookups(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (
 		if cond == nil {
 			return c.Node, nil
 		}
-		// Support expressions of the form (GetField = GetField AND GetField = GetField AND ...)
-		// where every equal comparison has one operand coming from primary and one operand
-		// coming from secondary. Accumulate the field accesses into a tuple expression for
-		// the primary row and another tuple expression for the child row. For the child row
-		// expression, rewrite the GetField indexes to work against the non-prefixed rows that
-		// are actually returned from the child.
+		// Support conditions with at least one conjunct of the form GetField = GetField, where one operand comes
+		// from primary and the other from secondary. Accumulate the field accesses into a tuple expression for the
+		// primary row and another tuple expression for the child row. For the child row expression, rewrite the
+		// GetField indexes to work against the non-prefixed rows that are actually returned from the child. The
+		// other conjuncts are left out of the hash key, as the join evaluates its whole condition on the rows
+		// returned by the lookup.
 		var primaryGetFields, secondaryGetFields []sql.Expression
-		validCondition := true
-		sql.Inspect(cond, func(e sql.Expression) bool {
-			if e == nil {
-				return true
+		for _, e := range splitConjunction(cond) {
+			eq, ok := e.(*expression.Equals)
+			if !ok {
+				continue
 			}
-			switch e := e.(type) {
-			case *expression.Equals:
-				if pgf := primaryGetter(e.Left()); pgf != nil {
-					if sgf := secondaryGetter(e.Right()); sgf != nil {
-						primaryGetFields = append(primaryGetFields, pgf)
-						secondaryGetFields = append(secondaryGetFields, sgf)
-					} else {
-						validCondition = false
-					}
-				} else if pgf := primaryGetter(e.Right()); pgf != nil {
-					if sgf := secondaryGetter(e.Left()); sgf != nil {
-						primaryGetFields = append(primaryGetFields, pgf)
-						secondaryGetFields = append(secondaryGetFields, sgf)
-					} else {
-						validCondition = false
-					}
-				} else {
-					validCondition = false
+			if pgf := primaryGetter(eq.Left()); pgf != nil {
+				if sgf := secondaryGetter(eq.Right()); sgf != nil {
+					primaryGetFields = append(primaryGetFields, pgf)
+					secondaryGetFields = append(secondaryGetFields, sgf)
+				}
+			} else if pgf := primaryGetter(eq.Right()); pgf != nil {
+				if sgf := secondaryGetter(eq.Left()); sgf != nil {
+					primaryGetFields = append(primaryGetFields, pgf)
+					secondaryGetFields = append(secondaryGetFields, sgf)
 				}
-				return false
-			case *expression.And:
-			default:
-				validCondition = false
-				return false
 			}
-			return validCondition
-		})
+		}
+		validCondition := len(primaryGetFields) > 0
 		if validCondition {
 			primaryTuple := expression.NewTuple(primaryGetFields...)
 			secondaryTuple := expression.NewTuple(secondaryGetFields...)
@@ -0,0 +1,140 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// applyMergeJoins replaces inner and left joins with merge joins when both children read from an index that returns
+// rows in ascending order of the columns compared in the join condition. See mergeJoinKeys for the conditions under
+// which the orderings of the children line up.
+func applyMergeJoins(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	span, _ := ctx.Span("apply_merge_joins")
+	defer span.Finish()
+
+	// Merge joins don't support rows from an outer scope, and updates through joins expect the original join nodes.
+	if !n.Resolved() || len(scope.Schema()) > 0 {
+		return n, nil
+	}
+	switch n.(type) {
+	case *plan.Update, *plan.DeleteFrom:
+		return n, nil
+	}
+
+	tableAliases, err := getTableAliases(n, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		var cond sql.Expression
+		var joinType plan.JoinType
+		switch j := n.(type) {
+		case *plan.InnerJoin:
+			if j.ScopeLen > 0 {
+				return n, nil
+			}
+			cond, joinType = j.Cond, plan.JoinTypeInner
+		case *plan.LeftJoin:
+			if j.ScopeLen > 0 {
+				return n, nil
+			}
+			cond, joinType = j.Cond, plan.JoinTypeLeft
+		default:
+			return n, nil
+		}
+
+		join := n.(plan.JoinNode)
+		leftKeys, rightKeys := mergeJoinKeys(ctx, join.Left(), join.Right(), cond, tableAliases)
+		if len(leftKeys) == 0 {
+			return n, nil
+		}
+
+		a.Log("join replaced by merge join on %d key(s)", len(leftKeys))
+		return plan.NewMergeJoin(join.Left(), join.Right(), joinType, cond, leftKeys, rightKeys), nil
+	})
+}
+
+// mergeJoinKeys returns the keys for a merge join between the children given, or nil if they can't be merge joined.
+// Both children must read from a sql.OrderedIndex with ascending order. The keys are the longest common prefix of both
+// indexes for which the join condition has a conjunct comparing the columns of the two indexes for equality, and the
+// columns of each pair must have the same type so that both sides are ordered the same way. The position of NULL values
+// in the indexes doesn't matter, as rows with NULL keys never match and are skipped.
+func mergeJoinKeys(ctx *sql.Context, left, right sql.Node, cond sql.Expression, tableAliases TableAliases) ([]sql.Expression, []sql.Expression) {
+	leftIdx := ascendingIndexExpressions(left)
+	rightIdx := ascendingIndexExpressions(right)
+	if len(leftIdx) == 0 || len(rightIdx) == 0 {
+		return nil, nil
+	}
+
+	leftLen := len(left.Schema())
+	var leftCols, rightCols []*expression.GetField
+	for _, e := range splitConjunction(cond) {
+		eq, ok := e.(*expression.Equals)
+		if !ok {
+			continue
+		}
+		l, lok := eq.Left().(*expression.GetField)
+		r, rok := eq.Right().(*expression.GetField)
+		if !lok || !rok {
+			continue
+		}
+		if l.Index() >= leftLen {
+			l, r = r, l
+		}
+		if l.Index() >= leftLen || r.Index() < leftLen || !sql.TypesEqual(l.Type(), r.Type()) {
+			continue
+		}
+		leftCols = append(leftCols, l)
+		rightCols = append(rightCols, r)
+	}
+
+	var leftKeys, rightKeys []sql.Expression
+	for i := 0; i < len(leftIdx) && i < len(rightIdx); i++ {
+		found := false
+		for j := range leftCols {
+			if strings.EqualFold(normalizeExpression(ctx, tableAliases, leftCols[j]).String(), leftIdx[i]) &&
+				strings.EqualFold(normalizeExpression(ctx, tableAliases, rightCols[j]).String(), rightIdx[i]) {
+				leftKeys = append(leftKeys, leftCols[j])
+				rightKeys = append(rightKeys, rightCols[j].WithIndex(rightCols[j].Index()-leftLen))
+				found = true
+				break
+			}
+		}
+		if !found {
+			break
+		}
+	}
+	return leftKeys, rightKeys
+}
+
+// ascendingIndexExpressions returns the expressions of the index that the node given reads from, provided that the
+// index returns rows in ascending order. Returns nil otherwise.
+func ascendingIndexExpressions(n sql.Node) []string {
+	ita := orderPreservingIndexedTableAccess(n)
+	if ita == nil {
+		return nil
+	}
+	orderedIdx, ok := ita.Index().(sql.OrderedIndex)
+	if !ok || orderedIdx.Order() != sql.IndexOrderAsc {
+		return nil
+	}
+	return orderedIdx.Expressions()
+}
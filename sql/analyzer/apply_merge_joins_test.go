@@ -0,0 +1,99 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+func TestApplyMergeJoins(t *testing.T) {
+	f := getRule("apply_merge_joins")
+
+	t1 := plan.NewResolvedTable(memory.NewTable("t1", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Source: "t1", Type: sql.Int64},
+		{Name: "b", Source: "t1", Type: sql.Text},
+	})), nil, nil)
+	t2 := plan.NewResolvedTable(memory.NewTable("t2", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "c", Source: "t2", Type: sql.Int64},
+		{Name: "d", Source: "t2", Type: sql.Text},
+	})), nil, nil)
+
+	a := expression.NewGetFieldWithTable(0, sql.Int64, "t1", "a", false)
+	b := expression.NewGetFieldWithTable(1, sql.Text, "t1", "b", false)
+	c := expression.NewGetFieldWithTable(2, sql.Int64, "t2", "c", false)
+	d := expression.NewGetFieldWithTable(3, sql.Text, "t2", "d", false)
+	key := []sql.Expression{expression.NewLiteral(int64(1), sql.Int64)}
+
+	access := func(rt *plan.ResolvedTable, order sql.IndexOrder, exprs ...sql.Expression) sql.Node {
+		idx := orderedDummyIdx{
+			dummyIdx:     &dummyIdx{id: rt.Name() + "_idx", expr: exprs, database: "mydb", table: rt.Name()},
+			order:        order,
+			nullOrdering: sql.NullsFirst,
+		}
+		return plan.NewStaticIndexedTableAccess(rt, nil, idx, key)
+	}
+	t1Asc := access(t1, sql.IndexOrderAsc, a)
+	t2Asc := access(t2, sql.IndexOrderAsc, c.WithIndex(0))
+	t2Desc := access(t2, sql.IndexOrderDesc, c.WithIndex(0))
+	t1Text := access(t1, sql.IndexOrderAsc, b)
+	t2Text := access(t2, sql.IndexOrderAsc, d.WithIndex(1))
+
+	rightC := expression.NewGetFieldWithTable(0, sql.Int64, "t2", "c", false)
+	residual := expression.NewAnd(expression.NewEquals(c, a), expression.NewNot(expression.NewEquals(b, d)))
+
+	testCases := []analyzerFnTestCase{
+		{
+			name:     "inner join over ascending indexes",
+			node:     plan.NewInnerJoin(t1Asc, t2Asc, expression.NewEquals(a, c)),
+			expected: plan.NewMergeJoin(t1Asc, t2Asc, plan.JoinTypeInner, expression.NewEquals(a, c), []sql.Expression{a}, []sql.Expression{rightC}),
+		},
+		{
+			name:     "left join with residual condition",
+			node:     plan.NewLeftJoin(t1Asc, t2Asc, residual),
+			expected: plan.NewMergeJoin(t1Asc, t2Asc, plan.JoinTypeLeft, residual, []sql.Expression{a}, []sql.Expression{rightC}),
+		},
+		{
+			name: "right join",
+			node: plan.NewRightJoin(t1Asc, t2Asc, expression.NewEquals(a, c)),
+		},
+		{
+			name: "descending index",
+			node: plan.NewInnerJoin(t1Asc, t2Desc, expression.NewEquals(a, c)),
+		},
+		{
+			name: "unindexed child",
+			node: plan.NewInnerJoin(t1Asc, t2, expression.NewEquals(a, c)),
+		},
+		{
+			name: "join columns are not the indexed columns",
+			node: plan.NewInnerJoin(t1Text, t2Text, expression.NewEquals(a, c)),
+		},
+		{
+			name: "indexed columns of different types",
+			node: plan.NewInnerJoin(t1Asc, t2Text, expression.NewEquals(a, d)),
+		},
+		{
+			name: "no equality in condition",
+			node: plan.NewInnerJoin(t1Asc, t2Asc, expression.NewLessThan(a, c)),
+		},
+	}
+
+	runTestCases(t, nil, testCases, NewDefault(nil), f)
+}
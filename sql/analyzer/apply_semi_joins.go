@@ -0,0 +1,193 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"math"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// applySemiJoins replaces subquery conditions of filters with SemiJoin nodes, which hash the results of the subquery
+// once instead of evaluating it for every row. Each conjunct of a filter is replaced if it has one of these forms:
+// key IN (SELECT ...), when the subquery doesn't reference the filtered row, becomes a semijoin
+// key NOT IN (SELECT ...), under the same condition, becomes a null-aware antijoin
+// EXISTS (SELECT ... WHERE inner = outer AND ...), when that equality is the only reference to the filtered row,
+// becomes a semijoin on outer IN (SELECT inner ...)
+// NOT EXISTS with the same form becomes an antijoin
+// Subqueries that reference the filtered row in any other way are still evaluated for each row.
+func applySemiJoins(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	span, _ := ctx.Span("apply_semi_joins")
+	defer span.Finish()
+
+	if !n.Resolved() {
+		return n, nil
+	}
+
+	scopeLen := len(scope.Schema())
+	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		filter, ok := n.(*plan.Filter)
+		if !ok {
+			return n, nil
+		}
+
+		childLen := len(filter.Child.Schema())
+		child := filter.Child
+		var remaining []sql.Expression
+		for _, cond := range splitConjunction(filter.Expression) {
+			key, subquery, joinType, ok := semiJoinCondition(cond, scopeLen, childLen)
+			if !ok {
+				remaining = append(remaining, cond)
+				continue
+			}
+			a.Log("replacing %s with a %s join", cond, joinType)
+			child = plan.NewSemiJoin(child, key, subquery, joinType)
+		}
+
+		if len(remaining) == 0 {
+			return child, nil
+		}
+		if child == filter.Child {
+			return n, nil
+		}
+		return plan.NewFilter(expression.JoinAnd(remaining...), child), nil
+	})
+}
+
+// semiJoinCondition returns the key, subquery and join type of a SemiJoin equivalent to the filter condition given, or
+// false if there isn't one. The rows the subquery is evaluated on start with |scopeLen| columns of the outer scope
+// followed by |childLen| columns of the filtered row.
+func semiJoinCondition(cond sql.Expression, scopeLen, childLen int) (sql.Expression, *plan.Subquery, plan.SemiJoinType, bool) {
+	negated := false
+	if not, ok := cond.(*expression.Not); ok {
+		cond = not.Child
+		negated = true
+	}
+
+	switch cond := cond.(type) {
+	case *plan.InSubquery:
+		subquery, ok := cond.Right.(*plan.Subquery)
+		if !ok || sql.NumColumns(cond.Left.Type()) != 1 || sql.NumColumns(subquery.Type()) != 1 {
+			return nil, nil, 0, false
+		}
+		if nodeHasSubqueryExpression(subquery.Query) ||
+			nodeHasGetFieldReferenceBetween(subquery.Query, scopeLen, scopeLen+childLen) {
+			return nil, nil, 0, false
+		}
+		if negated {
+			return cond.Left, subquery, plan.SemiJoinTypeNullAwareAnti, true
+		}
+		return cond.Left, subquery, plan.SemiJoinTypeSemi, true
+	case *plan.ExistsSubquery:
+		subquery, ok := cond.Children()[0].(*plan.Subquery)
+		if !ok {
+			return nil, nil, 0, false
+		}
+		key, subquery, ok := decorrelateExists(subquery, scopeLen, scopeLen+childLen)
+		if !ok {
+			return nil, nil, 0, false
+		}
+		if negated {
+			return key, subquery, plan.SemiJoinTypeAnti, true
+		}
+		return key, subquery, plan.SemiJoinTypeSemi, true
+	default:
+		return nil, nil, 0, false
+	}
+}
+
+// decorrelateExists rewrites the subquery of `EXISTS (SELECT ... FROM ... WHERE inner = outer AND ...)` as the subquery
+// of `outer IN (SELECT inner FROM ... WHERE ...)`, and returns the outer expression and the new subquery. The subquery
+// must project a filter, and the equality must be its only reference to the columns in [low, high) of its rows, which
+// are those of the filtered row.
+func decorrelateExists(subquery *plan.Subquery, low, high int) (sql.Expression, *plan.Subquery, bool) {
+	project, ok := subquery.Query.(*plan.Project)
+	if !ok {
+		return nil, nil, false
+	}
+	filter, ok := project.Child.(*plan.Filter)
+	if !ok || nodeHasSubqueryExpression(filter) || nodeHasGetFieldReferenceBetween(filter.Child, low, high) {
+		return nil, nil, false
+	}
+
+	var outer, inner sql.Expression
+	var remaining []sql.Expression
+	for _, cond := range splitConjunction(filter.Expression) {
+		if eq, ok := cond.(*expression.Equals); ok && outer == nil {
+			if o, i, ok := correlatedEquality(eq, low, high); ok {
+				outer, inner = o, i
+				continue
+			}
+		}
+		if expressionHasGetFieldReferenceBetween(cond, low, high) {
+			return nil, nil, false
+		}
+		remaining = append(remaining, cond)
+	}
+	if outer == nil {
+		return nil, nil, false
+	}
+
+	child := filter.Child
+	if len(remaining) > 0 {
+		child = plan.NewFilter(expression.JoinAnd(remaining...), child)
+	}
+	query := plan.NewProject([]sql.Expression{inner}, child)
+	decorrelated := plan.NewSubquery(query, subquery.QueryString)
+	if nodeIsCacheable(query, high) {
+		decorrelated = decorrelated.WithCachedResults()
+	}
+	return outer, decorrelated, true
+}
+
+// correlatedEquality returns the sides of an equality between an expression on the columns before |high|, at least one
+// of which is in [low, high), and an expression that doesn't reference any column in [low, high). Both sides must be
+// numbers or both strings, so that hashing them gives the same matches as comparing them.
+func correlatedEquality(eq *expression.Equals, low, high int) (outer, inner sql.Expression, ok bool) {
+	isOuter := func(e sql.Expression) bool {
+		return expressionHasGetFieldReferenceBetween(e, low, high) && !expressionHasGetFieldReferenceBetween(e, high, math.MaxInt32)
+	}
+	isInner := func(e sql.Expression) bool {
+		return !expressionHasGetFieldReferenceBetween(e, low, high)
+	}
+
+	if isOuter(eq.Left()) && isInner(eq.Right()) {
+		outer, inner = eq.Left(), eq.Right()
+	} else if isOuter(eq.Right()) && isInner(eq.Left()) {
+		outer, inner = eq.Right(), eq.Left()
+	} else {
+		return nil, nil, false
+	}
+
+	outerTyp, innerTyp := outer.Type(), inner.Type()
+	if !(sql.IsNumber(outerTyp) && sql.IsNumber(innerTyp)) && !(sql.IsText(outerTyp) && sql.IsText(innerTyp)) {
+		return nil, nil, false
+	}
+	return outer, inner, true
+}
+
+// nodeHasSubqueryExpression returns whether any expression of the node given or its children contains a subquery.
+func nodeHasSubqueryExpression(n sql.Node) bool {
+	found := false
+	plan.InspectExpressions(n, func(e sql.Expression) bool {
+		if _, ok := e.(*plan.Subquery); ok {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
@@ -0,0 +1,108 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+func TestApplySemiJoins(t *testing.T) {
+	f := getRule("apply_semi_joins")
+
+	t1 := plan.NewResolvedTable(memory.NewTable("t1", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Source: "t1", Type: sql.Int64},
+		{Name: "b", Source: "t1", Type: sql.Text},
+	})), nil, nil)
+	t2 := plan.NewResolvedTable(memory.NewTable("t2", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "c", Source: "t2", Type: sql.Int64},
+	})), nil, nil)
+
+	a := expression.NewGetFieldWithTable(0, sql.Int64, "t1", "a", false)
+	b := expression.NewGetFieldWithTable(1, sql.Text, "t1", "b", false)
+	// Subqueries are evaluated with the columns of t1 before their own
+	c := expression.NewGetFieldWithTable(2, sql.Int64, "t2", "c", false)
+	one := expression.NewLiteral(int64(1), sql.Int64)
+
+	uncorrelated := plan.NewSubquery(plan.NewProject([]sql.Expression{c}, t2), "select c from t2")
+	correlated := plan.NewSubquery(plan.NewProject(
+		[]sql.Expression{c},
+		plan.NewFilter(expression.NewGreaterThan(c, a), t2),
+	), "select c from t2 where c > a")
+	exists := plan.NewSubquery(plan.NewProject(
+		[]sql.Expression{one},
+		plan.NewFilter(expression.NewAnd(expression.NewEquals(a, c), expression.NewGreaterThan(c, one)), t2),
+	), "select 1 from t2 where a = c and c > 1")
+	decorrelated := plan.NewSubquery(plan.NewProject(
+		[]sql.Expression{c},
+		plan.NewFilter(expression.NewGreaterThan(c, one), t2),
+	), "select 1 from t2 where a = c and c > 1").WithCachedResults()
+	textExists := plan.NewSubquery(plan.NewProject(
+		[]sql.Expression{one},
+		plan.NewFilter(expression.NewEquals(b, c), t2),
+	), "select 1 from t2 where b = c")
+
+	testCases := []analyzerFnTestCase{
+		{
+			name:     "in subquery",
+			node:     plan.NewFilter(plan.NewInSubquery(a, uncorrelated), t1),
+			expected: plan.NewSemiJoin(t1, a, uncorrelated, plan.SemiJoinTypeSemi),
+		},
+		{
+			name:     "not in subquery",
+			node:     plan.NewFilter(plan.NewNotInSubquery(a, uncorrelated), t1),
+			expected: plan.NewSemiJoin(t1, a, uncorrelated, plan.SemiJoinTypeNullAwareAnti),
+		},
+		{
+			name: "in subquery with other conditions",
+			node: plan.NewFilter(expression.NewAnd(
+				expression.NewGreaterThan(a, one),
+				plan.NewInSubquery(a, uncorrelated),
+			), t1),
+			expected: plan.NewFilter(
+				expression.NewGreaterThan(a, one),
+				plan.NewSemiJoin(t1, a, uncorrelated, plan.SemiJoinTypeSemi),
+			),
+		},
+		{
+			name: "correlated in subquery",
+			node: plan.NewFilter(plan.NewInSubquery(a, correlated), t1),
+		},
+		{
+			name:     "exists correlated by an equality",
+			node:     plan.NewFilter(plan.NewExistsSubquery(exists), t1),
+			expected: plan.NewSemiJoin(t1, a, decorrelated, plan.SemiJoinTypeSemi),
+		},
+		{
+			name:     "not exists correlated by an equality",
+			node:     plan.NewFilter(expression.NewNot(plan.NewExistsSubquery(exists)), t1),
+			expected: plan.NewSemiJoin(t1, a, decorrelated, plan.SemiJoinTypeAnti),
+		},
+		{
+			name: "exists correlated by an inequality",
+			node: plan.NewFilter(plan.NewExistsSubquery(correlated), t1),
+		},
+		{
+			name: "exists correlated by comparing a string with a number",
+			node: plan.NewFilter(plan.NewExistsSubquery(textExists), t1),
+		},
+	}
+
+	runTestCases(t, nil, testCases, NewDefault(nil), f)
+}
@@ -0,0 +1,85 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// applyStreamGroupBy replaces GroupBy nodes with StreamGroupBy nodes when their child reads from an index that returns
+// the rows of each group next to each other. See indexGroupsRows for the conditions under which it does.
+func applyStreamGroupBy(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	span, _ := ctx.Span("apply_stream_group_by")
+	defer span.Finish()
+
+	if !n.Resolved() {
+		return n, nil
+	}
+
+	tableAliases, err := getTableAliases(n, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		gb, ok := n.(*plan.GroupBy)
+		if !ok || len(gb.GroupByExprs) == 0 {
+			return n, nil
+		}
+
+		ita := orderPreservingIndexedTableAccess(gb.Child)
+		if ita == nil || !indexGroupsRows(ctx, ita.Index(), gb.GroupByExprs, tableAliases) {
+			return n, nil
+		}
+
+		a.Log("group by replaced by stream group by on index %s", ita.Index().ID())
+		return plan.NewStreamGroupBy(gb.SelectedExprs, gb.GroupByExprs, gb.Child), nil
+	})
+}
+
+// indexGroupsRows returns whether reading from the index given returns the rows of each group of the grouping
+// expressions given next to each other. This is the case when the index is a sql.OrderedIndex, and the grouping
+// expressions are plain columns that are, in any order, the same as a prefix of the index expressions. Unlike for
+// sorting, the direction of the index and the position of NULL values don't matter.
+func indexGroupsRows(ctx *sql.Context, idx sql.Index, groupByExprs []sql.Expression, tableAliases TableAliases) bool {
+	orderedIdx, ok := idx.(sql.OrderedIndex)
+	if !ok || orderedIdx.Order() == sql.IndexOrderNone {
+		return false
+	}
+
+	grouping := make(map[string]bool)
+	for _, e := range groupByExprs {
+		gf, ok := e.(*expression.GetField)
+		if !ok {
+			return false
+		}
+		grouping[strings.ToLower(normalizeExpression(ctx, tableAliases, gf).String())] = true
+	}
+
+	idxExprs := orderedIdx.Expressions()
+	if len(grouping) > len(idxExprs) {
+		return false
+	}
+	for _, e := range idxExprs[:len(grouping)] {
+		if !grouping[strings.ToLower(e)] {
+			return false
+		}
+	}
+	return true
+}
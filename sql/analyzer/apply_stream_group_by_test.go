@@ -0,0 +1,102 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+func TestApplyStreamGroupBy(t *testing.T) {
+	f := getRule("apply_stream_group_by")
+
+	table := memory.NewTable("mytable", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "i", Source: "mytable", Type: sql.Int64},
+		{Name: "j", Source: "mytable", Type: sql.Int64, Nullable: true},
+		{Name: "k", Source: "mytable", Type: sql.Int64, Nullable: true},
+	}))
+	rt := plan.NewResolvedTable(table, nil, nil)
+
+	i := expression.NewGetFieldWithTable(0, sql.Int64, "mytable", "i", false)
+	j := expression.NewGetFieldWithTable(1, sql.Int64, "mytable", "j", true)
+	k := expression.NewGetFieldWithTable(2, sql.Int64, "mytable", "k", true)
+	key := []sql.Expression{expression.NewLiteral(int64(1), sql.Int64)}
+	sum := aggregation.NewSum(i)
+
+	newIdx := func(order sql.IndexOrder, exprs ...sql.Expression) sql.Index {
+		return orderedDummyIdx{
+			dummyIdx:     &dummyIdx{id: "jk_idx", expr: exprs, database: "mydb", table: "mytable"},
+			order:        order,
+			nullOrdering: sql.NullsFirst,
+		}
+	}
+	access := func(idx sql.Index) sql.Node {
+		return plan.NewStaticIndexedTableAccess(rt, nil, idx, key)
+	}
+
+	asc := access(newIdx(sql.IndexOrderAsc, j, k))
+	desc := access(newIdx(sql.IndexOrderDesc, j, k))
+	unordered := plan.NewStaticIndexedTableAccess(rt, nil, &dummyIdx{id: "jk_idx", expr: []sql.Expression{j, k}, database: "mydb", table: "mytable"}, key)
+
+	testCases := []analyzerFnTestCase{
+		{
+			name:     "grouping by a prefix of the index",
+			node:     plan.NewGroupBy([]sql.Expression{j, sum}, []sql.Expression{j}, asc),
+			expected: plan.NewStreamGroupBy([]sql.Expression{j, sum}, []sql.Expression{j}, asc),
+		},
+		{
+			name:     "grouping by all index columns in another order",
+			node:     plan.NewGroupBy([]sql.Expression{sum}, []sql.Expression{k, j}, asc),
+			expected: plan.NewStreamGroupBy([]sql.Expression{sum}, []sql.Expression{k, j}, asc),
+		},
+		{
+			name:     "descending index",
+			node:     plan.NewGroupBy([]sql.Expression{j, sum}, []sql.Expression{j}, desc),
+			expected: plan.NewStreamGroupBy([]sql.Expression{j, sum}, []sql.Expression{j}, desc),
+		},
+		{
+			name:     "rows grouped through filter",
+			node:     plan.NewGroupBy([]sql.Expression{j, sum}, []sql.Expression{j}, plan.NewFilter(expression.NewGreaterThan(i, key[0]), asc)),
+			expected: plan.NewStreamGroupBy([]sql.Expression{j, sum}, []sql.Expression{j}, plan.NewFilter(expression.NewGreaterThan(i, key[0]), asc)),
+		},
+		{
+			name: "grouping column is not a prefix of the index",
+			node: plan.NewGroupBy([]sql.Expression{k, sum}, []sql.Expression{k}, asc),
+		},
+		{
+			name: "grouping column is not indexed",
+			node: plan.NewGroupBy([]sql.Expression{sum}, []sql.Expression{j, i}, asc),
+		},
+		{
+			name: "grouping by an expression",
+			node: plan.NewGroupBy([]sql.Expression{sum}, []sql.Expression{expression.NewArithmetic(j, key[0], "+")}, asc),
+		},
+		{
+			name: "index without ordering",
+			node: plan.NewGroupBy([]sql.Expression{j, sum}, []sql.Expression{j}, unordered),
+		},
+		{
+			name: "table without index",
+			node: plan.NewGroupBy([]sql.Expression{j, sum}, []sql.Expression{j}, rt),
+		},
+	}
+
+	runTestCases(t, nil, testCases, NewDefault(nil), f)
+}
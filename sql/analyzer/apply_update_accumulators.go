@@ -49,7 +49,17 @@ func getUpdateAccumulatorType(n sql.Node) (plan.RowUpdateType, error) {
 	case *plan.TriggerExecutor:
 		return getUpdateAccumulatorType(n.Left())
 	case *plan.InsertInto:
-		if n.IsReplace {
+		isLoadData := false
+		plan.Inspect(n.Source, func(node sql.Node) bool {
+			if _, ok := node.(*plan.LoadData); ok {
+				isLoadData = true
+			}
+			return !isLoadData
+		})
+
+		if isLoadData {
+			return plan.UpdateTypeLoadData, nil
+		} else if n.IsReplace {
 			return plan.UpdateTypeReplace, nil
 		} else if len(n.OnDupExprs) > 0 {
 			return plan.UpdateTypeDuplicateKeyUpdate, nil
@@ -62,7 +72,7 @@ func getUpdateAccumulatorType(n sql.Node) (plan.RowUpdateType, error) {
 		hasJoin := false
 		plan.Inspect(n, func(node sql.Node) bool {
 			switch node.(type) {
-			case plan.JoinNode, *plan.CrossJoin, *plan.IndexedJoin:
+			case plan.JoinNode, *plan.CrossJoin, *plan.IndexedJoin, *plan.MergeJoin:
 				hasJoin = true
 				return false
 			}
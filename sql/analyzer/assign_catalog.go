@@ -52,10 +52,6 @@ func assignCatalog(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql
 			nc := *node
 			nc.Catalog = a.Catalog
 			return &nc, nil
-		case *plan.ShowProcessList:
-			nc := *node
-			nc.Database = ctx.GetCurrentDatabase()
-			return &nc, nil
 		case *plan.ShowTableStatus:
 			nc := *node
 			nc.Catalog = a.Catalog
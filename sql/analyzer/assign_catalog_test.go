@@ -55,15 +55,6 @@ func TestAssignCatalog(t *testing.T) {
 	require.Equal(a.Catalog, di.Catalog)
 	require.Equal("foo", di.CurrentDatabase)
 
-	node, err = f.Apply(ctx, a, plan.NewShowProcessList(), nil)
-	require.NoError(err)
-
-	pl, ok := node.(*plan.ShowProcessList)
-	require.True(ok)
-	require.Equal(db.Name(), pl.Database)
-	// TODO: get processlist from runtime
-	//	require.Equal(c.ProcessList, pl.ProcessList)
-
 	node, err = f.Apply(ctx, a, plan.NewShowDatabases(), nil)
 	require.NoError(err)
 	sd, ok := node.(*plan.ShowDatabases)
@@ -36,7 +36,13 @@ func modifyUpdateExpressionsForJoin(ctx *sql.Context, a *Analyzer, n sql.Node, s
 			return nil, err
 		}
 
+		fks, err := loadUpdateJoinForeignKeys(ctx, updaters, jn)
+		if err != nil {
+			return nil, err
+		}
+
 		uj := plan.NewUpdateJoin(updaters, us)
+		uj.ForeignKeys = fks
 		ret, err := n.WithChildren(uj)
 
 		if err != nil {
@@ -186,7 +186,7 @@ func (c *Catalog) TableAsOf(ctx *sql.Context, dbName, tableName string, asOf int
 
 	versionedDb, ok := db.(sql.VersionedDatabase)
 	if !ok {
-		return nil, nil, sql.ErrAsOfNotSupported.New(tableName)
+		return nil, nil, sql.ErrAsOfNotSupported.New(db.Name())
 	}
 
 	tbl, ok, err := versionedDb.GetTableInsensitiveAsOf(ctx, tableName, asOf)
@@ -200,6 +200,32 @@ func (c *Catalog) TableAsOf(ctx *sql.Context, dbName, tableName string, asOf int
 	return tbl, versionedDb, nil
 }
 
+// TableVersions returns every version of the table in the given database with the given name that existed in the
+// interval given, ordered from oldest to newest. The database named must support queries over intervals of time.
+func (c *Catalog) TableVersions(ctx *sql.Context, dbName, tableName string, from, to interface{}, inclusive bool) ([]sql.Table, sql.Database, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	db, err := c.Database(dbName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	versionedDb, ok := db.(sql.VersionRangeDatabase)
+	if !ok {
+		return nil, nil, sql.ErrVersionRangeNotSupported.New(db.Name())
+	}
+
+	tables, ok, err := versionedDb.GetTableInsensitiveVersions(ctx, tableName, from, to, inclusive)
+	if err != nil {
+		return nil, nil, err
+	} else if !ok {
+		return nil, nil, suggestSimilarTablesAsOf(versionedDb, ctx, tableName, to)
+	}
+
+	return tables, versionedDb, nil
+}
+
 // RegisterFunction registers the functions given, adding them to the built-in functions.
 // Integrators with custom functions should typically use the FunctionProvider interface instead.
 func (c *Catalog) RegisterFunction(fns ...sql.Function) {
@@ -79,6 +79,32 @@ func TestCatalogTable(t *testing.T) {
 	require.Equal(mytable, table)
 }
 
+func TestCatalogTableAsOf(t *testing.T) {
+	require := require.New(t)
+
+	db := memory.NewDatabase("foo")
+	db.AddTable("bar", memory.NewTable("bar", sql.PrimaryKeySchema{}))
+	historyDb := memory.NewHistoryDatabase("history")
+	v1 := memory.NewTable("bar", sql.PrimaryKeySchema{})
+	v2 := memory.NewTable("bar", sql.PrimaryKeySchema{})
+	historyDb.AddTableAsOf("bar", v1, "v1")
+	historyDb.AddTableAsOf("bar", v2, "v2")
+
+	c := NewCatalog(sql.NewDatabaseProvider(db, historyDb))
+	ctx := sql.NewEmptyContext()
+
+	_, _, err := c.TableAsOf(ctx, "foo", "bar", "v1")
+	require.EqualError(err, "AS OF not supported for database foo")
+
+	table, _, err := c.TableAsOf(ctx, "history", "bar", "v1")
+	require.NoError(err)
+	require.Same(v1, table)
+
+	table, _, err = c.TableAsOf(ctx, "history", "BAR", "v2")
+	require.NoError(err)
+	require.Same(v2, table)
+}
+
 func TestCatalogUnlockTables(t *testing.T) {
 	require := require.New(t)
 
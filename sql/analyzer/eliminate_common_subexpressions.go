@@ -0,0 +1,217 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// eliminateCommonSubexpressions evaluates deterministic expressions that are repeated in a Project or GroupBy node and
+// the Filter directly beneath it only once per row. The repeated expressions are computed by a new Project over the
+// rows both nodes evaluate, and every occurrence is replaced with a GetField on the new columns. For example:
+// Project([f(a) as x], Filter(f(a) > 1, table))
+// becomes
+// Project([f(a) as x], Filter(f(a) > 1, Project([a, f(a)], table)))
+// where f(a) in the top Project and the Filter is read from the last column of the new Project.
+func eliminateCommonSubexpressions(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	span, _ := ctx.Span("eliminate_common_subexpressions")
+	defer span.Finish()
+
+	// The rows of nodes in a subquery are prefixed with the outer scope row, which the new Project doesn't preserve.
+	if !n.Resolved() || len(scope.Schema()) > 0 {
+		return n, nil
+	}
+
+	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		switch n := n.(type) {
+		case *plan.Project:
+			exprs, child, ok, err := replaceCommonSubexpressions(n.Projections, n.Child)
+			if err != nil || !ok {
+				return n, err
+			}
+			a.Log("replaced %d common subexpression(s) in project", len(child.Schema())-len(n.Child.Schema()))
+			return plan.NewProject(exprs, child), nil
+		case *plan.GroupBy:
+			exprs := make([]sql.Expression, 0, len(n.SelectedExprs)+len(n.GroupByExprs))
+			exprs = append(exprs, n.SelectedExprs...)
+			exprs = append(exprs, n.GroupByExprs...)
+			exprs, child, ok, err := replaceCommonSubexpressions(exprs, n.Child)
+			if err != nil || !ok {
+				return n, err
+			}
+			a.Log("replaced %d common subexpression(s) in group by", len(child.Schema())-len(n.Child.Schema()))
+			return plan.NewGroupBy(exprs[:len(n.SelectedExprs)], exprs[len(n.SelectedExprs):], child), nil
+		default:
+			return n, nil
+		}
+	})
+}
+
+// replaceCommonSubexpressions finds the candidate expressions that occur more than once in |exprs| and, if |child| is
+// a Filter, in its condition. If there are any, it returns |exprs| rewritten to read them from a new Project, and
+// |child| with that Project inserted beneath the Filter, or above |child| if it isn't a Filter. The boolean result is
+// false if nothing was replaced.
+func replaceCommonSubexpressions(exprs []sql.Expression, child sql.Node) ([]sql.Expression, sql.Node, bool, error) {
+	roots := exprs
+	input := child
+	filter, isFilter := child.(*plan.Filter)
+	if isFilter {
+		roots = append(roots[:len(roots):len(roots)], filter.Expression)
+		input = filter.Child
+	}
+
+	counts := make(map[string]int)
+	for _, root := range roots {
+		// Subqueries index into the rows of the node they're evaluated in, which the new Project would lengthen
+		if hasSubqueryExpression(root) {
+			return exprs, child, false, nil
+		}
+		sql.Inspect(root, func(e sql.Expression) bool {
+			if isCommonSubexpressionCandidate(e) {
+				counts[e.String()]++
+			}
+			return true
+		})
+	}
+
+	// Visit the roots top down, so that the largest repeated expressions are the ones computed once
+	schema := input.Schema()
+	var common []sql.Expression
+	fields := make(map[string]sql.Expression)
+	for _, root := range roots {
+		sql.Inspect(root, func(e sql.Expression) bool {
+			if !isCommonSubexpressionCandidate(e) {
+				return true
+			}
+			key := e.String()
+			if _, ok := fields[key]; ok {
+				return false
+			}
+			if counts[key] < 2 {
+				return true
+			}
+			fields[key] = expression.NewGetField(len(schema)+len(common), e.Type(), key, e.IsNullable())
+			common = append(common, e)
+			return false
+		})
+	}
+
+	if len(common) == 0 {
+		return exprs, child, false, nil
+	}
+
+	projections := make([]sql.Expression, 0, len(schema)+len(common))
+	for i, col := range schema {
+		projections = append(projections, expression.NewGetFieldWithTable(i, col.Type, col.Source, col.Name, col.Nullable))
+	}
+	projections = append(projections, common...)
+	var newChild sql.Node = plan.NewProject(projections, input)
+
+	if isFilter {
+		cond, err := replaceCommonSubexpression(filter.Expression, fields)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		newChild = plan.NewFilter(cond, newChild)
+	}
+
+	newExprs := make([]sql.Expression, len(exprs))
+	for i, e := range exprs {
+		var err error
+		newExprs[i], err = replaceCommonSubexpression(e, fields)
+		if err != nil {
+			return nil, nil, false, err
+		}
+	}
+
+	return newExprs, newChild, true, nil
+}
+
+// replaceCommonSubexpression replaces the outermost occurrences in |e| of the expressions keyed in |fields| by their
+// string representation.
+func replaceCommonSubexpression(e sql.Expression, fields map[string]sql.Expression) (sql.Expression, error) {
+	if isCommonSubexpressionCandidate(e) {
+		if field, ok := fields[e.String()]; ok {
+			return field, nil
+		}
+	}
+
+	children := e.Children()
+	if len(children) == 0 {
+		return e, nil
+	}
+
+	newChildren := make([]sql.Expression, len(children))
+	changed := false
+	for i, c := range children {
+		var err error
+		newChildren[i], err = replaceCommonSubexpression(c, fields)
+		if err != nil {
+			return nil, err
+		}
+		changed = changed || newChildren[i] != c
+	}
+
+	if !changed {
+		return e, nil
+	}
+	return e.WithChildren(newChildren...)
+}
+
+// isCommonSubexpressionCandidate returns whether |e| can be computed once per row and shared between its occurrences.
+// It must read at least one column, and it must not contain anything that isn't a pure function of the input row, such
+// as non-deterministic functions or aggregations. Expressions that their parents inspect rather than evaluate, like
+// tuples and intervals, are never candidates.
+func isCommonSubexpressionCandidate(e sql.Expression) bool {
+	if e == nil || len(e.Children()) == 0 {
+		return false
+	}
+	switch e.(type) {
+	case *expression.Alias, *expression.Tuple, *expression.Interval:
+		return false
+	}
+
+	readsColumn := false
+	candidate := true
+	sql.Inspect(e, func(e sql.Expression) bool {
+		switch e := e.(type) {
+		case *expression.GetField:
+			readsColumn = true
+		case sql.Aggregation, sql.WindowAggregation, *plan.Subquery:
+			candidate = false
+		case sql.NonDeterministicExpression:
+			if e.IsNonDeterministic() {
+				candidate = false
+			}
+		}
+		return candidate
+	})
+
+	return candidate && readsColumn
+}
+
+// hasSubqueryExpression returns whether |e| contains a subquery expression.
+func hasSubqueryExpression(e sql.Expression) bool {
+	found := false
+	sql.Inspect(e, func(e sql.Expression) bool {
+		if _, ok := e.(*plan.Subquery); ok {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
@@ -0,0 +1,110 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+func TestEliminateCommonSubexpressions(t *testing.T) {
+	f := getRule("eliminate_common_subexpressions")
+
+	table := plan.NewResolvedTable(memory.NewTable("t", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Source: "t", Type: sql.Int64},
+		{Name: "b", Source: "t", Type: sql.Text},
+	})), nil, nil)
+	a := expression.NewGetFieldWithTable(0, sql.Int64, "t", "a", false)
+	b := expression.NewGetFieldWithTable(1, sql.Text, "t", "b", false)
+	one := expression.NewLiteral(int64(1), sql.Int64)
+
+	plus := expression.NewPlus(a, one)
+	plusField := expression.NewGetField(2, plus.Type(), plus.String(), plus.IsNullable())
+	withPlus := plan.NewProject([]sql.Expression{a, b, plus}, table)
+
+	rand, err := function.NewRand()
+	require.NoError(t, err)
+	plusRand := expression.NewPlus(a, rand)
+
+	testCases := []analyzerFnTestCase{
+		{
+			name: "project and filter",
+			node: plan.NewProject(
+				[]sql.Expression{expression.NewAlias("x", plus), b},
+				plan.NewFilter(expression.NewGreaterThan(plus, one), table),
+			),
+			expected: plan.NewProject(
+				[]sql.Expression{expression.NewAlias("x", plusField), b},
+				plan.NewFilter(expression.NewGreaterThan(plusField, one), withPlus),
+			),
+		},
+		{
+			name: "repeated within a project",
+			node: plan.NewProject(
+				[]sql.Expression{plus, expression.NewMult(plus, plus)},
+				table,
+			),
+			expected: plan.NewProject(
+				[]sql.Expression{plusField, expression.NewMult(plusField, plusField)},
+				withPlus,
+			),
+		},
+		{
+			name: "group by",
+			node: plan.NewGroupBy(
+				[]sql.Expression{expression.NewAlias("x", plus), aggregation.NewCount(plus)},
+				[]sql.Expression{plus},
+				table,
+			),
+			expected: plan.NewGroupBy(
+				[]sql.Expression{expression.NewAlias("x", plusField), aggregation.NewCount(plusField)},
+				[]sql.Expression{plusField},
+				withPlus,
+			),
+		},
+		{
+			name: "no repeated expressions",
+			node: plan.NewProject(
+				[]sql.Expression{expression.NewAlias("x", plus)},
+				plan.NewFilter(expression.NewGreaterThan(a, one), table),
+			),
+		},
+		{
+			name: "non-deterministic expression",
+			node: plan.NewProject(
+				[]sql.Expression{expression.NewAlias("x", plusRand)},
+				plan.NewFilter(expression.NewGreaterThan(plusRand, one), table),
+			),
+		},
+		{
+			name: "outer scope",
+			node: plan.NewProject(
+				[]sql.Expression{expression.NewAlias("x", plus)},
+				plan.NewFilter(expression.NewGreaterThan(plus, one), table),
+			),
+			scope: newScope(plan.NewProject([]sql.Expression{a}, table)),
+		},
+	}
+
+	runTestCases(t, nil, testCases, NewDefault(nil), f)
+}
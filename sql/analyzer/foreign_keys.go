@@ -0,0 +1,139 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// loadForeignKeys loads the foreign keys enforced on the rows written by INSERT, UPDATE and DELETE statements: the
+// foreign keys of the tables written to, and the ones of the tables referencing them. The ones of UPDATE statements
+// writing to the tables of a join are loaded along with their updaters, by modifyUpdateExpressionsForJoin.
+func loadForeignKeys(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	span, _ := ctx.Span("loadForeignKeys")
+	defer span.Finish()
+
+	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		switch node := n.(type) {
+		case *plan.InsertInto:
+			fks, err := loadForeignKeyHandler(ctx, node.Destination)
+			if err != nil || fks == nil {
+				return node, err
+			}
+			nn := *node
+			nn.ForeignKeys = fks
+			return &nn, nil
+		case *plan.Update:
+			fks, err := loadForeignKeyHandler(ctx, node.Child)
+			if err != nil || fks == nil {
+				return node, err
+			}
+			nn := *node
+			nn.ForeignKeys = fks
+			return &nn, nil
+		case *plan.DeleteFrom:
+			if len(node.Targets) > 0 {
+				return loadDeleteTargetForeignKeys(ctx, node)
+			}
+			fks, err := loadForeignKeyHandler(ctx, node.Child)
+			if err != nil || fks == nil {
+				return node, err
+			}
+			nn := *node
+			nn.ForeignKeys = fks
+			return &nn, nil
+		default:
+			return node, nil
+		}
+	})
+}
+
+// loadForeignKeyHandler returns the foreign keys enforced on the rows written to the table of the node given, or nil if
+// there are none or if the node reads from several tables.
+func loadForeignKeyHandler(ctx *sql.Context, n sql.Node) (*plan.ForeignKeyHandler, error) {
+	tables := 0
+	plan.Inspect(n, func(n sql.Node) bool {
+		switch n.(type) {
+		case *plan.ResolvedTable, *plan.IndexedTableAccess:
+			tables++
+			return false
+		}
+		return true
+	})
+	if tables != 1 {
+		return nil, nil
+	}
+
+	rtable := getResolvedTable(n)
+	if rtable == nil || rtable.Database == nil {
+		return nil, nil
+	}
+	return plan.LoadForeignKeyHandler(ctx, rtable.Database, rtable.Name())
+}
+
+// loadDeleteTargetForeignKeys loads the foreign keys enforced on the rows deleted from each target of the multi-table
+// DELETE given.
+func loadDeleteTargetForeignKeys(ctx *sql.Context, node *plan.DeleteFrom) (sql.Node, error) {
+	tables := deleteTargetTables(node.Child)
+	targetFks := make(map[string]*plan.ForeignKeyHandler)
+	for _, target := range node.Targets {
+		target = strings.ToLower(target)
+		rtable, ok := tables[target]
+		if !ok || rtable.Database == nil {
+			continue
+		}
+		fks, err := plan.LoadForeignKeyHandler(ctx, rtable.Database, rtable.Name())
+		if err != nil {
+			return nil, err
+		}
+		if fks != nil {
+			targetFks[target] = fks
+		}
+	}
+	if len(targetFks) == 0 {
+		return node, nil
+	}
+
+	nn := *node
+	nn.TargetForeignKeys = targetFks
+	return &nn, nil
+}
+
+// loadUpdateJoinForeignKeys loads the foreign keys enforced on the rows updated in each table of the join given by the
+// updaters given of an UPDATE JOIN, by the same name as the updater of the table.
+func loadUpdateJoinForeignKeys(ctx *sql.Context, updaters map[string]sql.RowUpdater, join sql.Node) (map[string]*plan.ForeignKeyHandler, error) {
+	tables := getTablesByName(join)
+	var fks map[string]*plan.ForeignKeyHandler
+	for name := range updaters {
+		rtable, ok := tables[name]
+		if !ok || rtable.Database == nil {
+			continue
+		}
+		handler, err := plan.LoadForeignKeyHandler(ctx, rtable.Database, rtable.Name())
+		if err != nil {
+			return nil, err
+		}
+		if handler != nil {
+			if fks == nil {
+				fks = make(map[string]*plan.ForeignKeyHandler)
+			}
+			fks[name] = handler
+		}
+	}
+	return fks, nil
+}
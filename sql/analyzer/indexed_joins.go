@@ -363,13 +363,49 @@ func replanJoin(ctx *sql.Context, node plan.JoinNode, a *Analyzer, joinIndexes j
 }
 
 func extractJoinHint(node plan.JoinNode) QueryHint {
+	var hint QueryHint
 	if node.Comment() != "" {
-		return parseJoinHint(node.Comment())
+		hint = parseJoinHint(node.Comment())
 	}
-	return nil
+
+	// A STRAIGHT_JOIN anywhere in the tree fixes the order of all of its tables
+	if hint == nil {
+		plan.Inspect(node, func(n sql.Node) bool {
+			if jn, ok := n.(plan.JoinNode); ok && jn.Comment() != "" {
+				if h, ok := parseJoinHint(jn.Comment()).(JoinFixedOrder); ok {
+					hint = h
+				}
+			}
+			return hint == nil
+		})
+	}
+
+	if _, ok := hint.(JoinFixedOrder); ok {
+		return JoinOrder{
+			tables: joinTableNames(node),
+		}
+	}
+	return hint
 }
 
-var hintRegex = regexp.MustCompile("(\\s*[a-z_]+\\([^\\(]+\\)\\s*)+")
+// joinTableNames returns the names of the tables joined by |node| in the
+// order they are written in the query, with the preserved side of a right
+// join first.
+func joinTableNames(node sql.Node) []string {
+	switch node := node.(type) {
+	case plan.JoinNode:
+		if node.JoinType() == plan.JoinTypeRight {
+			return append(joinTableNames(node.Right()), joinTableNames(node.Left())...)
+		}
+		return append(joinTableNames(node.Left()), joinTableNames(node.Right())...)
+	case NameableNode:
+		return []string{node.Name()}
+	default:
+		return nil
+	}
+}
+
+var hintRegex = regexp.MustCompile("(\\s*[a-z_]+\\([^\\(]*\\)\\s*)+")
 
 // TODO: this is pretty nasty. Should be done in the parser instead.
 func parseJoinHint(comment string) QueryHint {
@@ -397,6 +433,8 @@ func parseJoinHint(comment string) QueryHint {
 			return JoinOrder{
 				tables: tables,
 			}
+		} else if hintStr == "join_fixed_order()" {
+			return JoinFixedOrder{}
 		}
 	}
 
@@ -421,6 +459,18 @@ func (j JoinOrder) HintType() string {
 	return "JOIN_ORDER"
 }
 
+// JoinFixedOrder is the JOIN_FIXED_ORDER() hint, which also results from a
+// STRAIGHT_JOIN. It joins the tables in the order they appear in the query.
+type JoinFixedOrder struct{}
+
+func (j JoinFixedOrder) String() string {
+	return "JOIN_FIXED_ORDER()"
+}
+
+func (j JoinFixedOrder) HintType() string {
+	return "JOIN_FIXED_ORDER"
+}
+
 // joinTreeToNodes transforms the simplified join tree given into a real tree of IndexedJoin nodes.
 func joinTreeToNodes(tree *joinSearchNode, tablesByName map[string]NameableNode, scope *Scope) sql.Node {
 	if tree.isLeaf() {
@@ -315,8 +315,7 @@ func getIndexes(
 
 // getComparisonIndexLookup returns the index and index lookup for the given
 // comparison if any index can be found.
-// It works for the following comparisons: eq, lt, gt, gte and lte.
-// TODO: add support for BETWEEN
+// It works for the following comparisons: eq, lt, gt, gte and lte. BETWEEN is handled by getIndexes.
 func getComparisonIndexLookup(
 	ctx *sql.Context,
 	a *Analyzer,
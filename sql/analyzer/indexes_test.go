@@ -0,0 +1,210 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+func TestGetIndexesForRanges(t *testing.T) {
+	table := memory.NewTable("t", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "pk", Source: "t", Type: sql.Int64, PrimaryKey: true},
+		{Name: "x", Source: "t", Type: sql.Int64},
+	}))
+	for i := int64(0); i < 10; i++ {
+		require.NoError(t, table.Insert(sql.NewEmptyContext(), sql.NewRow(i, i*10)))
+	}
+
+	x := expression.NewGetFieldWithTable(1, sql.Int64, "t", "x", false)
+	idx := &memory.Index{DB: "mydb", Tbl: table, TableName: "t", Name: "x_idx", Exprs: []sql.Expression{x}}
+	ia := &indexAnalyzer{indexesByTable: map[string][]sql.Index{"t": {idx}}}
+	lit := func(v int64) sql.Expression {
+		return expression.NewLiteral(v, sql.Int64)
+	}
+
+	testCases := []struct {
+		name      string
+		filter    sql.Expression
+		rangeType sql.RangeType
+		expected  []int64
+	}{
+		{
+			name:      "greater than",
+			filter:    expression.NewGreaterThan(x, lit(50)),
+			rangeType: sql.RangeType_GreaterThan,
+			expected:  []int64{60, 70, 80, 90},
+		},
+		{
+			name:      "greater or equal with the column on the right",
+			filter:    expression.NewLessThanOrEqual(lit(50), x),
+			rangeType: sql.RangeType_GreaterOrEqual,
+			expected:  []int64{50, 60, 70, 80, 90},
+		},
+		{
+			name:      "less than",
+			filter:    expression.NewLessThan(x, lit(20)),
+			rangeType: sql.RangeType_LessThan,
+			expected:  []int64{0, 10},
+		},
+		{
+			name:      "open range",
+			filter:    expression.NewAnd(expression.NewGreaterThan(x, lit(20)), expression.NewLessThan(x, lit(60))),
+			rangeType: sql.RangeType_OpenOpen,
+			expected:  []int64{30, 40, 50},
+		},
+		{
+			name:      "half open range",
+			filter:    expression.NewAnd(expression.NewGreaterThan(x, lit(20)), expression.NewLessThanOrEqual(x, lit(60))),
+			rangeType: sql.RangeType_OpenClosed,
+			expected:  []int64{30, 40, 50, 60},
+		},
+		{
+			name:      "between",
+			filter:    expression.NewBetween(x, lit(20), lit(40)),
+			rangeType: sql.RangeType_ClosedClosed,
+			expected:  []int64{20, 30, 40},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			ctx := sql.NewEmptyContext()
+
+			result, err := getIndexes(ctx, NewDefault(nil), ia, tt.filter, nil)
+			require.NoError(err)
+			require.Contains(result, "t")
+
+			lookup := result["t"].lookup
+			ranges := lookup.Ranges()
+			require.Len(ranges, 1)
+			require.Len(ranges[0], 1)
+			require.Equal(tt.rangeType, ranges[0][0].Type())
+
+			// Only the rows within the range are read from the table.
+			rows, err := sql.NodeToRows(ctx, plan.NewStaticIndexedTableAccess(plan.NewResolvedTable(table, nil, nil), lookup, idx, nil))
+			require.NoError(err)
+			var actual []int64
+			for _, row := range rows {
+				actual = append(actual, row[1].(int64))
+			}
+			require.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestGetIndexesForCompositePrefixes(t *testing.T) {
+	table := memory.NewTable("t", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "pk", Source: "t", Type: sql.Int64, PrimaryKey: true},
+		{Name: "a", Source: "t", Type: sql.Int64},
+		{Name: "b", Source: "t", Type: sql.Int64},
+		{Name: "c", Source: "t", Type: sql.Int64},
+	}))
+	pk := int64(0)
+	for a := int64(1); a <= 3; a++ {
+		for b := int64(1); b <= 3; b++ {
+			for c := int64(1); c <= 3; c++ {
+				require.NoError(t, table.Insert(sql.NewEmptyContext(), sql.NewRow(pk, a, b, c)))
+				pk++
+			}
+		}
+	}
+
+	a := expression.NewGetFieldWithTable(1, sql.Int64, "t", "a", false)
+	b := expression.NewGetFieldWithTable(2, sql.Int64, "t", "b", false)
+	c := expression.NewGetFieldWithTable(3, sql.Int64, "t", "c", false)
+	idx := &memory.Index{DB: "mydb", Tbl: table, TableName: "t", Name: "abc_idx", Exprs: []sql.Expression{a, b, c}}
+	ia := &indexAnalyzer{indexesByTable: map[string][]sql.Index{"t": {idx}}}
+	lit := func(v int64) sql.Expression {
+		return expression.NewLiteral(v, sql.Int64)
+	}
+
+	testCases := []struct {
+		name       string
+		filter     sql.Expression
+		rangeTypes []sql.RangeType
+		numRows    int
+	}{
+		{
+			name:       "first column",
+			filter:     expression.NewEquals(a, lit(2)),
+			rangeTypes: []sql.RangeType{sql.RangeType_ClosedClosed, sql.RangeType_All, sql.RangeType_All},
+			numRows:    9,
+		},
+		{
+			name:       "first two columns",
+			filter:     expression.NewAnd(expression.NewEquals(b, lit(1)), expression.NewEquals(a, lit(2))),
+			rangeTypes: []sql.RangeType{sql.RangeType_ClosedClosed, sql.RangeType_ClosedClosed, sql.RangeType_All},
+			numRows:    3,
+		},
+		{
+			name:       "all columns",
+			filter:     expression.NewAnd(expression.NewAnd(expression.NewEquals(a, lit(2)), expression.NewEquals(b, lit(1))), expression.NewEquals(c, lit(3))),
+			rangeTypes: []sql.RangeType{sql.RangeType_ClosedClosed, sql.RangeType_ClosedClosed, sql.RangeType_ClosedClosed},
+			numRows:    1,
+		},
+		{
+			name:       "equality prefix with a trailing range",
+			filter:     expression.NewAnd(expression.NewEquals(a, lit(2)), expression.NewGreaterThan(b, lit(1))),
+			rangeTypes: []sql.RangeType{sql.RangeType_ClosedClosed, sql.RangeType_GreaterThan, sql.RangeType_All},
+			numRows:    6,
+		},
+		{
+			name:       "first and last columns",
+			filter:     expression.NewAnd(expression.NewEquals(a, lit(2)), expression.NewEquals(c, lit(3))),
+			rangeTypes: []sql.RangeType{sql.RangeType_ClosedClosed, sql.RangeType_All, sql.RangeType_ClosedClosed},
+			numRows:    3,
+		},
+		{
+			name:   "first column is not filtered",
+			filter: expression.NewAnd(expression.NewEquals(b, lit(2)), expression.NewEquals(c, lit(3))),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			ctx := sql.NewEmptyContext()
+
+			result, err := getIndexes(ctx, NewDefault(nil), ia, tt.filter, nil)
+			require.NoError(err)
+			if tt.rangeTypes == nil {
+				require.NotContains(result, "t")
+				return
+			}
+			require.Contains(result, "t")
+
+			lookup := result["t"].lookup
+			ranges := lookup.Ranges()
+			require.Len(ranges, 1)
+			var rangeTypes []sql.RangeType
+			for _, rce := range ranges[0] {
+				rangeTypes = append(rangeTypes, rce.Type())
+			}
+			require.Equal(tt.rangeTypes, rangeTypes)
+
+			rows, err := sql.NodeToRows(ctx, plan.NewStaticIndexedTableAccess(plan.NewResolvedTable(table, nil, nil), lookup, idx, nil))
+			require.NoError(err)
+			require.Len(rows, tt.numRows)
+		})
+	}
+}
@@ -24,6 +24,11 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
+// maxExhaustiveJoinSearchTables is the largest number of commutable tables
+// for which estimateCost considers every access order. Larger joins are
+// ordered greedily.
+const maxExhaustiveJoinSearchTables = 8
+
 func buildJoinTree(
 	jo *joinOrderNode,
 	joinConds []*joinCond,
@@ -302,7 +307,7 @@ func (jo *joinOrderNode) estimateCost(ctx *sql.Context, joinIndexes joinIndexesB
 		if err != nil {
 			return err
 		}
-		jo.cost = jo.left.cost * jo.right.cost
+		jo.cost = mulCost(jo.left.cost, jo.right.cost)
 	} else {
 		for i := range jo.commutes {
 			err := jo.commutes[i].estimateCost(ctx, joinIndexes)
@@ -310,6 +315,15 @@ func (jo *joinOrderNode) estimateCost(ctx *sql.Context, joinIndexes joinIndexesB
 				return err
 			}
 		}
+		if len(jo.commutes) > maxExhaustiveJoinSearchTables {
+			order, cost, err := jo.greedyAccessOrder(ctx, joinIndexes)
+			if err != nil {
+				return err
+			}
+			jo.order = order
+			jo.cost = cost
+			return nil
+		}
 		indexes := make([]int, len(jo.commutes))
 		for i := range jo.commutes {
 			indexes[i] = i
@@ -334,6 +348,37 @@ func (jo *joinOrderNode) estimateCost(ctx *sql.Context, joinIndexes joinIndexesB
 	return nil
 }
 
+// greedyAccessOrder builds an access order for the commutable children of
+// this node one child at a time, always appending the child that makes the
+// partial order cheapest. It is used in place of the exhaustive search when
+// there are too many children to consider every permutation.
+func (jo *joinOrderNode) greedyAccessOrder(ctx *sql.Context, joinIndexes joinIndexesByTable) ([]int, uint64, error) {
+	order := make([]int, 0, len(jo.commutes))
+	used := make([]bool, len(jo.commutes))
+	var cost uint64
+	for len(order) < len(jo.commutes) {
+		bestIdx := -1
+		bestCost := uint64(math.MaxUint64)
+		for i := range jo.commutes {
+			if used[i] {
+				continue
+			}
+			candidateCost, err := jo.estimateAccessOrderCost(ctx, append(order, i), joinIndexes, bestCost)
+			if err != nil {
+				return nil, 0, err
+			}
+			if bestIdx < 0 || candidateCost < bestCost {
+				bestIdx = i
+				bestCost = candidateCost
+			}
+		}
+		used[bestIdx] = true
+		order = append(order, bestIdx)
+		cost = bestCost
+	}
+	return order, cost, nil
+}
+
 func (jo *joinOrderNode) estimateAccessOrderCost(ctx *sql.Context, accessOrder []int, joinIndexes joinIndexesByTable, lowestCost uint64) (uint64, error) {
 	cost := uint64(1)
 	var availableSchemaForKeys sql.Schema
@@ -347,17 +392,26 @@ func (jo *joinOrderNode) estimateAccessOrderCost(ctx *sql.Context, accessOrder [
 			_, isSubquery := jo.commutes[idx].node.(*plan.SubqueryAlias)
 			_, isValuesTable := jo.commutes[idx].node.(*plan.ValueDerivedTable)
 			if i == 0 || isSubquery || isValuesTable || indexes.getUsableIndex(availableSchemaForKeys) == nil {
-				cost *= jo.commutes[idx].cost
+				cost = mulCost(cost, jo.commutes[idx].cost)
 			} else {
 				cost += 1
 			}
 		} else {
-			cost *= jo.commutes[idx].cost
+			cost = mulCost(cost, jo.commutes[idx].cost)
 		}
 	}
 	return cost, nil
 }
 
+// mulCost multiplies two cost estimates, saturating at math.MaxUint64
+// rather than overflowing for joins of many large tables.
+func mulCost(a, b uint64) uint64 {
+	if a != 0 && b > math.MaxUint64/a {
+		return math.MaxUint64
+	}
+	return a * b
+}
+
 func (jo *joinOrderNode) schema() sql.Schema {
 	if jo.node != nil {
 		return jo.node.Schema()
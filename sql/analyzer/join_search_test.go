@@ -16,11 +16,15 @@ package analyzer
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/dolthub/go-mysql-server/memory"
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
@@ -644,3 +648,48 @@ func tableOrder(tables ...interface{}) *joinOrderNode {
 	}
 	return jo
 }
+
+func TestEstimateCostGreedy(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	// More tables than the exhaustive search considers, with row counts out of order
+	rowCounts := []int{5, 2, 9, 1, 7, 3, 10, 4, 8, 6}
+	jo := &joinOrderNode{}
+	for i, n := range rowCounts {
+		table := memory.NewTable(fmt.Sprintf("t%d", i), sql.NewPrimaryKeySchema(sql.Schema{
+			{Name: "pk", Type: sql.Int64, PrimaryKey: true},
+		}))
+		for j := 0; j < n; j++ {
+			require.NoError(table.Insert(ctx, sql.NewRow(int64(j))))
+		}
+		jo.commutes = append(jo.commutes, joinOrderNode{node: plan.NewResolvedTable(table, nil, nil)})
+	}
+
+	require.NoError(jo.estimateCost(ctx, nil))
+	require.Equal([]int{3, 1, 5, 7, 0, 9, 4, 8, 2, 6}, jo.order)
+	require.Equal(uint64(3628800), jo.cost)
+}
+
+func TestMulCost(t *testing.T) {
+	assert.Equal(t, uint64(6), mulCost(2, 3))
+	assert.Equal(t, uint64(0), mulCost(0, math.MaxUint64))
+	assert.Equal(t, uint64(math.MaxUint64), mulCost(1<<40, 1<<40))
+}
+
+func TestExtractStraightJoinHint(t *testing.T) {
+	a := plan.NewUnresolvedTable("a", "")
+	b := plan.NewUnresolvedTable("b", "")
+	c := plan.NewUnresolvedTable("c", "")
+	cond := expression.NewLiteral(true, sql.Boolean)
+
+	node := plan.NewInnerJoin(
+		plan.NewInnerJoin(b, a, cond).WithComment("/*+ JOIN_FIXED_ORDER() */").(plan.JoinNode),
+		plan.NewRightJoin(c, plan.NewTableAlias("d", a), cond),
+		cond,
+	)
+	assert.Equal(t, JoinOrder{tables: []string{"b", "a", "d", "c"}}, extractJoinHint(node))
+
+	node = plan.NewInnerJoin(b, a, cond)
+	assert.Nil(t, extractJoinHint(node))
+}
@@ -15,6 +15,8 @@
 package analyzer
 
 import (
+	"strings"
+
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/plan"
@@ -71,6 +73,93 @@ func optimizeDistinct(ctx *sql.Context, a *Analyzer, node sql.Node, scope *Scope
 	return node, nil
 }
 
+// optimizeDistinctWithIndex substitutes a Distinct node for an OrderedDistinct node when the child of Distinct reads
+// its rows from an index that returns them sorted on exactly the distinct columns. Unlike optimizeDistinct, this runs
+// after indexes have been pushed down, so it can take advantage of the ordering they provide.
+func optimizeDistinctWithIndex(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	span, _ := ctx.Span("optimize_distinct_with_index")
+	defer span.Finish()
+
+	if !n.Resolved() {
+		return n, nil
+	}
+
+	tableAliases, err := getTableAliases(n, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		d, ok := n.(*plan.Distinct)
+		if !ok {
+			return n, nil
+		}
+
+		ita := orderPreservingIndexedTableAccess(d.Child)
+		if ita == nil {
+			return n, nil
+		}
+
+		if !indexGroupsDistinctColumns(ctx, ita.Index(), distinctColumns(d.Child), tableAliases) {
+			return n, nil
+		}
+
+		a.Log("distinct optimized for ordering of index %s", ita.Index().ID())
+		return plan.NewOrderedDistinct(d.Child), nil
+	})
+}
+
+// distinctColumns returns the columns a Distinct node over the node given must deduplicate, or nil if any of them is
+// not a plain column.
+func distinctColumns(n sql.Node) []sql.Expression {
+	if p, ok := n.(*plan.Project); ok {
+		for _, e := range p.Projections {
+			if _, ok := e.(*expression.GetField); !ok {
+				return nil
+			}
+		}
+		return p.Projections
+	}
+
+	schema := n.Schema()
+	cols := make([]sql.Expression, len(schema))
+	for i, col := range schema {
+		cols[i] = expression.NewGetFieldWithTable(i, col.Type, col.Source, col.Name, col.Nullable)
+	}
+	return cols
+}
+
+// indexGroupsDistinctColumns returns whether reading from the index given returns all rows with the same values for
+// the columns given next to each other. This is the case when the index is a sql.OrderedIndex and the columns are,
+// in any order, exactly a prefix of the index expressions. A prefix that is only a subset of the columns isn't enough:
+// rows with the same values for the prefix but different values for the remaining columns may be interleaved.
+func indexGroupsDistinctColumns(ctx *sql.Context, idx sql.Index, cols []sql.Expression, tableAliases TableAliases) bool {
+	orderedIdx, ok := idx.(sql.OrderedIndex)
+	if !ok || orderedIdx.Order() == sql.IndexOrderNone {
+		return false
+	}
+
+	idxExprs := orderedIdx.Expressions()
+	if len(cols) == 0 || len(cols) > len(idxExprs) {
+		return false
+	}
+
+	prefix := make(map[string]bool, len(cols))
+	for _, e := range idxExprs[:len(cols)] {
+		prefix[strings.ToLower(e)] = true
+	}
+
+	for _, col := range cols {
+		name := strings.ToLower(normalizeExpression(ctx, tableAliases, col).String())
+		if !prefix[name] {
+			return false
+		}
+		delete(prefix, name)
+	}
+
+	return true
+}
+
 // moveJoinConditionsToFilter looks for expressions in a join condition that reference only tables in the left or right
 // side of the join, and move those conditions to a new Filter node instead. If the join condition is empty after these
 // moves, the join is converted to a CrossJoin.
@@ -231,6 +320,20 @@ func expressionSources(expr sql.Expression) []string {
 	return result
 }
 
+// containsNonDeterministic returns whether the expression given contains a non-deterministic expression, such as
+// RAND(), which must be evaluated for every row rather than once.
+func containsNonDeterministic(e sql.Expression) bool {
+	var result bool
+	sql.Inspect(e, func(e sql.Expression) bool {
+		if nd, ok := e.(sql.NonDeterministicExpression); ok && nd.IsNonDeterministic() {
+			result = true
+			return false
+		}
+		return true
+	})
+	return result
+}
+
 // evalFilter simplifies the expressions in Filter nodes where possible. This involves removing redundant parts of AND
 // and OR expressions, as well as replacing evaluable expressions with their literal result. Filters that can
 // statically be determined to be true or false are replaced with the child node or an empty result, respectively.
@@ -286,7 +389,7 @@ func evalFilter(ctx *sql.Context, a *Analyzer, node sql.Node, scope *Scope) (sql
 			case *expression.Literal, expression.Tuple, *expression.Interval:
 				return e, nil
 			default:
-				if !isEvaluable(e) {
+				if !isEvaluable(e) || containsNonDeterministic(e) {
 					return e, nil
 				}
 
@@ -22,6 +22,7 @@ import (
 	"github.com/dolthub/go-mysql-server/memory"
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
@@ -128,6 +129,71 @@ func TestOptimizeDistinct(t *testing.T) {
 	}
 }
 
+func TestOptimizeDistinctWithIndex(t *testing.T) {
+	f := getRule("optimize_distinct_with_index")
+
+	table := memory.NewTable("mytable", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "i", Source: "mytable", Type: sql.Int64},
+		{Name: "j", Source: "mytable", Type: sql.Int64, Nullable: true},
+	}))
+	rt := plan.NewResolvedTable(table, nil, nil)
+
+	i := expression.NewGetFieldWithTable(0, sql.Int64, "mytable", "i", false)
+	j := expression.NewGetFieldWithTable(1, sql.Int64, "mytable", "j", true)
+	key := []sql.Expression{expression.NewLiteral(int64(1), sql.Int64)}
+
+	newIdx := func(order sql.IndexOrder, exprs ...sql.Expression) sql.Index {
+		return orderedDummyIdx{
+			dummyIdx: &dummyIdx{id: "idx", expr: exprs, database: "mydb", table: "mytable"},
+			order:    order,
+		}
+	}
+
+	jAccess := plan.NewStaticIndexedTableAccess(rt, nil, newIdx(sql.IndexOrderAsc, j), key)
+	jiAccess := plan.NewStaticIndexedTableAccess(rt, nil, newIdx(sql.IndexOrderDesc, j, i), key)
+	unorderedAccess := plan.NewStaticIndexedTableAccess(rt, nil, newIdx(sql.IndexOrderNone, j), key)
+
+	testCases := []analyzerFnTestCase{
+		{
+			name:     "distinct over indexed column",
+			node:     plan.NewDistinct(plan.NewProject([]sql.Expression{j}, jAccess)),
+			expected: plan.NewOrderedDistinct(plan.NewProject([]sql.Expression{j}, jAccess)),
+		},
+		{
+			name:     "distinct over all index columns in different order",
+			node:     plan.NewDistinct(plan.NewProject([]sql.Expression{i, j}, jiAccess)),
+			expected: plan.NewOrderedDistinct(plan.NewProject([]sql.Expression{i, j}, jiAccess)),
+		},
+		{
+			name:     "distinct over index prefix",
+			node:     plan.NewDistinct(plan.NewProject([]sql.Expression{j}, jiAccess)),
+			expected: plan.NewOrderedDistinct(plan.NewProject([]sql.Expression{j}, jiAccess)),
+		},
+		{
+			name: "distinct over column not in index prefix",
+			node: plan.NewDistinct(plan.NewProject([]sql.Expression{i}, jiAccess)),
+		},
+		{
+			name: "distinct over more columns than indexed",
+			node: plan.NewDistinct(plan.NewProject([]sql.Expression{i, j}, jAccess)),
+		},
+		{
+			name: "distinct over all table columns",
+			node: plan.NewDistinct(jAccess),
+		},
+		{
+			name: "index without ordering",
+			node: plan.NewDistinct(plan.NewProject([]sql.Expression{j}, unorderedAccess)),
+		},
+		{
+			name: "distinct over expression",
+			node: plan.NewDistinct(plan.NewProject([]sql.Expression{expression.NewAlias("k", j)}, jAccess)),
+		},
+	}
+
+	runTestCases(t, nil, testCases, NewDefault(nil), f)
+}
+
 func TestMoveJoinConditionsToFilter(t *testing.T) {
 	t1 := memory.NewTable("t1", sql.NewPrimaryKeySchema(sql.Schema{
 		{Name: "a", Source: "t1", Type: sql.Int64},
@@ -299,6 +365,7 @@ func TestMoveJoinConditionsToFilter(t *testing.T) {
 func TestEvalFilter(t *testing.T) {
 	inner := memory.NewTable("foo", sql.PrimaryKeySchema{})
 	rule := getRule("eval_filter")
+	random := mustExpr(function.NewRand())
 
 	testCases := []struct {
 		filter   sql.Expression
@@ -386,6 +453,13 @@ func TestEvalFilter(t *testing.T) {
 			),
 			plan.EmptyTable,
 		},
+		{
+			eq(random, lit(1)),
+			plan.NewFilter(
+				eq(random, lit(1)),
+				plan.NewResolvedTable(inner, nil, nil),
+			),
+		},
 	}
 
 	for _, tt := range testCases {
@@ -53,7 +53,12 @@ func processTruncate(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (s
 				return nil, err
 			}
 		}
-		_, err = validateTruncate(ctx, db, truncatePlan.Child)
+		// Tables that can't be truncated are truncated by deleting all of their rows
+		table := getTable(truncatePlan.Child)
+		if table == nil {
+			return nil, plan.ErrTruncateNotSupported.New()
+		}
+		err = validateTruncateForeignKeys(ctx, db, table.Name())
 		if err != nil {
 			return nil, err
 		}
@@ -122,7 +127,9 @@ func deleteToTruncate(ctx *sql.Context, a *Analyzer, deletePlan *plan.DeleteFrom
 		if err != nil {
 			return nil, err
 		}
-		return plan.NewTruncate(ctx.GetCurrentDatabase(), tbl), nil
+		truncate := plan.NewTruncate(ctx.GetCurrentDatabase(), tbl)
+		truncate.DeletedRows = true
+		return truncate, nil
 	}
 	return deletePlan, nil
 }
@@ -137,11 +144,21 @@ func validateTruncate(ctx *sql.Context, db sql.Database, tbl sql.Node) (bool, er
 	if err != nil {
 		return false, err // false as any caller besides Truncate would not care for this error
 	}
-	tableName := strings.ToLower(truncatable.Name())
+	err = validateTruncateForeignKeys(ctx, db, truncatable.Name())
+	if sql.ErrTruncateReferencedFromForeignKey.Is(err) {
+		return false, err
+	}
+	//TODO: check for an active table lock and error if one is found for the target table
+	return true, err // true as any other error should not happen under normal circumstances
+}
 
+// validateTruncateForeignKeys returns an error if the table with the name given is referenced by a foreign key of
+// another table of the database, as such tables can't be truncated.
+func validateTruncateForeignKeys(ctx *sql.Context, db sql.Database, tableName string) error {
+	tableName = strings.ToLower(tableName)
 	tableNames, err := db.GetTableNames(ctx)
 	if err != nil {
-		return true, err // true as this should not error under normal circumstances
+		return err
 	}
 	for _, tableNameToCheck := range tableNames {
 		if strings.ToLower(tableNameToCheck) == tableName {
@@ -149,24 +166,23 @@ func validateTruncate(ctx *sql.Context, db sql.Database, tbl sql.Node) (bool, er
 		}
 		tableToCheck, ok, err := db.GetTableInsensitive(ctx, tableNameToCheck)
 		if err != nil {
-			return true, err // should not error under normal circumstances
+			return err
 		}
 		if !ok {
-			return true, sql.ErrTableNotFound.New(tableNameToCheck)
+			return sql.ErrTableNotFound.New(tableNameToCheck)
 		}
 		fkTable, ok := tableToCheck.(sql.ForeignKeyTable)
 		if ok {
 			fks, err := fkTable.GetForeignKeys(ctx)
 			if err != nil {
-				return true, err
+				return err
 			}
 			for _, fk := range fks {
 				if strings.ToLower(fk.ReferencedTable) == tableName {
-					return false, sql.ErrTruncateReferencedFromForeignKey.New(tableName, fk.Name, tableNameToCheck)
+					return sql.ErrTruncateReferencedFromForeignKey.New(tableName, fk.Name, tableNameToCheck)
 				}
 			}
 		}
 	}
-	//TODO: check for an active table lock and error if one is found for the target table
-	return true, nil
+	return nil
 }
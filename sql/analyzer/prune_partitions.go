@@ -0,0 +1,295 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// prunePartitions restricts a partitioned table to the partitions that can contain rows matching the filter over it:
+// the single partition of the key of a hash partitioned table filtered with an equality on every column of its
+// partition key, or the partitions of a range partitioned table whose range overlaps the values of the partition key
+// allowed by the filter. The filter is kept, since other rows share the partitions.
+func prunePartitions(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	span, _ := ctx.Span("prune_partitions")
+	defer span.Finish()
+
+	if !n.Resolved() {
+		return n, nil
+	}
+
+	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		filter, ok := n.(*plan.Filter)
+		if !ok {
+			return n, nil
+		}
+
+		child := filter.Child
+		ta, isAlias := child.(*plan.TableAlias)
+		if isAlias {
+			child = ta.Child
+		}
+		rt, ok := child.(*plan.ResolvedTable)
+		if !ok {
+			return n, nil
+		}
+
+		tableName := rt.Name()
+		if isAlias {
+			tableName = ta.Name()
+		}
+		pruned, err := prunedTable(ctx, a, rt.Table, tableName, filter.Expression)
+		if err != nil {
+			return nil, err
+		}
+		if pruned == nil {
+			return n, nil
+		}
+
+		var newChild sql.Node
+		newChild, err = rt.WithTable(pruned)
+		if err != nil {
+			return nil, err
+		}
+		if isAlias {
+			newChild, err = ta.WithChildren(newChild)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return filter.WithChildren(newChild)
+	})
+}
+
+// prunedTable returns the table given restricted to the partitions the filter given can match, or nil if it can't be
+// pruned.
+func prunedTable(ctx *sql.Context, a *Analyzer, table sql.Table, tableName string, filter sql.Expression) (sql.Table, error) {
+	if rpt, ok := table.(sql.RangePartitionedTable); ok && rpt.RangePartitionColumn() != "" {
+		column := rpt.RangePartitionColumn()
+		for _, col := range rpt.Schema() {
+			if !strings.EqualFold(col.Name, column) {
+				continue
+			}
+			ranges, ok, err := partitionRangesFromFilter(ctx, filter, tableName, column, col.Type)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				a.Log("pruning table %s to the partitions overlapping %v", table.Name(), ranges)
+				return rpt.WithPartitionRanges(ctx, ranges)
+			}
+			break
+		}
+	}
+
+	if hpt, ok := table.(sql.HashPartitionedTable); ok {
+		key, ok := partitionKeyFromFilter(ctx, filter, tableName, hpt.PartitionKeyColumns())
+		if ok {
+			a.Log("pruning table %s to the partition of key %v", table.Name(), key)
+			return hpt.WithPartitionKey(ctx, key)
+		}
+	}
+
+	return nil, nil
+}
+
+// partitionRangesFromFilter returns the ranges of values of the partition key column given allowed by the filter
+// expression, or false if the filter doesn't restrict them.
+func partitionRangesFromFilter(ctx *sql.Context, filter sql.Expression, tableName, column string, typ sql.Type) ([]sql.RangeColumnExpr, bool, error) {
+	isColumn := func(e sql.Expression) bool {
+		gf, ok := e.(*expression.GetField)
+		return ok && strings.EqualFold(gf.Table(), tableName) && strings.EqualFold(gf.Name(), column)
+	}
+
+	switch e := filter.(type) {
+	case *expression.And:
+		left, lok, err := partitionRangesFromFilter(ctx, e.Left, tableName, column, typ)
+		if err != nil {
+			return nil, false, err
+		}
+		right, rok, err := partitionRangesFromFilter(ctx, e.Right, tableName, column, typ)
+		if err != nil {
+			return nil, false, err
+		}
+		if !lok || !rok {
+			if lok {
+				return left, true, nil
+			}
+			return right, rok, nil
+		}
+
+		ranges := make([]sql.RangeColumnExpr, 0)
+		for _, l := range left {
+			for _, r := range right {
+				overlap, ok, err := l.Overlaps(r)
+				if err != nil {
+					return nil, false, err
+				}
+				if ok {
+					ranges = append(ranges, overlap)
+				}
+			}
+		}
+		return ranges, true, nil
+	case *expression.Or:
+		left, lok, err := partitionRangesFromFilter(ctx, e.Left, tableName, column, typ)
+		if err != nil || !lok {
+			return nil, false, err
+		}
+		right, rok, err := partitionRangesFromFilter(ctx, e.Right, tableName, column, typ)
+		if err != nil || !rok {
+			return nil, false, err
+		}
+		return append(left, right...), true, nil
+	case *expression.Between:
+		if !isColumn(e.Val) {
+			return nil, false, nil
+		}
+		lower, lok := partitionKeyLiteral(ctx, e.Lower, typ)
+		upper, uok := partitionKeyLiteral(ctx, e.Upper, typ)
+		if !lok || !uok {
+			return nil, false, nil
+		}
+		return []sql.RangeColumnExpr{sql.ClosedRangeColumnExpr(lower, upper, typ)}, true, nil
+	case *expression.InTuple:
+		tuple, ok := e.Right().(expression.Tuple)
+		if !ok || !isColumn(e.Left()) {
+			return nil, false, nil
+		}
+		ranges := make([]sql.RangeColumnExpr, len(tuple))
+		for i, el := range tuple {
+			v, ok := partitionKeyLiteral(ctx, el, typ)
+			if !ok {
+				return nil, false, nil
+			}
+			ranges[i] = sql.ClosedRangeColumnExpr(v, v, typ)
+		}
+		return ranges, true, nil
+	case expression.Comparer:
+		field, value := e.Left(), e.Right()
+		flipped := false
+		if !isColumn(field) {
+			field, value = value, field
+			flipped = true
+		}
+		if !isColumn(field) {
+			return nil, false, nil
+		}
+		v, ok := partitionKeyLiteral(ctx, value, typ)
+		if !ok {
+			return nil, false, nil
+		}
+
+		switch e.(type) {
+		case *expression.Equals:
+			return []sql.RangeColumnExpr{sql.ClosedRangeColumnExpr(v, v, typ)}, true, nil
+		case *expression.GreaterThan, *expression.LessThan:
+			if _, gt := e.(*expression.GreaterThan); gt != flipped {
+				return []sql.RangeColumnExpr{sql.GreaterThanRangeColumnExpr(v, typ)}, true, nil
+			}
+			return []sql.RangeColumnExpr{sql.LessThanRangeColumnExpr(v, typ)}, true, nil
+		case *expression.GreaterThanOrEqual, *expression.LessThanOrEqual:
+			if _, gte := e.(*expression.GreaterThanOrEqual); gte != flipped {
+				return []sql.RangeColumnExpr{sql.GreaterOrEqualRangeColumnExpr(v, typ)}, true, nil
+			}
+			return []sql.RangeColumnExpr{sql.LessOrEqualRangeColumnExpr(v, typ)}, true, nil
+		}
+	}
+
+	return nil, false, nil
+}
+
+// partitionKeyLiteral returns the value of the expression given converted to the type of the partition key, if it's
+// a literal whose value can be compared with the partition bounds.
+func partitionKeyLiteral(ctx *sql.Context, e sql.Expression, typ sql.Type) (interface{}, bool) {
+	lit, ok := e.(*expression.Literal)
+	if !ok {
+		return nil, false
+	}
+	// Converting a decimal value to an integer rounds it, which would move the bound of the range
+	if sql.IsInteger(typ) && !sql.IsInteger(lit.Type()) {
+		return nil, false
+	}
+
+	v, err := lit.Eval(ctx, nil)
+	if err != nil || v == nil {
+		return nil, false
+	}
+	v, err = typ.Convert(v)
+	if err != nil {
+		return nil, false
+	}
+	return v, true
+}
+
+// partitionKeyFromFilter returns the values of the partition key columns given if the filter expression requires
+// each of them to be equal to a literal, and false otherwise.
+func partitionKeyFromFilter(ctx *sql.Context, filter sql.Expression, tableName string, columns []string) (sql.Row, bool) {
+	if len(columns) == 0 {
+		return nil, false
+	}
+
+	key := make(sql.Row, len(columns))
+	found := make([]bool, len(columns))
+	for _, e := range splitConjunction(filter) {
+		eq, ok := e.(*expression.Equals)
+		if !ok {
+			continue
+		}
+
+		gf, lit, ok := fieldAndLiteral(eq.Left(), eq.Right())
+		if !ok {
+			gf, lit, ok = fieldAndLiteral(eq.Right(), eq.Left())
+		}
+		if !ok || !strings.EqualFold(gf.Table(), tableName) {
+			continue
+		}
+
+		for i, col := range columns {
+			if !found[i] && strings.EqualFold(gf.Name(), col) {
+				v, err := lit.Eval(ctx, nil)
+				if err != nil || v == nil {
+					// NULL is never equal to anything, but the filter will take care of that
+					continue
+				}
+				key[i] = v
+				found[i] = true
+			}
+		}
+	}
+
+	for _, ok := range found {
+		if !ok {
+			return nil, false
+		}
+	}
+	return key, true
+}
+
+func fieldAndLiteral(left, right sql.Expression) (*expression.GetField, *expression.Literal, bool) {
+	gf, ok := left.(*expression.GetField)
+	if !ok {
+		return nil, nil, false
+	}
+	lit, ok := right.(*expression.Literal)
+	if !ok {
+		return nil, nil, false
+	}
+	return gf, lit, true
+}
@@ -0,0 +1,250 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// partitionSpyTable is a partitioned table that records the partitions whose rows were read.
+type partitionSpyTable struct {
+	*memory.Table
+	read *[]string
+}
+
+var _ sql.HashPartitionedTable = (*partitionSpyTable)(nil)
+var _ sql.RangePartitionedTable = (*partitionSpyTable)(nil)
+
+func (t *partitionSpyTable) PartitionRows(ctx *sql.Context, p sql.Partition) (sql.RowIter, error) {
+	*t.read = append(*t.read, string(p.Key()))
+	return t.Table.PartitionRows(ctx, p)
+}
+
+func (t *partitionSpyTable) WithPartitionKey(ctx *sql.Context, key sql.Row) (sql.Table, error) {
+	pruned, err := t.Table.WithPartitionKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return &partitionSpyTable{Table: pruned.(*memory.Table), read: t.read}, nil
+}
+
+func (t *partitionSpyTable) WithPartitionRanges(ctx *sql.Context, ranges []sql.RangeColumnExpr) (sql.Table, error) {
+	pruned, err := t.Table.WithPartitionRanges(ctx, ranges)
+	if err != nil {
+		return nil, err
+	}
+	return &partitionSpyTable{Table: pruned.(*memory.Table), read: t.read}, nil
+}
+
+func TestPrunePartitions(t *testing.T) {
+	f := getRule("prune_partitions")
+
+	newTable := func() *partitionSpyTable {
+		table, err := memory.NewHashPartitionedTable("mytable", sql.NewPrimaryKeySchema(sql.Schema{
+			{Name: "i", Source: "mytable", Type: sql.Int64},
+			{Name: "s", Source: "mytable", Type: sql.Text},
+		}), 4, "i")
+		require.NoError(t, err)
+		for i := int64(0); i < 20; i++ {
+			require.NoError(t, table.Insert(sql.NewEmptyContext(), sql.NewRow(i, "row")))
+		}
+		return &partitionSpyTable{Table: table, read: new([]string)}
+	}
+	field := expression.NewGetFieldWithTable(0, sql.Int64, "mytable", "i", false)
+
+	t.Run("equality on the partition key reads a single partition", func(t *testing.T) {
+		require := require.New(t)
+		ctx := sql.NewEmptyContext()
+
+		table := newTable()
+		node := plan.NewFilter(
+			expression.NewEquals(field, expression.NewLiteral(int8(7), sql.Int8)),
+			plan.NewResolvedTable(table, nil, nil),
+		)
+		result, err := f.Apply(ctx, NewDefault(nil), node, nil)
+		require.NoError(err)
+
+		rows, err := sql.NodeToRows(ctx, result)
+		require.NoError(err)
+		require.Equal([]sql.Row{{int64(7), "row"}}, rows)
+		require.Len(*table.read, 1)
+	})
+
+	t.Run("key on the left of the comparison and through an alias", func(t *testing.T) {
+		require := require.New(t)
+		ctx := sql.NewEmptyContext()
+
+		table := newTable()
+		node := plan.NewFilter(
+			expression.NewAnd(
+				expression.NewEquals(
+					expression.NewGetFieldWithTable(1, sql.Text, "t", "s", false),
+					expression.NewLiteral("row", sql.LongText),
+				),
+				expression.NewEquals(
+					expression.NewLiteral(int64(12), sql.Int64),
+					expression.NewGetFieldWithTable(0, sql.Int64, "t", "i", false),
+				),
+			),
+			plan.NewTableAlias("t", plan.NewResolvedTable(table, nil, nil)),
+		)
+		result, err := f.Apply(ctx, NewDefault(nil), node, nil)
+		require.NoError(err)
+
+		rows, err := sql.NodeToRows(ctx, result)
+		require.NoError(err)
+		require.Equal([]sql.Row{{int64(12), "row"}}, rows)
+		require.Len(*table.read, 1)
+	})
+
+	t.Run("other predicates read every partition", func(t *testing.T) {
+		require := require.New(t)
+		ctx := sql.NewEmptyContext()
+
+		table := newTable()
+		node := plan.NewFilter(
+			expression.NewGreaterThan(field, expression.NewLiteral(int64(17), sql.Int64)),
+			plan.NewResolvedTable(table, nil, nil),
+		)
+		result, err := f.Apply(ctx, NewDefault(nil), node, nil)
+		require.NoError(err)
+		require.Equal(node, result)
+
+		rows, err := sql.NodeToRows(ctx, result)
+		require.NoError(err)
+		require.ElementsMatch([]sql.Row{{int64(18), "row"}, {int64(19), "row"}}, rows)
+		require.Greater(len(*table.read), 1)
+	})
+}
+
+func TestPruneRangePartitions(t *testing.T) {
+	f := getRule("prune_partitions")
+
+	newTable := func() *partitionSpyTable {
+		table, err := memory.NewRangePartitionedTable("mytable", sql.NewPrimaryKeySchema(sql.Schema{
+			{Name: "i", Source: "mytable", Type: sql.Int64},
+		}), "i", 10, 20, 30, nil)
+		require.NoError(t, err)
+		for i := int64(0); i < 40; i++ {
+			require.NoError(t, table.Insert(sql.NewEmptyContext(), sql.NewRow(i)))
+		}
+		return &partitionSpyTable{Table: table, read: new([]string)}
+	}
+	field := expression.NewGetFieldWithTable(0, sql.Int64, "mytable", "i", false)
+	rowsBetween := func(from, to int64) []sql.Row {
+		var rows []sql.Row
+		for i := from; i <= to; i++ {
+			rows = append(rows, sql.NewRow(i))
+		}
+		return rows
+	}
+
+	testCases := []struct {
+		name       string
+		filter     sql.Expression
+		expected   []sql.Row
+		partitions []string
+	}{
+		{
+			name: "between",
+			filter: expression.NewBetween(
+				field,
+				expression.NewLiteral(int8(12), sql.Int8),
+				expression.NewLiteral(int8(25), sql.Int8),
+			),
+			expected:   rowsBetween(12, 25),
+			partitions: []string{"1", "2"},
+		},
+		{
+			name: "bound of a partition",
+			filter: expression.NewAnd(
+				expression.NewGreaterThanOrEqual(field, expression.NewLiteral(int8(20), sql.Int8)),
+				expression.NewLessThan(field, expression.NewLiteral(int8(30), sql.Int8)),
+			),
+			expected:   rowsBetween(20, 29),
+			partitions: []string{"2"},
+		},
+		{
+			name: "in",
+			filter: expression.NewInTuple(field, expression.NewTuple(
+				expression.NewLiteral(int8(5), sql.Int8),
+				expression.NewLiteral(int8(35), sql.Int8),
+			)),
+			expected:   []sql.Row{{int64(5)}, {int64(35)}},
+			partitions: []string{"0", "3"},
+		},
+		{
+			name: "flipped comparison in the last partition",
+			filter: expression.NewLessThan(
+				expression.NewLiteral(int8(36), sql.Int8),
+				field,
+			),
+			expected:   rowsBetween(37, 39),
+			partitions: []string{"3"},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			ctx := sql.NewEmptyContext()
+
+			table := newTable()
+			node := plan.NewFilter(tt.filter, plan.NewResolvedTable(table, nil, nil))
+			result, err := f.Apply(ctx, NewDefault(nil), node, nil)
+			require.NoError(err)
+
+			rows, err := sql.NodeToRows(ctx, result)
+			require.NoError(err)
+			require.ElementsMatch(tt.expected, rows)
+			require.ElementsMatch(tt.partitions, *table.read)
+		})
+	}
+}
+
+func TestExplainPrunedPartitions(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	table, err := memory.NewRangePartitionedTable("mytable", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "i", Source: "mytable", Type: sql.Int64},
+	}), "i", 10, 20, 30, nil)
+	require.NoError(err)
+
+	node := plan.NewFilter(
+		expression.NewBetween(
+			expression.NewGetFieldWithTable(0, sql.Int64, "mytable", "i", false),
+			expression.NewLiteral(int8(12), sql.Int8),
+			expression.NewLiteral(int8(25), sql.Int8),
+		),
+		plan.NewResolvedTable(table, nil, nil),
+	)
+	result, err := getRule("prune_partitions").Apply(ctx, NewDefault(nil), node, nil)
+	require.NoError(err)
+
+	rows, err := sql.NodeToRows(ctx, plan.NewDescribeQuery("tree", result))
+	require.NoError(err)
+	require.Equal([]sql.Row{
+		{"Filter(mytable.i BETWEEN 12 AND 25)"},
+		{" └─ Table(mytable, partitions=[1,2])"},
+	}, rows)
+}
@@ -0,0 +1,75 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// replaceRandomSort replaces Limit(Sort(...)) and Limit(Offset(Sort(...))) sorting on RAND() or RAND(seed) with a
+// RandomSample of the rows needed, which doesn't need to sort every row of the child. Must run before insert_topn.
+func replaceRandomSort(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	return plan.TransformUpCtx(n, nil, func(tc plan.TransformContext) (sql.Node, error) {
+		if o, ok := tc.Node.(*plan.Offset); ok {
+			parentLimit, ok := tc.Parent.(*plan.Limit)
+			if !ok || parentLimit.CalcFoundRows {
+				return tc.Node, nil
+			}
+			seed, ok := randomSortSeed(o.Child)
+			if !ok {
+				return tc.Node, nil
+			}
+			a.Log("replacing random sort with a sample of %s rows", parentLimit.Limit)
+			sample := plan.NewRandomSample(expression.NewPlus(parentLimit.Limit, o.Offset), seed, o.Child.(*plan.Sort).Child)
+			return o.WithChildren(sample)
+		} else if l, ok := tc.Node.(*plan.Limit); ok {
+			if l.CalcFoundRows {
+				return tc.Node, nil
+			}
+			seed, ok := randomSortSeed(l.Child)
+			if !ok {
+				return tc.Node, nil
+			}
+			a.Log("replacing random sort with a sample of %s rows", l.Limit)
+			return l.WithChildren(plan.NewRandomSample(l.Limit, seed, l.Child.(*plan.Sort).Child))
+		}
+		return tc.Node, nil
+	})
+}
+
+// randomSortSeed returns whether the node given is a Sort on RAND() alone, and the seed of the RAND if it has a
+// literal one.
+func randomSortSeed(n sql.Node) (sql.Expression, bool) {
+	sort, ok := n.(*plan.Sort)
+	if !ok || len(sort.SortFields) != 1 {
+		return nil, false
+	}
+
+	r, ok := sort.SortFields[0].Column.(*function.Rand)
+	if !ok {
+		return nil, false
+	}
+	if r.Child == nil {
+		return nil, true
+	}
+	// A seed depending on the row makes RAND a hash of the row, which isn't sampled uniformly
+	if _, ok := r.Child.(*expression.Literal); !ok {
+		return nil, false
+	}
+	return r.Child, true
+}
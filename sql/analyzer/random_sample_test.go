@@ -0,0 +1,117 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+func TestReplaceRandomSort(t *testing.T) {
+	f := getRule("replace_random_sort")
+
+	table := memory.NewTable("mytable", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "i", Source: "mytable", Type: sql.Int64},
+	}))
+	for i := int64(0); i < 100; i++ {
+		require.NoError(t, table.Insert(sql.NewEmptyContext(), sql.NewRow(i)))
+	}
+	rt := plan.NewResolvedTable(table, nil, nil)
+
+	randomSort := func(seed ...sql.Expression) *plan.Sort {
+		r, err := function.NewRand(seed...)
+		require.NoError(t, err)
+		return plan.NewSort([]sql.SortField{{Column: r, Order: sql.Ascending}}, rt)
+	}
+	limit := expression.NewLiteral(int8(5), sql.Int8)
+	seed := expression.NewLiteral(int64(42), sql.Int64)
+
+	t.Run("seeded sample is deterministic", func(t *testing.T) {
+		require := require.New(t)
+		ctx := sql.NewEmptyContext()
+
+		node := plan.NewLimit(limit, randomSort(seed))
+		result, err := f.Apply(ctx, NewDefault(nil), node, nil)
+		require.NoError(err)
+		require.Equal(plan.NewLimit(limit, plan.NewRandomSample(limit, seed, rt)), result)
+
+		first, err := sql.NodeToRows(ctx, result)
+		require.NoError(err)
+		require.Len(first, 5)
+
+		second, err := sql.NodeToRows(ctx, result)
+		require.NoError(err)
+		require.Equal(first, second)
+
+		seen := make(map[int64]bool)
+		for _, row := range first {
+			seen[row[0].(int64)] = true
+		}
+		require.Len(seen, 5)
+	})
+
+	t.Run("sample includes the offset", func(t *testing.T) {
+		require := require.New(t)
+		ctx := sql.NewEmptyContext()
+
+		offset := expression.NewLiteral(int8(3), sql.Int8)
+		node := plan.NewLimit(limit, plan.NewOffset(offset, randomSort()))
+		result, err := f.Apply(ctx, NewDefault(nil), node, nil)
+		require.NoError(err)
+
+		rows, err := sql.NodeToRows(ctx, result)
+		require.NoError(err)
+		require.Len(rows, 5)
+	})
+
+	t.Run("sample of more rows than the table returns every row", func(t *testing.T) {
+		require := require.New(t)
+		ctx := sql.NewEmptyContext()
+
+		big := expression.NewLiteral(int64(1000), sql.Int64)
+		result, err := f.Apply(ctx, NewDefault(nil), plan.NewLimit(big, randomSort(seed)), nil)
+		require.NoError(err)
+
+		rows, err := sql.NodeToRows(ctx, result)
+		require.NoError(err)
+		require.Len(rows, 100)
+	})
+
+	testCases := []analyzerFnTestCase{
+		{
+			name: "seed depending on the row",
+			node: plan.NewLimit(limit, randomSort(expression.NewGetFieldWithTable(0, sql.Int64, "mytable", "i", false))),
+		},
+		{
+			name: "sort on other fields",
+			node: plan.NewLimit(limit, plan.NewSort([]sql.SortField{
+				{Column: expression.NewGetFieldWithTable(0, sql.Int64, "mytable", "i", false), Order: sql.Ascending},
+			}, rt)),
+		},
+		{
+			name: "random sort without a limit",
+			node: randomSort(),
+		},
+	}
+
+	runTestCases(t, sql.NewEmptyContext(), testCases, NewDefault(nil), f)
+}
@@ -0,0 +1,80 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// replaceCountStar replaces a COUNT(*) with no grouping over an unfiltered table with a plan.TableCount node, which
+// reads the row count maintained by the table instead of scanning it. This only applies to tables implementing
+// sql.RowCountTable that are able to report their count.
+func replaceCountStar(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	span, _ := ctx.Span("replace_count_star")
+	defer span.Finish()
+
+	if !n.Resolved() {
+		return n, nil
+	}
+
+	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		gb, ok := n.(*plan.GroupBy)
+		if !ok || len(gb.GroupByExprs) != 0 || len(gb.SelectedExprs) != 1 || !isCountOfAllRows(gb.SelectedExprs[0]) {
+			return n, nil
+		}
+
+		child := gb.Child
+		if ta, ok := child.(*plan.TableAlias); ok {
+			child = ta.Child
+		}
+		rt, ok := child.(*plan.ResolvedTable)
+		if !ok {
+			return n, nil
+		}
+		table, ok := rt.Table.(sql.RowCountTable)
+		if !ok {
+			return n, nil
+		}
+		if _, ok := table.RowCount(ctx); !ok {
+			return n, nil
+		}
+
+		a.Log("replacing count of all rows with row count of table %s", table.Name())
+		return plan.NewTableCount(table, gb.Schema()), nil
+	})
+}
+
+// isCountOfAllRows returns whether the expression given is a COUNT of every row, such as COUNT(*) or COUNT(1),
+// optionally aliased.
+func isCountOfAllRows(e sql.Expression) bool {
+	if alias, ok := e.(*expression.Alias); ok {
+		e = alias.Child
+	}
+	count, ok := e.(*aggregation.Count)
+	if !ok {
+		return false
+	}
+	switch arg := count.Child.(type) {
+	case *expression.Star:
+		return true
+	case *expression.Literal:
+		return arg.Value() != nil
+	default:
+		return false
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// rowCountSpyTable is a table that records whether its rows were ever read.
+type rowCountSpyTable struct {
+	*memory.Table
+	countAvailable bool
+	scanned        bool
+}
+
+var _ sql.RowCountTable = (*rowCountSpyTable)(nil)
+
+func (t *rowCountSpyTable) PartitionRows(ctx *sql.Context, p sql.Partition) (sql.RowIter, error) {
+	t.scanned = true
+	return t.Table.PartitionRows(ctx, p)
+}
+
+func (t *rowCountSpyTable) RowCount(ctx *sql.Context) (uint64, bool) {
+	if !t.countAvailable {
+		return 0, false
+	}
+	return t.Table.RowCount(ctx)
+}
+
+func TestReplaceCountStar(t *testing.T) {
+	f := getRule("replace_count_star")
+
+	newTable := func(countAvailable bool) *rowCountSpyTable {
+		table := memory.NewTable("mytable", sql.NewPrimaryKeySchema(sql.Schema{
+			{Name: "i", Source: "mytable", Type: sql.Int64},
+		}))
+		for i := int64(0); i < 5; i++ {
+			require.NoError(t, table.Insert(sql.NewEmptyContext(), sql.NewRow(i)))
+		}
+		return &rowCountSpyTable{Table: table, countAvailable: countAvailable}
+	}
+	countStar := expression.NewAlias("c", aggregation.NewCount(expression.NewStar()))
+
+	t.Run("count is read from the table", func(t *testing.T) {
+		require := require.New(t)
+		ctx := sql.NewEmptyContext()
+
+		table := newTable(true)
+		node := plan.NewGroupBy([]sql.Expression{countStar}, nil, plan.NewResolvedTable(table, nil, nil))
+		result, err := f.Apply(ctx, NewDefault(nil), node, nil)
+		require.NoError(err)
+		require.Equal(plan.NewTableCount(table, node.Schema()), result)
+
+		rows, err := sql.NodeToRows(ctx, result)
+		require.NoError(err)
+		require.Equal([]sql.Row{{int64(5)}}, rows)
+		require.False(table.scanned)
+	})
+
+	t.Run("count falls back to scanning when no longer available", func(t *testing.T) {
+		require := require.New(t)
+		ctx := sql.NewEmptyContext()
+
+		table := newTable(true)
+		node := plan.NewGroupBy([]sql.Expression{countStar}, nil, plan.NewResolvedTable(table, nil, nil))
+		result, err := f.Apply(ctx, NewDefault(nil), node, nil)
+		require.NoError(err)
+
+		table.countAvailable = false
+		rows, err := sql.NodeToRows(ctx, result)
+		require.NoError(err)
+		require.Equal([]sql.Row{{int64(5)}}, rows)
+		require.True(table.scanned)
+	})
+
+	i := expression.NewGetFieldWithTable(0, sql.Int64, "mytable", "i", false)
+	table := newTable(true)
+	rt := plan.NewResolvedTable(table, nil, nil)
+
+	testCases := []analyzerFnTestCase{
+		{
+			name: "count of literal",
+			node: plan.NewGroupBy([]sql.Expression{aggregation.NewCount(expression.NewLiteral(int64(1), sql.Int64))}, nil, rt),
+			expected: plan.NewTableCount(table, sql.Schema{
+				{Name: "COUNT(1)", Type: sql.Int64},
+			}),
+		},
+		{
+			name: "count of column",
+			node: plan.NewGroupBy([]sql.Expression{aggregation.NewCount(i)}, nil, rt),
+		},
+		{
+			name: "count with grouping",
+			node: plan.NewGroupBy([]sql.Expression{countStar}, []sql.Expression{i}, rt),
+		},
+		{
+			name: "count of filtered rows",
+			node: plan.NewGroupBy([]sql.Expression{countStar}, nil, plan.NewFilter(
+				expression.NewEquals(i, expression.NewLiteral(int64(1), sql.Int64)),
+				rt,
+			)),
+		},
+		{
+			name: "table cannot provide its count",
+			node: plan.NewGroupBy([]sql.Expression{countStar}, nil, plan.NewResolvedTable(newTable(false), nil, nil)),
+		},
+	}
+
+	runTestCases(t, nil, testCases, NewDefault(nil), f)
+}
@@ -0,0 +1,174 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// replaceMinMaxWithIndex rewrites a MIN or MAX of a column with no grouping over a table so that only the first entry
+// of an ordered index on that column is read, rather than every row of the table. MIN requires an ascending index and
+// MAX a descending one. The index lookup excludes NULL values, so the result doesn't depend on where the index stores
+// them, and the aggregation is kept on top of the single row read so that an empty lookup still results in NULL.
+func replaceMinMaxWithIndex(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	span, _ := ctx.Span("replace_min_max_with_index")
+	defer span.Finish()
+
+	if !n.Resolved() {
+		return n, nil
+	}
+
+	tableAliases, err := getTableAliases(n, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		gb, ok := n.(*plan.GroupBy)
+		if !ok || len(gb.GroupByExprs) != 0 || len(gb.SelectedExprs) != 1 {
+			return n, nil
+		}
+
+		col, order, ok := minMaxColumn(gb.SelectedExprs[0])
+		if !ok {
+			return n, nil
+		}
+
+		// The table is optionally aliased, and decorated with the projection pushed down to it
+		var tableNode sql.Node = gb.Child
+		ta, isAlias := tableNode.(*plan.TableAlias)
+		if isAlias {
+			tableNode = ta.Child
+		}
+		decorated, isDecorated := tableNode.(*plan.DecoratedNode)
+		if isDecorated {
+			tableNode = decorated.Child
+		}
+		rt, ok := tableNode.(*plan.ResolvedTable)
+		if !ok {
+			return n, nil
+		}
+
+		var nameable sql.Node = rt
+		if isAlias {
+			nameable = ta
+		}
+		ita, err := firstIndexEntryAccess(ctx, a, nameable, rt, col, order, tableAliases)
+		if err != nil || ita == nil {
+			return n, err
+		}
+
+		var child sql.Node = ita
+		if isDecorated {
+			child, err = decorated.WithChildren(child)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if isAlias {
+			child, err = ta.WithChildren(child)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		a.Log("%s replaced by first entry of index %s", gb.SelectedExprs[0], ita.Index().ID())
+		return gb.WithChildren(plan.NewLimit(expression.NewLiteral(int64(1), sql.Int64), child))
+	})
+}
+
+// minMaxColumn returns the column aggregated by the MIN or MAX expression given, optionally aliased, along with the
+// index order whose first entry holds the result.
+func minMaxColumn(e sql.Expression) (*expression.GetField, sql.IndexOrder, bool) {
+	if alias, ok := e.(*expression.Alias); ok {
+		e = alias.Child
+	}
+
+	var child sql.Expression
+	var order sql.IndexOrder
+	switch agg := e.(type) {
+	case *aggregation.Min:
+		child, order = agg.Child, sql.IndexOrderAsc
+	case *aggregation.Max:
+		child, order = agg.Child, sql.IndexOrderDesc
+	default:
+		return nil, sql.IndexOrderNone, false
+	}
+
+	gf, ok := child.(*expression.GetField)
+	return gf, order, ok
+}
+
+// firstIndexEntryAccess returns an IndexedTableAccess over the table given that reads the non-NULL values of the
+// column given in the order given, using an ordered index whose first expression is that column. The node given is
+// either the table or an alias of it. Returns nil if the table has no such index.
+func firstIndexEntryAccess(
+	ctx *sql.Context,
+	a *Analyzer,
+	n sql.Node,
+	rt *plan.ResolvedTable,
+	col *expression.GetField,
+	order sql.IndexOrder,
+	tableAliases TableAliases,
+) (*plan.IndexedTableAccess, error) {
+	if _, ok := rt.Table.(sql.IndexAddressableTable); !ok {
+		return nil, nil
+	}
+
+	ia, err := getIndexesForNode(ctx, a, n)
+	if err != nil {
+		return nil, err
+	}
+	defer ia.releaseUsedIndexes()
+
+	colName := normalizeExpression(ctx, tableAliases, col).String()
+	for _, idx := range ia.IndexesByTable(ctx, ctx.GetCurrentDatabase(), n.(sql.Nameable).Name()) {
+		orderedIdx, ok := idx.(sql.OrderedIndex)
+		if !ok || orderedIdx.Order() != order {
+			continue
+		}
+		if exprs := idx.Expressions(); len(exprs) == 0 || !strings.EqualFold(exprs[0], colName) {
+			continue
+		}
+
+		// Ranges consider NULL greater than every other value, so everything below it is every non-NULL value
+		colTypes := idx.ColumnExpressionTypes(ctx)
+		rang := make(sql.Range, len(colTypes))
+		for i, colType := range colTypes {
+			if i == 0 {
+				rang[i] = sql.LessThanRangeColumnExpr(nil, colType.Type)
+			} else {
+				rang[i] = sql.AllRangeColumnExpr(colType.Type)
+			}
+		}
+
+		lookup, err := idx.NewLookup(ctx, rang)
+		if err != nil {
+			return nil, err
+		}
+		if lookup == nil {
+			continue
+		}
+
+		return plan.NewStaticIndexedTableAccess(rt, lookup, idx, []sql.Expression{col}), nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,181 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// orderedMemoryIdx is a memory index that declares an ordering other than the one of memory indexes. Its lookups return
+// rows in the order they are stored, so the tables it is used with must store their rows in the declared order.
+type orderedMemoryIdx struct {
+	*memory.Index
+	order        sql.IndexOrder
+	nullOrdering sql.NullOrdering
+}
+
+var _ sql.OrderedIndex = orderedMemoryIdx{}
+
+func (i orderedMemoryIdx) Order() sql.IndexOrder          { return i.order }
+func (i orderedMemoryIdx) NullOrdering() sql.NullOrdering { return i.nullOrdering }
+
+func (i orderedMemoryIdx) NewLookup(ctx *sql.Context, ranges ...sql.Range) (sql.IndexLookup, error) {
+	lookup, err := i.Index.NewLookup(ctx, ranges...)
+	if err != nil || lookup == nil {
+		return lookup, err
+	}
+	return storedOrderLookup{lookup.(*memory.IndexLookup)}, nil
+}
+
+// storedOrderLookup is a memory index lookup that the memory table reads partition by partition, returning the matching
+// rows in the order they are stored rather than sorted by the index.
+type storedOrderLookup struct {
+	*memory.IndexLookup
+}
+
+// orderedIndexTable is a memory table whose only index is the one given.
+type orderedIndexTable struct {
+	*memory.Table
+	idx sql.Index
+}
+
+func (t *orderedIndexTable) GetIndexes(ctx *sql.Context) ([]sql.Index, error) {
+	return []sql.Index{t.idx}, nil
+}
+
+func TestReplaceMinMaxWithIndex(t *testing.T) {
+	f := getRule("replace_min_max_with_index")
+
+	pk := expression.NewGetFieldWithTable(0, sql.Int64, "mytable", "pk", false)
+	v := expression.NewGetFieldWithTable(1, sql.Int64, "mytable", "v", true)
+
+	// newTable returns a table with the values given for v, inserted in the order given, and an index on v with the
+	// order and null ordering given.
+	newTable := func(order sql.IndexOrder, nullOrdering sql.NullOrdering, vals ...interface{}) *plan.ResolvedTable {
+		table := memory.NewTable("mytable", sql.NewPrimaryKeySchema(sql.Schema{
+			{Name: "pk", Source: "mytable", Type: sql.Int64, PrimaryKey: true},
+			{Name: "v", Source: "mytable", Type: sql.Int64, Nullable: true},
+		}))
+		for i, val := range vals {
+			require.NoError(t, table.Insert(sql.NewEmptyContext(), sql.NewRow(int64(i), val)))
+		}
+		idx := orderedMemoryIdx{
+			Index:        &memory.Index{Tbl: table, TableName: "mytable", Name: "v_idx", Exprs: []sql.Expression{v}},
+			order:        order,
+			nullOrdering: nullOrdering,
+		}
+		return plan.NewResolvedTable(&orderedIndexTable{Table: table, idx: idx}, nil, nil)
+	}
+
+	testCases := []struct {
+		name      string
+		agg       sql.Expression
+		table     *plan.ResolvedTable
+		rewritten bool
+		expected  interface{}
+	}{
+		{
+			name:      "min with nulls stored first",
+			agg:       aggregation.NewMin(v),
+			table:     newTable(sql.IndexOrderAsc, sql.NullsFirst, nil, nil, int64(2), int64(5), int64(9)),
+			rewritten: true,
+			expected:  int64(2),
+		},
+		{
+			name:      "min with nulls stored last",
+			agg:       aggregation.NewMin(v),
+			table:     newTable(sql.IndexOrderAsc, sql.NullsLast, int64(2), int64(5), int64(9), nil),
+			rewritten: true,
+			expected:  int64(2),
+		},
+		{
+			name:      "max over descending index",
+			agg:       expression.NewAlias("m", aggregation.NewMax(v)),
+			table:     newTable(sql.IndexOrderDesc, sql.NullsFirst, int64(9), int64(5), nil, nil),
+			rewritten: true,
+			expected:  int64(9),
+		},
+		{
+			name:      "only nulls",
+			agg:       aggregation.NewMin(v),
+			table:     newTable(sql.IndexOrderAsc, sql.NullsFirst, nil, nil),
+			rewritten: true,
+			expected:  nil,
+		},
+		{
+			name:     "index order does not match aggregation",
+			agg:      aggregation.NewMax(v),
+			table:    newTable(sql.IndexOrderAsc, sql.NullsFirst, nil, int64(2), int64(5)),
+			expected: int64(5),
+		},
+		{
+			name:     "index without ordering",
+			agg:      aggregation.NewMin(v),
+			table:    newTable(sql.IndexOrderNone, sql.NullsFirst, int64(5), int64(2)),
+			expected: int64(2),
+		},
+		{
+			name:     "column is not indexed",
+			agg:      aggregation.NewMin(pk),
+			table:    newTable(sql.IndexOrderAsc, sql.NullsFirst, int64(2), int64(5)),
+			expected: int64(0),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			ctx := sql.NewEmptyContext()
+
+			node := plan.NewGroupBy([]sql.Expression{tt.agg}, nil, tt.table)
+			result, err := f.Apply(ctx, NewDefault(nil), node, nil)
+			require.NoError(err)
+
+			if tt.rewritten {
+				gb, ok := result.(*plan.GroupBy)
+				require.True(ok)
+				limit, ok := gb.Child.(*plan.Limit)
+				require.True(ok)
+				_, ok = limit.Child.(*plan.IndexedTableAccess)
+				require.True(ok)
+			} else {
+				require.Equal(node, result)
+			}
+
+			rows, err := sql.NodeToRows(ctx, result)
+			require.NoError(err)
+			require.Equal([]sql.Row{{tt.expected}}, rows)
+		})
+	}
+
+	t.Run("grouped aggregation is not rewritten", func(t *testing.T) {
+		node := plan.NewGroupBy(
+			[]sql.Expression{aggregation.NewMin(v)},
+			[]sql.Expression{pk},
+			newTable(sql.IndexOrderAsc, sql.NullsFirst, int64(2)),
+		)
+		result, err := f.Apply(sql.NewEmptyContext(), NewDefault(nil), node, nil)
+		require.NoError(t, err)
+		require.Equal(t, node, result)
+	})
+}
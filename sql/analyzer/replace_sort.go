@@ -0,0 +1,123 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// replaceSortWithIndex removes Sort nodes whose ordering is already provided by the index of an IndexedTableAccess
+// beneath them. See indexProvidesOrdering for the conditions under which an index satisfies a sort.
+func replaceSortWithIndex(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	span, _ := ctx.Span("replace_sort_with_index")
+	defer span.Finish()
+
+	if !n.Resolved() {
+		return n, nil
+	}
+
+	tableAliases, err := getTableAliases(n, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		s, ok := n.(*plan.Sort)
+		if !ok {
+			return n, nil
+		}
+
+		ita := orderPreservingIndexedTableAccess(s.Child)
+		if ita == nil {
+			return n, nil
+		}
+
+		if !indexProvidesOrdering(ctx, ita.Index(), s.SortFields, tableAliases) {
+			return n, nil
+		}
+
+		a.Log("sort replaced by ordering of index %s", ita.Index().ID())
+		return s.Child, nil
+	})
+}
+
+// orderPreservingIndexedTableAccess returns the IndexedTableAccess at the bottom of the node given, provided that
+// every node in between returns rows in the same order as its child. Returns nil otherwise.
+func orderPreservingIndexedTableAccess(n sql.Node) *plan.IndexedTableAccess {
+	for {
+		switch nn := n.(type) {
+		case *plan.IndexedTableAccess:
+			return nn
+		case *plan.Project:
+			n = nn.Child
+		case *plan.Filter:
+			n = nn.Child
+		case *plan.TableAlias:
+			n = nn.Child
+		case *plan.DecoratedNode:
+			n = nn.Child
+		default:
+			return nil
+		}
+	}
+}
+
+// indexProvidesOrdering returns whether reading from the index given returns rows in the order described by the sort
+// fields. This is only the case when the index is a sql.OrderedIndex, the sort fields are plain columns matching a
+// prefix of the index expressions, and every sort field has the same direction as the index. Additionally, the index
+// must place NULL values where the sort expects them: MySQL considers NULL smaller than any other value, so an index
+// that stores NULLs after all other values cannot satisfy the sort even when the non-NULL values are in order.
+func indexProvidesOrdering(ctx *sql.Context, idx sql.Index, sortFields sql.SortFields, tableAliases TableAliases) bool {
+	orderedIdx, ok := idx.(sql.OrderedIndex)
+	if !ok {
+		return false
+	}
+
+	var order sql.SortOrder
+	switch orderedIdx.Order() {
+	case sql.IndexOrderAsc:
+		order = sql.Ascending
+	case sql.IndexOrderDesc:
+		order = sql.Descending
+	default:
+		return false
+	}
+
+	idxExprs := orderedIdx.Expressions()
+	if len(sortFields) == 0 || len(sortFields) > len(idxExprs) {
+		return false
+	}
+
+	for i, sf := range sortFields {
+		if sf.Order != order || sf.NullOrdering != orderedIdx.NullOrdering() {
+			return false
+		}
+
+		gf, ok := sf.Column.(*expression.GetField)
+		if !ok {
+			return false
+		}
+
+		if !strings.EqualFold(normalizeExpression(ctx, tableAliases, gf).String(), idxExprs[i]) {
+			return false
+		}
+	}
+
+	return true
+}
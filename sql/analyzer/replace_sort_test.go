@@ -0,0 +1,105 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+type orderedDummyIdx struct {
+	*dummyIdx
+	order        sql.IndexOrder
+	nullOrdering sql.NullOrdering
+}
+
+var _ sql.OrderedIndex = orderedDummyIdx{}
+
+func (i orderedDummyIdx) Order() sql.IndexOrder          { return i.order }
+func (i orderedDummyIdx) NullOrdering() sql.NullOrdering { return i.nullOrdering }
+
+func TestReplaceSortWithIndex(t *testing.T) {
+	f := getRule("replace_sort_with_index")
+
+	table := memory.NewTable("mytable", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "i", Source: "mytable", Type: sql.Int64},
+		{Name: "j", Source: "mytable", Type: sql.Int64, Nullable: true},
+	}))
+	rt := plan.NewResolvedTable(table, nil, nil)
+
+	i := expression.NewGetFieldWithTable(0, sql.Int64, "mytable", "i", false)
+	j := expression.NewGetFieldWithTable(1, sql.Int64, "mytable", "j", true)
+	key := []sql.Expression{expression.NewLiteral(int64(1), sql.Int64)}
+
+	newIdx := func(order sql.IndexOrder, nullOrdering sql.NullOrdering) sql.Index {
+		return orderedDummyIdx{
+			dummyIdx:     &dummyIdx{id: "j_idx", expr: []sql.Expression{j}, database: "mydb", table: "mytable"},
+			order:        order,
+			nullOrdering: nullOrdering,
+		}
+	}
+	access := func(idx sql.Index) sql.Node {
+		return plan.NewStaticIndexedTableAccess(rt, nil, idx, key)
+	}
+
+	ascNullsFirst := access(newIdx(sql.IndexOrderAsc, sql.NullsFirst))
+	ascNullsLast := access(newIdx(sql.IndexOrderAsc, sql.NullsLast))
+	descNullsFirst := access(newIdx(sql.IndexOrderDesc, sql.NullsFirst))
+	unordered := plan.NewStaticIndexedTableAccess(rt, nil, &dummyIdx{id: "j_idx", expr: []sql.Expression{j}, database: "mydb", table: "mytable"}, key)
+
+	testCases := []analyzerFnTestCase{
+		{
+			name:     "ascending index with nulls first satisfies ascending sort",
+			node:     plan.NewSort(sql.SortFields{{Column: j, Order: sql.Ascending}}, ascNullsFirst),
+			expected: ascNullsFirst,
+		},
+		{
+			name:     "ordering preserved through project",
+			node:     plan.NewSort(sql.SortFields{{Column: j, Order: sql.Ascending}}, plan.NewProject([]sql.Expression{i, j}, ascNullsFirst)),
+			expected: plan.NewProject([]sql.Expression{i, j}, ascNullsFirst),
+		},
+		{
+			name:     "descending index satisfies descending sort",
+			node:     plan.NewSort(sql.SortFields{{Column: j, Order: sql.Descending}}, descNullsFirst),
+			expected: descNullsFirst,
+		},
+		{
+			name: "index storing nulls last does not satisfy sort",
+			node: plan.NewSort(sql.SortFields{{Column: j, Order: sql.Ascending}}, ascNullsLast),
+		},
+		{
+			name: "index direction does not match sort",
+			node: plan.NewSort(sql.SortFields{{Column: j, Order: sql.Descending}}, ascNullsFirst),
+		},
+		{
+			name: "sort column is not indexed",
+			node: plan.NewSort(sql.SortFields{{Column: i, Order: sql.Ascending}}, ascNullsFirst),
+		},
+		{
+			name: "more sort fields than index columns",
+			node: plan.NewSort(sql.SortFields{{Column: j, Order: sql.Ascending}, {Column: i, Order: sql.Ascending}}, ascNullsFirst),
+		},
+		{
+			name: "index without ordering",
+			node: plan.NewSort(sql.SortFields{{Column: j, Order: sql.Ascending}}, unordered),
+		},
+	}
+
+	runTestCases(t, nil, testCases, NewDefault(nil), f)
+}
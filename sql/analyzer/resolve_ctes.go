@@ -75,8 +75,9 @@ func resolveCtesInNode(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope,
 		cur, err = transformUpWithOpaque(prev, func(n sql.Node) (sql.Node, error) {
 			switch n := n.(type) {
 			case *plan.UnresolvedTable:
+				// A CTE shadows the tables with the same name, but not qualified references to them
 				lowerName := strings.ToLower(n.Name())
-				if ctes[lowerName] != nil {
+				if n.Database == "" && ctes[lowerName] != nil {
 					return ctes[lowerName], nil
 				}
 				return n, nil
@@ -106,9 +107,12 @@ func stripWith(ctx *sql.Context, a *Analyzer, n sql.Node, ctes map[string]sql.No
 		return n, nil
 	}
 
-	for _, cte := range with.CTEs {
+	for i, cte := range with.CTEs {
 		cteName := cte.Subquery.Name()
-		subquery := cte.Subquery
+		subquery, err := hideLaterCtes(ctx, cte.Subquery, with.CTEs[i:])
+		if err != nil {
+			return nil, err
+		}
 
 		if len(cte.Columns) > 0 {
 			schemaLen := schemaLength(subquery)
@@ -125,6 +129,51 @@ func stripWith(ctx *sql.Context, a *Analyzer, n sql.Node, ctes map[string]sql.No
 	return with.Child, nil
 }
 
+// hideLaterCtes returns the definition of a non-recursive CTE with its references to itself and to the CTEs defined
+// after it qualified with the current database. A non-recursive CTE can only refer to the CTEs defined before it, so
+// these references are to tables, which also prevents cycles between CTEs.
+func hideLaterCtes(ctx *sql.Context, sq *plan.SubqueryAlias, later []*plan.CommonTableExpression) (*plan.SubqueryAlias, error) {
+	names := make(map[string]bool)
+	for _, cte := range later {
+		names[strings.ToLower(cte.Subquery.Name())] = true
+	}
+	db := ctx.GetCurrentDatabase()
+
+	var qualify func(n sql.Node) (sql.Node, error)
+	qualify = func(n sql.Node) (sql.Node, error) {
+		return transformUpWithOpaque(n, func(n sql.Node) (sql.Node, error) {
+			if t, ok := n.(*plan.UnresolvedTable); ok {
+				if t.Database == "" && names[strings.ToLower(t.Name())] {
+					return t.WithDatabase(db)
+				}
+				return n, nil
+			}
+
+			return plan.TransformExpressions(n, func(e sql.Expression) (sql.Expression, error) {
+				subquery, ok := e.(*plan.Subquery)
+				if !ok {
+					return e, nil
+				}
+				query, err := qualify(subquery.Query)
+				if err != nil {
+					return nil, err
+				}
+				return subquery.WithQuery(query), nil
+			})
+		})
+	}
+
+	child, err := qualify(sq.Child)
+	if err != nil {
+		return nil, err
+	}
+	newSq, err := sq.WithChildren(child)
+	if err != nil {
+		return nil, err
+	}
+	return newSq.(*plan.SubqueryAlias), nil
+}
+
 // transformUpWithOpaque applies a transformation function to the given tree from the bottom up, including through
 // opaque nodes. This method is generally not safe to use for a transformation. Opaque nodes need to be considered in
 // isolation except for very specific exceptions.
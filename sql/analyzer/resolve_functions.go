@@ -15,8 +15,11 @@
 package analyzer
 
 import (
+	"fmt"
+
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
@@ -64,6 +67,14 @@ func resolveFunctionsInExpr(ctx *sql.Context, a *Analyzer) sql.TransformExprFunc
 			if err != nil {
 				return nil, err
 			}
+		} else if w := uf.Window; w != nil && (len(w.PartitionBy) > 0 || len(w.OrderBy) > 0 || w.Frame != nil) {
+			// An aggregate function with an empty OVER () clause aggregates every row, like it would without one.
+			// Otherwise, it's computed over the frame of each row.
+			wa, ok := rf.(sql.WindowAdaptableExpression)
+			if !ok {
+				return nil, sql.ErrUnsupportedFeature.New(fmt.Sprintf("%s with a non-empty OVER clause", n))
+			}
+			rf = aggregation.NewWindowedAggregation(wa, w)
 		}
 
 		a.Log("resolved function %q", n)
@@ -0,0 +1,158 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// unnamedWindow is the name errors use for a window defined in an OVER clause, like MySQL's.
+const unnamedWindow = "<unnamed window>"
+
+// resolveNamedWindows replaces the windows of window functions that refer to a named window, like `OVER w` or
+// `OVER (w ORDER BY b)`, with the definition of that window from the WINDOW clause of their query, combined with
+// their own clauses. Named windows may also extend each other. The NamedWindows nodes holding the definitions are
+// removed.
+func resolveNamedWindows(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	span, _ := ctx.Span("resolve_named_windows")
+	defer span.Finish()
+
+	n, err := plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		nw, ok := n.(*plan.NamedWindows)
+		if !ok {
+			return n, nil
+		}
+
+		defs, err := resolveWindowDefinitions(nw.WindowDefs)
+		if err != nil {
+			return nil, err
+		}
+
+		return plan.TransformExpressionsUp(nw.Child, func(e sql.Expression) (sql.Expression, error) {
+			return replaceWindowReference(e, defs)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Any reference left isn't to a window of its own query
+	return plan.TransformExpressionsUp(n, func(e sql.Expression) (sql.Expression, error) {
+		return replaceWindowReference(e, nil)
+	})
+}
+
+// replaceWindowReference returns the window function given with the named window its window refers to substituted,
+// or the expression unchanged if it isn't a window function with a reference.
+func replaceWindowReference(e sql.Expression, defs map[string]*sql.Window) (sql.Expression, error) {
+	switch e := e.(type) {
+	case *expression.UnresolvedFunction:
+		if e.Window == nil || e.Window.Ref == "" {
+			return e, nil
+		}
+		w, err := mergeWindows(e.Window, unnamedWindow, defs)
+		if err != nil {
+			return nil, err
+		}
+		nf := *e
+		nf.Window = w
+		return &nf, nil
+	case sql.WindowAggregation:
+		if e.Window() == nil || e.Window().Ref == "" {
+			return e, nil
+		}
+		w, err := mergeWindows(e.Window(), unnamedWindow, defs)
+		if err != nil {
+			return nil, err
+		}
+		return e.WithWindow(w)
+	default:
+		return e, nil
+	}
+}
+
+// resolveWindowDefinitions returns the named windows given with the windows they extend merged into them.
+func resolveWindowDefinitions(defs map[string]*sql.Window) (map[string]*sql.Window, error) {
+	resolved := make(map[string]*sql.Window, len(defs))
+	resolving := make(map[string]bool)
+
+	var resolve func(name string) (*sql.Window, error)
+	resolve = func(name string) (*sql.Window, error) {
+		if w, ok := resolved[name]; ok {
+			return w, nil
+		}
+		w, ok := defs[name]
+		if !ok {
+			return nil, sql.ErrUnknownWindowName.New(name)
+		}
+		if resolving[name] {
+			return nil, sql.ErrCircularWindowReference.New()
+		}
+
+		if w.Ref != "" {
+			resolving[name] = true
+			ref, err := resolve(w.Ref)
+			if err != nil {
+				return nil, err
+			}
+			w, err = mergeWindows(w, name, map[string]*sql.Window{w.Ref: ref})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		resolved[name] = w
+		return w, nil
+	}
+
+	for name := range defs {
+		if _, err := resolve(name); err != nil {
+			return nil, err
+		}
+	}
+	return resolved, nil
+}
+
+// mergeWindows returns the window |w|, named |name|, combined with the resolved definition of the named window it
+// refers to. As in MySQL, a window that refers to another can't define its own partitioning, can only add an ORDER BY
+// if the other window doesn't have one, and can't add anything to a window with a frame.
+func mergeWindows(w *sql.Window, name string, defs map[string]*sql.Window) (*sql.Window, error) {
+	ref, ok := defs[w.Ref]
+	if !ok {
+		return nil, sql.ErrUnknownWindowName.New(w.Ref)
+	}
+
+	if len(w.PartitionBy) > 0 {
+		return nil, sql.ErrWindowReferencePartitionBy.New(name)
+	}
+	if len(w.OrderBy) > 0 && len(ref.OrderBy) > 0 {
+		return nil, sql.ErrWindowReferenceOrderBy.New(name, w.Ref)
+	}
+	if ref.Frame != nil && (len(w.OrderBy) > 0 || w.Frame != nil) {
+		return nil, sql.ErrWindowReferenceFrame.New(w.Ref)
+	}
+
+	merged := *ref
+	if len(w.OrderBy) > 0 {
+		merged.OrderBy = w.OrderBy
+	}
+	if w.Frame != nil {
+		merged.Frame = w.Frame
+	}
+	merged.Ref = ""
+	return &merged, nil
+}
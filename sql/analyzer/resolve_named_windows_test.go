@@ -0,0 +1,129 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+func TestResolveNamedWindows(t *testing.T) {
+	f := getRule("resolve_named_windows")
+
+	table := plan.NewUnresolvedTable("t", "")
+	a := expression.NewUnresolvedColumn("a")
+	b := expression.NewUnresolvedColumn("b")
+	byA := []sql.Expression{a}
+	orderByB := sql.SortFields{{Column: b, Order: sql.Ascending}}
+	frame := sql.NewWindowFrame(
+		sql.WindowFrameUnitRows,
+		sql.WindowFrameBound{Type: sql.WindowFrameUnboundedPreceding},
+		sql.WindowFrameBound{Type: sql.WindowFrameCurrentRow},
+		sql.WindowFrameExcludeNoOthers,
+	)
+
+	rowNumber := func(w *sql.Window) sql.Node {
+		return plan.NewWindow([]sql.Expression{
+			expression.NewUnresolvedFunction("row_number", false, w),
+			a,
+		}, table)
+	}
+	ref := func(name string) *sql.Window {
+		return sql.NewWindow(nil, nil).WithRef(name)
+	}
+
+	testCases := []analyzerFnTestCase{
+		{
+			name:     "window without a reference",
+			node:     rowNumber(sql.NewWindow(byA, orderByB)),
+			expected: rowNumber(sql.NewWindow(byA, orderByB)),
+		},
+		{
+			name: "reference to a named window",
+			node: plan.NewNamedWindows(map[string]*sql.Window{
+				"w": sql.NewWindow(byA, orderByB),
+			}, rowNumber(ref("w"))),
+			expected: rowNumber(sql.NewWindow(byA, orderByB)),
+		},
+		{
+			name: "named window extended with an order by",
+			node: plan.NewNamedWindows(map[string]*sql.Window{
+				"w": sql.NewWindow(byA, nil),
+			}, rowNumber(sql.NewWindow(nil, orderByB).WithRef("w"))),
+			expected: rowNumber(sql.NewWindow(byA, orderByB)),
+		},
+		{
+			name: "named window extending another",
+			node: plan.NewNamedWindows(map[string]*sql.Window{
+				"w":  sql.NewWindow(byA, nil),
+				"w2": sql.NewWindow(nil, orderByB).WithRef("w"),
+			}, rowNumber(ref("w2"))),
+			expected: rowNumber(sql.NewWindow(byA, orderByB)),
+		},
+		{
+			name: "named window extended with a frame",
+			node: plan.NewNamedWindows(map[string]*sql.Window{
+				"w": sql.NewWindow(byA, orderByB),
+			}, rowNumber(ref("w").WithFrame(frame))),
+			expected: rowNumber(sql.NewWindow(byA, orderByB).WithFrame(frame)),
+		},
+		{
+			name: "unknown window",
+			node: plan.NewNamedWindows(map[string]*sql.Window{
+				"w": sql.NewWindow(byA, nil),
+			}, rowNumber(ref("w2"))),
+			err: sql.ErrUnknownWindowName,
+		},
+		{
+			name: "reference without a window clause",
+			node: rowNumber(ref("w")),
+			err:  sql.ErrUnknownWindowName,
+		},
+		{
+			name: "circular reference",
+			node: plan.NewNamedWindows(map[string]*sql.Window{
+				"w":  ref("w2"),
+				"w2": ref("w"),
+			}, rowNumber(ref("w"))),
+			err: sql.ErrCircularWindowReference,
+		},
+		{
+			name: "reference with a partition by",
+			node: plan.NewNamedWindows(map[string]*sql.Window{
+				"w": sql.NewWindow(nil, orderByB),
+			}, rowNumber(sql.NewWindow(byA, nil).WithRef("w"))),
+			err: sql.ErrWindowReferencePartitionBy,
+		},
+		{
+			name: "order by in both windows",
+			node: plan.NewNamedWindows(map[string]*sql.Window{
+				"w": sql.NewWindow(byA, orderByB),
+			}, rowNumber(sql.NewWindow(nil, orderByB).WithRef("w"))),
+			err: sql.ErrWindowReferenceOrderBy,
+		},
+		{
+			name: "reference to a window with a frame",
+			node: plan.NewNamedWindows(map[string]*sql.Window{
+				"w": sql.NewWindow(byA, nil).WithFrame(frame),
+			}, rowNumber(sql.NewWindow(nil, orderByB).WithRef("w"))),
+			err: sql.ErrWindowReferenceFrame,
+		},
+	}
+
+	runTestCases(t, nil, testCases, NewDefault(nil), f)
+}
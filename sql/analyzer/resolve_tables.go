@@ -59,20 +59,12 @@ func resolveTables(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql
 			db = ctx.GetCurrentDatabase()
 		}
 
-		if t.AsOf != nil {
-			// This is necessary to use functions in AS OF expressions. Because function resolution happens after table
-			// resolution, we resolve any functions in the AsOf here in order to evaluate them immediately. A better solution
-			// might be to defer evaluating the expression until later in the analysis, but that requires bigger changes.
-			asOfExpr, err := expression.TransformUp(t.AsOf, resolveFunctionsInExpr(ctx, a))
-			if err != nil {
-				return nil, err
-			}
-
-			if !asOfExpr.Resolved() {
-				return nil, sql.ErrInvalidAsOfExpression.New(asOfExpr.String())
-			}
+		if t.Versions != nil {
+			return resolveTableVersions(ctx, a, t, db)
+		}
 
-			asOf, err := asOfExpr.Eval(ctx, nil)
+		if t.AsOf != nil {
+			asOf, err := evalAsOf(ctx, a, t.AsOf)
 			if err != nil {
 				return nil, err
 			}
@@ -96,6 +88,56 @@ func resolveTables(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql
 	})
 }
 
+// resolveTableVersions resolves a table with a FOR SYSTEM_TIME BETWEEN or FOR SYSTEM_TIME FROM clause to the versions
+// of the table in the interval of the clause.
+func resolveTableVersions(ctx *sql.Context, a *Analyzer, t *plan.UnresolvedTable, db string) (sql.Node, error) {
+	from, err := evalAsOf(ctx, a, t.Versions.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := evalAsOf(ctx, a, t.Versions.To)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, database, err := a.Catalog.TableVersions(ctx, db, t.Name(), from, to, t.Versions.Inclusive)
+	if err != nil {
+		return handleTableLookupFailure(err, t.Name(), db, a, t)
+	}
+
+	// The interval may not contain any version of the table, so the schema comes from its current version
+	var schema sql.Schema
+	if len(versions) > 0 {
+		schema = versions[len(versions)-1].Schema()
+	} else {
+		current, _, err := a.Catalog.Table(ctx, db, t.Name())
+		if err != nil {
+			return nil, err
+		}
+		schema = current.Schema()
+	}
+
+	a.Log("table resolved: %q with %d versions between %s and %s", t.Name(), len(versions), from, to)
+	return plan.NewTableVersions(t.Name(), schema, database, versions), nil
+}
+
+// evalAsOf evaluates the given expression of an AS OF or FOR SYSTEM_TIME clause.
+func evalAsOf(ctx *sql.Context, a *Analyzer, e sql.Expression) (interface{}, error) {
+	// This is necessary to use functions in AS OF expressions. Because function resolution happens after table
+	// resolution, we resolve any functions in the AsOf here in order to evaluate them immediately. A better solution
+	// might be to defer evaluating the expression until later in the analysis, but that requires bigger changes.
+	asOfExpr, err := expression.TransformUp(e, resolveFunctionsInExpr(ctx, a))
+	if err != nil {
+		return nil, err
+	}
+
+	if !asOfExpr.Resolved() {
+		return nil, sql.ErrInvalidAsOfExpression.New(asOfExpr.String())
+	}
+
+	return asOfExpr.Eval(ctx, nil)
+}
+
 // setTargetSchemas fills in the target schema for any nodes in the tree that operate on a table node but also want to
 // store supplementary schema information. This is useful for lazy resolution of column default values.
 func setTargetSchemas(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
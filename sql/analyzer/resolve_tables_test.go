@@ -75,6 +75,100 @@ func TestResolveTables(t *testing.T) {
 	require.Error(err)
 }
 
+func TestResolveTableVersions(t *testing.T) {
+	f := getRule("resolve_tables")
+
+	schema := sql.NewPrimaryKeySchema(sql.Schema{{Name: "i", Source: "mytable", Type: sql.Int32}})
+	db := memory.NewHistoryDatabase("mydb")
+	versions := map[string][]int32{
+		"2019-01-01": {1, 2},
+		"2019-01-03": {2, 3},
+		"2019-01-05": {4},
+	}
+	tables := make(map[string]sql.Table)
+	for _, revision := range []string{"2019-01-01", "2019-01-03", "2019-01-05"} {
+		table := memory.NewTable("mytable", schema)
+		for _, i := range versions[revision] {
+			require.NoError(t, table.Insert(sql.NewEmptyContext(), sql.NewRow(i)))
+		}
+		db.AddTableAsOf("mytable", table, revision)
+		tables[revision] = table
+	}
+
+	a := NewBuilder(sql.NewDatabaseProvider(db)).AddPostAnalyzeRule(f.Name, f.Apply).Build()
+	ctx := sql.NewEmptyContext().WithCurrentDB("mydb")
+
+	testCases := []struct {
+		name      string
+		from, to  string
+		inclusive bool
+		versions  []string
+		rows      []sql.Row
+	}{
+		{
+			name:      "between includes the version starting at its upper bound",
+			from:      "2019-01-02",
+			to:        "2019-01-03",
+			inclusive: true,
+			versions:  []string{"2019-01-01", "2019-01-03"},
+			rows:      []sql.Row{{int32(1)}, {int32(2)}, {int32(3)}},
+		},
+		{
+			name:     "from excludes the version starting at its upper bound",
+			from:     "2019-01-02",
+			to:       "2019-01-03",
+			versions: []string{"2019-01-01"},
+			rows:     []sql.Row{{int32(1)}, {int32(2)}},
+		},
+		{
+			name:      "interval starting with a version",
+			from:      "2019-01-03",
+			to:        "2019-01-10",
+			inclusive: true,
+			versions:  []string{"2019-01-03", "2019-01-05"},
+			rows:      []sql.Row{{int32(2)}, {int32(3)}, {int32(4)}},
+		},
+		{
+			name:      "interval before the first version",
+			from:      "2018-01-01",
+			to:        "2018-12-31",
+			inclusive: true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+
+			notAnalyzed := plan.NewUnresolvedTableVersions("MyTable", "",
+				expression.NewLiteral(tt.from, sql.LongText), expression.NewLiteral(tt.to, sql.LongText), tt.inclusive)
+			analyzed, err := f.Apply(ctx, a, notAnalyzed, nil)
+			require.NoError(err)
+
+			tv, ok := analyzed.(*plan.TableVersions)
+			require.True(ok)
+			require.Equal(schema.Schema, tv.Schema())
+			require.Len(tv.Versions, len(tt.versions))
+			for i, revision := range tt.versions {
+				require.Same(tables[revision], tv.Versions[i])
+			}
+
+			rows, err := sql.NodeToRows(ctx, tv)
+			require.NoError(err)
+			require.Equal(tt.rows, rows)
+		})
+	}
+
+	t.Run("database without versions", func(t *testing.T) {
+		a := NewBuilder(sql.NewDatabaseProvider(memory.NewDatabase("mydb"))).AddPostAnalyzeRule(f.Name, f.Apply).Build()
+		notAnalyzed := plan.NewUnresolvedTableVersions("mytable", "",
+			expression.NewLiteral("2019-01-01", sql.LongText), expression.NewLiteral("2019-01-02", sql.LongText), true)
+		_, err := f.Apply(ctx, a, notAnalyzed, nil)
+		require.Error(t, err)
+		require.True(t, sql.ErrVersionRangeNotSupported.Is(err))
+	})
+}
+
 func TestResolveTablesNoCurrentDB(t *testing.T) {
 	require := require.New(t)
 	f := getRule("resolve_tables")
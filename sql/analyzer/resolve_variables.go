@@ -231,8 +231,16 @@ func resolveSystemOrUserVariable(ctx *sql.Context, a *Analyzer, col column) (sql
 		a.Log("resolved column %s to session system variable", col)
 		return expression.NewSystemVar(varName, sql.SystemVariableScope_Session), true, nil
 	case sqlparser.SetScope_User:
+		t, _, err := ctx.GetUserVariable(ctx, varName)
+		if err != nil {
+			return nil, false, err
+		}
+		// Undefined variables read as NULL
+		if t == sql.Null {
+			t = sql.LongText
+		}
 		a.Log("resolved column %s to user variable", col)
-		return expression.NewUserVar(varName), true, nil
+		return expression.NewUserVarWithType(varName, t), true, nil
 	default: // shouldn't happen
 		return nil, false, fmt.Errorf("unknown set scope %v", scope)
 	}
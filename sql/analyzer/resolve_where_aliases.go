@@ -0,0 +1,131 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// aliasesInWhereSessionVar is the session variable that allows the WHERE clause of a SELECT to refer to the aliases
+// of its select list.
+const aliasesInWhereSessionVar = "aliases_in_where"
+
+// resolveWhereAliases replaces the columns of a WHERE clause naming an alias of its select list, and no column of the
+// tables it filters, with the aliased expression when the aliases_in_where session variable is enabled. Standard SQL
+// doesn't allow such references, so an error explaining how to enable them is returned otherwise.
+func resolveWhereAliases(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	span, _ := ctx.Span("resolve_where_aliases")
+	defer span.Finish()
+
+	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		var projections []sql.Expression
+		var child sql.Node
+		switch n := n.(type) {
+		case *plan.Project:
+			projections, child = n.Projections, n.Child
+		case *plan.GroupBy:
+			projections, child = n.SelectedExprs, n.Child
+		default:
+			return n, nil
+		}
+
+		// The sort of an ORDER BY may have been pushed below the projection
+		sort, sorted := child.(*plan.Sort)
+		if sorted {
+			child = sort.Child
+		}
+
+		filter, ok := child.(*plan.Filter)
+		// The tables must be resolved to tell the aliases from their columns
+		if !ok || !filter.Child.Resolved() {
+			return n, nil
+		}
+
+		aliases := make(map[string]sql.Expression)
+		for _, e := range projections {
+			// The values of aggregations aren't known when filtering rows
+			if alias, ok := e.(*expression.Alias); ok && !containsAggregation(alias.Child) {
+				aliases[strings.ToLower(alias.Name())] = alias.Child
+			}
+		}
+		if len(aliases) == 0 {
+			return n, nil
+		}
+
+		columns := make(map[string]bool)
+		schema := filter.Child.Schema()
+		if scope != nil {
+			schema = append(scope.Schema(), schema...)
+		}
+		for _, col := range schema {
+			columns[strings.ToLower(col.Name)] = true
+		}
+
+		enabled := false
+		if val, err := ctx.GetSessionVariable(ctx, aliasesInWhereSessionVar); err == nil && val == int8(1) {
+			enabled = true
+		}
+
+		replaced := false
+		expr, err := expression.TransformUp(filter.Expression, func(e sql.Expression) (sql.Expression, error) {
+			var name string
+			switch e := e.(type) {
+			case *expression.UnresolvedColumn:
+				if e.Table() != "" {
+					return e, nil
+				}
+				name = e.Name()
+			case *deferredColumn:
+				if e.Table() != "" {
+					return e, nil
+				}
+				name = e.Name()
+			default:
+				return e, nil
+			}
+
+			aliased, ok := aliases[strings.ToLower(name)]
+			if !ok || columns[strings.ToLower(name)] {
+				return e, nil
+			}
+			if !enabled {
+				return nil, sql.ErrAliasInWhere.New(name)
+			}
+
+			a.Log("replacing alias %s in filter with %s", name, aliased)
+			replaced = true
+			return aliased, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !replaced {
+			return n, nil
+		}
+
+		child = plan.NewFilter(expr, filter.Child)
+		if sorted {
+			child, err = sort.WithChildren(child)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return n.WithChildren(child)
+	})
+}
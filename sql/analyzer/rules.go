@@ -37,7 +37,9 @@ var OnceBeforeDefault = []Rule{
 	{"resolve_drop_constraint", resolveDropConstraint},
 	{"validate_drop_constraint", validateDropConstraint},
 	{"load_check_constraints", loadChecks},
+	{"load_foreign_keys", loadForeignKeys},
 	{"resolve_create_select", resolveCreateSelect},
+	{"resolve_named_windows", resolveNamedWindows},
 	{"resolve_subqueries", resolveSubqueries},
 	{"resolve_unions", resolveUnions},
 	{"resolve_describe_query", resolveDescribeQuery},
@@ -60,6 +62,7 @@ var DefaultRules = []Rule{
 	{"pushdown_sort", pushdownSort},
 	{"pushdown_groupby_aliases", pushdownGroupByAliases},
 	{"pushdown_subquery_alias_filters", pushdownSubqueryAliasFilters},
+	{"resolve_where_aliases", resolveWhereAliases},
 	{"qualify_columns", qualifyColumns},
 	{"resolve_columns", resolveColumns},
 	{"validate_check_constraint", validateCreateCheck},
@@ -91,16 +94,26 @@ var OnceAfterDefault = []Rule{
 	{"prune_columns", pruneColumns},
 	{"optimize_joins", constructJoinPlan},
 	{"pushdown_filters", pushdownFilters},
+	{"prune_partitions", prunePartitions},
 	{"subquery_indexes", applyIndexesFromOuterScope},
 	{"in_subquery_indexes", applyIndexesForSubqueryComparisons},
 	{"pushdown_projections", pushdownProjections},
 	{"set_join_scope_len", setJoinScopeLen},
 	{"erase_projection", eraseProjection},
+	{"replace_sort_with_index", replaceSortWithIndex},
+	{"apply_merge_joins", applyMergeJoins},
+	{"optimize_distinct_with_index", optimizeDistinctWithIndex},
+	{"replace_count_star", replaceCountStar},
+	{"replace_min_max_with_index", replaceMinMaxWithIndex},
+	{"replace_random_sort", replaceRandomSort},
 	{"insert_topn", insertTopNNodes},
+	{"eliminate_common_subexpressions", eliminateCommonSubexpressions},
+	{"apply_stream_group_by", applyStreamGroupBy},
 	// One final pass at analyzing subqueries to handle rewriting field indexes after changes to outer scope by
 	// previous rules.
 	{"resolve_subquery_exprs", resolveSubqueryExpressions},
 	{"cache_subquery_results", cacheSubqueryResults},
+	{"apply_semi_joins", applySemiJoins},
 	{"cache_subquery_aliases_in_joins", cacheSubqueryAlisesInJoins},
 	{"apply_hash_lookups", applyHashLookups},
 	{"apply_hash_in", applyHashIn},
@@ -106,7 +106,7 @@ func analyzeProcedureBodies(ctx *sql.Context, a *Analyzer, node sql.Node, skipCa
 		var newChild sql.Node
 		switch child := child.(type) {
 		// Anything that may represent a collection of statements should go here
-		case *plan.Procedure, *plan.BeginEndBlock, *plan.Block, *plan.IfElseBlock, *plan.IfConditional:
+		case *plan.Procedure, *plan.BeginEndBlock, *plan.Block, *plan.IfElseBlock, *plan.IfConditional, *plan.Loop:
 			newChild, err = analyzeProcedureBodies(ctx, a, child, skipCall, scope)
 		case *plan.Call:
 			if skipCall {
@@ -215,9 +215,44 @@ func validateStoredProcedure(ctx *sql.Context, proc *plan.Procedure) (map[string
 		return nil, err
 	}
 
+	if err = validateLoopLabels(proc, nil); err != nil {
+		return nil, err
+	}
+
 	return paramNames, nil
 }
 
+// validateLoopLabels ensures that every LEAVE and ITERATE statement is contained within a loop with its label.
+func validateLoopLabels(n sql.Node, labels []string) error {
+	switch n := n.(type) {
+	case *plan.Loop:
+		labels = append(labels, n.Label)
+	case *plan.Leave:
+		if !containsLabel(labels, n.Label) {
+			return sql.ErrLoopLabelNotFound.New("LEAVE", n.Label)
+		}
+	case *plan.Iterate:
+		if !containsLabel(labels, n.Label) {
+			return sql.ErrLoopLabelNotFound.New("ITERATE", n.Label)
+		}
+	}
+	for _, child := range n.Children() {
+		if err := validateLoopLabels(child, labels); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func containsLabel(labels []string, label string) bool {
+	for _, l := range labels {
+		if l == label {
+			return true
+		}
+	}
+	return false
+}
+
 // resolveProcedureParams resolves all of the named parameters and declared variables inside of a stored procedure.
 func resolveProcedureParams(ctx *sql.Context, paramNames map[string]struct{}, proc sql.Node) (sql.Node, error) {
 	newProcNode, err := resolveProcedureParamsTransform(ctx, paramNames, proc)
@@ -360,6 +395,8 @@ func applyProceduresCall(ctx *sql.Context, a *Analyzer, call *plan.Call, scope *
 				return nil, err
 			}
 			return n.WithChildren(newLeft, newRight)
+		case plan.ProcedureReferencable:
+			return n.WithParamReference(pRef), nil
 		default:
 			return n, nil
 		}
@@ -389,6 +426,16 @@ func applyProceduresCall(ctx *sql.Context, a *Analyzer, call *plan.Call, scope *
 	if len(procedure.Params) != len(call.Params) {
 		return nil, sql.ErrCallIncorrectParameterCount.New(procedure.Name, len(procedure.Params), len(call.Params))
 	}
+	for i, param := range procedure.Params {
+		if param.Direction == plan.ProcedureParamDirection_In {
+			continue
+		}
+		switch call.Params[i].(type) {
+		case *expression.UserVar, *expression.ProcedureParam:
+		default:
+			return nil, sql.ErrCallOutParamNotVariable.New(i+1, procedure.Name)
+		}
+	}
 
 	call = call.WithProcedure(procedure)
 	return call, nil
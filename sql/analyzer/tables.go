@@ -170,6 +170,30 @@ func getTablesByName(node sql.Node) map[string]*plan.ResolvedTable {
 	return ret
 }
 
+// deleteTargetTables returns the tables a multi-table DELETE with the child given can delete from, by their lowercased
+// name, or alias if they are aliased. Tables in subqueries can't be deleted from.
+func deleteTargetTables(node sql.Node) map[string]*plan.ResolvedTable {
+	ret := make(map[string]*plan.ResolvedTable)
+	plan.Inspect(node, func(node sql.Node) bool {
+		switch n := node.(type) {
+		case *plan.ResolvedTable:
+			ret[strings.ToLower(n.Name())] = n
+		case *plan.IndexedTableAccess:
+			ret[strings.ToLower(n.Name())] = n.ResolvedTable
+		case *plan.TableAlias:
+			// An aliased table can only be referred to by its alias
+			if rt := getResolvedTable(n.Child); rt != nil {
+				ret[strings.ToLower(n.Name())] = rt
+			}
+		case *plan.SubqueryAlias:
+		default:
+			return true
+		}
+		return false
+	})
+	return ret
+}
+
 // Returns the tables used in the expressions given
 func findTables(exprs ...sql.Expression) []string {
 	tables := make(map[string]bool)
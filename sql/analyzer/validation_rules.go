@@ -41,6 +41,7 @@ const (
 	validateSubqueryColumnsRule   = "validate_subquery_columns"
 	validateUnionSchemasMatchRule = "validate_union_schemas_match"
 	validateAggregationsRule      = "validate_aggregations"
+	validateDeleteTargetsRule     = "validate_delete_targets"
 )
 
 var (
@@ -88,6 +89,10 @@ var (
 		"the schema of the left side of union does not match the right side, expected %s to match %s",
 	)
 
+	// ErrUnknownTableInMultiDelete is returned when a table to delete from in a multi-table DELETE isn't one of the
+	// tables of its FROM clause.
+	ErrUnknownTableInMultiDelete = errors.NewKind("Unknown table '%s' in MULTI DELETE")
+
 	// ErrReadOnlyDatabase is returned when a write is attempted to a ReadOnlyDatabse.
 	ErrReadOnlyDatabase = errors.NewKind("Database %s is read-only.")
 
@@ -112,6 +117,7 @@ var DefaultValidationRules = []Rule{
 	{validateSubqueryColumnsRule, validateSubqueryColumns},
 	{validateUnionSchemasMatchRule, validateUnionSchemasMatch},
 	{validateAggregationsRule, validateAggregations},
+	{validateDeleteTargetsRule, validateDeleteTargets},
 }
 
 // validateLimitAndOffset ensures that only integer literals are used for limit and offset values
@@ -233,28 +239,31 @@ func validateGroupBy(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (s
 	span, _ := ctx.Span("validate_group_by")
 	defer span.Finish()
 
+	var selectedExprs, groupByExprs []sql.Expression
 	switch n := n.(type) {
 	case *plan.GroupBy:
-		// Allow the parser use the GroupBy node to eval the aggregation functions
-		// for sql statements that don't make use of the GROUP BY expression.
-		if len(n.GroupByExprs) == 0 {
-			return n, nil
-		}
+		selectedExprs, groupByExprs = n.SelectedExprs, n.GroupByExprs
+	case *plan.StreamGroupBy:
+		selectedExprs, groupByExprs = n.SelectedExprs, n.GroupByExprs
+	}
 
-		var groupBys []string
-		for _, expr := range n.GroupByExprs {
-			groupBys = append(groupBys, expr.String())
-		}
+	// Allow the parser use the GroupBy node to eval the aggregation functions
+	// for sql statements that don't make use of the GROUP BY expression.
+	if len(groupByExprs) == 0 {
+		return n, nil
+	}
 
-		for _, expr := range n.SelectedExprs {
-			if _, ok := expr.(sql.Aggregation); !ok {
-				if !expressionReferencesOnlyGroupBys(groupBys, expr) {
-					return nil, ErrValidationGroupBy.New(expr.String())
-				}
+	var groupBys []string
+	for _, expr := range groupByExprs {
+		groupBys = append(groupBys, expr.String())
+	}
+
+	for _, expr := range selectedExprs {
+		if _, ok := expr.(sql.Aggregation); !ok {
+			if !expressionReferencesOnlyGroupBys(groupBys, expr) {
+				return nil, ErrValidationGroupBy.New(expr.String())
 			}
 		}
-
-		return n, nil
 	}
 
 	return n, nil
@@ -382,6 +391,33 @@ func validateUnionSchemasMatch(ctx *sql.Context, a *Analyzer, n sql.Node, scope
 	return n, nil
 }
 
+// validateDeleteTargets returns an error if a table to delete from in a multi-table DELETE isn't one of the tables of
+// its FROM clause.
+func validateDeleteTargets(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	span, _ := ctx.Span("validate_delete_targets")
+	defer span.Finish()
+
+	var err error
+	plan.Inspect(n, func(n sql.Node) bool {
+		d, ok := n.(*plan.DeleteFrom)
+		if !ok || len(d.Targets) == 0 {
+			return err == nil
+		}
+		tables := deleteTargetTables(d.Child)
+		for _, target := range d.Targets {
+			if _, ok := tables[strings.ToLower(target)]; !ok {
+				err = ErrUnknownTableInMultiDelete.New(target)
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
 func validateCaseResultTypes(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
 	span, ctx := ctx.Span("validate_case_result_types")
 	defer span.Finish()
@@ -753,8 +789,8 @@ func validateReadOnlyTransaction(ctx *sql.Context, a *Analyzer, n sql.Node, scop
 }
 
 // validateAggregations returns an error if an Aggregation
-// expression node appears outside of a GroupBy or Window node. Only GroupBy
-// and Window nodes know how to evaluate Aggregation expressions.
+// expression node appears outside of a GroupBy, StreamGroupBy or Window node.
+// Only those nodes know how to evaluate Aggregation expressions.
 //
 // See https://github.com/dolthub/go-mysql-server/issues/542 for some queries
 // that should be supported but that currently trigger this validation because
@@ -775,6 +811,8 @@ func validateAggregations(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scop
 	plan.Inspect(n, func(n sql.Node) bool {
 		if gb, ok := n.(*plan.GroupBy); ok {
 			return checkExpressions(gb.GroupByExprs)
+		} else if gb, ok := n.(*plan.StreamGroupBy); ok {
+			return checkExpressions(gb.GroupByExprs)
 		} else if _, ok := n.(*plan.Window); ok {
 		} else if n, ok := n.(sql.Expressioner); ok {
 			return checkExpressions(n.Expressions())
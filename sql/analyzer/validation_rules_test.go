@@ -899,6 +899,38 @@ func TestValidateSubqueryColumns(t *testing.T) {
 
 }
 
+func TestValidateDeleteTargets(t *testing.T) {
+	schema := sql.NewPrimaryKeySchema(sql.Schema{{Name: "i", Type: sql.Int64}})
+	t1 := plan.NewResolvedTable(memory.NewTable("t1", schema), nil, nil)
+	t2 := plan.NewResolvedTable(memory.NewTable("t2", schema), nil, nil)
+	join := plan.NewCrossJoin(plan.NewTableAlias("a", t1), t2)
+
+	testCases := []struct {
+		name    string
+		targets []string
+		ok      bool
+	}{
+		{"alias of a table", []string{"A"}, true},
+		{"name of a table", []string{"a", "t2"}, true},
+		{"name of an aliased table", []string{"t1"}, false},
+		{"unknown table", []string{"t3"}, false},
+	}
+
+	rule := getValidationRule(validateDeleteTargetsRule)
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			_, err := rule.Apply(sql.NewEmptyContext(), nil, plan.NewDeleteFrom(join, tt.targets...), nil)
+			if tt.ok {
+				require.NoError(err)
+			} else {
+				require.Error(err)
+				require.True(ErrUnknownTableInMultiDelete.Is(err))
+			}
+		})
+	}
+}
+
 type dummyNode struct{ resolved bool }
 
 func (n dummyNode) String() string                                   { return "dummynode" }
@@ -36,6 +36,10 @@ type Catalog interface {
 	// TableAsOf returns the table with the name given in the db with the name given, as of the given marker
 	TableAsOf(ctx *Context, dbName, tableName string, asOf interface{}) (Table, Database, error)
 
+	// TableVersions returns every version of the table with the name given in the db with the name given that is valid
+	// in the interval between the given markers, ordered from oldest to newest
+	TableVersions(ctx *Context, dbName, tableName string, from, to interface{}, inclusive bool) ([]Table, Database, error)
+
 	// Function returns the function with the name given, or sql.ErrFunctionNotFound if it doesn't exist
 	Function(name string) (Function, error)
 
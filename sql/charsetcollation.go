@@ -73,7 +73,12 @@ type Collation struct {
 
 var Collations = map[string]Collation{}
 
+// newCollation creates and registers a collation. Binary (_bin) and case-sensitive (_cs) collations compare and match
+// LIKE patterns case-sensitively, while all others do so case-insensitively.
 func newCollation(name string, cs CharacterSet) Collation {
+	if strings.HasSuffix(name, "_bin") || strings.HasSuffix(name, "_cs") {
+		return newCSCollation(name, cs)
+	}
 	c := Collation{Name: name, CharSet: cs, compare: collationCompareInsensitive, like: collationLikeInsensitive}
 	Collations[name] = c
 	return c
@@ -1141,6 +1146,21 @@ func (c Collation) PadSpace() string {
 	return s.PadSpace
 }
 
+// Key returns the form of the string given that compares equal to the forms of all the strings equal to it under the
+// collation: the string itself for case-sensitive collations, and its lowercase form for all others.
+func (c Collation) Key(s string) string {
+	if c.compare == collationCompareInsensitive {
+		return strings.ToLower(s)
+	}
+	return s
+}
+
+// Compare compares two strings under the collation, returning -1, 0 or 1 as the first sorts before, the same as or
+// after the second.
+func (c Collation) Compare(a, b string) int {
+	return strings.Compare(c.Key(a), c.Key(b))
+}
+
 // Equals returns true if two collations are equal, false otherwise
 func (c Collation) Equals(other Collation) bool {
 	return c.Name == other.Name
@@ -69,3 +69,9 @@ func testParseCollation(t *testing.T, charset *string, collation *string, binary
 		}
 	})
 }
+
+func TestCollationKey(t *testing.T) {
+	require.Equal(t, "abc", Collation_utf8mb4_0900_ai_ci.Key("AbC"))
+	require.Equal(t, "AbC", Collation_utf8mb4_0900_bin.Key("AbC"))
+	require.Equal(t, Collation_utf8mb4_0900_ai_ci.Key("ABC"), Collation_utf8mb4_0900_ai_ci.Key("abc"))
+}
@@ -158,6 +158,16 @@ type WindowFramer interface {
 	//SlidingInterval(ctx Context) (WindowInterval, WindowInterval, WindowInterval)
 }
 
+// BufferedWindowFramer is a WindowFramer whose frames depend on the values of the partition's rows, such as RANGE
+// frames and frames that exclude the peers of the current row. Callers should use NextWithBuffer for them, since Next
+// may not be able to compute their frames.
+type BufferedWindowFramer interface {
+	WindowFramer
+	// NextWithBuffer returns the next WindowInterval frame, or an io.EOF error after the last row. The buffer given
+	// holds the rows of the partition, sorted by the window's ORDER BY.
+	NextWithBuffer(*Context, WindowBuffer) (WindowInterval, error)
+}
+
 type AggregationBuffer interface {
 	Disposable
 
@@ -316,6 +326,39 @@ type PartitionCounter interface {
 	PartitionCount(*Context) (int64, error)
 }
 
+// HashPartitionedTable is a table whose rows are stored in the partition given by the hash of the values of its
+// partition key columns, as declared with PARTITION BY HASH. A query with an equality predicate on every partition
+// key column only needs to read a single partition.
+type HashPartitionedTable interface {
+	Table
+	// PartitionKeyColumns returns the names of the columns of the partition key, in order.
+	PartitionKeyColumns() []string
+	// WithPartitionKey returns a version of this table that only returns the partition of the key given, with one
+	// value for each partition key column.
+	WithPartitionKey(ctx *Context, key Row) (Table, error)
+}
+
+// RangePartitionedTable is a table whose rows are stored in the partition whose range contains the value of its
+// partition key column, as declared with PARTITION BY RANGE. A query restricting the values of the partition key only
+// needs to read the partitions whose range overlaps them.
+type RangePartitionedTable interface {
+	Table
+	// RangePartitionColumn returns the name of the partition key column, or an empty string if the table isn't range
+	// partitioned.
+	RangePartitionColumn() string
+	// WithPartitionRanges returns a version of this table that only returns the partitions whose range overlaps one of
+	// the ranges of partition key values given.
+	WithPartitionRanges(ctx *Context, ranges []RangeColumnExpr) (Table, error)
+}
+
+// PrunedPartitionsTable is a partitioned table that can report the partitions it was restricted to by partition
+// pruning, which EXPLAIN shows.
+type PrunedPartitionsTable interface {
+	Table
+	// PrunedPartitions returns the keys of the partitions the table reads, and false if it wasn't pruned.
+	PrunedPartitions() ([]string, bool)
+}
+
 // FilteredTable is a table that can produce a specific RowIter
 // that's more optimized given the filters.
 type FilteredTable interface {
@@ -341,6 +384,35 @@ type StatisticsTable interface {
 	DataLength(ctx *Context) (uint64, error)
 }
 
+// RowCountTable is a table that keeps track of its number of rows, and can report it without scanning. The analyzer
+// uses it to answer COUNT(*) over the whole table.
+type RowCountTable interface {
+	Table
+	// RowCount returns the exact number of rows in the table. The boolean result is false when the count is not
+	// available without scanning the table, in which case the count must not be used.
+	RowCount(*Context) (uint64, bool)
+}
+
+// SystemVersionedTable is a table that keeps the history of its changes, which can be queried with AS OF. Such a table
+// is declared WITH SYSTEM VERSIONING.
+type SystemVersionedTable interface {
+	Table
+	// IsSystemVersioned returns whether the history of the table is kept.
+	IsSystemVersioned() bool
+}
+
+// IsSystemVersioned returns whether the table given, or the table it wraps, keeps the history of its changes.
+func IsSystemVersioned(t Table) bool {
+	switch t := t.(type) {
+	case SystemVersionedTable:
+		return t.IsSystemVersioned()
+	case TableWrapper:
+		return IsSystemVersioned(t.Underlying())
+	default:
+		return false
+	}
+}
+
 // IndexUsing is the desired storage type.
 type IndexUsing byte
 
@@ -650,6 +722,19 @@ type VersionedDatabase interface {
 	GetTableNamesAsOf(ctx *Context, asOf interface{}) ([]string, error)
 }
 
+// VersionRangeDatabase is a VersionedDatabase that can also return every version of a table over an interval of
+// revisions. The engine supports these queries via the FOR SYSTEM_TIME BETWEEN ... AND ... and FOR SYSTEM_TIME FROM
+// ... TO ... constructs introduced in SQL 2011.
+type VersionRangeDatabase interface {
+	VersionedDatabase
+
+	// GetTableInsensitiveVersions retrieves every version of a table by its case-insensitive name that is valid at some
+	// point of the interval between the two revisions given, ordered from oldest to newest. The interval always includes
+	// its lower bound, and includes its upper bound only if inclusive is true. Implementors must choose which types of
+	// expressions to accept as revision names.
+	GetTableInsensitiveVersions(ctx *Context, tblName string, from, to interface{}, inclusive bool) ([]Table, bool, error)
+}
+
 type TransactionCharacteristic int
 
 const (
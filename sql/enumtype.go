@@ -76,11 +76,12 @@ func CreateEnumType(values []string, collation Collation) (EnumType, error) {
 			value = strings.TrimRight(value, " ")
 		}
 		values[i] = value
-		if _, ok := valToIndex[value]; ok {
+		key := enumKey(value, collation)
+		if _, ok := valToIndex[key]; ok {
 			return nil, fmt.Errorf("duplicate entry: %v", value)
 		}
 		/// The elements listed in the column specification are assigned index numbers, beginning with 1.
-		valToIndex[value] = i + 1
+		valToIndex[key] = i + 1
 	}
 	return enumType{
 		collation:  collation,
@@ -277,14 +278,14 @@ func (t enumType) Collation() Collation {
 
 // IndexOf returns the index of the given string. If the string was not found, then this returns -1.
 func (t enumType) IndexOf(v string) int {
-	if index, ok := t.valToIndex[v]; ok {
+	if index, ok := t.valToIndex[enumKey(v, t.collation)]; ok {
 		return index
 	}
 	/// ENUM('0','1','2')
 	/// If you store '3', it does not match any enumeration value, so it is treated as an index and becomes '2' (the value with index 3).
 	if parsedIndex, err := strconv.ParseInt(v, 10, 32); err == nil {
 		if realV, ok := t.At(int(parsedIndex)); ok {
-			if index, ok := t.valToIndex[realV]; ok {
+			if index, ok := t.valToIndex[enumKey(realV, t.collation)]; ok {
 				return index
 			}
 		}
@@ -292,6 +293,12 @@ func (t enumType) IndexOf(v string) int {
 	return -1
 }
 
+// enumKey returns the key used to look up the given value in an enum or set with the given collation. Values are
+// matched case-insensitively unless the collation is case-sensitive.
+func enumKey(v string, collation Collation) string {
+	return collation.Key(v)
+}
+
 // Marshal takes a valid Enum value and returns it as an int64.
 func (t enumType) Marshal(v interface{}) (int64, error) {
 	i, err := t.ConvertToIndex(v)
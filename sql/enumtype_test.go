@@ -72,6 +72,9 @@ func TestEnumCreate(t *testing.T) {
 		{[]string{}, Collation_Default, nil, true},
 		{[]string{"one", "one"}, Collation_Default, nil, true},
 		{[]string{"one", "one "}, Collation_Default, nil, true},
+		{[]string{"one", "ONE"}, Collation_utf8mb4_0900_bin,
+			map[string]int{"one": 1, "ONE": 2}, false},
+		{[]string{"one", "ONE"}, Collation_utf8mb4_0900_ai_ci, nil, true},
 	}
 
 	for _, test := range tests {
@@ -129,10 +132,13 @@ func TestEnumConvert(t *testing.T) {
 		{[]string{"0", "1", "2"}, Collation_Default, 2, "1", false},
 		{[]string{"0", "1", "2"}, Collation_Default, "3", "2", false},
 		{[]string{"0", "1", "2"}, Collation_Default, "2", "2", false},
+		{[]string{"one", "two"}, Collation_utf8mb4_0900_ai_ci, "TWO", "two", false},
+		{[]string{"One", "Two"}, Collation_utf8mb4_0900_ai_ci, "one", "One", false},
 
 		{[]string{"one", "two"}, Collation_Default, 3, nil, true},
 		{[]string{"one", "two"}, Collation_Default, 0, nil, true},
 		{[]string{"one", "two"}, Collation_Default, "three", nil, true},
+		{[]string{"one", "two"}, Collation_utf8mb4_0900_bin, "TWO", nil, true},
 		{[]string{"one", "two"}, Collation_Default, time.Date(2019, 12, 12, 12, 12, 12, 0, time.UTC), nil, true},
 	}
 
@@ -95,6 +95,15 @@ var (
 	ErrMisusedAlias = errors.NewKind("column %q does not exist in scope, but there is an alias defined in" +
 		" this projection with that name. Aliases cannot be used in the same projection they're defined in")
 
+	// ErrAliasInWhere is returned when a WHERE clause refers to an alias of its select list while aliases_in_where
+	// is disabled.
+	ErrAliasInWhere = errors.NewKind("column %q could not be found in any table in scope. Aliases of the select " +
+		"list can only be used in a WHERE clause when the aliases_in_where session variable is enabled")
+
+	// ErrDivisionByZero is returned when a value is divided by zero while the ERROR_FOR_DIVISION_BY_ZERO sql mode is
+	// enabled.
+	ErrDivisionByZero = errors.NewKind("Division by 0")
+
 	// ErrInvalidAsOfExpression is returned when an expression for AS OF cannot be used
 	ErrInvalidAsOfExpression = errors.NewKind("expression %s cannot be used in AS OF")
 
@@ -161,6 +170,31 @@ var (
 	// ErrCallIncorrectParameterCount is returned when a CALL statement has the incorrect number of parameters.
 	ErrCallIncorrectParameterCount = errors.NewKind("`%s` expected `%d` parameters but got `%d`")
 
+	// ErrCallOutParamNotVariable is returned when a CALL statement gives an OUT or INOUT parameter a value other than a
+	// user variable or a parameter of the calling procedure.
+	ErrCallOutParamNotVariable = errors.NewKind("OUT or INOUT argument %d for routine %s is not a variable")
+
+	// ErrLoopLabelNotFound is returned when a LEAVE or ITERATE statement is not inside of a loop with its label.
+	ErrLoopLabelNotFound = errors.NewKind("%s with no matching label: %s")
+
+	// ErrCaseNotFound is returned when no branch of a CASE statement without an ELSE is taken.
+	ErrCaseNotFound = errors.NewKind("Case not found for CASE statement")
+
+	// ErrCursorNotFound is returned when a cursor is used without having been declared.
+	ErrCursorNotFound = errors.NewKind("Undefined CURSOR: %s")
+
+	// ErrCursorAlreadyOpen is returned when opening a cursor that is already open.
+	ErrCursorAlreadyOpen = errors.NewKind("Cursor is already open")
+
+	// ErrCursorNotOpen is returned when fetching from or closing a cursor that is not open.
+	ErrCursorNotOpen = errors.NewKind("Cursor is not open")
+
+	// ErrFetchNoData is returned when fetching from a cursor that has no rows left, which is the NOT FOUND condition.
+	ErrFetchNoData = errors.NewKind("No data - zero rows fetched, selected, or processed")
+
+	// ErrFetchIncorrectCount is returned when a FETCH statement does not have a variable for each column of the cursor.
+	ErrFetchIncorrectCount = errors.NewKind("Incorrect number of FETCH variables")
+
 	// ErrUnknownSystemVariable is returned when a query references a system variable that doesn't exist
 	ErrUnknownSystemVariable = errors.NewKind(`Unknown system variable '%s'`)
 
@@ -252,6 +286,13 @@ var (
 	// ErrPartitionNotFound is thrown when a partition key on a table is not found
 	ErrPartitionNotFound = errors.NewKind("partition not found %q")
 
+	// ErrNoPartitionForValue is returned when a row of a range partitioned table has a partition key value greater
+	// than the range of every partition
+	ErrNoPartitionForValue = errors.NewKind("Table has no partition for value %v")
+
+	// ErrRangeNotIncreasing is returned when the ranges of the partitions of a range partitioned table are not in order
+	ErrRangeNotIncreasing = errors.NewKind("VALUES LESS THAN value must be strictly increasing for each partition")
+
 	// ErrInsertIntoNonNullableProvidedNull is called when a null value is inserted into a non-nullable column
 	ErrInsertIntoNonNullableProvidedNull = errors.NewKind("column name '%v' is non-nullable but attempted to set a value of null")
 
@@ -261,6 +302,10 @@ var (
 	// ErrForeignKeyParentViolation is called when a parent row that is deleted has children, and a foreign key constraint fails. Delete the children first.
 	ErrForeignKeyParentViolation = errors.NewKind("cannot delete or update a parent row - Foreign key violation on fk: `%s`, table: `%s`, referenced table: `%s`, key: `%s`")
 
+	// ErrForeignKeyCascadeDepth is returned when a cascade of foreign keys goes through more foreign keys than allowed,
+	// which happens with foreign keys cascading in a cycle.
+	ErrForeignKeyCascadeDepth = errors.NewKind("Foreign key cascade delete/update exceeds max depth of %d.")
+
 	// ErrForeignKeyColumnCountMismatch is called when the declared column and referenced column counts do not match.
 	ErrForeignKeyColumnCountMismatch = errors.NewKind("the foreign key must reference an equivalent number of columns")
 
@@ -331,6 +376,10 @@ var (
 	// ErrAsOfNotSupported is thrown when an AS OF query is run on a database that can't support it
 	ErrAsOfNotSupported = errors.NewKind("AS OF not supported for database %s")
 
+	// ErrVersionRangeNotSupported is thrown when a FOR SYSTEM_TIME BETWEEN or FOR SYSTEM_TIME FROM query is run on a
+	// database that can't support it
+	ErrVersionRangeNotSupported = errors.NewKind("FOR SYSTEM_TIME BETWEEN not supported for database %s")
+
 	// ErrIncompatibleAsOf is thrown when an AS OF clause is used in an incompatible manner, such as when using an AS OF
 	// expression with a view when the view definition has its own AS OF expressions.
 	ErrIncompatibleAsOf = errors.NewKind("incompatible use of AS OF: %s")
@@ -382,6 +431,39 @@ var (
 
 	// ErrInvalidCheckConstraint is returned when a  check constraint is defined incorrectly
 	ErrInvalidCheckConstraint = errors.NewKind("invalid constraint definition: %s")
+
+	// ErrUnknownWindowName is returned when a window refers to a named window that isn't defined
+	ErrUnknownWindowName = errors.NewKind("window name '%s' is not defined")
+
+	// ErrCircularWindowReference is returned when named windows refer to each other in a cycle
+	ErrCircularWindowReference = errors.NewKind("there is a circularity in the window dependency graph")
+
+	// ErrWindowReferencePartitionBy is returned when a window that refers to another has its own PARTITION BY
+	ErrWindowReferencePartitionBy = errors.NewKind("window '%s' depends on another window, so cannot define partitioning")
+
+	// ErrWindowReferenceOrderBy is returned when a window with an ORDER BY extends a window that also has one
+	ErrWindowReferenceOrderBy = errors.NewKind("window '%s' cannot inherit '%s' since both contain an ORDER BY clause")
+
+	// ErrWindowReferenceFrame is returned when a window extends a named window with a frame
+	ErrWindowReferenceFrame = errors.NewKind("window '%s' has a frame definition, so cannot be referenced by another window")
+
+	// ErrCollationInvalidForCharSet is returned when COLLATE names a collation of a character set other than that of
+	// the string it's applied to
+	ErrCollationInvalidForCharSet = errors.NewKind("COLLATION '%s' is not valid for CHARACTER SET '%s'")
+
+	// ErrUnsupportedBlockEncryptionMode is returned when AES_ENCRYPT or AES_DECRYPT is called with block_encryption_mode
+	// set to a mode that isn't supported
+	ErrUnsupportedBlockEncryptionMode = errors.NewKind("unsupported block_encryption_mode '%s'")
+
+	// ErrAesMissingIV is returned when AES_ENCRYPT or AES_DECRYPT is called without an initialization vector in a block
+	// encryption mode that requires one
+	ErrAesMissingIV = errors.NewKind("Incorrect parameter count in the call to native function '%s'")
+
+	// ErrAesInvalidIV is returned when the initialization vector given to AES_ENCRYPT or AES_DECRYPT is too short
+	ErrAesInvalidIV = errors.NewKind("The initialization vector supplied to %s is too short. Must be at least %d bytes long")
+
+	// ErrRandomBytesLength is returned when RANDOM_BYTES is asked for fewer than 1 or more than 1024 bytes
+	ErrRandomBytesLength = errors.NewKind("length value is out of range in 'random_bytes'")
 )
 
 func CastSQLError(err error) (*mysql.SQLError, error, bool) {
@@ -420,6 +502,8 @@ func CastSQLError(err error) (*mysql.SQLError, error, bool) {
 		code = mysql.ErNoReferencedRow2 // test with mysql returns 1452 vs 1216
 	case ErrForeignKeyParentViolation.Is(err):
 		code = mysql.ERRowIsReferenced2 // test with mysql returns 1451 vs 1215
+	case ErrForeignKeyCascadeDepth.Is(err):
+		code = 3008 // TODO: Needs to be added to vitess
 	case ErrDuplicateEntry.Is(err):
 		code = mysql.ERDupEntry
 	case ErrInvalidJSONText.Is(err):
@@ -438,6 +522,11 @@ func CastSQLError(err error) (*mysql.SQLError, error, bool) {
 		code = 1553 // TODO: Needs to be added to vitess
 	case ErrInvalidValue.Is(err):
 		code = mysql.ERTruncatedWrongValueForField
+	case ErrDivisionByZero.Is(err):
+		code = 1365 // TODO: Needs to be added to vitess
+	case ErrFetchNoData.Is(err):
+		code = 1329 // TODO: Needs to be added to vitess
+		sqlState = "02000"
 	default:
 		code = mysql.ERUnknownError
 	}
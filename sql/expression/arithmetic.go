@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/shopspring/decimal"
 	errors "gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -134,19 +135,27 @@ func (a *Arithmetic) Type() sql.Type {
 			return sql.Int64
 		}
 
+		if sql.IsDecimal(a.Left.Type()) && sql.IsDecimal(a.Right.Type()) {
+			return decimalArithmeticType(a.Op, a.Left.Type().(sql.DecimalType), a.Right.Type().(sql.DecimalType))
+		}
+
 		if sql.IsInteger(a.Left.Type()) && sql.IsInteger(a.Right.Type()) {
 			if sql.IsUnsigned(a.Left.Type()) && sql.IsUnsigned(a.Right.Type()) {
 				return sql.Uint64
 			}
+			// As in MySQL, adding, subtracting or multiplying with an unsigned operand gives an unsigned result
+			if a.Op != sqlparser.DivStr && (isUnsignedOperand(a.Left) || isUnsignedOperand(a.Right)) {
+				return sql.Uint64
+			}
 			return sql.Int64
 		}
 
 		return sql.Float64
 
-	case sqlparser.ShiftLeftStr, sqlparser.ShiftRightStr:
+	case sqlparser.ShiftLeftStr, sqlparser.ShiftRightStr, sqlparser.BitAndStr, sqlparser.BitOrStr, sqlparser.BitXorStr:
 		return sql.Uint64
 
-	case sqlparser.BitAndStr, sqlparser.BitOrStr, sqlparser.BitXorStr, sqlparser.IntDivStr, sqlparser.ModStr:
+	case sqlparser.IntDivStr, sqlparser.ModStr:
 		if sql.IsUnsigned(a.Left.Type()) && sql.IsUnsigned(a.Right.Type()) {
 			return sql.Uint64
 		}
@@ -156,6 +165,63 @@ func (a *Arithmetic) Type() sql.Type {
 	return sql.Float64
 }
 
+// divPrecisionIncrement is the number of digits by which division increases the scale of its first operand, as with
+// MySQL's div_precision_increment system variable at its default.
+const divPrecisionIncrement = 4
+
+// decimalArithmeticType returns the type of the result of an arithmetic operation between two DECIMAL operands. As in
+// MySQL, addition and subtraction keep the larger scale, multiplication adds the scales together, and division adds
+// divPrecisionIncrement to the scale of the dividend. Enough integer digits are kept for the largest possible result,
+// up to the maximum precision.
+func decimalArithmeticType(op string, left, right sql.DecimalType) sql.DecimalType {
+	lInt := int(left.Precision()) - int(left.Scale())
+	rInt := int(right.Precision()) - int(right.Scale())
+
+	var intDigits, scale int
+	switch strings.ToLower(op) {
+	case sqlparser.PlusStr, sqlparser.MinusStr:
+		scale, intDigits = int(left.Scale()), lInt
+		if int(right.Scale()) > scale {
+			scale = int(right.Scale())
+		}
+		if rInt > intDigits {
+			intDigits = rInt
+		}
+		intDigits++
+	case sqlparser.MultStr:
+		scale = int(left.Scale()) + int(right.Scale())
+		intDigits = lInt + rInt
+	default:
+		scale = int(left.Scale()) + divPrecisionIncrement
+		intDigits = lInt + int(right.Scale())
+	}
+
+	if scale > sql.DecimalTypeMaxScale {
+		scale = sql.DecimalTypeMaxScale
+	}
+	precision := intDigits + scale
+	if precision > sql.DecimalTypeMaxPrecision {
+		precision = sql.DecimalTypeMaxPrecision
+	}
+	if precision < 1 {
+		precision = 1
+	}
+	return sql.MustCreateDecimalType(uint8(precision), uint8(scale))
+}
+
+// isUnsignedOperand returns whether the expression given makes integer arithmetic unsigned. Small integer literals
+// are typed as the smallest type that holds them, which may be unsigned, but MySQL considers them signed unless they
+// are too large for BIGINT.
+func isUnsignedOperand(e sql.Expression) bool {
+	if !sql.IsUnsigned(e.Type()) {
+		return false
+	}
+	if _, ok := e.(*Literal); ok {
+		return e.Type() == sql.Uint64
+	}
+	return true
+}
+
 func isInterval(expr sql.Expression) bool {
 	_, ok := expr.(*Interval)
 	return ok
@@ -180,6 +246,22 @@ func (a *Arithmetic) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
+	if a.isDivision() && isZeroDivisor(rval) {
+		return divisionByZero(ctx)
+	}
+
+	if a.isBitwise() {
+		return a.evalBitwise(lval, rval)
+	}
+
+	if typ, ok := a.Type().(sql.DecimalType); ok {
+		return a.evalDecimal(ctx, lval, rval, typ)
+	}
+
+	if a.Type() == sql.Uint64 && !(sql.IsUnsigned(a.Left.Type()) && sql.IsUnsigned(a.Right.Type())) {
+		return a.evalMixedSignedness(lval, rval)
+	}
+
 	lval, rval, err = a.convertLeftRight(lval, rval)
 	if err != nil {
 		return nil, err
@@ -213,6 +295,152 @@ func (a *Arithmetic) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	return nil, errUnableToEval.New(lval, a.Op, rval)
 }
 
+// evalDecimal evaluates an operation between two DECIMAL values exactly, rather than by way of float64, and returns
+// the result as the DECIMAL type given.
+func (a *Arithmetic) evalDecimal(ctx *sql.Context, lval, rval interface{}, typ sql.DecimalType) (interface{}, error) {
+	l, err := a.Left.Type().(sql.DecimalType).ConvertToDecimal(lval)
+	if err != nil {
+		return nil, err
+	}
+	r, err := a.Right.Type().(sql.DecimalType).ConvertToDecimal(rval)
+	if err != nil {
+		return nil, err
+	}
+
+	var res decimal.Decimal
+	switch strings.ToLower(a.Op) {
+	case sqlparser.PlusStr:
+		res = l.Decimal.Add(r.Decimal)
+	case sqlparser.MinusStr:
+		res = l.Decimal.Sub(r.Decimal)
+	case sqlparser.MultStr:
+		res = l.Decimal.Mul(r.Decimal)
+	case sqlparser.DivStr:
+		if r.Decimal.Sign() == 0 {
+			return divisionByZero(ctx)
+		}
+		res = l.Decimal.DivRound(r.Decimal, int32(typ.Scale()))
+	default:
+		return nil, errUnableToEval.New(lval, a.Op, rval)
+	}
+
+	return typ.Convert(res)
+}
+
+// evalMixedSignedness evaluates an operation between a signed and an unsigned integer. The operation is computed
+// exactly, so that a negative operand is allowed as long as the result fits in BIGINT UNSIGNED, and it is an error
+// otherwise.
+func (a *Arithmetic) evalMixedSignedness(lval, rval interface{}) (interface{}, error) {
+	l, err := sql.InternalDecimalType.ConvertToDecimal(lval)
+	if err != nil {
+		return nil, err
+	}
+	r, err := sql.InternalDecimalType.ConvertToDecimal(rval)
+	if err != nil {
+		return nil, err
+	}
+
+	var res decimal.Decimal
+	switch strings.ToLower(a.Op) {
+	case sqlparser.PlusStr:
+		res = l.Decimal.Add(r.Decimal)
+	case sqlparser.MinusStr:
+		res = l.Decimal.Sub(r.Decimal)
+	case sqlparser.MultStr:
+		res = l.Decimal.Mul(r.Decimal)
+	default:
+		return nil, errUnableToEval.New(lval, a.Op, rval)
+	}
+
+	return sql.Uint64.Convert(res)
+}
+
+// evalBitwise evaluates a bitwise operation. As in MySQL, both operands are converted to BIGINT UNSIGNED as with
+// CAST(... AS UNSIGNED), so that negative numbers are taken as their two's complement, and shifting by 64 or more bits
+// results in 0.
+func (a *Arithmetic) evalBitwise(lval, rval interface{}) (interface{}, error) {
+	l, err := convertValue(lval, ConvertToUnsigned)
+	if err != nil {
+		return nil, err
+	}
+	r, err := convertValue(rval, ConvertToUnsigned)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(a.Op) {
+	case sqlparser.BitAndStr:
+		return bitAnd(l, r)
+	case sqlparser.BitOrStr:
+		return bitOr(l, r)
+	case sqlparser.BitXorStr:
+		return bitXor(l, r)
+	case sqlparser.ShiftLeftStr:
+		return shiftLeft(l, r)
+	case sqlparser.ShiftRightStr:
+		return shiftRight(l, r)
+	}
+
+	return nil, errUnableToEval.New(lval, a.Op, rval)
+}
+
+// isBitwise returns whether the operation is a bitwise one: &, |, ^, << or >>.
+func (a *Arithmetic) isBitwise() bool {
+	switch strings.ToLower(a.Op) {
+	case sqlparser.BitAndStr, sqlparser.BitOrStr, sqlparser.BitXorStr, sqlparser.ShiftLeftStr, sqlparser.ShiftRightStr:
+		return true
+	default:
+		return false
+	}
+}
+
+// isDivision returns whether the operation divides its left operand by its right one.
+func (a *Arithmetic) isDivision() bool {
+	switch strings.ToLower(a.Op) {
+	case sqlparser.DivStr, sqlparser.IntDivStr, sqlparser.ModStr:
+		return true
+	default:
+		return false
+	}
+}
+
+// isZeroDivisor returns whether the value given is a number equal to zero.
+func isZeroDivisor(v interface{}) bool {
+	if _, ok := v.(*TimeDelta); ok {
+		return false
+	}
+	f, err := sql.Float64.Convert(v)
+	return err == nil && f == float64(0)
+}
+
+// divisionByZero returns the result of a division by zero: an error if the ERROR_FOR_DIVISION_BY_ZERO sql mode is
+// enabled, and NULL with a warning otherwise. Aggregations of a division, like AVG(x / 0), follow the same mode.
+func divisionByZero(ctx *sql.Context) (interface{}, error) {
+	if sqlModeEnabled(ctx, "ERROR_FOR_DIVISION_BY_ZERO") {
+		return nil, sql.ErrDivisionByZero.New()
+	}
+	ctx.Warn(1365, "Division by 0")
+	return nil, nil
+}
+
+// sqlModeEnabled returns whether the mode given is part of the sql_mode of the session.
+func sqlModeEnabled(ctx *sql.Context, mode string) bool {
+	val, err := ctx.GetSessionVariable(ctx, "sql_mode")
+	if err != nil {
+		return false
+	}
+	modes, ok := val.(string)
+	if !ok {
+		return false
+	}
+	for _, m := range strings.Split(modes, ",") {
+		if strings.EqualFold(strings.TrimSpace(m), mode) {
+			return true
+		}
+	}
+	return false
+}
+
 func (a *Arithmetic) evalLeftRight(ctx *sql.Context, row sql.Row) (interface{}, interface{}, error) {
 	var lval, rval interface{}
 	var err error
@@ -600,3 +828,48 @@ func (e *UnaryMinus) WithChildren(children ...sql.Expression) (sql.Expression, e
 	}
 	return NewUnaryMinus(children[0]), nil
 }
+
+// BitNot is the ~ operator, which inverts all the bits of its operand as a BIGINT UNSIGNED.
+type BitNot struct {
+	UnaryExpression
+}
+
+// NewBitNot creates a new BitNot expression node.
+func NewBitNot(child sql.Expression) *BitNot {
+	return &BitNot{UnaryExpression{Child: child}}
+}
+
+// Eval implements the sql.Expression interface.
+func (e *BitNot) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	child, err := e.Child.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	if child == nil {
+		return nil, nil
+	}
+
+	n, err := convertValue(child, ConvertToUnsigned)
+	if err != nil {
+		return nil, err
+	}
+	return ^n.(uint64), nil
+}
+
+// Type implements the sql.Expression interface.
+func (e *BitNot) Type() sql.Type {
+	return sql.Uint64
+}
+
+func (e *BitNot) String() string {
+	return fmt.Sprintf("~%s", e.Child)
+}
+
+// WithChildren implements the Expression interface.
+func (e *BitNot) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(e, len(children), 1)
+	}
+	return NewBitNot(children[0]), nil
+}
@@ -15,6 +15,7 @@
 package expression
 
 import (
+	"math"
 	"testing"
 	"time"
 
@@ -178,7 +179,7 @@ func TestDiv(t *testing.T) {
 			).Eval(sql.NewEmptyContext(), sql.NewRow())
 			require.NoError(t, err)
 			if tt.null {
-				assert.Equal(t, sql.Null, result)
+				assert.Nil(t, result)
 			} else {
 				assert.Equal(t, tt.expected, result)
 			}
@@ -205,7 +206,7 @@ func TestDiv(t *testing.T) {
 			).Eval(sql.NewEmptyContext(), sql.NewRow())
 			require.NoError(t, err)
 			if tt.null {
-				assert.Equal(t, sql.Null, result)
+				assert.Nil(t, result)
 			} else {
 				assert.Equal(t, tt.expected, result)
 			}
@@ -231,7 +232,7 @@ func TestDiv(t *testing.T) {
 			).Eval(sql.NewEmptyContext(), sql.NewRow())
 			require.NoError(t, err)
 			if tt.null {
-				assert.Equal(t, sql.Null, result)
+				assert.Nil(t, result)
 			} else {
 				assert.Equal(t, tt.expected, result)
 			}
@@ -249,6 +250,8 @@ func TestShiftLeft(t *testing.T) {
 		{"1 << 3", 1, 3, 8},
 		{"1024 << 0", 1024, 0, 1024},
 		{"0 << 1024", 0, 1024, 0},
+		{"1 << 63", 1, 63, 1 << 63},
+		{"1 << 64", 1, 64, 0},
 	}
 
 	for _, tt := range testCases {
@@ -275,6 +278,7 @@ func TestShiftRight(t *testing.T) {
 		{"3 >> 1", 3, 1, 1},
 		{"1024 >> 0", 1024, 0, 1024},
 		{"0 >> 1024", 0, 1024, 0},
+		{"1 >> 64", 1, 64, 0},
 	}
 
 	for _, tt := range testCases {
@@ -294,13 +298,14 @@ func TestBitAnd(t *testing.T) {
 	var testCases = []struct {
 		name        string
 		left, right int64
-		expected    int64
+		expected    uint64
 	}{
 		{"1 & 1", 1, 1, 1},
 		{"8 & 1", 8, 1, 0},
 		{"3 & 1", 3, 1, 1},
 		{"1024 & 0", 1024, 0, 0},
 		{"0 & 1024", 0, 1024, 0},
+		{"-1 & 255", -1, 255, 255},
 	}
 
 	for _, tt := range testCases {
@@ -320,13 +325,14 @@ func TestBitOr(t *testing.T) {
 	var testCases = []struct {
 		name        string
 		left, right int64
-		expected    int64
+		expected    uint64
 	}{
 		{"1 | 1", 1, 1, 1},
 		{"8 | 1", 8, 1, 9},
 		{"3 | 1", 3, 1, 3},
 		{"1024 | 0", 1024, 0, 1024},
 		{"0 | 1024", 0, 1024, 1024},
+		{"-1 | 0", -1, 0, 18446744073709551615},
 	}
 
 	for _, tt := range testCases {
@@ -346,13 +352,13 @@ func TestBitXor(t *testing.T) {
 	var testCases = []struct {
 		name        string
 		left, right int64
-		expected    int64
+		expected    uint64
 	}{
 		{"1 ^ 1", 1, 1, 0},
 		{"8 ^ 1", 8, 1, 9},
 		{"3 ^ 1", 3, 1, 2},
 		{"1024 ^ 0", 1024, 0, 1024},
-		{"0 ^ -1024", 0, -1024, -1024},
+		{"0 ^ -1024", 0, -1024, 18446744073709550592},
 	}
 
 	for _, tt := range testCases {
@@ -368,6 +374,52 @@ func TestBitXor(t *testing.T) {
 	}
 }
 
+func TestBitwiseSignedOperands(t *testing.T) {
+	var testCases = []struct {
+		name        string
+		op          string
+		left, right int64
+		expected    uint64
+	}{
+		{"1 << 2", "<<", 1, 2, 4},
+		{"-1 >> 60", ">>", -1, 60, 15},
+		{"1 << -1", "<<", 1, -1, 0},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			e := NewArithmetic(NewLiteral(tt.left, sql.Int64), NewLiteral(tt.right, sql.Int64), tt.op)
+			require.Equal(sql.Uint64, e.Type())
+			result, err := e.Eval(sql.NewEmptyContext(), sql.NewRow())
+			require.NoError(err)
+			require.Equal(tt.expected, result)
+		})
+	}
+}
+
+func TestBitNot(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    interface{}
+		typ      sql.Type
+		expected interface{}
+	}{
+		{"zero", int64(0), sql.Int64, uint64(18446744073709551615)},
+		{"negative", int64(-1), sql.Int64, uint64(0)},
+		{"unsigned", uint64(5), sql.Uint64, uint64(18446744073709551610)},
+		{"nil", nil, sql.Int64, nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewBitNot(NewLiteral(tt.input, tt.typ)).Eval(sql.NewEmptyContext(), nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
+
 func TestIntDiv(t *testing.T) {
 	var testCases = []struct {
 		name        string
@@ -392,7 +444,7 @@ func TestIntDiv(t *testing.T) {
 			).Eval(sql.NewEmptyContext(), sql.NewRow())
 			require.NoError(err)
 			if tt.null {
-				assert.Equal(t, sql.Null, result)
+				assert.Nil(t, result)
 			} else {
 				assert.Equal(t, tt.expected, result)
 			}
@@ -400,6 +452,32 @@ func TestIntDiv(t *testing.T) {
 	}
 }
 
+func TestDivisionByZero(t *testing.T) {
+	ops := []*Arithmetic{
+		NewDiv(NewLiteral(int64(1), sql.Int64), NewLiteral(int64(0), sql.Int64)),
+		NewDiv(NewLiteral(1.5, sql.Float64), NewLiteral(0.0, sql.Float64)),
+		NewIntDiv(NewLiteral(int64(1), sql.Int64), NewLiteral(int64(0), sql.Int64)),
+		NewMod(NewLiteral(int64(1), sql.Int64), NewLiteral(int64(0), sql.Int64)),
+	}
+
+	for _, op := range ops {
+		t.Run(op.String(), func(t *testing.T) {
+			require := require.New(t)
+
+			ctx := sql.NewEmptyContext()
+			result, err := op.Eval(ctx, sql.NewRow())
+			require.NoError(err)
+			require.Nil(result)
+			require.Equal(uint16(1), ctx.WarningCount())
+
+			ctx = sql.NewEmptyContext()
+			require.NoError(ctx.SetSessionVariable(ctx, "sql_mode", "STRICT_TRANS_TABLES,ERROR_FOR_DIVISION_BY_ZERO"))
+			_, err = op.Eval(ctx, sql.NewRow())
+			require.True(sql.ErrDivisionByZero.Is(err))
+		})
+	}
+}
+
 func TestMod(t *testing.T) {
 	var testCases = []struct {
 		name        string
@@ -425,6 +503,78 @@ func TestMod(t *testing.T) {
 	}
 }
 
+func TestDecimalArithmetic(t *testing.T) {
+	dec := func(v string, precision, scale uint8) sql.Expression {
+		return NewLiteral(v, sql.MustCreateDecimalType(precision, scale))
+	}
+
+	var testCases = []struct {
+		name         string
+		expr         sql.Expression
+		expectedType sql.Type
+		expected     interface{}
+	}{
+		{"0.1 + 0.2", NewPlus(dec("0.1", 2, 1), dec("0.2", 2, 1)), sql.MustCreateDecimalType(3, 1), "0.3"},
+		{"1.25 - 0.5", NewMinus(dec("1.25", 4, 2), dec("0.5", 2, 1)), sql.MustCreateDecimalType(5, 2), "0.75"},
+		{"1.5 * 0.25", NewMult(dec("1.5", 2, 1), dec("0.25", 3, 2)), sql.MustCreateDecimalType(5, 3), "0.375"},
+		{
+			"multiplication beyond float64 precision",
+			NewMult(dec("12345678901234567890", 20, 0), dec("98765432109876543210", 20, 0)),
+			sql.MustCreateDecimalType(40, 0),
+			"1219326311370217952237463801111263526900",
+		},
+		{"1.00 / 3", NewDiv(dec("1.00", 3, 2), dec("3", 1, 0)), sql.MustCreateDecimalType(7, 6), "0.333333"},
+		{"2 / 0.5", NewDiv(dec("2", 1, 0), dec("0.5", 2, 1)), sql.MustCreateDecimalType(6, 4), "4.0000"},
+		{"1.0 / 0", NewDiv(dec("1.0", 2, 1), dec("0", 1, 0)), sql.MustCreateDecimalType(6, 5), nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tt.expectedType, tt.expr.Type())
+			result, err := tt.expr.Eval(sql.NewEmptyContext(), sql.NewRow())
+			require.NoError(err)
+			require.Equal(tt.expected, result)
+		})
+	}
+}
+
+func TestUnsignedArithmetic(t *testing.T) {
+	maxUint := NewLiteral(uint64(math.MaxUint64), sql.Uint64)
+	ucol := NewGetField(0, sql.Uint64, "u", false)
+	icol := NewGetField(1, sql.Int64, "i", false)
+	row := sql.NewRow(uint64(math.MaxUint64), int64(-1))
+
+	var testCases = []struct {
+		name         string
+		expr         sql.Expression
+		expectedType sql.Type
+		expected     interface{}
+		err          bool
+	}{
+		{"max unsigned + -1", NewPlus(maxUint, NewLiteral(int64(-1), sql.Int64)), sql.Uint64, uint64(math.MaxUint64 - 1), false},
+		{"unsigned column + signed column", NewPlus(ucol, icol), sql.Uint64, uint64(math.MaxUint64 - 1), false},
+		{"signed column + unsigned literal", NewPlus(icol, NewLiteral(uint64(math.MaxInt64+1), sql.Uint64)), sql.Uint64, uint64(math.MaxInt64), false},
+		{"unsigned column * small literal", NewMult(NewGetField(0, sql.Uint32, "u", false), NewLiteral(int8(2), sql.Int8)), sql.Uint64, nil, true},
+		{"negative unsigned result", NewMinus(NewLiteral(uint32(1), sql.Uint32), NewLiteral(int64(2), sql.Int64)), sql.Uint64, nil, true},
+		{"signed column + small unsigned literal", NewPlus(icol, NewLiteral(uint8(200), sql.Uint8)), sql.Int64, int64(199), false},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tt.expectedType, tt.expr.Type())
+			result, err := tt.expr.Eval(sql.NewEmptyContext(), row)
+			if tt.err {
+				require.Error(err)
+			} else {
+				require.NoError(err)
+				require.Equal(tt.expected, result)
+			}
+		})
+	}
+}
+
 func TestAllFloat64(t *testing.T) {
 	var testCases = []struct {
 		op       string
@@ -457,13 +607,13 @@ func TestAllInt64(t *testing.T) {
 	var testCases = []struct {
 		op       string
 		value    int64
-		expected int64
+		expected interface{}
 	}{
-		{"|", 1, 1},
-		{"&", 3, 1},
-		{"^", 1024, 1025},
-		{"%", 1024, 1},
-		{"div", 1024, 0},
+		{"|", 1, uint64(1)},
+		{"&", 3, uint64(1)},
+		{"^", 1024, uint64(1025)},
+		{"%", 1024, int64(1)},
+		{"div", 1024, int64(0)},
 	}
 
 	// (((((0 | 1) & 3) ^ 1024) % 1024) div 1024) == 0
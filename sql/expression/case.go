@@ -68,7 +68,7 @@ func combinedCaseBranchType(left, right sql.Type) sql.Type {
 			return sql.Float32
 		}
 		if sql.IsDecimal(left) || sql.IsDecimal(right) {
-			return sql.MustCreateDecimalType(65, 10)
+			return combinedDecimalType(left, right)
 		}
 		if left == sql.Uint64 && sql.IsSigned(right) ||
 			right == sql.Uint64 && sql.IsSigned(left) {
@@ -83,16 +83,73 @@ func combinedCaseBranchType(left, right sql.Type) sql.Type {
 	return sql.LongText
 }
 
+// CombinedType returns the type of an expression whose value is that of any of the expressions of the types given,
+// as a CASE expression or COALESCE are, aggregated the way a CASE expression's branch types are. It's NULL if there
+// are no types, or if all of them are NULL.
+func CombinedType(types ...sql.Type) sql.Type {
+	curr := sql.Null
+	for _, t := range types {
+		curr = combinedCaseBranchType(curr, t)
+	}
+	return curr
+}
+
+// combinedDecimalType returns a DECIMAL type able to hold the values of both the types given, each of which is a
+// DECIMAL or an integer type, without losing digits. It has the largest number of integer digits and the largest scale
+// of both, so that e.g. DECIMAL(5,1) and DECIMAL(4,3) result in DECIMAL(7,3), and DECIMAL(5,1) and INT in
+// DECIMAL(11,1).
+func combinedDecimalType(left, right sql.Type) sql.Type {
+	var intDigits, scale uint8
+	for _, t := range []sql.Type{left, right} {
+		if dt, ok := t.(sql.DecimalType); ok {
+			if d := dt.Precision() - dt.Scale(); d > intDigits {
+				intDigits = d
+			}
+			if dt.Scale() > scale {
+				scale = dt.Scale()
+			}
+		} else if d := integerDigits(t); d > intDigits {
+			intDigits = d
+		}
+	}
+
+	precision := intDigits + scale
+	if precision > sql.DecimalTypeMaxPrecision {
+		precision = sql.DecimalTypeMaxPrecision
+	}
+	return sql.MustCreateDecimalType(precision, scale)
+}
+
+// integerDigits returns the number of digits of the largest value of the integer type given.
+func integerDigits(t sql.Type) uint8 {
+	switch t {
+	case sql.Int8, sql.Uint8:
+		return 3
+	case sql.Int16, sql.Uint16:
+		return 5
+	case sql.Int24:
+		return 7
+	case sql.Uint24:
+		return 8
+	case sql.Int32, sql.Uint32:
+		return 10
+	case sql.Int64:
+		return 19
+	default:
+		return 20
+	}
+}
+
 // Type implements the sql.Expression interface.
 func (c *Case) Type() sql.Type {
-	curr := sql.Null
+	var types []sql.Type
 	for _, b := range c.Branches {
-		curr = combinedCaseBranchType(curr, b.Value.Type())
+		types = append(types, b.Value.Type())
 	}
 	if c.Else != nil {
-		curr = combinedCaseBranchType(curr, c.Else.Type())
+		types = append(types, c.Else.Type())
 	}
-	return curr
+	return CombinedType(types...)
 }
 
 // IsNullable implements the sql.Expression interface.
@@ -159,7 +216,11 @@ func (c *Case) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 
 	for _, b := range c.Branches {
 		var cond sql.Expression
-		if expr != nil {
+		if c.Expr != nil {
+			if expr == nil {
+				// NULL isn't equal to anything, so only the else branch may apply
+				break
+			}
 			cond = NewEquals(NewLiteral(expr, c.Expr.Type()), b.Cond)
 		} else {
 			cond = b.Cond
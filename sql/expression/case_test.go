@@ -132,6 +132,18 @@ func TestCase(t *testing.T) {
 			sql.Row{int64(9)},
 			nil,
 		},
+		{
+			"with NULL expr, else branch",
+			f1,
+			sql.Row{nil},
+			int64(7),
+		},
+		{
+			"with NULL expr and without else",
+			f3,
+			sql.Row{nil},
+			nil,
+		},
 	}
 
 	for _, tt := range testCases {
@@ -232,6 +244,16 @@ func TestCaseType(t *testing.T) {
 			caseExpr(NewLiteral(int32(10), sql.Int32), NewLiteral(decimal.NewFromInt(1), decimalType)),
 			decimalType,
 		},
+		{
+			"decimal and decimal to wider decimal",
+			caseExpr(NewLiteral("12.5", sql.MustCreateDecimalType(5, 1)), NewLiteral("1.250", sql.MustCreateDecimalType(4, 3))),
+			sql.MustCreateDecimalType(7, 3),
+		},
+		{
+			"int8 and decimal to decimal with the int's digits",
+			caseExpr(NewLiteral(int8(1), sql.Int8), NewLiteral("1.25", sql.MustCreateDecimalType(3, 2))),
+			sql.MustCreateDecimalType(5, 2),
+		},
 		{
 			"date and date stays date",
 			caseExpr(NewLiteral("2020-04-07", sql.Date), NewLiteral("2020-04-07", sql.Date)),
@@ -267,3 +289,28 @@ func TestCaseNullBranch(t *testing.T) {
 	require.NoError(err)
 	require.Nil(result)
 }
+
+func TestCaseBranchesConvertedToCommonType(t *testing.T) {
+	testCases := []struct {
+		name     string
+		cond     bool
+		ifTrue   sql.Expression
+		ifFalse  sql.Expression
+		expected interface{}
+	}{
+		{"int branch of int and float", true, NewLiteral(int64(1), sql.Int64), NewLiteral(2.5, sql.Float64), float64(1)},
+		{"float branch of int and float", false, NewLiteral(int64(1), sql.Int64), NewLiteral(2.5, sql.Float64), 2.5},
+		{"int branch of int and decimal", true, NewLiteral(int8(1), sql.Int8), NewLiteral("2.50", sql.MustCreateDecimalType(3, 2)), "1.00"},
+		{"decimal branch of int and decimal", false, NewLiteral(int8(1), sql.Int8), NewLiteral("2.50", sql.MustCreateDecimalType(3, 2)), "2.50"},
+		{"int branch of int and text", true, NewLiteral(int64(1), sql.Int64), NewLiteral("a", sql.LongText), "1"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewCase(nil, []CaseBranch{{Cond: NewLiteral(tt.cond, sql.Boolean), Value: tt.ifTrue}}, tt.ifFalse)
+			result, err := f.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
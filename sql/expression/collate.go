@@ -0,0 +1,70 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// Collate is the COLLATE operator, as in `name = 'x' COLLATE utf8mb4_bin`. It gives a string the collation named, which
+// takes precedence over the collations of the other operands when comparing it with them.
+//
+// cc: https://dev.mysql.com/doc/refman/8.0/en/charset-collate.html
+type Collate struct {
+	UnaryExpression
+	Collation sql.Collation
+}
+
+var _ sql.Expression = (*Collate)(nil)
+
+// NewCollate returns a new Collate expression giving |e| the collation given.
+func NewCollate(e sql.Expression, collation sql.Collation) *Collate {
+	return &Collate{UnaryExpression: UnaryExpression{Child: e}, Collation: collation}
+}
+
+func (c *Collate) String() string {
+	return fmt.Sprintf("%s COLLATE %s", c.Child, c.Collation.Name)
+}
+
+// Type implements the sql.Expression interface.
+func (c *Collate) Type() sql.Type {
+	return sql.CreateLongText(c.Collation)
+}
+
+// Eval implements the sql.Expression interface.
+func (c *Collate) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	if st, ok := c.Child.Type().(sql.StringType); ok {
+		if cs := st.Collation().CharacterSet(); !c.Collation.WorksWithCharacterSet(cs) {
+			return nil, sql.ErrCollationInvalidForCharSet.New(c.Collation.Name, cs.String())
+		}
+	}
+
+	val, err := c.Child.Eval(ctx, row)
+	if err != nil || val == nil {
+		return nil, err
+	}
+
+	return c.Type().Convert(val)
+}
+
+// WithChildren implements the sql.Expression interface.
+func (c *Collate) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 1)
+	}
+	return NewCollate(children[0], c.Collation), nil
+}
@@ -0,0 +1,79 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"testing"
+
+	"github.com/dolthub/vitess/go/vt/proto/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestCollate(t *testing.T) {
+	ci := sql.MustCreateString(query.Type_VARCHAR, 10, sql.Collation_utf8mb4_general_ci)
+	column := NewGetField(0, ci, "name", true)
+
+	testCases := []struct {
+		name     string
+		left     sql.Expression
+		right    sql.Expression
+		expected interface{}
+	}{
+		{
+			name:     "column collation",
+			left:     column,
+			right:    NewLiteral("abc", sql.LongText),
+			expected: true,
+		},
+		{
+			name:     "column collation on the right",
+			left:     NewLiteral("abc", sql.LongText),
+			right:    column,
+			expected: true,
+		},
+		{
+			name:     "COLLATE takes precedence over the column collation",
+			left:     column,
+			right:    NewCollate(NewLiteral("abc", sql.LongText), sql.Collation_utf8mb4_bin),
+			expected: false,
+		},
+		{
+			name:     "COLLATE on the column",
+			left:     NewCollate(column, sql.Collation_utf8mb4_bin),
+			right:    NewLiteral("ABC", sql.LongText),
+			expected: true,
+		},
+		{
+			name:     "COLLATE on a literal",
+			left:     NewCollate(NewLiteral("abc", sql.LongText), sql.Collation_utf8mb4_unicode_ci),
+			right:    NewLiteral("ABC", sql.LongText),
+			expected: true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, eval(t, NewEquals(tt.left, tt.right), sql.NewRow("ABC")))
+		})
+	}
+
+	t.Run("collation of another character set", func(t *testing.T) {
+		e := NewCollate(NewLiteral("abc", sql.LongText), sql.Collation_latin1_bin)
+		_, err := e.Eval(sql.NewEmptyContext(), nil)
+		require.True(t, sql.ErrCollationInvalidForCharSet.Is(err))
+	})
+}
@@ -16,6 +16,9 @@ package expression
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 	"sync"
 
 	errors "gopkg.in/src-d/go-errors.v1"
@@ -85,7 +88,7 @@ func (c *comparison) Compare(ctx *sql.Context, row sql.Row) (int, error) {
 		}
 	}
 	if compareType == nil {
-		left, right, compareType, err = c.castLeftAndRight(left, right)
+		left, right, compareType, err = c.castLeftAndRight(ctx, left, right)
 		if err != nil {
 			return 0, err
 		}
@@ -138,7 +141,7 @@ func (c *comparison) NullSafeCompare(ctx *sql.Context, row sql.Row) (int, error)
 		}
 	}
 	if compareType == nil {
-		left, right, compareType, err = c.castLeftAndRight(left, right)
+		left, right, compareType, err = c.castLeftAndRight(ctx, left, right)
 		if err != nil {
 			return 0, err
 		}
@@ -161,26 +164,42 @@ func (c *comparison) evalLeftAndRight(ctx *sql.Context, row sql.Row) (interface{
 	return left, right, nil
 }
 
-func (c *comparison) castLeftAndRight(left, right interface{}) (interface{}, interface{}, sql.Type, error) {
+// isMixedSignedness returns whether one of the types given is a signed integer and the other an unsigned integer.
+func isMixedSignedness(left, right sql.Type) bool {
+	return (sql.IsSigned(left) && sql.IsUnsigned(right)) || (sql.IsUnsigned(left) && sql.IsSigned(right))
+}
+
+func (c *comparison) castLeftAndRight(ctx *sql.Context, left, right interface{}) (interface{}, interface{}, sql.Type, error) {
 	leftType := c.Left().Type()
 	rightType := c.Right().Type()
 	if sql.IsTuple(leftType) && sql.IsTuple(rightType) {
 		return left, right, c.Left().Type(), nil
 	}
 
+	// A string compared with a number is converted to a number, and both are compared as doubles
+	if (sql.IsNumber(leftType) && sql.IsText(rightType)) || (sql.IsText(leftType) && sql.IsNumber(rightType)) {
+		l, err := numericContextValue(ctx, left)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		r, err := numericContextValue(ctx, right)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		return l, r, sql.Float64, nil
+	}
+
 	if sql.IsNumber(leftType) || sql.IsNumber(rightType) {
 		if sql.IsDecimal(leftType) || sql.IsDecimal(rightType) {
-			//TODO: We need to set to the actual DECIMAL type
 			l, r, err := convertLeftAndRight(left, right, ConvertToDecimal)
 			if err != nil {
 				return nil, nil, nil, err
 			}
 
-			if sql.IsDecimal(leftType) {
-				return l, r, leftType, nil
-			} else {
-				return l, r, rightType, nil
-			}
+			// Comparing with the type of either side would round the other side to its scale first
+			return l, r, sql.InternalDecimalType, nil
 		}
 
 		if sql.IsFloat(leftType) || sql.IsFloat(rightType) {
@@ -192,6 +211,16 @@ func (c *comparison) castLeftAndRight(left, right interface{}) (interface{}, int
 			return l, r, sql.Float64, nil
 		}
 
+		// Neither BIGINT nor BIGINT UNSIGNED can hold every value of the other, so mixed comparisons are made as decimals
+		if isMixedSignedness(leftType, rightType) {
+			l, r, err := convertLeftAndRight(left, right, ConvertToDecimal)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+
+			return l, r, sql.InternalDecimalType, nil
+		}
+
 		if sql.IsSigned(leftType) || sql.IsSigned(rightType) {
 			l, r, err := convertLeftAndRight(left, right, ConvertToSigned)
 			if err != nil {
@@ -223,7 +252,67 @@ func (c *comparison) castLeftAndRight(left, right interface{}) (interface{}, int
 		return nil, nil, nil, err
 	}
 
-	return left, right, sql.LongText, nil
+	return left, right, sql.CreateLongText(comparisonCollation(c.Left(), c.Right())), nil
+}
+
+// comparisonCollation returns the collation two strings are compared with: that of the operand with the lowest
+// coercibility, or of the left one if they have the same. A collation given with COLLATE takes precedence over that of
+// a column, which takes precedence over those of literals and other expressions.
+//
+// cc: https://dev.mysql.com/doc/refman/8.0/en/charset-collation-coercibility.html
+func comparisonCollation(left, right sql.Expression) sql.Collation {
+	leftCollation, leftCoercibility := collationCoercibility(left)
+	rightCollation, rightCoercibility := collationCoercibility(right)
+	if rightCoercibility < leftCoercibility {
+		return rightCollation
+	}
+	return leftCollation
+}
+
+// collationCoercibility returns the collation of an expression and its coercibility, lower for the collations that
+// take precedence in comparisons.
+func collationCoercibility(e sql.Expression) (sql.Collation, int) {
+	if c, ok := e.(*Collate); ok {
+		return c.Collation, 0
+	}
+
+	st, ok := e.Type().(sql.StringType)
+	if !ok {
+		return sql.Collation_Default, 5
+	}
+	switch e.(type) {
+	case *GetField, *UserVar, *SystemVar, *ProcedureParam:
+		return st.Collation(), 2
+	default:
+		return st.Collation(), 4
+	}
+}
+
+// numericPrefixRegex matches the longest prefix of a string that MySQL reads as a number in numeric context.
+var numericPrefixRegex = regexp.MustCompile(`^[+-]?([0-9]+(\.[0-9]*)?|\.[0-9]+)([eE][+-]?[0-9]+)?`)
+
+// numericContextValue returns the value given as a double. A string is read like MySQL does in numeric context: its
+// value is the one of its longest numeric prefix after leading spaces, or 0 if it has none, and a warning is added if
+// any of its characters were ignored.
+func numericContextValue(ctx *sql.Context, v interface{}) (interface{}, error) {
+	s, ok := v.(string)
+	if !ok {
+		return sql.Float64.Convert(v)
+	}
+
+	trimmed := strings.TrimLeft(s, " \t\n\r")
+	prefix := numericPrefixRegex.FindString(trimmed)
+	if prefix != strings.TrimRight(trimmed, " \t\n\r") && ctx != nil {
+		ctx.Warn(1292, "Truncated incorrect DOUBLE value: '%s'", s)
+	}
+	if prefix == "" {
+		return float64(0), nil
+	}
+
+	// The prefix is always a valid number, so the only possible error is a value out of range, which is parsed as an
+	// infinity
+	f, _ := strconv.ParseFloat(prefix, 64)
+	return f, nil
 }
 
 func convertLeftAndRight(left, right interface{}, convertTo string) (interface{}, interface{}, error) {
@@ -327,7 +416,7 @@ func (e *NullSafeEquals) Compare(ctx *sql.Context, row sql.Row) (int, error) {
 	}
 
 	var compareType sql.Type
-	left, right, compareType, err = e.castLeftAndRight(left, right)
+	left, right, compareType, err = e.castLeftAndRight(ctx, left, right)
 	if err != nil {
 		return 0, err
 	}
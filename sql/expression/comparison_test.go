@@ -15,6 +15,7 @@
 package expression_test
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -133,6 +134,75 @@ func TestEquals(t *testing.T) {
 	}
 }
 
+func TestDecimalComparisonIsExact(t *testing.T) {
+	require := require.New(t)
+	left := expression.NewLiteral("1.0", sql.MustCreateDecimalType(3, 1))
+	right := expression.NewLiteral("1.04", sql.MustCreateDecimalType(4, 2))
+
+	require.Equal(false, eval(t, expression.NewEquals(left, right), nil))
+	require.Equal(true, eval(t, expression.NewLessThan(left, right), nil))
+	require.Equal(false, eval(t, expression.NewEquals(left, expression.NewLiteral(1.04, sql.Float64)), nil))
+}
+
+func TestMixedSignednessComparison(t *testing.T) {
+	require := require.New(t)
+	ucol := expression.NewGetField(0, sql.Uint64, "u", false)
+	row := sql.NewRow(uint64(math.MaxUint64))
+
+	require.Equal(true, eval(t, expression.NewGreaterThan(ucol, expression.NewLiteral(int8(5), sql.Int8)), row))
+	require.Equal(true, eval(t, expression.NewGreaterThan(ucol, expression.NewLiteral(uint64(9223372036854775808), sql.Uint64)), row))
+	require.Equal(true, eval(t, expression.NewLessThan(expression.NewLiteral(int64(-1), sql.Int64), expression.NewLiteral(uint64(0), sql.Uint64)), nil))
+	require.Equal(false, eval(t, expression.NewEquals(ucol, expression.NewLiteral(int64(-1), sql.Int64)), row))
+}
+
+func TestStringNumberComparison(t *testing.T) {
+	testCases := []struct {
+		name     string
+		cmp      sql.Expression
+		expected interface{}
+		warnings uint16
+	}{
+		{
+			name:     "numeric prefix",
+			cmp:      expression.NewEquals(expression.NewLiteral("5abc", sql.LongText), expression.NewLiteral(int8(5), sql.Int8)),
+			expected: true,
+			warnings: 1,
+		},
+		{
+			name:     "no numeric prefix",
+			cmp:      expression.NewEquals(expression.NewLiteral("abc", sql.LongText), expression.NewLiteral(int8(0), sql.Int8)),
+			expected: true,
+			warnings: 1,
+		},
+		{
+			name:     "number with spaces",
+			cmp:      expression.NewEquals(expression.NewLiteral(int64(12), sql.Int64), expression.NewLiteral(" 12 ", sql.LongText)),
+			expected: true,
+		},
+		{
+			name:     "decimal string and integer",
+			cmp:      expression.NewEquals(expression.NewLiteral("1.5", sql.LongText), expression.NewLiteral(int8(1), sql.Int8)),
+			expected: false,
+		},
+		{
+			name:     "exponent",
+			cmp:      expression.NewGreaterThan(expression.NewLiteral("1e3x", sql.LongText), expression.NewLiteral(int16(999), sql.Int16)),
+			expected: true,
+			warnings: 1,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := sql.NewEmptyContext()
+			res, err := tt.cmp.Eval(ctx, nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, res)
+			require.Equal(t, tt.warnings, ctx.WarningCount())
+		})
+	}
+}
+
 func TestNullSafeEquals(t *testing.T) {
 	require := require.New(t)
 	for resultType, cmpCase := range comparisonCases {
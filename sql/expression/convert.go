@@ -18,7 +18,10 @@ import (
 	"fmt"
 	"strings"
 	"time"
+	"unicode/utf8"
 
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/shopspring/decimal"
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -42,6 +45,8 @@ const (
 	ConvertToDecimal = "decimal"
 	// ConvertToDouble is a conversion to double.
 	ConvertToDouble = "double"
+	// ConvertToFloat is a conversion to float.
+	ConvertToFloat = "float"
 	// ConvertToJSON is a conversion to json.
 	ConvertToJSON = "json"
 	// ConvertToReal is a conversion to double.
@@ -59,13 +64,26 @@ type Convert struct {
 	UnaryExpression
 	// Type to cast
 	castToType string
+	// typeLength is the length of a CHAR(N) or BINARY(N) type, or the precision of a DECIMAL(M,D) type. It's 0 if the
+	// type has none.
+	typeLength int
+	// typeScale is the scale of a DECIMAL(M,D) type
+	typeScale int
 }
 
 // NewConvert creates a new Convert expression.
 func NewConvert(expr sql.Expression, castToType string) *Convert {
+	return NewConvertWithLengthAndScale(expr, castToType, 0, 0)
+}
+
+// NewConvertWithLengthAndScale creates a new Convert expression to a type with a length, like CHAR(N), or a precision
+// and scale, like DECIMAL(M,D). A length of 0 is the same as none.
+func NewConvertWithLengthAndScale(expr sql.Expression, castToType string, typeLength, typeScale int) *Convert {
 	return &Convert{
 		UnaryExpression: UnaryExpression{Child: expr},
 		castToType:      strings.ToLower(castToType),
+		typeLength:      typeLength,
+		typeScale:       typeScale,
 	}
 }
 
@@ -83,18 +101,34 @@ func (c *Convert) IsNullable() bool {
 func (c *Convert) Type() sql.Type {
 	switch c.castToType {
 	case ConvertToBinary:
+		if c.typeLength > 0 {
+			// Lengths too long for a VARBINARY are allowed, and give a LONGBLOB like no length
+			if t, err := sql.CreateBinary(sqltypes.VarBinary, int64(c.typeLength)); err == nil {
+				return t
+			}
+		}
 		return sql.LongBlob
 	case ConvertToChar, ConvertToNChar:
+		if c.typeLength > 0 {
+			if t, err := sql.CreateStringWithDefaults(sqltypes.VarChar, int64(c.typeLength)); err == nil {
+				return t
+			}
+		}
 		return sql.LongText
 	case ConvertToDate:
 		return sql.Date
 	case ConvertToDatetime:
 		return sql.Datetime
 	case ConvertToDecimal:
-		//TODO: these values are completely arbitrary, we need to get the given precision/scale and store it
+		if c.typeLength > 0 {
+			return sql.MustCreateDecimalType(uint8(c.typeLength), uint8(c.typeScale))
+		}
+		//TODO: these values are completely arbitrary, MySQL's default is DECIMAL(10,0)
 		return sql.MustCreateDecimalType(65, 10)
 	case ConvertToDouble, ConvertToReal:
 		return sql.Float64
+	case ConvertToFloat:
+		return sql.Float32
 	case ConvertToJSON:
 		return sql.JSON
 	case ConvertToSigned:
@@ -110,7 +144,19 @@ func (c *Convert) Type() sql.Type {
 
 // Name implements the Expression interface.
 func (c *Convert) String() string {
-	return fmt.Sprintf("convert(%v, %v)", c.Child, c.castToType)
+	return fmt.Sprintf("convert(%v, %v)", c.Child, c.typeString())
+}
+
+// typeString returns the type converted to as it's written in SQL, with its length or precision and scale.
+func (c *Convert) typeString() string {
+	switch {
+	case c.typeLength == 0:
+		return c.castToType
+	case c.castToType == ConvertToDecimal:
+		return fmt.Sprintf("%s(%d,%d)", c.castToType, c.typeLength, c.typeScale)
+	default:
+		return fmt.Sprintf("%s(%d)", c.castToType, c.typeLength)
+	}
 }
 
 // WithChildren implements the Expression interface.
@@ -118,7 +164,7 @@ func (c *Convert) WithChildren(children ...sql.Expression) (sql.Expression, erro
 	if len(children) != 1 {
 		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 1)
 	}
-	return NewConvert(children[0], c.castToType), nil
+	return NewConvertWithLengthAndScale(children[0], c.castToType, c.typeLength, c.typeScale), nil
 }
 
 // Eval implements the Expression interface.
@@ -137,9 +183,54 @@ func (c *Convert) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, ErrConvertExpression.Wrap(err, c.String(), c.castToType)
 	}
 
+	if c.typeLength > 0 && casted != nil {
+		return c.applyLength(casted)
+	}
 	return casted, nil
 }
 
+// applyLength fits a value converted to a type with a length to it. As in MySQL, CHAR(N) truncates strings to N
+// characters, BINARY(N) truncates or pads with 0x00 bytes to N bytes, and DECIMAL(M,D) rounds to D decimal places and
+// clips values out of its range to its largest or smallest value.
+func (c *Convert) applyLength(val interface{}) (interface{}, error) {
+	switch c.castToType {
+	case ConvertToChar, ConvertToNChar:
+		s, ok := val.(string)
+		if !ok {
+			return val, nil
+		}
+		if utf8.RuneCountInString(s) > c.typeLength {
+			s = string([]rune(s)[:c.typeLength])
+		}
+		return s, nil
+	case ConvertToBinary:
+		s, ok := val.(string)
+		if !ok {
+			return val, nil
+		}
+		if len(s) > c.typeLength {
+			return s[:c.typeLength], nil
+		}
+		return s + strings.Repeat("\x00", c.typeLength-len(s)), nil
+	case ConvertToDecimal:
+		dt := c.Type().(sql.DecimalType)
+		d, err := dt.Convert(val)
+		if sql.ErrConvertToDecimalLimit.Is(err) {
+			limit := decimal.New(1, int32(c.typeLength-c.typeScale)).Sub(decimal.New(1, -int32(c.typeScale)))
+			if s, ok := val.(string); ok && strings.HasPrefix(s, "-") {
+				limit = limit.Neg()
+			}
+			return limit.StringFixed(int32(c.typeScale)), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return d, nil
+	default:
+		return val, nil
+	}
+}
+
 // convertValue only returns an error if converting to JSON, and returns the zero value for float types.
 // Nil is returned in all other cases.
 func convertValue(val interface{}, castTo string) (interface{}, error) {
@@ -190,6 +281,12 @@ func convertValue(val interface{}, castTo string) (interface{}, error) {
 			return sql.Float64.Zero(), nil
 		}
 		return d, nil
+	case ConvertToFloat:
+		d, err := sql.Float32.Convert(val)
+		if err != nil {
+			return sql.Float32.Zero(), nil
+		}
+		return d, nil
 	case ConvertToJSON:
 		js, err := sql.JSON.Convert(val)
 		if err != nil {
@@ -18,6 +18,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -185,3 +186,103 @@ func TestConvert(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertWithLengthAndScale(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression sql.Expression
+		castTo     string
+		length     int
+		scale      int
+		expected   interface{}
+		typ        sql.Type
+	}{
+		{
+			name:       "decimal rounds half away from zero",
+			expression: NewLiteral(1.255, sql.Float64),
+			castTo:     ConvertToDecimal,
+			length:     5,
+			scale:      2,
+			expected:   "1.26",
+			typ:        sql.MustCreateDecimalType(5, 2),
+		},
+		{
+			name:       "decimal rounds negative numbers",
+			expression: NewLiteral("-2.5", sql.LongText),
+			castTo:     ConvertToDecimal,
+			length:     10,
+			scale:      0,
+			expected:   "-3",
+			typ:        sql.MustCreateDecimalType(10, 0),
+		},
+		{
+			name:       "decimal out of range is clipped",
+			expression: NewLiteral(int64(12345), sql.Int64),
+			castTo:     ConvertToDecimal,
+			length:     4,
+			scale:      1,
+			expected:   "999.9",
+			typ:        sql.MustCreateDecimalType(4, 1),
+		},
+		{
+			name:       "negative decimal out of range is clipped",
+			expression: NewLiteral(int64(-12345), sql.Int64),
+			castTo:     ConvertToDecimal,
+			length:     4,
+			scale:      1,
+			expected:   "-999.9",
+			typ:        sql.MustCreateDecimalType(4, 1),
+		},
+		{
+			name:       "char truncates to its length in characters",
+			expression: NewLiteral("héllo wörld", sql.LongText),
+			castTo:     ConvertToChar,
+			length:     5,
+			expected:   "héllo",
+			typ:        sql.MustCreateStringWithDefaults(sqltypes.VarChar, 5),
+		},
+		{
+			name:       "char shorter than its length",
+			expression: NewLiteral(int64(42), sql.Int64),
+			castTo:     ConvertToChar,
+			length:     5,
+			expected:   "42",
+			typ:        sql.MustCreateStringWithDefaults(sqltypes.VarChar, 5),
+		},
+		{
+			name:       "binary pads to its length",
+			expression: NewLiteral("ab", sql.LongText),
+			castTo:     ConvertToBinary,
+			length:     4,
+			expected:   "ab\x00\x00",
+			typ:        sql.MustCreateBinary(sqltypes.VarBinary, 4),
+		},
+		{
+			name:       "binary truncates to its length",
+			expression: NewLiteral("abcdef", sql.LongText),
+			castTo:     ConvertToBinary,
+			length:     3,
+			expected:   "abc",
+			typ:        sql.MustCreateBinary(sqltypes.VarBinary, 3),
+		},
+		{
+			name:       "null",
+			expression: NewLiteral(nil, sql.Null),
+			castTo:     ConvertToChar,
+			length:     3,
+			expected:   nil,
+			typ:        sql.MustCreateStringWithDefaults(sqltypes.VarChar, 3),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			convert := NewConvertWithLengthAndScale(test.expression, test.castTo, test.length, test.scale)
+			require.Equal(test.typ, convert.Type())
+			val, err := convert.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(err)
+			require.Equal(test.expected, val)
+		})
+	}
+}
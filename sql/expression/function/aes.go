@@ -0,0 +1,312 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// aesFunction is the base of AES_ENCRYPT and AES_DECRYPT, which take a string, a key and, in the block encryption
+// modes that need one, an initialization vector.
+type aesFunction struct {
+	name string
+	args []sql.Expression
+}
+
+func newAESFunction(name string, args ...sql.Expression) (*aesFunction, error) {
+	if len(args) < 2 || len(args) > 3 {
+		return nil, sql.ErrInvalidArgumentNumber.New(name, "2 or 3", len(args))
+	}
+	return &aesFunction{name: name, args: args}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (f *aesFunction) FunctionName() string {
+	return strings.ToLower(f.name)
+}
+
+// Type implements the sql.Expression interface.
+func (f *aesFunction) Type() sql.Type {
+	return sql.LongBlob
+}
+
+// IsNullable implements the sql.Expression interface.
+func (f *aesFunction) IsNullable() bool {
+	return true
+}
+
+// Resolved implements the sql.Expression interface.
+func (f *aesFunction) Resolved() bool {
+	for _, arg := range f.args {
+		if !arg.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// Children implements the sql.Expression interface.
+func (f *aesFunction) Children() []sql.Expression {
+	return f.args
+}
+
+func (f *aesFunction) String() string {
+	args := make([]string, len(f.args))
+	for i, arg := range f.args {
+		args[i] = arg.String()
+	}
+	return fmt.Sprintf("%s(%s)", f.name, strings.Join(args, ", "))
+}
+
+// aesCipher is an AES cipher in one of the block encryption modes of MySQL.
+type aesCipher struct {
+	block cipher.Block
+	mode  string
+	iv    []byte
+}
+
+// evalArgs evaluates the arguments of the function, and returns the string to encrypt or decrypt along with the
+// cipher to do it with, as given by the key, the initialization vector and the block_encryption_mode system variable.
+// It returns a nil cipher if any argument is NULL.
+func (f *aesFunction) evalArgs(ctx *sql.Context, row sql.Row) (string, *aesCipher, error) {
+	vals := make([]string, len(f.args))
+	for i, arg := range f.args {
+		val, err := arg.Eval(ctx, row)
+		if err != nil {
+			return "", nil, err
+		}
+		val, err = sql.LongBlob.Convert(val)
+		if err != nil {
+			return "", nil, err
+		}
+		if val == nil {
+			return "", nil, nil
+		}
+		vals[i] = val.(string)
+	}
+
+	modeVal, err := ctx.GetSessionVariable(ctx, "block_encryption_mode")
+	if err != nil {
+		return "", nil, err
+	}
+	mode, _ := modeVal.(string)
+	keySize, cipherMode, ok := parseBlockEncryptionMode(mode)
+	if !ok {
+		return "", nil, sql.ErrUnsupportedBlockEncryptionMode.New(mode)
+	}
+
+	block, err := aes.NewCipher(aesKey(vals[1], keySize))
+	if err != nil {
+		return "", nil, err
+	}
+
+	c := &aesCipher{block: block, mode: cipherMode}
+	if cipherMode != "ecb" {
+		if len(vals) < 3 {
+			return "", nil, sql.ErrAesMissingIV.New(f.FunctionName())
+		}
+		if len(vals[2]) < aes.BlockSize {
+			return "", nil, sql.ErrAesInvalidIV.New(f.FunctionName(), aes.BlockSize)
+		}
+		c.iv = []byte(vals[2][:aes.BlockSize])
+	}
+
+	return vals[0], c, nil
+}
+
+// parseBlockEncryptionMode parses a value of the block_encryption_mode system variable, such as aes-128-ecb, into the
+// key size in bytes and the cipher mode.
+func parseBlockEncryptionMode(mode string) (int, string, bool) {
+	parts := strings.Split(strings.ToLower(mode), "-")
+	if len(parts) != 3 || parts[0] != "aes" {
+		return 0, "", false
+	}
+
+	var keySize int
+	switch parts[1] {
+	case "128":
+		keySize = 16
+	case "192":
+		keySize = 24
+	case "256":
+		keySize = 32
+	default:
+		return 0, "", false
+	}
+
+	switch parts[2] {
+	case "ecb", "cbc", "cfb128", "ofb":
+		return keySize, parts[2], true
+	default:
+		// cfb1 and cfb8 aren't supported by crypto/cipher
+		return 0, "", false
+	}
+}
+
+// aesKey derives a key of the size given from the key string given the way MySQL does, by XORing each byte of the key
+// string into a buffer of that size, wrapping around at its end.
+func aesKey(key string, size int) []byte {
+	rkey := make([]byte, size)
+	for i := 0; i < len(key); i++ {
+		rkey[i%size] ^= key[i]
+	}
+	return rkey
+}
+
+func (c *aesCipher) encrypt(plaintext []byte) []byte {
+	switch c.mode {
+	case "cfb128":
+		ciphertext := make([]byte, len(plaintext))
+		cipher.NewCFBEncrypter(c.block, c.iv).XORKeyStream(ciphertext, plaintext)
+		return ciphertext
+	case "ofb":
+		ciphertext := make([]byte, len(plaintext))
+		cipher.NewOFB(c.block, c.iv).XORKeyStream(ciphertext, plaintext)
+		return ciphertext
+	}
+
+	// ECB and CBC work on whole blocks, so the plaintext is padded as described in PKCS#7
+	padding := aes.BlockSize - len(plaintext)%aes.BlockSize
+	ciphertext := append(plaintext, bytes.Repeat([]byte{byte(padding)}, padding)...)
+	if c.mode == "cbc" {
+		cipher.NewCBCEncrypter(c.block, c.iv).CryptBlocks(ciphertext, ciphertext)
+	} else {
+		for i := 0; i < len(ciphertext); i += aes.BlockSize {
+			c.block.Encrypt(ciphertext[i:i+aes.BlockSize], ciphertext[i:i+aes.BlockSize])
+		}
+	}
+	return ciphertext
+}
+
+// decrypt returns the plaintext of the ciphertext given, or false if it isn't a valid ciphertext.
+func (c *aesCipher) decrypt(ciphertext []byte) ([]byte, bool) {
+	switch c.mode {
+	case "cfb128":
+		plaintext := make([]byte, len(ciphertext))
+		cipher.NewCFBDecrypter(c.block, c.iv).XORKeyStream(plaintext, ciphertext)
+		return plaintext, true
+	case "ofb":
+		plaintext := make([]byte, len(ciphertext))
+		cipher.NewOFB(c.block, c.iv).XORKeyStream(plaintext, ciphertext)
+		return plaintext, true
+	}
+
+	if len(ciphertext) == 0 || len(ciphertext)%aes.BlockSize != 0 {
+		return nil, false
+	}
+
+	plaintext := make([]byte, len(ciphertext))
+	if c.mode == "cbc" {
+		cipher.NewCBCDecrypter(c.block, c.iv).CryptBlocks(plaintext, ciphertext)
+	} else {
+		for i := 0; i < len(ciphertext); i += aes.BlockSize {
+			c.block.Decrypt(plaintext[i:i+aes.BlockSize], ciphertext[i:i+aes.BlockSize])
+		}
+	}
+
+	padding := int(plaintext[len(plaintext)-1])
+	if padding == 0 || padding > aes.BlockSize {
+		return nil, false
+	}
+	for _, b := range plaintext[len(plaintext)-padding:] {
+		if int(b) != padding {
+			return nil, false
+		}
+	}
+	return plaintext[:len(plaintext)-padding], true
+}
+
+// AESEncrypt function encrypts a string with AES, in the block encryption mode given by the block_encryption_mode
+// system variable.
+// https://dev.mysql.com/doc/refman/8.0/en/encryption-functions.html#function_aes-encrypt
+type AESEncrypt struct {
+	*aesFunction
+}
+
+var _ sql.FunctionExpression = (*AESEncrypt)(nil)
+
+// NewAESEncrypt returns a new AESEncrypt function expression
+func NewAESEncrypt(args ...sql.Expression) (sql.Expression, error) {
+	f, err := newAESFunction("AES_ENCRYPT", args...)
+	if err != nil {
+		return nil, err
+	}
+	return &AESEncrypt{f}, nil
+}
+
+// Description implements sql.FunctionExpression
+func (f *AESEncrypt) Description() string {
+	return "encrypts a string using AES."
+}
+
+// Eval implements sql.Expression
+func (f *AESEncrypt) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	str, c, err := f.evalArgs(ctx, row)
+	if err != nil || c == nil {
+		return nil, err
+	}
+	return string(c.encrypt([]byte(str))), nil
+}
+
+// WithChildren implements sql.Expression
+func (f *AESEncrypt) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewAESEncrypt(children...)
+}
+
+// AESDecrypt function decrypts a string encrypted by AES_ENCRYPT, or returns NULL if it can't be decrypted.
+// https://dev.mysql.com/doc/refman/8.0/en/encryption-functions.html#function_aes-decrypt
+type AESDecrypt struct {
+	*aesFunction
+}
+
+var _ sql.FunctionExpression = (*AESDecrypt)(nil)
+
+// NewAESDecrypt returns a new AESDecrypt function expression
+func NewAESDecrypt(args ...sql.Expression) (sql.Expression, error) {
+	f, err := newAESFunction("AES_DECRYPT", args...)
+	if err != nil {
+		return nil, err
+	}
+	return &AESDecrypt{f}, nil
+}
+
+// Description implements sql.FunctionExpression
+func (f *AESDecrypt) Description() string {
+	return "decrypts a string encrypted using AES."
+}
+
+// Eval implements sql.Expression
+func (f *AESDecrypt) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	str, c, err := f.evalArgs(ctx, row)
+	if err != nil || c == nil {
+		return nil, err
+	}
+	plaintext, ok := c.decrypt([]byte(str))
+	if !ok {
+		return nil, nil
+	}
+	return string(plaintext), nil
+}
+
+// WithChildren implements sql.Expression
+func (f *AESDecrypt) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewAESDecrypt(children...)
+}
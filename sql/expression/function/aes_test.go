@@ -0,0 +1,135 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// NOTE: all ciphertexts are those of MySQL 8.0, which uses OpenSSL for AES, so they were checked with `openssl enc`
+// and the key derived as MySQL does.
+
+func TestAES(t *testing.T) {
+	testCases := []struct {
+		name       string
+		mode       string
+		plaintext  string
+		key        string
+		iv         interface{}
+		ciphertext string
+	}{
+		{"default mode", "aes-128-ecb", "text", "key", nil, "15e36637363712fc2e699b9c95b75393"},
+		{"empty string", "aes-128-ecb", "", "key", nil, "c717530f41f320757b4aa1bfaf11c42e"},
+		{"key longer than 16 bytes", "aes-128-ecb", "hello world", "a very long key of more than sixteen bytes", nil, "3436c6c037ece56b489e2a169d804c30"},
+		{"iv is ignored in ecb mode", "aes-128-ecb", "text", "key", "1234567890123456", "15e36637363712fc2e699b9c95b75393"},
+		{"cbc", "aes-256-cbc", "hello world", "key", "1234567890123456", "c5414249250710bde50dc8e202ab066c"},
+		{"only 16 bytes of the iv are used", "aes-256-cbc", "hello world", "key", "1234567890123456789", "c5414249250710bde50dc8e202ab066c"},
+		{"ofb", "aes-192-ofb", "hello world", "key", "1234567890123456", "111c6019153e2235616706"},
+		{"cfb128", "AES-128-CFB128", "hello world", "key", "1234567890123456", "1a8803e6beba7f3340652a"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := sql.NewEmptyContext()
+			require.NoError(t, ctx.SetSessionVariable(ctx, "block_encryption_mode", tt.mode))
+
+			ciphertext, err := hex.DecodeString(tt.ciphertext)
+			require.NoError(t, err)
+
+			args := []sql.Expression{expression.NewLiteral(tt.plaintext, sql.LongText), expression.NewLiteral(tt.key, sql.LongText)}
+			if tt.iv != nil {
+				args = append(args, expression.NewLiteral(tt.iv, sql.LongText))
+			}
+			encrypt, err := NewAESEncrypt(args...)
+			require.NoError(t, err)
+			res, err := encrypt.Eval(ctx, nil)
+			require.NoError(t, err)
+			require.Equal(t, string(ciphertext), res)
+
+			args[0] = expression.NewLiteral(ciphertext, sql.LongBlob)
+			decrypt, err := NewAESDecrypt(args...)
+			require.NoError(t, err)
+			res, err = decrypt.Eval(ctx, nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.plaintext, res)
+		})
+	}
+}
+
+func TestAESDecryptInvalid(t *testing.T) {
+	ciphertext, err := hex.DecodeString("15e36637363712fc2e699b9c95b75393")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		ciphertext interface{}
+		key        interface{}
+	}{
+		{"wrong key", string(ciphertext), "not the key"},
+		{"truncated", string(ciphertext[:15]), "key"},
+		{"empty string", "", "key"},
+		{"NULL string", nil, "key"},
+		{"NULL key", string(ciphertext), nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := NewAESDecrypt(expression.NewLiteral(tt.ciphertext, sql.LongBlob), expression.NewLiteral(tt.key, sql.LongText))
+			require.NoError(t, err)
+			require.Nil(t, eval(t, f, nil))
+		})
+	}
+}
+
+func TestAESErrors(t *testing.T) {
+	lit := func(s string) sql.Expression {
+		return expression.NewLiteral(s, sql.LongText)
+	}
+
+	_, err := NewAESEncrypt(lit("text"))
+	require.True(t, sql.ErrInvalidArgumentNumber.Is(err))
+
+	testCases := []struct {
+		name string
+		mode string
+		args []sql.Expression
+		err  *errors.Kind
+	}{
+		{"missing iv", "aes-128-cbc", []sql.Expression{lit("text"), lit("key")}, sql.ErrAesMissingIV},
+		{"short iv", "aes-128-cbc", []sql.Expression{lit("text"), lit("key"), lit("123")}, sql.ErrAesInvalidIV},
+		{"unknown mode", "des-128-ecb", []sql.Expression{lit("text"), lit("key")}, sql.ErrUnsupportedBlockEncryptionMode},
+		{"unsupported mode", "aes-128-cfb8", []sql.Expression{lit("text"), lit("key"), lit("1234567890123456")}, sql.ErrUnsupportedBlockEncryptionMode},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := sql.NewEmptyContext()
+			require.NoError(t, ctx.SetSessionVariable(ctx, "block_encryption_mode", tt.mode))
+
+			f, err := NewAESEncrypt(tt.args...)
+			require.NoError(t, err)
+			_, err = f.Eval(ctx, nil)
+			require.Error(t, err)
+			require.True(t, tt.err.Is(err))
+		})
+	}
+}
@@ -117,7 +117,7 @@ func NewCountDistinct(e sql.Expression) *CountDistinct {
 
 // NewBuffer creates a new buffer for the aggregation.
 func (c *CountDistinct) NewBuffer() (sql.AggregationBuffer, error) {
-	return &countDistinctBuffer{make(map[uint64]struct{}), c.Child}, nil
+	return &countDistinctBuffer{seen: make(map[uint64]struct{}), expr: c.Child, threshold: -1}, nil
 }
 
 // NewWindowFunctionAggregation implements sql.WindowAdaptableExpression
@@ -175,9 +175,16 @@ func (c *CountDistinct) Description() string {
 	return "returns the number of distinct values in a result set."
 }
 
+// countDistinctBuffer counts the distinct values exactly, until their number exceeds the
+// count_distinct_approximation_threshold system variable. It then estimates the count with a HyperLogLog instead, unless
+// count_distinct_exact is set.
 type countDistinctBuffer struct {
 	seen map[uint64]struct{}
 	expr sql.Expression
+	// threshold is the number of distinct values above which the count is estimated, 0 if it's never estimated and -1
+	// until it's loaded.
+	threshold int64
+	hll       *hyperLogLog
 }
 
 // Update implements the AggregationBuffer interface.
@@ -203,13 +210,50 @@ func (c *countDistinctBuffer) Update(ctx *sql.Context, row sql.Row) error {
 		return fmt.Errorf("count distinct unable to hash value: %s", err)
 	}
 
+	if c.hll != nil {
+		c.hll.add(hash)
+		return nil
+	}
+
 	c.seen[hash] = struct{}{}
 
+	if c.threshold == -1 {
+		c.threshold = countDistinctApproximationThreshold(ctx)
+	}
+	if c.threshold > 0 && int64(len(c.seen)) > c.threshold {
+		c.hll = newHyperLogLog()
+		for seen := range c.seen {
+			c.hll.add(seen)
+		}
+		c.seen = nil
+	}
+
 	return nil
 }
 
+// countDistinctApproximationThreshold returns the number of distinct values above which COUNT(DISTINCT) is estimated
+// in the session, or 0 if it's never estimated.
+func countDistinctApproximationThreshold(ctx *sql.Context) int64 {
+	exact, err := ctx.GetSessionVariable(ctx, "count_distinct_exact")
+	if err != nil || exact != int8(0) {
+		return 0
+	}
+	threshold, err := ctx.GetSessionVariable(ctx, "count_distinct_approximation_threshold")
+	if err != nil {
+		return 0
+	}
+	t, ok := threshold.(int64)
+	if !ok {
+		return 0
+	}
+	return t
+}
+
 // Eval implements the AggregationBuffer interface.
 func (c *countDistinctBuffer) Eval(ctx *sql.Context) (interface{}, error) {
+	if c.hll != nil {
+		return c.hll.count(), nil
+	}
 	return int64(len(c.seen)), nil
 }
 
@@ -118,3 +118,37 @@ func TestCountDistinctEvalString(t *testing.T) {
 	require.NoError(b.Update(ctx, sql.NewRow("bar")))
 	require.Equal(int64(2), evalBuffer(t, b))
 }
+
+func TestCountDistinctApproximation(t *testing.T) {
+	const distinct = 50000
+
+	countDistinct := func(t *testing.T, ctx *sql.Context) int64 {
+		c := NewCountDistinct(expression.NewGetField(0, sql.Int64, "", true))
+		b, err := c.NewBuffer()
+		require.NoError(t, err)
+		for i := int64(0); i < distinct; i++ {
+			require.NoError(t, b.Update(ctx, sql.NewRow(i)))
+			require.NoError(t, b.Update(ctx, sql.NewRow(i)))
+		}
+		return evalBuffer(t, b).(int64)
+	}
+
+	t.Run("below the threshold", func(t *testing.T) {
+		ctx := sql.NewEmptyContext()
+		require.NoError(t, ctx.SetSessionVariable(ctx, "count_distinct_approximation_threshold", int64(distinct)))
+		require.Equal(t, int64(distinct), countDistinct(t, ctx))
+	})
+
+	t.Run("above the threshold", func(t *testing.T) {
+		ctx := sql.NewEmptyContext()
+		require.NoError(t, ctx.SetSessionVariable(ctx, "count_distinct_approximation_threshold", int64(100)))
+		require.InEpsilon(t, distinct, countDistinct(t, ctx), 0.03)
+	})
+
+	t.Run("forced exact", func(t *testing.T) {
+		ctx := sql.NewEmptyContext()
+		require.NoError(t, ctx.SetSessionVariable(ctx, "count_distinct_approximation_threshold", int64(100)))
+		require.NoError(t, ctx.SetSessionVariable(ctx, "count_distinct_exact", int8(1)))
+		require.Equal(t, int64(distinct), countDistinct(t, ctx))
+	})
+}
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/dolthub/vitess/go/vt/proto/query"
 
@@ -32,6 +33,8 @@ type GroupConcat struct {
 	selectExprs []sql.Expression
 	maxLen      int
 	returnType  sql.Type
+	// groups is the number of groups aggregated so far, used to number the groups in truncation warnings
+	groups int
 }
 
 var _ sql.FunctionExpression = &GroupConcat{}
@@ -60,7 +63,8 @@ func NewGroupConcat(distinct string, orderBy sql.SortFields, separator string, s
 func (g *GroupConcat) NewBuffer() (sql.AggregationBuffer, error) {
 	var rows []sql.Row
 	distinctSet := make(map[string]bool)
-	return &groupConcatBuffer{g, rows, distinctSet}, nil
+	g.groups++
+	return &groupConcatBuffer{gc: g, group: g.groups, rows: rows, distinctSet: distinctSet}, nil
 }
 
 // NewWindowFunctionAggregation implements sql.WindowAdaptableExpression
@@ -171,9 +175,15 @@ func (g *GroupConcat) WithChildren(children ...sql.Expression) (sql.Expression,
 }
 
 type groupConcatBuffer struct {
-	gc          *GroupConcat
+	gc *GroupConcat
+	// group is the number of the group this buffer aggregates, starting at 1
+	group       int
 	rows        []sql.Row
 	distinctSet map[string]bool
+	// length is the length of the result so far, only tracked when there is no ORDER BY
+	length int
+	// truncated is set when rows were dropped because the result was already longer than maxLen
+	truncated bool
 }
 
 // Update implements the AggregationBuffer interface.
@@ -218,6 +228,19 @@ func (g *groupConcatBuffer) Update(ctx *sql.Context, originalRow sql.Row) error
 		}
 	}
 
+	// Without an ORDER BY, rows are concatenated in the order they are received, so once the result is longer than
+	// maxLen no further rows can be part of it. Drop them rather than keeping them in memory.
+	if g.gc.sf == nil {
+		if g.length > g.gc.maxLen {
+			g.truncated = true
+			return nil
+		}
+		if len(g.rows) > 0 {
+			g.length += len(g.gc.separator)
+		}
+		g.length += len(vs)
+	}
+
 	// Append the current value to the end of the row. We want to preserve the row's original structure for
 	// for sort ordering in the final step.
 	g.rows = append(g.rows, append(originalRow, nil, vs))
@@ -248,35 +271,53 @@ func (g *groupConcatBuffer) Eval(ctx *sql.Context) (interface{}, error) {
 		}
 	}
 
+	return g.gc.concat(ctx, rows, g.truncated, g.group), nil
+}
+
+// Dispose implements the Disposable interface.
+func (g *groupConcatBuffer) Dispose() {
+}
+
+// concat joins the values in the last column of the rows given with the separator, truncating the result to maxLen
+// bytes. Text results are never truncated in the middle of a multibyte character. If the result was truncated, or if
+// truncated is true because rows were already dropped when accumulating them, a warning for the group numbered group
+// is added to the session as MySQL does.
+func (g *GroupConcat) concat(ctx *sql.Context, rows []sql.Row, truncated bool, group int) string {
 	sb := strings.Builder{}
 	for i, row := range rows {
 		lastIdx := len(row) - 1
 		if i == 0 {
 			sb.WriteString(row[lastIdx].(string))
 		} else {
-			sb.WriteString(g.gc.separator)
+			sb.WriteString(g.separator)
 			sb.WriteString(row[lastIdx].(string))
 		}
 
 		// Don't allow the string to cross maxlen
-		if sb.Len() >= g.gc.maxLen {
+		if sb.Len() > g.maxLen {
 			break
 		}
 	}
 
 	ret := sb.String()
 
-	// There might be a couple of character differences even if we broke early in the loop
-	if len(ret) > g.gc.maxLen {
-		ret = ret[:g.gc.maxLen]
+	// Truncate to maxLen, backing up to the start of a character if needed
+	if len(ret) > g.maxLen {
+		end := g.maxLen
+		if g.returnType != sql.Blob {
+			for end > 0 && !utf8.RuneStart(ret[end]) {
+				end--
+			}
+		}
+		ret = ret[:end]
+		truncated = true
 	}
 
-	// Add this to handle any one off errors.
-	return ret, nil
-}
+	if truncated {
+		ctx.Warn(1260, "Row %d was cut by GROUP_CONCAT()", group)
+	}
 
-// Dispose implements the Disposable interface.
-func (g *groupConcatBuffer) Dispose() {
+	return ret
 }
 
 func evalExprs(ctx *sql.Context, exprs []sql.Expression, row sql.Row) (sql.Row, sql.Type, error) {
@@ -107,3 +107,113 @@ func TestGroupConcat_ReturnType(t *testing.T) {
 		require.Equal(t, tt.returnType, gc.Type())
 	}
 }
+
+func TestGroupConcat_Truncation(t *testing.T) {
+	t.Run("warns when truncated", func(t *testing.T) {
+		ctx := sql.NewEmptyContext()
+		gc, err := NewGroupConcat("", nil, ",", []sql.Expression{expression.NewGetField(0, sql.LongText, "s", true)}, 10)
+		require.NoError(t, err)
+
+		buf, _ := gc.NewBuffer()
+		for _, s := range []string{"abcd", "efgh", "ijkl", "mnop"} {
+			require.NoError(t, buf.Update(ctx, sql.Row{s}))
+		}
+
+		result, err := buf.Eval(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "abcd,efgh,", result)
+		require.Equal(t, uint16(1), ctx.WarningCount())
+		require.Equal(t, 1260, ctx.Warnings()[0].Code)
+	})
+
+	t.Run("no warning at exactly max length", func(t *testing.T) {
+		ctx := sql.NewEmptyContext()
+		gc, err := NewGroupConcat("", nil, ",", []sql.Expression{expression.NewGetField(0, sql.LongText, "s", true)}, 9)
+		require.NoError(t, err)
+
+		buf, _ := gc.NewBuffer()
+		for _, s := range []string{"abcd", "efgh"} {
+			require.NoError(t, buf.Update(ctx, sql.Row{s}))
+		}
+
+		result, err := buf.Eval(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "abcd,efgh", result)
+		require.Equal(t, uint16(0), ctx.WarningCount())
+	})
+
+	t.Run("does not split multibyte characters", func(t *testing.T) {
+		ctx := sql.NewEmptyContext()
+		gc, err := NewGroupConcat("", nil, ",", []sql.Expression{expression.NewGetField(0, sql.LongText, "s", true)}, 8)
+		require.NoError(t, err)
+
+		buf, _ := gc.NewBuffer()
+		// Each of these characters is 3 bytes long
+		for _, s := range []string{"こん", "にちは"} {
+			require.NoError(t, buf.Update(ctx, sql.Row{s}))
+		}
+
+		result, err := buf.Eval(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "こん,", result)
+		require.Equal(t, uint16(1), ctx.WarningCount())
+	})
+
+	t.Run("drops rows past max length without order by", func(t *testing.T) {
+		ctx := sql.NewEmptyContext()
+		gc, err := NewGroupConcat("", nil, ",", []sql.Expression{expression.NewGetField(0, sql.Int64, "i", true)}, 16)
+		require.NoError(t, err)
+
+		buf, _ := gc.NewBuffer()
+		for i := 0; i < 1000; i++ {
+			require.NoError(t, buf.Update(ctx, sql.Row{int64(i)}))
+		}
+		require.Less(t, len(buf.(*groupConcatBuffer).rows), 10)
+
+		result, err := buf.Eval(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "0,1,2,3,4,5,6,7,", result)
+		require.Equal(t, uint16(1), ctx.WarningCount())
+	})
+
+	t.Run("warns with the number of the truncated group", func(t *testing.T) {
+		ctx := sql.NewEmptyContext()
+		gc, err := NewGroupConcat("", nil, ",", []sql.Expression{expression.NewGetField(0, sql.LongText, "s", true)}, 5)
+		require.NoError(t, err)
+
+		for _, group := range [][]string{{"ab", "cd"}, {"abcd", "efgh", "ijkl"}} {
+			buf, _ := gc.NewBuffer()
+			for _, s := range group {
+				require.NoError(t, buf.Update(ctx, sql.Row{s}))
+			}
+			_, err := buf.Eval(ctx)
+			require.NoError(t, err)
+		}
+
+		require.Equal(t, uint16(1), ctx.WarningCount())
+		require.Equal(t, "Row 2 was cut by GROUP_CONCAT()", ctx.Warnings()[0].Message)
+	})
+}
+
+func TestGroupConcatAgg_Truncation(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	gc, err := NewGroupConcat("", nil, ",", []sql.Expression{expression.NewGetField(0, sql.LongText, "s", true)}, 5)
+	require.NoError(t, err)
+	agg := NewGroupConcatAgg(gc)
+
+	buf := sql.WindowBuffer{{"abcd"}, {"efgh"}, {"ab"}, {"cd"}, {"abcd"}, {"efgh"}, {"ijkl"}}
+	partitions := []sql.WindowInterval{{Start: 0, End: 2}, {Start: 2, End: 4}, {Start: 4, End: 7}}
+	var res []interface{}
+	for _, p := range partitions {
+		require.NoError(t, agg.StartPartition(ctx, p, buf))
+		for i := p.Start; i < p.End; i++ {
+			res = append(res, agg.Compute(ctx, sql.WindowInterval{Start: p.Start, End: i + 1}, buf))
+		}
+	}
+
+	require.Equal(t, []interface{}{"abcd,", "abcd,", "ab,cd", "ab,cd", "abcd,", "abcd,", "abcd,"}, res)
+	require.Equal(t, uint16(2), ctx.WarningCount())
+	// Warnings are listed from the most recent
+	require.Equal(t, "Row 3 was cut by GROUP_CONCAT()", ctx.Warnings()[0].Message)
+	require.Equal(t, "Row 1 was cut by GROUP_CONCAT()", ctx.Warnings()[1].Message)
+}
@@ -0,0 +1,225 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// ErrInvalidPercentile is returned when the fraction given to a percentile aggregation is not between 0 and 1.
+var ErrInvalidPercentile = errors.NewKind("percentile value %v is not between 0 and 1")
+
+// PercentileCont is the ordered-set aggregation PERCENTILE_CONT(fraction) WITHIN GROUP (ORDER BY expr). It returns
+// the value found at the given fraction of the group's ordering, interpolating linearly between the two nearest
+// values when the fraction falls between rows. NULL values are ignored.
+type PercentileCont struct {
+	fraction float64
+	// sf is the WITHIN GROUP ordering, whose column provides the values of the group
+	sf sql.SortField
+	// median is set for MEDIAN(expr), which is PERCENTILE_CONT(0.5) under another name
+	median bool
+}
+
+var _ sql.FunctionExpression = (*PercentileCont)(nil)
+var _ sql.Aggregation = (*PercentileCont)(nil)
+var _ sql.WindowAdaptableExpression = (*PercentileCont)(nil)
+
+// NewPercentileCont returns a new PercentileCont aggregation for the fraction and WITHIN GROUP ordering given. The
+// parser has no grammar for WITHIN GROUP yet, so queries can only reach this aggregation through MEDIAN.
+func NewPercentileCont(fraction float64, withinGroup sql.SortField) (*PercentileCont, error) {
+	if fraction < 0 || fraction > 1 || math.IsNaN(fraction) {
+		return nil, ErrInvalidPercentile.New(fraction)
+	}
+	return &PercentileCont{fraction: fraction, sf: withinGroup}, nil
+}
+
+// NewMedian returns a PercentileCont aggregation that computes the median of the expression given.
+func NewMedian(e sql.Expression) *PercentileCont {
+	return &PercentileCont{fraction: 0.5, sf: sql.SortField{Column: e, Order: sql.Ascending}, median: true}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (p *PercentileCont) FunctionName() string {
+	if p.median {
+		return "median"
+	}
+	return "percentile_cont"
+}
+
+// Description implements sql.FunctionExpression
+func (p *PercentileCont) Description() string {
+	return "returns the interpolated value at the given fraction of the group ordering."
+}
+
+// Type implements the Expression interface.
+func (p *PercentileCont) Type() sql.Type {
+	return sql.Float64
+}
+
+// IsNullable implements the Expression interface.
+func (p *PercentileCont) IsNullable() bool {
+	return true
+}
+
+// Resolved implements the Expression interface.
+func (p *PercentileCont) Resolved() bool {
+	return p.sf.Column.Resolved()
+}
+
+// Children implements the Expression interface.
+func (p *PercentileCont) Children() []sql.Expression {
+	return []sql.Expression{p.sf.Column}
+}
+
+func (p *PercentileCont) String() string {
+	if p.median {
+		return fmt.Sprintf("MEDIAN(%s)", p.sf.Column)
+	}
+	return fmt.Sprintf("PERCENTILE_CONT(%v) WITHIN GROUP (ORDER BY %s)", p.fraction, p.sf.String())
+}
+
+// WithChildren implements the Expression interface.
+func (p *PercentileCont) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(p, len(children), 1)
+	}
+	np := *p
+	np.sf.Column = children[0]
+	return &np, nil
+}
+
+// Eval implements the Expression interface.
+func (p *PercentileCont) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return nil, ErrEvalUnsupportedOnAggregation.New("PercentileCont")
+}
+
+// NewBuffer implements the Aggregation interface.
+func (p *PercentileCont) NewBuffer() (sql.AggregationBuffer, error) {
+	expr, err := expression.Clone(p.sf.Column)
+	if err != nil {
+		return nil, err
+	}
+	return &percentileBuffer{p: p, expr: expr}, nil
+}
+
+// NewWindowFunction implements sql.WindowAdaptableExpression
+func (p *PercentileCont) NewWindowFunction() (sql.WindowFunction, error) {
+	expr, err := expression.Clone(p.sf.Column)
+	if err != nil {
+		return nil, err
+	}
+	return NewPercentileContAgg(p, expr), nil
+}
+
+// percentileOf returns the value at the fraction of this aggregation among the values given, which are sorted in the
+// process. Returns nil if there are no values.
+func (p *PercentileCont) percentileOf(vals []float64) interface{} {
+	if len(vals) == 0 {
+		return nil
+	}
+	if p.sf.Order == sql.Descending {
+		sort.Sort(sort.Reverse(sort.Float64Slice(vals)))
+	} else {
+		sort.Float64s(vals)
+	}
+
+	pos := p.fraction * float64(len(vals)-1)
+	lo, hi := math.Floor(pos), math.Ceil(pos)
+	loVal, hiVal := vals[int(lo)], vals[int(hi)]
+	return loVal + (pos-lo)*(hiVal-loVal)
+}
+
+// appendFloat evaluates the expression against the row and appends the result to the values given, skipping NULLs.
+func appendFloat(ctx *sql.Context, vals []float64, expr sql.Expression, row sql.Row) ([]float64, error) {
+	v, err := expr.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if v == nil {
+		return vals, nil
+	}
+	f, err := sql.Float64.Convert(v)
+	if err != nil {
+		return nil, err
+	}
+	return append(vals, f.(float64)), nil
+}
+
+type percentileBuffer struct {
+	p    *PercentileCont
+	expr sql.Expression
+	vals []float64
+}
+
+// Update implements the AggregationBuffer interface.
+func (b *percentileBuffer) Update(ctx *sql.Context, row sql.Row) error {
+	var err error
+	b.vals, err = appendFloat(ctx, b.vals, b.expr, row)
+	return err
+}
+
+// Eval implements the AggregationBuffer interface.
+func (b *percentileBuffer) Eval(ctx *sql.Context) (interface{}, error) {
+	return b.p.percentileOf(b.vals), nil
+}
+
+// Dispose implements the Disposable interface.
+func (b *percentileBuffer) Dispose() {
+	expression.Dispose(b.expr)
+}
+
+type PercentileContAgg struct {
+	p    *PercentileCont
+	expr sql.Expression
+}
+
+func NewPercentileContAgg(p *PercentileCont, e sql.Expression) *PercentileContAgg {
+	return &PercentileContAgg{
+		p:    p,
+		expr: e,
+	}
+}
+
+func (a *PercentileContAgg) Dispose() {
+	expression.Dispose(a.expr)
+}
+
+func (a *PercentileContAgg) StartPartition(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) error {
+	a.Dispose()
+	return nil
+}
+
+func (a *PercentileContAgg) NewSlidingFrameInterval(added, dropped sql.WindowInterval) {
+	panic("sliding window interface not implemented yet")
+}
+
+func (a *PercentileContAgg) Compute(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) interface{} {
+	vals := make([]float64, 0, interval.End-interval.Start)
+	for i := interval.Start; i < interval.End; i++ {
+		var err error
+		vals, err = appendFloat(ctx, vals, a.expr, buf[i])
+		if err != nil {
+			return nil
+		}
+	}
+	return a.p.percentileOf(vals)
+}
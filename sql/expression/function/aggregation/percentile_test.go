@@ -0,0 +1,84 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestPercentileCont(t *testing.T) {
+	z := expression.NewGetField(3, sql.Int32, "z", true)
+	x := expression.NewGetField(1, sql.Text, "x", false)
+
+	mustPercentile := func(fraction float64, order sql.SortOrder) *PercentileCont {
+		p, err := NewPercentileCont(fraction, sql.SortField{Column: z, Order: order})
+		require.NoError(t, err)
+		return p
+	}
+	windowFunction := func(p *PercentileCont) sql.WindowFunction {
+		fn, err := p.NewWindowFunction()
+		require.NoError(t, err)
+		return fn
+	}
+
+	t.Run("group by", func(t *testing.T) {
+		ctx := sql.NewEmptyContext()
+		// values of z: forest 3,4,4,6,10 and desert 4,5,6,8
+		iter := NewWindowBlockIter([]sql.Expression{x}, nil, []*Aggregation{
+			NewAggregation(NewLastAgg(x), NewGroupByFramer()),
+			NewAggregation(windowFunction(NewMedian(z)), NewGroupByFramer()),
+			NewAggregation(windowFunction(mustPercentile(0.5, sql.Ascending)), NewGroupByFramer()),
+			NewAggregation(windowFunction(mustPercentile(0.25, sql.Ascending)), NewGroupByFramer()),
+			NewAggregation(windowFunction(mustPercentile(0.25, sql.Descending)), NewGroupByFramer()),
+		}, newRowIter(t, ctx))
+
+		res, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(t, err)
+		require.Equal(t, []sql.Row{
+			{"forest", float64(4), float64(4), float64(4), float64(6)},
+			{"desert", float64(5.5), float64(5.5), float64(4.75), float64(6.5)},
+		}, res)
+	})
+
+	t.Run("buffer", func(t *testing.T) {
+		require := require.New(t)
+		ctx := sql.NewEmptyContext()
+
+		buf, err := NewMedian(z).NewBuffer()
+		require.NoError(err)
+		v, err := buf.Eval(ctx)
+		require.NoError(err)
+		require.Nil(v)
+
+		for _, row := range []sql.Row{{nil, nil, nil, 7}, {nil, nil, nil, nil}, {nil, nil, nil, 1}, {nil, nil, nil, 4}} {
+			require.NoError(buf.Update(ctx, row))
+		}
+		v, err = buf.Eval(ctx)
+		require.NoError(err)
+		require.Equal(float64(4), v)
+	})
+
+	t.Run("invalid fraction", func(t *testing.T) {
+		_, err := NewPercentileCont(1.5, sql.SortField{Column: z})
+		require.True(t, ErrInvalidPercentile.Is(err))
+		_, err = NewPercentileCont(-0.1, sql.SortField{Column: z})
+		require.True(t, ErrInvalidPercentile.Is(err))
+	})
+}
@@ -0,0 +1,111 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// streamGroupIter aggregates rows that arrive already grouped, meaning that the rows of each group are next to each
+// other, such as when they are read in the order of the grouping expressions. Unlike windowBlockIter, it only buffers
+// the rows of one group at a time, and returns the aggregates of each group as soon as the next group starts.
+type streamGroupIter struct {
+	groupBy []sql.Expression
+	aggs    []*Aggregation
+	child   sql.RowIter
+
+	// group holds the rows of the current group
+	group sql.WindowBuffer
+	done  bool
+}
+
+var _ sql.RowIter = (*streamGroupIter)(nil)
+var _ sql.Disposable = (*streamGroupIter)(nil)
+
+// NewStreamGroupIter returns an iterator of the aggregates given for each group of the rows of |child|, which must
+// return the rows of each group of the expressions given next to each other. Groups are returned in the order their
+// rows are read, which is the same order a GroupBy returns them in.
+func NewStreamGroupIter(groupBy []sql.Expression, aggs []*Aggregation, child sql.RowIter) *streamGroupIter {
+	return &streamGroupIter{
+		groupBy: groupingKeys(groupBy),
+		aggs:    aggs,
+		child:   child,
+	}
+}
+
+func (i *streamGroupIter) Next(ctx *sql.Context) (sql.Row, error) {
+	if i.done {
+		return nil, io.EOF
+	}
+
+	for {
+		row, err := i.child.Next(ctx)
+		if err == io.EOF {
+			i.done = true
+			if len(i.group) == 0 {
+				return nil, io.EOF
+			}
+			return i.compute(ctx)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if len(i.group) > 0 {
+			newGroup, err := isNewPartition(ctx, i.groupBy, i.group[len(i.group)-1], row)
+			if err != nil {
+				return nil, err
+			}
+			if newGroup {
+				res, err := i.compute(ctx)
+				i.group = sql.WindowBuffer{row}
+				return res, err
+			}
+		}
+		i.group = append(i.group, row)
+	}
+}
+
+// compute returns the aggregates of the rows of the current group.
+func (i *streamGroupIter) compute(ctx *sql.Context) (sql.Row, error) {
+	interval := sql.WindowInterval{Start: 0, End: len(i.group)}
+	row := make(sql.Row, len(i.aggs))
+	for j, agg := range i.aggs {
+		if err := agg.fn.StartPartition(ctx, interval, i.group); err != nil {
+			return nil, err
+		}
+		agg.framer = agg.framer.NewFramer(interval)
+		frame, err := nextFrame(ctx, agg.framer, i.group)
+		if err != nil {
+			return nil, err
+		}
+		row[j] = agg.fn.Compute(ctx, frame, i.group)
+	}
+	return row, nil
+}
+
+func (i *streamGroupIter) Close(ctx *sql.Context) error {
+	i.Dispose()
+	i.group = nil
+	return i.child.Close(ctx)
+}
+
+func (i *streamGroupIter) Dispose() {
+	for _, a := range i.aggs {
+		a.fn.Dispose()
+	}
+}
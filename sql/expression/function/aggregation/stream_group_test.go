@@ -0,0 +1,73 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestStreamGroupIter(t *testing.T) {
+	aggs := func() []*Aggregation {
+		return []*Aggregation{
+			NewAggregation(
+				NewLastAgg(expression.NewGetField(1, sql.Text, "x", true)),
+				NewGroupByFramer(),
+			),
+			NewAggregation(
+				NewFirstAgg(expression.NewGetField(2, sql.Text, "y", true)),
+				NewGroupByFramer(),
+			),
+			NewAggregation(
+				NewSumAgg(expression.NewGetField(3, sql.Int64, "z", true)),
+				NewGroupByFramer(),
+			),
+		}
+	}
+	groupBy := []sql.Expression{expression.NewGetFieldWithTable(1, sql.Text, "a", "x", false)}
+
+	t.Run("grouped rows", func(t *testing.T) {
+		ctx := sql.NewEmptyContext()
+		iter := NewStreamGroupIter(groupBy, aggs(), newRowIter(t, ctx))
+		res, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(t, err)
+		require.Equal(t, []sql.Row{
+			{"forest", "leaf", float64(27)},
+			{"desert", "sand", float64(23)},
+		}, res)
+	})
+
+	t.Run("no rows", func(t *testing.T) {
+		ctx := sql.NewEmptyContext()
+		iter := NewStreamGroupIter(groupBy, aggs(), sql.RowsToRowIter())
+		res, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(t, err)
+		require.Empty(t, res)
+	})
+
+	t.Run("groups are returned as they end", func(t *testing.T) {
+		ctx := sql.NewEmptyContext()
+		iter := NewStreamGroupIter(groupBy, aggs(), newRowIter(t, ctx))
+		row, err := iter.Next(ctx)
+		require.NoError(t, err)
+		require.Equal(t, sql.Row{"forest", "leaf", float64(27)}, row)
+		require.Len(t, iter.group, 1)
+		require.NoError(t, iter.Close(ctx))
+	})
+}
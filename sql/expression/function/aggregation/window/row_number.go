@@ -23,9 +23,12 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 )
 
+// RowNumber is the ROW_NUMBER() window function. Rows that tie on every PARTITION BY and ORDER BY expression of the
+// window are numbered in the order they were received from the child node, so the same input always yields the same
+// numbering. MySQL leaves the numbering of ties unspecified, so this is always the behavior, and there is no setting
+// to change it.
 type RowNumber struct {
 	window *sql.Window
-	pos    int
 }
 
 var _ sql.FunctionExpression = (*RowNumber)(nil)
@@ -37,7 +40,7 @@ func NewRowNumber() sql.Expression {
 
 // Description implements sql.FunctionExpression
 func (r *RowNumber) Description() string {
-	return "returns the number of rows updated."
+	return "returns the number of the current row within its partition, numbering ties in input order."
 }
 
 // Window implements sql.WindowExpression
@@ -119,17 +122,31 @@ func (r *RowNumber) WithWindow(window *sql.Window) (sql.WindowAggregation, error
 // Add implements sql.WindowAggregation
 func (r *RowNumber) Add(ctx *sql.Context, buffer, row sql.Row) error {
 	rows := buffer[0].([]sql.Row)
-	buffer[0] = append(rows, append(row, nil, r.pos))
-	r.pos++
+	buffer[0] = append(rows, append(row, nil, len(rows)))
 	return nil
 }
 
 // Finish implements sql.WindowAggregation
 func (r *RowNumber) Finish(ctx *sql.Context, buffer sql.Row) error {
 	rows := buffer[0].([]sql.Row)
-	if len(rows) > 0 && r.window != nil && r.window.OrderBy != nil {
+	if len(rows) > 0 {
+		rowNumIdx := len(rows[0]) - 2
+		originalOrderIdx := len(rows[0]) - 1
+
+		var sortFields sql.SortFields
+		var partitionBy []sql.Expression
+		if r.window != nil {
+			partitionBy = r.window.PartitionBy
+			sortFields = append(partitionsToSortFields(partitionBy), r.window.OrderBy...)
+		}
+		// Ties are broken by the original position of each row, rather than relying on the sort leaving them alone
+		sortFields = append(sortFields, sql.SortField{
+			Column: expression.NewGetField(originalOrderIdx, sql.Int64, "", false),
+			Order:  sql.Ascending,
+		})
+
 		sorter := &expression.Sorter{
-			SortFields: append(partitionsToSortFields(r.Window().PartitionBy), r.Window().OrderBy...),
+			SortFields: sortFields,
 			Rows:       rows,
 			Ctx:        ctx,
 		}
@@ -139,13 +156,11 @@ func (r *RowNumber) Finish(ctx *sql.Context, buffer sql.Row) error {
 		}
 
 		// Now that we have the rows in sorted order, number them
-		rowNumIdx := len(rows[0]) - 2
-		originalOrderIdx := len(rows[0]) - 1
 		var last sql.Row
 		var rowNum int
 		for _, row := range rows {
 			// every time we encounter a new partition, start the count over
-			isNew, err := isNewPartition(ctx, r.window.PartitionBy, last, row)
+			isNew, err := isNewPartition(ctx, partitionBy, last, row)
 			if err != nil {
 				return err
 			}
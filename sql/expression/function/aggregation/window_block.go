@@ -59,7 +59,7 @@ var _ sql.Disposable = (*windowBlockIter)(nil)
 
 func NewWindowBlockIter(partitionBy []sql.Expression, sortBy sql.SortFields, aggs []*Aggregation, child sql.RowIter) *windowBlockIter {
 	return &windowBlockIter{
-		partitionBy:  partitionBy,
+		partitionBy:  groupingKeys(partitionBy),
 		sortBy:       sortBy,
 		aggs:         aggs,
 		child:        child,
@@ -219,18 +219,26 @@ func (i *windowBlockIter) compute(ctx *sql.Context) (sql.Row, error) {
 	// but updated independently. This allows aggregations with the same
 	// partition and sorting to have different framing behavior.
 	for j, agg := range i.aggs {
-		interval, err := agg.framer.Next()
+		interval, err := nextFrame(ctx, agg.framer, i.input)
 		if errors.Is(err, io.EOF) {
 			err = i.nextPartition(ctx)
 			if err != nil {
 				return nil, err
 			}
-			interval, err = agg.framer.Next()
+			interval, err = nextFrame(ctx, agg.framer, i.input)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if ef, ok := agg.framer.(excludingFramer); ok && len(ef.excluded()) > 0 {
+			row[j], err = computeWithExclusions(ctx, agg.fn, interval, ef.excluded(), i.input)
 			if err != nil {
 				return nil, err
 			}
+		} else {
+			row[j] = agg.fn.Compute(ctx, interval, i.input)
 		}
-		row[j] = agg.fn.Compute(ctx, interval, i.input)
 	}
 
 	// TODO: move sort by above aggregation
@@ -241,6 +249,32 @@ func (i *windowBlockIter) compute(ctx *sql.Context) (sql.Row, error) {
 	return row, nil
 }
 
+// computeWithExclusions evaluates |fn| over the rows of |interval| that aren't in any of the |excluded| intervals. The
+// remaining rows are copied to a buffer of their own, which |fn| is restarted on, since window functions can only
+// compute contiguous intervals.
+func computeWithExclusions(ctx *sql.Context, fn sql.WindowFunction, interval sql.WindowInterval, excluded []sql.WindowInterval, buf sql.WindowBuffer) (interface{}, error) {
+	frame := make(sql.WindowBuffer, 0, interval.End-interval.Start)
+	for k := interval.Start; k < interval.End; k++ {
+		isExcluded := false
+		for _, e := range excluded {
+			if e.Start <= k && k < e.End {
+				isExcluded = true
+				break
+			}
+		}
+		if !isExcluded {
+			frame = append(frame, buf[k])
+		}
+	}
+
+	frameInterval := sql.WindowInterval{Start: 0, End: len(frame)}
+	err := fn.StartPartition(ctx, frameInterval, frame)
+	if err != nil {
+		return nil, err
+	}
+	return fn.Compute(ctx, frameInterval, frame), nil
+}
+
 // sortAndFilterOutput in-place sorts the [i.output] buffer using the last
 // value in every row as the sort index.
 func (i *windowBlockIter) sortAndFilterOutput() error {
@@ -327,8 +361,59 @@ func isNewPartition(ctx *sql.Context, partitionBy []sql.Expression, last sql.Row
 		if err != nil {
 			return false, err
 		}
-		return cmp != 0, nil
+		if cmp != 0 {
+			return true, nil
+		}
 	}
 
 	return false, nil
 }
+
+// groupingKeys returns the partition expressions given with the strings of case-insensitive collations replaced with
+// their collation key, so that strings equal under their collation are sorted and partitioned together.
+func groupingKeys(partitionBy []sql.Expression) []sql.Expression {
+	keys := make([]sql.Expression, len(partitionBy))
+	for i, expr := range partitionBy {
+		keys[i] = expr
+		if _, ok := expr.Type().(sql.StringType); ok {
+			keys[i] = &collationKey{expression.UnaryExpression{Child: expr}}
+		}
+	}
+	return keys
+}
+
+// collationKey evaluates to the collation key of the string its child evaluates to.
+type collationKey struct {
+	expression.UnaryExpression
+}
+
+var _ sql.Expression = (*collationKey)(nil)
+
+// Type implements the sql.Expression interface.
+func (k *collationKey) Type() sql.Type {
+	return k.Child.Type()
+}
+
+// Eval implements the sql.Expression interface.
+func (k *collationKey) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	v, err := k.Child.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if s, ok := v.(string); ok {
+		return k.Child.Type().(sql.StringType).Collation().Key(s), nil
+	}
+	return v, nil
+}
+
+func (k *collationKey) String() string {
+	return k.Child.String()
+}
+
+// WithChildren implements the sql.Expression interface.
+func (k *collationKey) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(k, len(children), 1)
+	}
+	return &collationKey{expression.UnaryExpression{Child: children[0]}}, nil
+}
@@ -0,0 +1,205 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// ErrInvalidWindowFrameStart is returned for a window frame that starts with UNBOUNDED FOLLOWING.
+var ErrInvalidWindowFrameStart = errors.NewKind("window frame start cannot be UNBOUNDED FOLLOWING")
+
+// ErrInvalidWindowFrameEnd is returned for a window frame that ends with UNBOUNDED PRECEDING.
+var ErrInvalidWindowFrameEnd = errors.NewKind("window frame end cannot be UNBOUNDED PRECEDING")
+
+// ErrInvalidWindowFrameBounds is returned for a window frame whose start comes after its end, such as CURRENT ROW to
+// 1 PRECEDING.
+var ErrInvalidWindowFrameBounds = errors.NewKind("window frame cannot start at %s and end at %s")
+
+// ErrInvalidWindowFrameOffset is returned for an N PRECEDING or N FOLLOWING bound with an invalid N.
+var ErrInvalidWindowFrameOffset = errors.NewKind("window frame start or end is negative, NULL or of the wrong type: %s")
+
+// ErrInvalidWindowRangeOrderBy is returned for a RANGE frame with an offset bound and an unsuitable ORDER BY.
+var ErrInvalidWindowRangeOrderBy = errors.NewKind("window with RANGE N PRECEDING/FOLLOWING frame requires exactly one ORDER BY expression, of numeric or temporal type")
+
+// NewWindowFramer returns a sql.WindowFramer for the frame of the window given. As in MySQL, a window without a frame
+// clause frames the whole partition if it has no ORDER BY, and frames RANGE BETWEEN UNBOUNDED PRECEDING AND CURRENT
+// ROW if it does, so that the peers of each row are in its frame.
+func NewWindowFramer(ctx *sql.Context, w *sql.Window) (sql.WindowFramer, error) {
+	var orderBy sql.SortFields
+	var frame *sql.WindowFrame
+	if w != nil {
+		orderBy, frame = w.OrderBy, w.Frame
+	}
+
+	if frame == nil {
+		if len(orderBy) == 0 {
+			return NewPartitionFramer(), nil
+		}
+		frame = sql.NewWindowFrame(
+			sql.WindowFrameUnitRange,
+			sql.WindowFrameBound{Type: sql.WindowFrameUnboundedPreceding},
+			sql.WindowFrameBound{Type: sql.WindowFrameCurrentRow},
+			sql.WindowFrameExcludeNoOthers,
+		)
+	}
+
+	if frame.Start.Type == sql.WindowFrameUnboundedFollowing {
+		return nil, ErrInvalidWindowFrameStart.New()
+	}
+	if frame.End.Type == sql.WindowFrameUnboundedPreceding {
+		return nil, ErrInvalidWindowFrameEnd.New()
+	}
+	if frame.Start.Type > frame.End.Type {
+		return nil, ErrInvalidWindowFrameBounds.New(frame.Start, frame.End)
+	}
+
+	exclusion := frameExclusion{exclusion: frame.Exclusion, peers: peerGroups{orderBy: orderBy}}
+
+	if frame.Unit == sql.WindowFrameUnitRows {
+		startOffset, err := rowsFrameOffset(ctx, frame.Start)
+		if err != nil {
+			return nil, err
+		}
+		endOffset, err := rowsFrameOffset(ctx, frame.End)
+		if err != nil {
+			return nil, err
+		}
+		return &RowFramer{
+			startOffset:        startOffset,
+			endOffset:          endOffset,
+			unboundedPreceding: frame.Start.Type == sql.WindowFrameUnboundedPreceding,
+			unboundedFollowing: frame.End.Type == sql.WindowFrameUnboundedFollowing,
+			exclusion:          exclusion,
+			frameStart:         -1,
+			frameEnd:           -1,
+			partitionStart:     -1,
+			partitionEnd:       -1,
+		}, nil
+	}
+
+	f := &RangeFramer{
+		peers:          peerGroups{orderBy: orderBy},
+		exclusion:      exclusion,
+		frameStart:     -1,
+		frameEnd:       -1,
+		partitionStart: -1,
+		partitionEnd:   -1,
+	}
+	if hasOffset(frame.Start) || hasOffset(frame.End) {
+		if len(orderBy) != 1 {
+			return nil, ErrInvalidWindowRangeOrderBy.New()
+		}
+		typ := orderBy[0].Column.Type()
+		if !sql.IsNumber(typ) && !sql.IsTime(typ) {
+			return nil, ErrInvalidWindowRangeOrderBy.New()
+		}
+		f.orderBy = &orderBy[0]
+	}
+
+	var err error
+	f.start, err = rangeFrameOffset(ctx, frame.Start, f.orderBy)
+	if err != nil {
+		return nil, err
+	}
+	f.end, err = rangeFrameOffset(ctx, frame.End, f.orderBy)
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// hasOffset returns whether the bound given is an N PRECEDING or N FOLLOWING bound.
+func hasOffset(b sql.WindowFrameBound) bool {
+	return b.Type == sql.WindowFramePreceding || b.Type == sql.WindowFrameFollowing
+}
+
+// rowsFrameOffset returns the position of the row a bound of a ROWS frame refers to, relative to the current row.
+func rowsFrameOffset(ctx *sql.Context, b sql.WindowFrameBound) (int, error) {
+	if !hasOffset(b) {
+		return 0, nil
+	}
+
+	if b.Offset == nil || !sql.IsInteger(b.Offset.Type()) {
+		return 0, ErrInvalidWindowFrameOffset.New(b)
+	}
+	v, err := b.Offset.Eval(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	if v == nil {
+		return 0, ErrInvalidWindowFrameOffset.New(b)
+	}
+	n, err := sql.Int64.Convert(v)
+	if err != nil {
+		return 0, err
+	}
+	if n.(int64) < 0 {
+		return 0, ErrInvalidWindowFrameOffset.New(b)
+	}
+
+	if b.Type == sql.WindowFramePreceding {
+		return -int(n.(int64)), nil
+	}
+	return int(n.(int64)), nil
+}
+
+// rangeFrameOffset evaluates the offset of a bound of a RANGE frame ordered by the field given: a number for a numeric
+// ORDER BY, and an INTERVAL for a temporal one.
+func rangeFrameOffset(ctx *sql.Context, b sql.WindowFrameBound, orderBy *sql.SortField) (rangeFrameBound, error) {
+	if !hasOffset(b) {
+		return rangeFrameBound{typ: b.Type}, nil
+	}
+
+	if b.Offset == nil {
+		return rangeFrameBound{}, ErrInvalidWindowFrameOffset.New(b)
+	}
+	interval, isInterval := b.Offset.(*expression.Interval)
+	if sql.IsTime(orderBy.Column.Type()) {
+		if !isInterval {
+			return rangeFrameBound{}, ErrInvalidWindowFrameOffset.New(b)
+		}
+		delta, err := interval.EvalDelta(ctx, nil)
+		if err != nil {
+			return rangeFrameBound{}, err
+		}
+		if delta == nil {
+			return rangeFrameBound{}, ErrInvalidWindowFrameOffset.New(b)
+		}
+		return rangeFrameBound{typ: b.Type, offset: delta}, nil
+	}
+
+	if isInterval || !sql.IsNumber(b.Offset.Type()) {
+		return rangeFrameBound{}, ErrInvalidWindowFrameOffset.New(b)
+	}
+	v, err := b.Offset.Eval(ctx, nil)
+	if err != nil {
+		return rangeFrameBound{}, err
+	}
+	if v == nil {
+		return rangeFrameBound{}, ErrInvalidWindowFrameOffset.New(b)
+	}
+	n, err := sql.Float64.Convert(v)
+	if err != nil {
+		return rangeFrameBound{}, err
+	}
+	if n.(float64) < 0 {
+		return rangeFrameBound{}, ErrInvalidWindowFrameOffset.New(b)
+	}
+	return rangeFrameBound{typ: b.Type, offset: n}, nil
+}
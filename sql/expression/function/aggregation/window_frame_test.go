@@ -0,0 +1,83 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestInvalidWindowFrames(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+
+	x := expression.NewGetField(0, sql.Int64, "x", true)
+	s := expression.NewGetField(1, sql.Text, "s", true)
+	one := expression.NewLiteral(int64(1), sql.Int64)
+	currentRow := sql.WindowFrameBound{Type: sql.WindowFrameCurrentRow}
+	onePreceding := sql.WindowFrameBound{Type: sql.WindowFramePreceding, Offset: one}
+
+	tests := []struct {
+		name   string
+		window *sql.Window
+		err    *errors.Kind
+	}{
+		{
+			name: "start after end",
+			window: sql.NewWindow(nil, nil).WithFrame(sql.NewWindowFrame(
+				sql.WindowFrameUnitRows, currentRow, onePreceding, sql.WindowFrameExcludeNoOthers)),
+			err: ErrInvalidWindowFrameBounds,
+		},
+		{
+			name: "unbounded following start",
+			window: sql.NewWindow(nil, nil).WithFrame(sql.NewWindowFrame(
+				sql.WindowFrameUnitRows, sql.WindowFrameBound{Type: sql.WindowFrameUnboundedFollowing},
+				sql.WindowFrameBound{Type: sql.WindowFrameUnboundedFollowing}, sql.WindowFrameExcludeNoOthers)),
+			err: ErrInvalidWindowFrameStart,
+		},
+		{
+			name: "negative rows offset",
+			window: sql.NewWindow(nil, nil).WithFrame(sql.NewWindowFrame(
+				sql.WindowFrameUnitRows,
+				sql.WindowFrameBound{Type: sql.WindowFramePreceding, Offset: expression.NewLiteral(int64(-1), sql.Int64)},
+				currentRow, sql.WindowFrameExcludeNoOthers)),
+			err: ErrInvalidWindowFrameOffset,
+		},
+		{
+			name: "range offset with two order by fields",
+			window: sql.NewWindow(nil, sql.SortFields{{Column: x}, {Column: s}}).WithFrame(sql.NewWindowFrame(
+				sql.WindowFrameUnitRange, onePreceding, currentRow, sql.WindowFrameExcludeNoOthers)),
+			err: ErrInvalidWindowRangeOrderBy,
+		},
+		{
+			name: "range offset with a text order by",
+			window: sql.NewWindow(nil, sql.SortFields{{Column: s}}).WithFrame(sql.NewWindowFrame(
+				sql.WindowFrameUnitRange, onePreceding, currentRow, sql.WindowFrameExcludeNoOthers)),
+			err: ErrInvalidWindowRangeOrderBy,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewWindowFramer(ctx, tt.window)
+			require.Error(t, err)
+			require.True(t, tt.err.Is(err), "unexpected error %v", err)
+		})
+	}
+}
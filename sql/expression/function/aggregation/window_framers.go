@@ -17,20 +17,36 @@ package aggregation
 import (
 	"errors"
 	"io"
+	"sort"
+	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 )
 
 var ErrPartitionNotSet = errors.New("attempted to general a window frame interval before framer partition was set")
 
-var _ sql.WindowFramer = (*RowFramer)(nil)
+// ErrFramerNeedsBuffer is returned by the Next method of framers that can only compute their frames from the rows of
+// the partition, given to NextWithBuffer.
+var ErrFramerNeedsBuffer = errors.New("window framer needs the partition buffer to compute its frames")
+
+var _ sql.BufferedWindowFramer = (*RowFramer)(nil)
+var _ sql.BufferedWindowFramer = (*RangeFramer)(nil)
 var _ sql.WindowFramer = (*PartitionFramer)(nil)
 var _ sql.WindowFramer = (*GroupByFramer)(nil)
 
+// nextFrame returns the next frame of the framer given, passing it the rows of the partition if it needs them.
+func nextFrame(ctx *sql.Context, framer sql.WindowFramer, buf sql.WindowBuffer) (sql.WindowInterval, error) {
+	if bf, ok := framer.(sql.BufferedWindowFramer); ok {
+		return bf.NextWithBuffer(ctx, buf)
+	}
+	return framer.Next()
+}
+
 func NewUnboundedPrecedingToCurrentRowFramer() *RowFramer {
 	return &RowFramer{
 		unboundedPreceding: true,
-		followingOffset:    0,
+		endOffset:          0,
 		frameEnd:           -1,
 		frameStart:         -1,
 		partitionStart:     -1,
@@ -38,14 +54,18 @@ func NewUnboundedPrecedingToCurrentRowFramer() *RowFramer {
 	}
 }
 
+// RowFramer frames each row with the rows a fixed number of positions before or after it, for ROWS frames.
 type RowFramer struct {
 	idx                          int
 	partitionStart, partitionEnd int
 	frameStart, frameEnd         int
 	partitionSet                 bool
 
-	followingOffset, precedingOffset       int
+	// startOffset and endOffset are the positions of the first and last rows of the frame relative to the current
+	// row, which are negative for rows before it
+	startOffset, endOffset                 int
 	unboundedPreceding, unboundedFollowing bool
+	exclusion                              frameExclusion
 }
 
 func (f *RowFramer) Close() {
@@ -63,29 +83,51 @@ func (f *RowFramer) NewFramer(interval sql.WindowInterval) sql.WindowFramer {
 		// pass through parent state
 		unboundedPreceding: f.unboundedPreceding,
 		unboundedFollowing: f.unboundedFollowing,
-		followingOffset:    f.followingOffset,
-		precedingOffset:    f.precedingOffset,
+		startOffset:        f.startOffset,
+		endOffset:          f.endOffset,
+		exclusion:          f.exclusion.newPartition(),
 	}
 }
 
+// Next implements sql.WindowFramer. Frames that exclude the peers of the current row need the partition buffer given to
+// NextWithBuffer.
 func (f *RowFramer) Next() (sql.WindowInterval, error) {
+	if f.exclusion.usesPeers() {
+		return sql.WindowInterval{}, ErrFramerNeedsBuffer
+	}
+	return f.NextWithBuffer(nil, nil)
+}
+
+// NextWithBuffer implements sql.BufferedWindowFramer.
+func (f *RowFramer) NextWithBuffer(ctx *sql.Context, buf sql.WindowBuffer) (sql.WindowInterval, error) {
 	if f.idx != 0 && f.idx >= f.partitionEnd || !f.partitionSet {
 		return sql.WindowInterval{}, io.EOF
 	}
 
-	newStart := f.idx - f.precedingOffset
+	newStart := f.idx + f.startOffset
 	if f.unboundedPreceding || newStart < f.partitionStart {
 		newStart = f.partitionStart
 	}
+	if newStart > f.partitionEnd {
+		newStart = f.partitionEnd
+	}
 
-	newEnd := f.idx + f.followingOffset + 1
+	newEnd := f.idx + f.endOffset + 1
 	if f.unboundedFollowing || newEnd > f.partitionEnd {
 		newEnd = f.partitionEnd
 	}
+	if newEnd < newStart {
+		newEnd = newStart
+	}
 
 	f.frameStart = newStart
 	f.frameEnd = newEnd
 
+	err := f.exclusion.next(ctx, buf, f.idx, sql.WindowInterval{Start: f.partitionStart, End: f.partitionEnd})
+	if err != nil {
+		return sql.WindowInterval{}, err
+	}
+
 	f.idx++
 	return f.Interval()
 }
@@ -109,6 +151,368 @@ func (f *RowFramer) SlidingInterval(ctx sql.Context) (sql.WindowInterval, sql.Wi
 	panic("implement me")
 }
 
+func (f *RowFramer) excluded() []sql.WindowInterval {
+	return f.exclusion.excluded
+}
+
+// RangeFramer frames each row with the rows whose ORDER BY values are within a distance of its own, for RANGE
+// frames. A CURRENT ROW bound, or an offset bound of a row with a NULL ORDER BY value, extends to the peers of the
+// row: the rows with the same value for every ORDER BY expression.
+type RangeFramer struct {
+	idx                          int
+	partitionStart, partitionEnd int
+	frameStart, frameEnd         int
+	partitionSet                 bool
+
+	start, end rangeFrameBound
+	peers      peerGroups
+	exclusion  frameExclusion
+	// orderBy is the only ORDER BY field of a frame with an offset bound, which is nil otherwise
+	orderBy *sql.SortField
+	// values holds the ORDER BY value of each row of the partition when orderBy is set, converted to float64 or
+	// time.Time, and nonNull is the interval of the rows where it isn't NULL
+	values  []interface{}
+	nonNull sql.WindowInterval
+}
+
+// rangeFrameBound is a bound of a RANGE frame, with its offset evaluated to a float64 or an *expression.TimeDelta
+type rangeFrameBound struct {
+	typ    sql.WindowFrameBoundType
+	offset interface{}
+}
+
+func (f *RangeFramer) NewFramer(interval sql.WindowInterval) sql.WindowFramer {
+	return &RangeFramer{
+		idx:            interval.Start,
+		partitionStart: interval.Start,
+		partitionEnd:   interval.End,
+		frameStart:     -1,
+		frameEnd:       -1,
+		partitionSet:   true,
+		// pass through parent state
+		start:     f.start,
+		end:       f.end,
+		orderBy:   f.orderBy,
+		peers:     peerGroups{orderBy: f.peers.orderBy},
+		exclusion: f.exclusion.newPartition(),
+	}
+}
+
+// Next implements sql.WindowFramer. RANGE frames are found from the ORDER BY values of the partition's rows, so they
+// need the partition buffer given to NextWithBuffer.
+func (f *RangeFramer) Next() (sql.WindowInterval, error) {
+	return sql.WindowInterval{}, ErrFramerNeedsBuffer
+}
+
+// NextWithBuffer implements sql.BufferedWindowFramer.
+func (f *RangeFramer) NextWithBuffer(ctx *sql.Context, buf sql.WindowBuffer) (sql.WindowInterval, error) {
+	if f.idx != 0 && f.idx >= f.partitionEnd || !f.partitionSet {
+		return sql.WindowInterval{}, io.EOF
+	}
+
+	partition := sql.WindowInterval{Start: f.partitionStart, End: f.partitionEnd}
+	if f.partitionStart == f.partitionEnd {
+		f.frameStart, f.frameEnd = f.partitionStart, f.partitionEnd
+		f.idx++
+		return f.Interval()
+	}
+
+	if f.idx == f.partitionStart {
+		err := f.startPartition(ctx, buf)
+		if err != nil {
+			return sql.WindowInterval{}, err
+		}
+	}
+
+	f.frameStart = f.boundIdx(f.start, false)
+	f.frameEnd = f.boundIdx(f.end, true)
+	if f.frameEnd < f.frameStart {
+		f.frameEnd = f.frameStart
+	}
+
+	err := f.exclusion.next(ctx, buf, f.idx, partition)
+	if err != nil {
+		return sql.WindowInterval{}, err
+	}
+
+	f.idx++
+	return f.Interval()
+}
+
+// startPartition evaluates the peer groups and ORDER BY values of the partition's rows.
+func (f *RangeFramer) startPartition(ctx *sql.Context, buf sql.WindowBuffer) error {
+	partition := sql.WindowInterval{Start: f.partitionStart, End: f.partitionEnd}
+	err := f.peers.startPartition(ctx, buf, partition)
+	if err != nil {
+		return err
+	}
+
+	if f.orderBy == nil {
+		return nil
+	}
+
+	typ := f.orderBy.Column.Type()
+	f.values = make([]interface{}, partition.End-partition.Start)
+	f.nonNull = sql.WindowInterval{Start: partition.End, End: partition.End}
+	for i := range f.values {
+		v, err := f.orderBy.Column.Eval(ctx, buf[partition.Start+i])
+		if err != nil {
+			return err
+		}
+		if v == nil {
+			continue
+		}
+
+		if sql.IsTime(typ) {
+			v, err = sql.Datetime.Convert(v)
+		} else {
+			v, err = sql.Float64.Convert(v)
+		}
+		if err != nil {
+			return err
+		}
+		f.values[i] = v
+
+		// NULLs are sorted together at one end of the partition, so the other rows are contiguous
+		if f.nonNull.Start == partition.End {
+			f.nonNull.Start = partition.Start + i
+		}
+		f.nonNull.End = partition.Start + i + 1
+	}
+	return nil
+}
+
+// boundIdx returns the index of the first row of the current frame, or the index after its last row if |isEnd| is
+// set.
+func (f *RangeFramer) boundIdx(b rangeFrameBound, isEnd bool) int {
+	peers := f.peers.interval(f.idx)
+	switch b.typ {
+	case sql.WindowFrameUnboundedPreceding:
+		return f.partitionStart
+	case sql.WindowFrameUnboundedFollowing:
+		return f.partitionEnd
+	case sql.WindowFrameCurrentRow:
+		if isEnd {
+			return peers.End
+		}
+		return peers.Start
+	}
+
+	v := f.values[f.idx-f.partitionStart]
+	if v == nil {
+		// a NULL is only within range of other NULLs
+		if isEnd {
+			return peers.End
+		}
+		return peers.Start
+	}
+
+	// Rows before the current one have lower values in ascending order and higher values in descending order
+	sign := 1
+	if b.typ == sql.WindowFramePreceding {
+		sign = -sign
+	}
+	descending := f.orderBy.Order == sql.Descending
+	if descending {
+		sign = -sign
+	}
+	bound := shiftRangeValue(v, b.offset, sign)
+
+	// the rows are sorted on their values, so this finds the first row that's past the bound
+	n := f.nonNull.End - f.nonNull.Start
+	return f.nonNull.Start + sort.Search(n, func(k int) bool {
+		cmp := compareRangeValues(f.values[f.nonNull.Start+k-f.partitionStart], bound)
+		if descending {
+			cmp = -cmp
+		}
+		if isEnd {
+			return cmp > 0
+		}
+		return cmp >= 0
+	})
+}
+
+// shiftRangeValue returns the ORDER BY value given plus the offset given if |sign| is positive, or minus it otherwise.
+func shiftRangeValue(v interface{}, offset interface{}, sign int) interface{} {
+	switch v := v.(type) {
+	case time.Time:
+		delta := offset.(*expression.TimeDelta)
+		if sign > 0 {
+			return delta.Add(v)
+		}
+		return delta.Sub(v)
+	default:
+		return v.(float64) + float64(sign)*offset.(float64)
+	}
+}
+
+// compareRangeValues compares two non-NULL ORDER BY values of a RANGE frame.
+func compareRangeValues(a, b interface{}) int {
+	switch a := a.(type) {
+	case time.Time:
+		b := b.(time.Time)
+		if a.Before(b) {
+			return -1
+		} else if a.After(b) {
+			return 1
+		}
+		return 0
+	default:
+		af, bf := a.(float64), b.(float64)
+		if af < bf {
+			return -1
+		} else if af > bf {
+			return 1
+		}
+		return 0
+	}
+}
+
+func (f *RangeFramer) FirstIdx() int {
+	return f.frameStart
+}
+
+func (f *RangeFramer) LastIdx() int {
+	return f.frameEnd
+}
+
+func (f *RangeFramer) Interval() (sql.WindowInterval, error) {
+	if !f.partitionSet {
+		return sql.WindowInterval{}, ErrPartitionNotSet
+	}
+	return sql.WindowInterval{Start: f.frameStart, End: f.frameEnd}, nil
+}
+
+func (f *RangeFramer) excluded() []sql.WindowInterval {
+	return f.exclusion.excluded
+}
+
+// peerGroups finds the peer group of each row of a partition: the rows with the same values for every ORDER BY
+// expression. Without an ORDER BY, every row of the partition is a peer of every other.
+type peerGroups struct {
+	orderBy        sql.SortFields
+	partitionStart int
+	// start and end hold the bounds of the peer group of each row, indexed from the start of the partition
+	start, end []int
+}
+
+func (p *peerGroups) startPartition(ctx *sql.Context, buf sql.WindowBuffer, partition sql.WindowInterval) error {
+	n := partition.End - partition.Start
+	p.partitionStart = partition.Start
+	p.start = make([]int, n)
+	p.end = make([]int, n)
+
+	groupStart := 0
+	var last sql.Row
+	for i := 0; i < n; i++ {
+		values := make(sql.Row, len(p.orderBy))
+		for j, sf := range p.orderBy {
+			v, err := sf.Column.Eval(ctx, buf[partition.Start+i])
+			if err != nil {
+				return err
+			}
+			values[j] = v
+		}
+
+		if last != nil {
+			same, err := p.samePeerGroup(last, values)
+			if err != nil {
+				return err
+			}
+			if !same {
+				for k := groupStart; k < i; k++ {
+					p.end[k] = i
+				}
+				groupStart = i
+			}
+		}
+		p.start[i] = groupStart
+		last = values
+	}
+	for k := groupStart; k < n; k++ {
+		p.end[k] = n
+	}
+	return nil
+}
+
+func (p *peerGroups) samePeerGroup(a, b sql.Row) (bool, error) {
+	for j, sf := range p.orderBy {
+		if a[j] == nil || b[j] == nil {
+			if a[j] != nil || b[j] != nil {
+				return false, nil
+			}
+			continue
+		}
+		cmp, err := sf.Column.Type().Compare(a[j], b[j])
+		if err != nil {
+			return false, err
+		}
+		if cmp != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// interval returns the peer group of the row at the index of the buffer given.
+func (p *peerGroups) interval(idx int) sql.WindowInterval {
+	i := idx - p.partitionStart
+	return sql.WindowInterval{Start: p.partitionStart + p.start[i], End: p.partitionStart + p.end[i]}
+}
+
+// excludingFramer is a sql.WindowFramer for frames with an EXCLUDE clause, which removes rows around the current row
+// from its frame.
+type excludingFramer interface {
+	sql.WindowFramer
+	// excluded returns the intervals of rows removed from the current frame, which may be outside of it
+	excluded() []sql.WindowInterval
+}
+
+var _ excludingFramer = (*RowFramer)(nil)
+var _ excludingFramer = (*RangeFramer)(nil)
+
+// frameExclusion tracks the rows an EXCLUDE clause removes from the frame of the current row.
+type frameExclusion struct {
+	exclusion sql.WindowFrameExclusion
+	peers     peerGroups
+	excluded  []sql.WindowInterval
+}
+
+func (e frameExclusion) newPartition() frameExclusion {
+	return frameExclusion{exclusion: e.exclusion, peers: peerGroups{orderBy: e.peers.orderBy}}
+}
+
+// usesPeers returns whether the rows excluded depend on the peer groups of the partition.
+func (e frameExclusion) usesPeers() bool {
+	return e.exclusion == sql.WindowFrameExcludeGroup || e.exclusion == sql.WindowFrameExcludeTies
+}
+
+// next updates the excluded rows for the row at |idx|, which is the first row of the partition when it's the
+// partition's start.
+func (e *frameExclusion) next(ctx *sql.Context, buf sql.WindowBuffer, idx int, partition sql.WindowInterval) error {
+	if e.exclusion == sql.WindowFrameExcludeNoOthers || partition.Start == partition.End {
+		return nil
+	}
+
+	if idx == partition.Start && e.exclusion != sql.WindowFrameExcludeCurrentRow {
+		err := e.peers.startPartition(ctx, buf, partition)
+		if err != nil {
+			return err
+		}
+	}
+
+	switch e.exclusion {
+	case sql.WindowFrameExcludeCurrentRow:
+		e.excluded = []sql.WindowInterval{{Start: idx, End: idx + 1}}
+	case sql.WindowFrameExcludeGroup:
+		e.excluded = []sql.WindowInterval{e.peers.interval(idx)}
+	case sql.WindowFrameExcludeTies:
+		peers := e.peers.interval(idx)
+		e.excluded = []sql.WindowInterval{{Start: peers.Start, End: idx}, {Start: idx + 1, End: peers.End}}
+	}
+	return nil
+}
+
 type PartitionFramer struct {
 	idx                          int
 	partitionStart, partitionEnd int
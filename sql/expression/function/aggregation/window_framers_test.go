@@ -18,10 +18,12 @@ import (
 	"errors"
 	"io"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 )
 
 func TestWindowFramers(t *testing.T) {
@@ -103,3 +105,167 @@ func TestWindowFramers(t *testing.T) {
 		})
 	}
 }
+
+func TestWindowFrames(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+
+	x := expression.NewGetField(0, sql.Int64, "x", true)
+	lit := func(n int64) sql.Expression {
+		return expression.NewLiteral(n, sql.Int64)
+	}
+	unboundedPreceding := sql.WindowFrameBound{Type: sql.WindowFrameUnboundedPreceding}
+	unboundedFollowing := sql.WindowFrameBound{Type: sql.WindowFrameUnboundedFollowing}
+	currentRow := sql.WindowFrameBound{Type: sql.WindowFrameCurrentRow}
+	preceding := func(e sql.Expression) sql.WindowFrameBound {
+		return sql.WindowFrameBound{Type: sql.WindowFramePreceding, Offset: e}
+	}
+	following := func(e sql.Expression) sql.WindowFrameBound {
+		return sql.WindowFrameBound{Type: sql.WindowFrameFollowing, Offset: e}
+	}
+	window := func(order sql.SortOrder, frame *sql.WindowFrame) *sql.Window {
+		w := sql.NewWindow(nil, sql.SortFields{{Column: x, Order: order}})
+		if frame != nil {
+			w = w.WithFrame(frame)
+		}
+		return w
+	}
+	rows := func(start, end sql.WindowFrameBound) *sql.WindowFrame {
+		return sql.NewWindowFrame(sql.WindowFrameUnitRows, start, end, sql.WindowFrameExcludeNoOthers)
+	}
+	rangeFrame := func(start, end sql.WindowFrameBound) *sql.WindowFrame {
+		return sql.NewWindowFrame(sql.WindowFrameUnitRange, start, end, sql.WindowFrameExcludeNoOthers)
+	}
+
+	// Two partitions, sorted on x
+	ascending := sql.WindowBuffer{{int64(1)}, {int64(2)}, {int64(2)}, {int64(4)}, {nil}, {int64(1)}, {int64(5)}}
+	ascendingPartitions := []sql.WindowInterval{{Start: 0, End: 4}, {Start: 4, End: 7}}
+	descending := sql.WindowBuffer{{int64(4)}, {int64(2)}, {int64(2)}, {int64(1)}}
+	descendingPartitions := []sql.WindowInterval{{Start: 0, End: 4}}
+
+	day := func(d int) time.Time {
+		return time.Date(2022, time.January, d, 0, 0, 0, 0, time.UTC)
+	}
+	dates := sql.WindowBuffer{{day(1)}, {day(2)}, {day(5)}}
+	datesPartitions := []sql.WindowInterval{{Start: 0, End: 3}}
+	date := expression.NewGetField(0, sql.Datetime, "d", true)
+	oneDay := expression.NewInterval(lit(1), "DAY")
+
+	tests := []struct {
+		name       string
+		window     *sql.Window
+		buffer     sql.WindowBuffer
+		partitions []sql.WindowInterval
+		expected   []sql.WindowInterval
+	}{
+		{
+			name:       "rows 1 preceding and 1 following",
+			window:     window(sql.Ascending, rows(preceding(lit(1)), following(lit(1)))),
+			buffer:     ascending,
+			partitions: ascendingPartitions,
+			expected: []sql.WindowInterval{
+				{Start: 0, End: 2}, {Start: 0, End: 3}, {Start: 1, End: 4}, {Start: 2, End: 4},
+				{Start: 4, End: 6}, {Start: 4, End: 7}, {Start: 5, End: 7},
+			},
+		},
+		{
+			name:       "rows 2 following and 3 following",
+			window:     window(sql.Ascending, rows(following(lit(2)), following(lit(3)))),
+			buffer:     ascending,
+			partitions: ascendingPartitions,
+			expected: []sql.WindowInterval{
+				{Start: 2, End: 4}, {Start: 3, End: 4}, {Start: 4, End: 4}, {Start: 4, End: 4},
+				{Start: 6, End: 7}, {Start: 7, End: 7}, {Start: 7, End: 7},
+			},
+		},
+		{
+			name:       "rows current row and unbounded following",
+			window:     window(sql.Ascending, rows(currentRow, unboundedFollowing)),
+			buffer:     ascending,
+			partitions: ascendingPartitions,
+			expected: []sql.WindowInterval{
+				{Start: 0, End: 4}, {Start: 1, End: 4}, {Start: 2, End: 4}, {Start: 3, End: 4},
+				{Start: 4, End: 7}, {Start: 5, End: 7}, {Start: 6, End: 7},
+			},
+		},
+		{
+			name:       "default frame with an order by includes peers",
+			window:     window(sql.Ascending, nil),
+			buffer:     ascending,
+			partitions: ascendingPartitions,
+			expected: []sql.WindowInterval{
+				{Start: 0, End: 1}, {Start: 0, End: 3}, {Start: 0, End: 3}, {Start: 0, End: 4},
+				{Start: 4, End: 5}, {Start: 4, End: 6}, {Start: 4, End: 7},
+			},
+		},
+		{
+			name:       "range current row",
+			window:     window(sql.Ascending, rangeFrame(currentRow, currentRow)),
+			buffer:     ascending,
+			partitions: ascendingPartitions,
+			expected: []sql.WindowInterval{
+				{Start: 0, End: 1}, {Start: 1, End: 3}, {Start: 1, End: 3}, {Start: 3, End: 4},
+				{Start: 4, End: 5}, {Start: 5, End: 6}, {Start: 6, End: 7},
+			},
+		},
+		{
+			name:       "range 1 preceding and 1 following",
+			window:     window(sql.Ascending, rangeFrame(preceding(lit(1)), following(lit(1)))),
+			buffer:     ascending,
+			partitions: ascendingPartitions,
+			expected: []sql.WindowInterval{
+				{Start: 0, End: 3}, {Start: 0, End: 3}, {Start: 0, End: 3}, {Start: 3, End: 4},
+				{Start: 4, End: 5}, {Start: 5, End: 6}, {Start: 6, End: 7},
+			},
+		},
+		{
+			name:       "range 1 preceding in descending order",
+			window:     window(sql.Descending, rangeFrame(preceding(lit(1)), currentRow)),
+			buffer:     descending,
+			partitions: descendingPartitions,
+			expected: []sql.WindowInterval{
+				{Start: 0, End: 1}, {Start: 1, End: 3}, {Start: 1, End: 3}, {Start: 1, End: 4},
+			},
+		},
+		{
+			name: "range interval preceding",
+			window: sql.NewWindow(nil, sql.SortFields{{Column: date, Order: sql.Ascending}}).
+				WithFrame(rangeFrame(preceding(oneDay), currentRow)),
+			buffer:     dates,
+			partitions: datesPartitions,
+			expected: []sql.WindowInterval{
+				{Start: 0, End: 1}, {Start: 0, End: 2}, {Start: 2, End: 3},
+			},
+		},
+		{
+			name:       "range unbounded",
+			window:     window(sql.Ascending, rangeFrame(unboundedPreceding, unboundedFollowing)),
+			buffer:     ascending,
+			partitions: ascendingPartitions,
+			expected: []sql.WindowInterval{
+				{Start: 0, End: 4}, {Start: 0, End: 4}, {Start: 0, End: 4}, {Start: 0, End: 4},
+				{Start: 4, End: 7}, {Start: 4, End: 7}, {Start: 4, End: 7},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			framer, err := NewWindowFramer(ctx, tt.window)
+			require.NoError(t, err)
+
+			var res []sql.WindowInterval
+			for _, p := range tt.partitions {
+				framer = framer.NewFramer(p)
+				for {
+					frame, err := nextFrame(ctx, framer, tt.buffer)
+					if errors.Is(err, io.EOF) {
+						break
+					}
+					require.NoError(t, err)
+					res = append(res, frame)
+				}
+			}
+			require.Equal(t, tt.expected, res)
+		})
+	}
+}
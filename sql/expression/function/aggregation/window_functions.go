@@ -16,7 +16,6 @@ package aggregation
 
 import (
 	"sort"
-	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
@@ -157,6 +156,10 @@ func (a *AvgAgg) Compute(ctx *sql.Context, interval sql.WindowInterval, buf sql.
 		nonNullCnt -= startIdx + 1
 		nonNullCnt += a.nullCnt[startIdx]
 	}
+	if nonNullCnt == 0 {
+		// the frame is empty, or only has NULLs
+		return nil
+	}
 	return computePrefixSum(interval, a.partitionStart, a.prefixSum) / float64(nonNullCnt)
 }
 
@@ -408,6 +411,11 @@ type GroupConcatAgg struct {
 	distinct map[string]struct{}
 	// original row order used for optional result sorting
 	rows []sql.Row
+	// partitions is the number of partitions started so far, used to number them in truncation warnings
+	partitions int
+	// res is the result for the current partition, which is the same for every row of it
+	res      interface{}
+	computed bool
 }
 
 func NewGroupConcatAgg(gc *GroupConcat) *GroupConcatAgg {
@@ -422,6 +430,8 @@ func (a *GroupConcatAgg) Dispose() {
 
 func (a *GroupConcatAgg) StartPartition(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) error {
 	a.Dispose()
+	a.partitions++
+	a.res, a.computed = nil, false
 	var err error
 	a.rows, a.distinct, err = a.filterToDistinct(ctx, buf[interval.Start:interval.End])
 	return err
@@ -432,6 +442,15 @@ func (a *GroupConcatAgg) NewSlidingFrameInterval(added, dropped sql.WindowInterv
 }
 
 func (a *GroupConcatAgg) Compute(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) interface{} {
+	if !a.computed {
+		a.res = a.compute(ctx)
+		a.computed = true
+	}
+	return a.res
+}
+
+// compute returns the result for the current partition, warning once if it is truncated.
+func (a *GroupConcatAgg) compute(ctx *sql.Context) interface{} {
 	rows := a.rows
 
 	if len(rows) == 0 {
@@ -452,31 +471,7 @@ func (a *GroupConcatAgg) Compute(ctx *sql.Context, interval sql.WindowInterval,
 		}
 	}
 
-	sb := strings.Builder{}
-	for i, row := range rows {
-		lastIdx := len(row) - 1
-		if i == 0 {
-			sb.WriteString(row[lastIdx].(string))
-		} else {
-			sb.WriteString(a.gc.separator)
-			sb.WriteString(row[lastIdx].(string))
-		}
-
-		// Don't allow the string to cross maxlen
-		if sb.Len() >= a.gc.maxLen {
-			break
-		}
-	}
-
-	ret := sb.String()
-
-	// There might be a couple of character differences even if we broke early in the loop
-	if len(ret) > a.gc.maxLen {
-		ret = ret[:a.gc.maxLen]
-	}
-
-	// Add this to handle any one off errors.
-	return ret
+	return a.gc.concat(ctx, rows, false, a.partitions)
 }
 
 func (a *GroupConcatAgg) filterToDistinct(ctx *sql.Context, buf sql.WindowBuffer) ([]sql.Row, map[string]struct{}, error) {
@@ -0,0 +1,150 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// WindowedAggregation is an aggregate function, like SUM or AVG, used as a window function with a PARTITION BY, ORDER
+// BY or frame clause. Each row gets the aggregate of the rows in its frame, such as a running total or a moving
+// average.
+type WindowedAggregation struct {
+	fn     sql.WindowAdaptableExpression
+	window *sql.Window
+}
+
+var _ sql.WindowAggregation = (*WindowedAggregation)(nil)
+
+// NewWindowedAggregation returns a WindowedAggregation of the aggregate function given over |window|.
+func NewWindowedAggregation(fn sql.WindowAdaptableExpression, window *sql.Window) *WindowedAggregation {
+	return &WindowedAggregation{fn: fn, window: window}
+}
+
+// Window implements sql.WindowAggregation
+func (a *WindowedAggregation) Window() *sql.Window {
+	return a.window
+}
+
+// WithWindow implements sql.WindowAggregation
+func (a *WindowedAggregation) WithWindow(window *sql.Window) (sql.WindowAggregation, error) {
+	na := *a
+	na.window = window
+	return &na, nil
+}
+
+// Resolved implements sql.Expression
+func (a *WindowedAggregation) Resolved() bool {
+	return a.fn.Resolved() && expression.ExpressionsResolved(a.window.ToExpressions()...)
+}
+
+func (a *WindowedAggregation) String() string {
+	return fmt.Sprintf("%s %s", a.fn, a.window)
+}
+
+func (a *WindowedAggregation) DebugString() string {
+	return fmt.Sprintf("%s %s", sql.DebugString(a.fn), sql.DebugString(a.window))
+}
+
+// Type implements sql.Expression
+func (a *WindowedAggregation) Type() sql.Type {
+	return a.fn.Type()
+}
+
+// IsNullable implements sql.Expression
+func (a *WindowedAggregation) IsNullable() bool {
+	return true
+}
+
+// Eval implements sql.Expression
+func (a *WindowedAggregation) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return nil, ErrEvalUnsupportedOnAggregation.New(a.fn.String())
+}
+
+// Children implements sql.Expression. The arguments of the aggregate function are its children, rather than the
+// function itself, so that it isn't taken for an aggregation outside of a window.
+func (a *WindowedAggregation) Children() []sql.Expression {
+	children := append([]sql.Expression{}, a.fn.Children()...)
+	return append(children, a.window.ToExpressions()...)
+}
+
+// WithChildren implements sql.Expression
+func (a *WindowedAggregation) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	numArgs := len(a.fn.Children())
+	if len(children) < numArgs {
+		return nil, sql.ErrInvalidChildrenNumber.New(a, len(children), numArgs+len(a.window.ToExpressions()))
+	}
+
+	fn, err := a.fn.WithChildren(children[:numArgs]...)
+	if err != nil {
+		return nil, err
+	}
+	window, err := a.window.FromExpressions(children[numArgs:])
+	if err != nil {
+		return nil, err
+	}
+	return &WindowedAggregation{fn: fn.(sql.WindowAdaptableExpression), window: window}, nil
+}
+
+// NewBuffer implements sql.WindowAggregation. The buffer holds the rows added, and the result for each of them once
+// Finish is called.
+func (a *WindowedAggregation) NewBuffer() sql.Row {
+	return sql.NewRow(make([]sql.Row, 0), nil)
+}
+
+// Add implements sql.WindowAggregation
+func (a *WindowedAggregation) Add(ctx *sql.Context, buffer, row sql.Row) error {
+	buffer[0] = append(buffer[0].([]sql.Row), row)
+	return nil
+}
+
+// Finish implements sql.WindowAggregation
+func (a *WindowedAggregation) Finish(ctx *sql.Context, buffer sql.Row) error {
+	rows := buffer[0].([]sql.Row)
+
+	fn, err := a.fn.NewWindowFunction()
+	if err != nil {
+		return err
+	}
+	framer, err := NewWindowFramer(ctx, a.window)
+	if err != nil {
+		return err
+	}
+
+	iter := NewWindowBlockIter(a.window.PartitionBy, a.window.OrderBy, []*Aggregation{NewAggregation(fn, framer)}, sql.RowsToRowIter(rows...))
+	results := make([]interface{}, 0, len(rows))
+	for {
+		row, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			iter.Close(ctx)
+			return err
+		}
+		results = append(results, row[0])
+	}
+	buffer[1] = results
+	return iter.Close(ctx)
+}
+
+// EvalRow implements sql.WindowAggregation
+func (a *WindowedAggregation) EvalRow(i int, buffer sql.Row) (interface{}, error) {
+	return buffer[1].([]interface{})[i], nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestWindowedAggregation(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+
+	p := expression.NewGetField(0, sql.Int64, "p", false)
+	x := expression.NewGetField(1, sql.Int64, "x", false)
+	v := expression.NewGetField(2, sql.Int64, "v", false)
+	rows := []sql.Row{
+		{int64(1), int64(2), int64(20)},
+		{int64(1), int64(1), int64(10)},
+		{int64(2), int64(1), int64(100)},
+		{int64(1), int64(2), int64(30)},
+		{int64(1), int64(4), int64(40)},
+		{int64(2), int64(3), int64(300)},
+	}
+
+	one := expression.NewLiteral(int64(1), sql.Int64)
+	unboundedPreceding := sql.WindowFrameBound{Type: sql.WindowFrameUnboundedPreceding}
+	unboundedFollowing := sql.WindowFrameBound{Type: sql.WindowFrameUnboundedFollowing}
+	currentRow := sql.WindowFrameBound{Type: sql.WindowFrameCurrentRow}
+	onePreceding := sql.WindowFrameBound{Type: sql.WindowFramePreceding, Offset: one}
+	oneFollowing := sql.WindowFrameBound{Type: sql.WindowFrameFollowing, Offset: one}
+	byX := sql.NewWindow([]sql.Expression{p}, sql.SortFields{{Column: x, Order: sql.Ascending}})
+
+	tests := []struct {
+		name     string
+		fn       sql.WindowAdaptableExpression
+		window   *sql.Window
+		expected []interface{}
+	}{
+		{
+			name:     "running sum with peers",
+			fn:       NewSum(v),
+			window:   byX,
+			expected: []interface{}{float64(60), float64(10), float64(100), float64(60), float64(100), float64(400)},
+		},
+		{
+			name: "sum of rows 1 preceding and current row",
+			fn:   NewSum(v),
+			window: byX.WithFrame(sql.NewWindowFrame(
+				sql.WindowFrameUnitRows, onePreceding, currentRow, sql.WindowFrameExcludeNoOthers)),
+			expected: []interface{}{float64(30), float64(10), float64(100), float64(50), float64(70), float64(400)},
+		},
+		{
+			name: "moving average over range 1 preceding and 1 following",
+			fn:   NewAvg(v),
+			window: byX.WithFrame(sql.NewWindowFrame(
+				sql.WindowFrameUnitRange, onePreceding, oneFollowing, sql.WindowFrameExcludeNoOthers)),
+			expected: []interface{}{float64(20), float64(20), float64(100), float64(20), float64(40), float64(300)},
+		},
+		{
+			name: "exclude current row",
+			fn:   NewSum(v),
+			window: byX.WithFrame(sql.NewWindowFrame(
+				sql.WindowFrameUnitRows, unboundedPreceding, unboundedFollowing, sql.WindowFrameExcludeCurrentRow)),
+			expected: []interface{}{float64(80), float64(90), float64(300), float64(70), float64(60), float64(100)},
+		},
+		{
+			name: "exclude group",
+			fn:   NewSum(v),
+			window: byX.WithFrame(sql.NewWindowFrame(
+				sql.WindowFrameUnitRows, unboundedPreceding, unboundedFollowing, sql.WindowFrameExcludeGroup)),
+			expected: []interface{}{float64(50), float64(90), float64(300), float64(50), float64(60), float64(100)},
+		},
+		{
+			name: "exclude ties",
+			fn:   NewSum(v),
+			window: byX.WithFrame(sql.NewWindowFrame(
+				sql.WindowFrameUnitRange, currentRow, currentRow, sql.WindowFrameExcludeTies)),
+			expected: []interface{}{float64(20), float64(10), float64(100), float64(30), float64(40), float64(300)},
+		},
+		{
+			name: "count of rows after the current row without a partition",
+			fn:   NewCount(v),
+			window: sql.NewWindow(nil, sql.SortFields{{Column: x, Order: sql.Ascending}}).WithFrame(sql.NewWindowFrame(
+				sql.WindowFrameUnitRows,
+				sql.WindowFrameBound{Type: sql.WindowFrameFollowing, Offset: expression.NewLiteral(int64(2), sql.Int64)},
+				sql.WindowFrameBound{Type: sql.WindowFrameFollowing, Offset: expression.NewLiteral(int64(3), sql.Int64)},
+				sql.WindowFrameExcludeNoOthers,
+			)),
+			expected: []interface{}{int64(2), int64(2), int64(2), int64(1), int64(0), int64(0)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := NewWindowedAggregation(tt.fn, tt.window)
+			buf := a.NewBuffer()
+			for _, row := range rows {
+				require.NoError(t, a.Add(ctx, buf, sql.NewRow(row...)))
+			}
+			require.NoError(t, a.Finish(ctx, buf))
+
+			res := make([]interface{}, len(rows))
+			for i := range rows {
+				var err error
+				res[i], err = a.EvalRow(i, buf)
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.expected, res)
+		})
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// Area is a function that returns the planar area of a polygon.
+type Area struct {
+	expression.UnaryExpression
+}
+
+var _ sql.FunctionExpression = (*Area)(nil)
+
+// NewArea creates a new Area expression.
+func NewArea(e sql.Expression) sql.Expression {
+	return &Area{expression.UnaryExpression{Child: e}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (a *Area) FunctionName() string {
+	return "st_area"
+}
+
+// Description implements sql.FunctionExpression
+func (a *Area) Description() string {
+	return "returns the area of the given polygon."
+}
+
+// Type implements the sql.Expression interface.
+func (a *Area) Type() sql.Type {
+	return sql.Float64
+}
+
+func (a *Area) String() string {
+	return fmt.Sprintf("ST_AREA(%s)", a.Child.String())
+}
+
+// WithChildren implements the Expression interface.
+func (a *Area) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(a, len(children), 1)
+	}
+	return NewArea(children[0]), nil
+}
+
+// ringArea calculates the area enclosed by a closed linestring using the shoelace formula.
+func ringArea(l sql.Linestring) float64 {
+	var area float64
+	for i := 0; i < len(l.Points)-1; i++ {
+		p, q := l.Points[i], l.Points[i+1]
+		area += p.X*q.Y - q.X*p.Y
+	}
+	return math.Abs(area) / 2
+}
+
+// PolygonArea returns the area of a polygon, which is the area of its exterior ring minus the areas of its interior
+// rings.
+func PolygonArea(p sql.Polygon) float64 {
+	if len(p.Lines) == 0 {
+		return 0
+	}
+	area := ringArea(p.Lines[0])
+	for _, l := range p.Lines[1:] {
+		area -= ringArea(l)
+	}
+	return area
+}
+
+// Eval implements the sql.Expression interface.
+func (a *Area) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	val, err := a.Child.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	if val == nil {
+		return nil, nil
+	}
+
+	switch v := val.(type) {
+	case sql.Point, sql.Linestring:
+		return float64(0), nil
+	case sql.Polygon:
+		return PolygonArea(v), nil
+	case sql.MultiPoint, sql.MultiLinestring:
+		return float64(0), nil
+	case sql.MultiPolygon:
+		var area float64
+		for _, p := range v.Polygons {
+			area += PolygonArea(p)
+		}
+		return area, nil
+	default:
+		return nil, sql.ErrInvalidGISData.New(a.FunctionName())
+	}
+}
+
+// STLength is a function that returns the length of a linestring.
+type STLength struct {
+	expression.UnaryExpression
+}
+
+var _ sql.FunctionExpression = (*STLength)(nil)
+
+// NewSTLength creates a new STLength expression.
+func NewSTLength(e sql.Expression) sql.Expression {
+	return &STLength{expression.UnaryExpression{Child: e}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (s *STLength) FunctionName() string {
+	return "st_length"
+}
+
+// Description implements sql.FunctionExpression
+func (s *STLength) Description() string {
+	return "returns the length of the given linestring."
+}
+
+// Type implements the sql.Expression interface.
+func (s *STLength) Type() sql.Type {
+	return sql.Float64
+}
+
+func (s *STLength) String() string {
+	return fmt.Sprintf("ST_LENGTH(%s)", s.Child.String())
+}
+
+// WithChildren implements the Expression interface.
+func (s *STLength) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 1)
+	}
+	return NewSTLength(children[0]), nil
+}
+
+// LinestringLength returns the sum of the lengths of each segment of a linestring.
+func LinestringLength(l sql.Linestring) float64 {
+	var length float64
+	for i := 0; i < len(l.Points)-1; i++ {
+		p, q := l.Points[i], l.Points[i+1]
+		length += math.Hypot(q.X-p.X, q.Y-p.Y)
+	}
+	return length
+}
+
+// Eval implements the sql.Expression interface.
+func (s *STLength) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	val, err := s.Child.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	if val == nil {
+		return nil, nil
+	}
+
+	switch v := val.(type) {
+	case sql.Point:
+		return float64(0), nil
+	case sql.Linestring:
+		return LinestringLength(v), nil
+	case sql.MultiLinestring:
+		var length float64
+		for _, l := range v.Lines {
+			length += LinestringLength(l)
+		}
+		return length, nil
+	default:
+		return nil, sql.ErrInvalidGISData.New(s.FunctionName())
+	}
+}
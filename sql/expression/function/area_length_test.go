@@ -0,0 +1,117 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestArea(t *testing.T) {
+	square := sql.Linestring{Points: []sql.Point{{X: 0, Y: 0}, {X: 0, Y: 4}, {X: 4, Y: 4}, {X: 4, Y: 0}, {X: 0, Y: 0}}}
+	hole := sql.Linestring{Points: []sql.Point{{X: 1, Y: 1}, {X: 1, Y: 2}, {X: 2, Y: 2}, {X: 2, Y: 1}, {X: 1, Y: 1}}}
+
+	t.Run("polygon", func(t *testing.T) {
+		require := require.New(t)
+		f := NewArea(expression.NewLiteral(sql.Polygon{Lines: []sql.Linestring{square}}, sql.PolygonType{}))
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Equal(16.0, v)
+	})
+
+	t.Run("polygon with interior ring", func(t *testing.T) {
+		require := require.New(t)
+		f := NewArea(expression.NewLiteral(sql.Polygon{Lines: []sql.Linestring{square, hole}}, sql.PolygonType{}))
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Equal(15.0, v)
+	})
+
+	t.Run("triangle", func(t *testing.T) {
+		require := require.New(t)
+		triangle := sql.Linestring{Points: []sql.Point{{X: 0, Y: 0}, {X: 1, Y: 1}, {X: 1, Y: 0}, {X: 0, Y: 0}}}
+		f := NewArea(expression.NewLiteral(sql.Polygon{Lines: []sql.Linestring{triangle}}, sql.PolygonType{}))
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Equal(0.5, v)
+	})
+
+	t.Run("point and linestring", func(t *testing.T) {
+		require := require.New(t)
+		f := NewArea(expression.NewLiteral(sql.Point{X: 1, Y: 2}, sql.PointType{}))
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Equal(0.0, v)
+
+		f = NewArea(expression.NewLiteral(square, sql.LinestringType{}))
+		v, err = f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Equal(0.0, v)
+	})
+
+	t.Run("null", func(t *testing.T) {
+		require := require.New(t)
+		f := NewArea(expression.NewLiteral(nil, sql.Null))
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Equal(nil, v)
+	})
+
+	t.Run("non-geometry", func(t *testing.T) {
+		require := require.New(t)
+		f := NewArea(expression.NewLiteral("abc", sql.LongText))
+		_, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.Error(err)
+	})
+}
+
+func TestSTLength(t *testing.T) {
+	t.Run("linestring", func(t *testing.T) {
+		require := require.New(t)
+		line := sql.Linestring{Points: []sql.Point{{X: 0, Y: 0}, {X: 3, Y: 4}, {X: 3, Y: 0}}}
+		f := NewSTLength(expression.NewLiteral(line, sql.LinestringType{}))
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Equal(9.0, v)
+	})
+
+	t.Run("point", func(t *testing.T) {
+		require := require.New(t)
+		f := NewSTLength(expression.NewLiteral(sql.Point{X: 1, Y: 2}, sql.PointType{}))
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Equal(0.0, v)
+	})
+
+	t.Run("null", func(t *testing.T) {
+		require := require.New(t)
+		f := NewSTLength(expression.NewLiteral(nil, sql.Null))
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Equal(nil, v)
+	})
+
+	t.Run("polygon", func(t *testing.T) {
+		require := require.New(t)
+		square := sql.Linestring{Points: []sql.Point{{X: 0, Y: 0}, {X: 0, Y: 1}, {X: 1, Y: 1}, {X: 0, Y: 0}}}
+		f := NewSTLength(expression.NewLiteral(sql.Polygon{Lines: []sql.Linestring{square}}, sql.PolygonType{}))
+		_, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.Error(err)
+	})
+}
@@ -0,0 +1,72 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// evalCounter is a literal that counts the times it's evaluated.
+type evalCounter struct {
+	*expression.Literal
+	evals int
+}
+
+func (e *evalCounter) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	e.evals++
+	return e.Literal.Eval(ctx, row)
+}
+
+func TestBenchmark(t *testing.T) {
+	testCases := []struct {
+		name     string
+		count    sql.Expression
+		expected interface{}
+		evals    int
+	}{
+		{"null count", expression.NewLiteral(nil, sql.Null), nil, 0},
+		{"negative count", expression.NewLiteral(int64(-1), sql.Int64), nil, 0},
+		{"zero count", expression.NewLiteral(int64(0), sql.Int64), int32(0), 0},
+		{"positive count", expression.NewLiteral(int64(1000), sql.Int64), int32(0), 1000},
+		{"string count", expression.NewLiteral("10", sql.LongText), int32(0), 10},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			counter := &evalCounter{Literal: expression.NewLiteral(int64(1), sql.Int64)}
+			f := NewBenchmark(tt.count, counter)
+			require.Equal(t, tt.expected, eval(t, f, nil))
+			require.Equal(t, tt.evals, counter.evals)
+		})
+	}
+}
+
+func TestBenchmarkCancelled(t *testing.T) {
+	octx, cancel := context.WithCancel(context.Background())
+	cancel()
+	ctx := sql.NewContext(octx)
+
+	counter := &evalCounter{Literal: expression.NewLiteral(int64(1), sql.Int64)}
+	f := NewBenchmark(expression.NewLiteral(int64(1000000000), sql.Int64), counter)
+	_, err := f.Eval(ctx, nil)
+	require.Equal(t, context.Canceled, err)
+	require.Equal(t, 0, counter.evals)
+}
@@ -16,14 +16,19 @@ package function
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
+	"sync"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 )
 
-// Coalesce returns the first non-NULL value in the list, or NULL if there are no non-NULL values.
+// Coalesce returns the first non-NULL value in the list, or NULL if there are no non-NULL values. The arguments after
+// the first non-NULL one aren't evaluated.
 type Coalesce struct {
 	args []sql.Expression
+	conv aggregateConverter
 }
 
 var _ sql.FunctionExpression = (*Coalesce)(nil)
@@ -34,7 +39,7 @@ func NewCoalesce(args ...sql.Expression) (sql.Expression, error) {
 		return nil, sql.ErrInvalidArgumentNumber.New("COALESCE", "1 or more", 0)
 	}
 
-	return &Coalesce{args}, nil
+	return &Coalesce{args: args}, nil
 }
 
 // FunctionName implements sql.FunctionExpression
@@ -50,29 +55,16 @@ func (c *Coalesce) Description() string {
 // Type implements the sql.Expression interface.
 // The return type of Type() is the aggregated type of the argument types.
 func (c *Coalesce) Type() sql.Type {
-	for _, arg := range c.args {
-		if arg == nil {
-			continue
-		}
-		t := arg.Type()
-		if t == nil {
-			continue
-		}
-		return t
-	}
-
-	return nil
+	return aggregateType(c.args...)
 }
 
 // IsNullable implements the sql.Expression interface.
-// Returns true if all arguments are nil
-// or of the first non-nil argument is nullable, otherwise false.
+// Returns false if any argument can't be NULL, since the arguments after it are never reached, otherwise true.
 func (c *Coalesce) IsNullable() bool {
 	for _, arg := range c.args {
-		if arg == nil {
-			continue
+		if arg != nil && !arg.IsNullable() {
+			return false
 		}
-		return arg.IsNullable()
 	}
 	return true
 }
@@ -111,7 +103,7 @@ func (c *Coalesce) Children() []sql.Expression { return c.args }
 // The function evaluates the first non-nil argument. If the value is nil,
 // then we keep going, otherwise we return the first non-nil value.
 func (c *Coalesce) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
-	for _, arg := range c.args {
+	for i, arg := range c.args {
 		if arg == nil {
 			continue
 		}
@@ -125,8 +117,56 @@ func (c *Coalesce) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 			continue
 		}
 
-		return val, nil
+		return c.conv.convert(val, i, c.args...)
 	}
 
 	return nil, nil
 }
+
+// aggregateType returns the type of the result of a function whose value is that of any of the expressions given, such
+// as COALESCE. NULL expressions are ignored, and the type of the expressions is kept if all of them have the same one.
+// Otherwise, it's the type CASE aggregates them into. It's nil if all expressions are NULL.
+func aggregateType(exprs ...sql.Expression) sql.Type {
+	var types []sql.Type
+	for _, e := range exprs {
+		if sql.IsNull(e) || e.Type() == nil {
+			continue
+		}
+		types = append(types, e.Type())
+	}
+	if len(types) == 0 {
+		return nil
+	}
+
+	for _, t := range types[1:] {
+		if !reflect.DeepEqual(t, types[0]) {
+			return expression.CombinedType(types...)
+		}
+	}
+	return types[0]
+}
+
+// aggregateConverter converts the values of expressions to the aggregated type of all of them, so that the type of the
+// result doesn't depend on which expression it came from. The type, and which expressions need their values converted,
+// are worked out the first time a value is converted, since they don't change between rows.
+type aggregateConverter struct {
+	once        sync.Once
+	typ         sql.Type
+	needConvert []bool
+}
+
+// convert converts the value given, which is that of exprs[i], to the aggregated type of exprs.
+func (c *aggregateConverter) convert(val interface{}, i int, exprs ...sql.Expression) (interface{}, error) {
+	c.once.Do(func() {
+		c.typ = aggregateType(exprs...)
+		_, isDecimal := c.typ.(sql.DecimalType)
+		c.needConvert = make([]bool, len(exprs))
+		for j, e := range exprs {
+			c.needConvert[j] = e != nil && c.typ != nil && (isDecimal || !reflect.DeepEqual(c.typ, e.Type()))
+		}
+	})
+	if !c.needConvert[i] {
+		return val, nil
+	}
+	return c.typ.Convert(val)
+}
@@ -15,6 +15,7 @@
 package function
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -78,3 +79,128 @@ func TestComposeCoalasce(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 1, v)
 }
+
+func TestCoalesceDecimalScale(t *testing.T) {
+	dec51 := sql.MustCreateDecimalType(5, 1)
+	dec43 := sql.MustCreateDecimalType(4, 3)
+
+	testCases := []struct {
+		name     string
+		input    []sql.Expression
+		expected interface{}
+		typ      sql.Type
+	}{
+		{
+			"coalesce(NULL, 1.250)",
+			[]sql.Expression{expression.NewLiteral(nil, sql.Null), expression.NewLiteral("1.250", dec43)},
+			"1.250",
+			dec43,
+		},
+		{
+			"coalesce(12.5, 1.250)",
+			[]sql.Expression{expression.NewLiteral("12.5", dec51), expression.NewLiteral("1.250", dec43)},
+			"12.500",
+			sql.MustCreateDecimalType(7, 3),
+		},
+		{
+			"coalesce(NULL, 1.250, 12.5)",
+			[]sql.Expression{nil, expression.NewLiteral("1.250", dec43), expression.NewLiteral("12.5", dec51)},
+			"1.250",
+			sql.MustCreateDecimalType(7, 3),
+		},
+		{
+			"coalesce(12.5, 3)",
+			[]sql.Expression{expression.NewLiteral("12.5", dec51), expression.NewLiteral(3, sql.Int32)},
+			"12.5",
+			sql.MustCreateDecimalType(11, 1),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewCoalesce(tt.input...)
+			require.NoError(t, err)
+
+			require.Equal(t, tt.typ, c.Type())
+			v, err := c.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, v)
+		})
+	}
+}
+
+func TestCoalesceTypeAggregation(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    []sql.Expression
+		expected interface{}
+		typ      sql.Type
+	}{
+		{
+			"coalesce(int8, int64)",
+			[]sql.Expression{expression.NewLiteral(int8(1), sql.Int8), expression.NewLiteral(int64(2), sql.Int64)},
+			int64(1),
+			sql.Int64,
+		},
+		{
+			"coalesce(NULL, int64, float64)",
+			[]sql.Expression{expression.NewLiteral(nil, sql.Null), expression.NewLiteral(int64(2), sql.Int64), expression.NewLiteral(2.5, sql.Float64)},
+			float64(2),
+			sql.Float64,
+		},
+		{
+			"coalesce(int64, text)",
+			[]sql.Expression{expression.NewLiteral(int64(1), sql.Int64), expression.NewLiteral("a", sql.LongText)},
+			"1",
+			sql.LongText,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewCoalesce(tt.input...)
+			require.NoError(t, err)
+
+			require.Equal(t, tt.typ, c.Type())
+			v, err := c.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, v)
+		})
+	}
+}
+
+// failingExpr is a literal that fails to evaluate.
+type failingExpr struct {
+	*expression.Literal
+}
+
+func (e *failingExpr) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return nil, errors.New("should not be evaluated")
+}
+
+func TestCoalesceShortCircuit(t *testing.T) {
+	counter := &evalCounter{Literal: expression.NewLiteral(int64(2), sql.Int64)}
+	failing := &failingExpr{expression.NewLiteral(int64(3), sql.Int64)}
+	c, err := NewCoalesce(expression.NewLiteral(nil, sql.Null), expression.NewLiteral(int64(1), sql.Int64), counter, failing)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), eval(t, c, nil))
+	require.Equal(t, 0, counter.evals)
+
+	c, err = NewCoalesce(expression.NewLiteral(nil, sql.Null), counter, failing)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), eval(t, c, nil))
+	require.Equal(t, 1, counter.evals)
+}
+
+func TestCoalesceNullable(t *testing.T) {
+	nullable := expression.NewGetField(0, sql.Int64, "a", true)
+	notNullable := expression.NewGetField(1, sql.Int64, "b", false)
+
+	c, err := NewCoalesce(nullable, notNullable)
+	require.NoError(t, err)
+	require.False(t, c.IsNullable())
+
+	c, err = NewCoalesce(nullable, nullable)
+	require.NoError(t, err)
+	require.True(t, c.IsNullable())
+}
@@ -0,0 +1,217 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// compressedLengthMask masks the length prefix of a compressed string. MySQL only uses the low 30 bits of it.
+const compressedLengthMask = 0x3FFFFFFF
+
+// errZlibDataCode is the code of MySQL's ER_ZLIB_Z_DATA_ERROR warning.
+const errZlibDataCode = 1259
+
+// Compress function compresses a string the way MySQL does: the length of the string as a 4-byte little-endian
+// integer, followed by the zlib compressed string.
+// https://dev.mysql.com/doc/refman/8.0/en/encryption-functions.html#function_compress
+type Compress struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*Compress)(nil)
+
+// NewCompress returns a new Compress function expression
+func NewCompress(arg sql.Expression) sql.Expression {
+	return &Compress{NewUnaryFunc(arg, "COMPRESS", sql.LongBlob)}
+}
+
+// Description implements sql.FunctionExpression
+func (f *Compress) Description() string {
+	return "returns the result as a binary string."
+}
+
+// Eval implements sql.Expression
+func (f *Compress) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	arg, err := f.EvalChild(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if arg == nil {
+		return nil, nil
+	}
+
+	val, err := sql.LongBlob.Convert(arg)
+	if err != nil {
+		return nil, err
+	}
+	str := val.(string)
+	if len(str) == 0 {
+		return "", nil
+	}
+
+	var buf bytes.Buffer
+	var length [4]byte
+	binary.LittleEndian.PutUint32(length[:], uint32(len(str))&compressedLengthMask)
+	buf.Write(length[:])
+
+	w := zlib.NewWriter(&buf)
+	if _, err = io.WriteString(w, str); err != nil {
+		return nil, err
+	}
+	if err = w.Close(); err != nil {
+		return nil, err
+	}
+
+	// MySQL appends a '.' to compressed strings ending in a space, so they survive the trimming of CHAR columns
+	if buf.Bytes()[buf.Len()-1] == ' ' {
+		buf.WriteByte('.')
+	}
+
+	return buf.String(), nil
+}
+
+// WithChildren implements sql.Expression
+func (f *Compress) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 1)
+	}
+	return NewCompress(children[0]), nil
+}
+
+// Uncompress function uncompresses a string compressed by COMPRESS, or returns NULL if it isn't a compressed string.
+// https://dev.mysql.com/doc/refman/8.0/en/encryption-functions.html#function_uncompress
+type Uncompress struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*Uncompress)(nil)
+
+// NewUncompress returns a new Uncompress function expression
+func NewUncompress(arg sql.Expression) sql.Expression {
+	return &Uncompress{NewUnaryFunc(arg, "UNCOMPRESS", sql.LongBlob)}
+}
+
+// Description implements sql.FunctionExpression
+func (f *Uncompress) Description() string {
+	return "uncompresses a string compressed."
+}
+
+// Eval implements sql.Expression
+func (f *Uncompress) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	arg, err := f.EvalChild(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if arg == nil {
+		return nil, nil
+	}
+
+	val, err := sql.LongBlob.Convert(arg)
+	if err != nil {
+		return nil, err
+	}
+	str := val.(string)
+	if len(str) == 0 {
+		return "", nil
+	}
+	if len(str) <= 4 {
+		ctx.Warn(errZlibDataCode, "ZLIB: Input data corrupted")
+		return nil, nil
+	}
+
+	length := int64(binary.LittleEndian.Uint32([]byte(str[:4])) & compressedLengthMask)
+	r, err := zlib.NewReader(bytes.NewReader([]byte(str[4:])))
+	if err != nil {
+		ctx.Warn(errZlibDataCode, "ZLIB: Input data corrupted")
+		return nil, nil
+	}
+
+	// Read one byte more than the length prefix, to tell strings longer than it apart
+	uncompressed, err := ioutil.ReadAll(io.LimitReader(r, length+1))
+	if err != nil || int64(len(uncompressed)) > length {
+		ctx.Warn(errZlibDataCode, "ZLIB: Input data corrupted")
+		return nil, nil
+	}
+
+	return string(uncompressed), nil
+}
+
+// WithChildren implements sql.Expression
+func (f *Uncompress) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 1)
+	}
+	return NewUncompress(children[0]), nil
+}
+
+// UncompressedLength function returns the length of a string compressed by COMPRESS before it was compressed, as
+// stored in its length prefix.
+// https://dev.mysql.com/doc/refman/8.0/en/encryption-functions.html#function_uncompressed-length
+type UncompressedLength struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*UncompressedLength)(nil)
+
+// NewUncompressedLength returns a new UncompressedLength function expression
+func NewUncompressedLength(arg sql.Expression) sql.Expression {
+	return &UncompressedLength{NewUnaryFunc(arg, "UNCOMPRESSED_LENGTH", sql.Uint32)}
+}
+
+// Description implements sql.FunctionExpression
+func (f *UncompressedLength) Description() string {
+	return "returns the length of a string before compression."
+}
+
+// Eval implements sql.Expression
+func (f *UncompressedLength) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	arg, err := f.EvalChild(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if arg == nil {
+		return nil, nil
+	}
+
+	val, err := sql.LongBlob.Convert(arg)
+	if err != nil {
+		return nil, err
+	}
+	str := val.(string)
+	if len(str) == 0 {
+		return uint32(0), nil
+	}
+	if len(str) <= 4 {
+		ctx.Warn(errZlibDataCode, "ZLIB: Input data corrupted")
+		return uint32(0), nil
+	}
+
+	return binary.LittleEndian.Uint32([]byte(str[:4])) & compressedLengthMask, nil
+}
+
+// WithChildren implements sql.Expression
+func (f *UncompressedLength) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 1)
+	}
+	return NewUncompressedLength(children[0]), nil
+}
@@ -0,0 +1,107 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// compressedA is COMPRESS('a'), as returned by MySQL 8.0
+const compressedA = "\x01\x00\x00\x00\x78\x9c\x4b\x04\x00\x00\x62\x00\x62"
+
+func TestCompressRoundTrip(t *testing.T) {
+	for _, s := range []string{"a", "abc", strings.Repeat("hello world ", 1000), "ends in a space "} {
+		t.Run(fmt.Sprintf("%d bytes", len(s)), func(t *testing.T) {
+			compressed, err := NewCompress(expression.NewLiteral(s, sql.LongText)).Eval(sql.NewEmptyContext(), nil)
+			require.NoError(t, err)
+			require.Equal(t, uint32(len(s)), eval(t, NewUncompressedLength(expression.NewLiteral(compressed, sql.LongBlob)), nil))
+			require.Equal(t, s, eval(t, NewUncompress(expression.NewLiteral(compressed, sql.LongBlob)), nil))
+		})
+	}
+}
+
+func TestCompress(t *testing.T) {
+	tests := []struct {
+		val      sql.Expression
+		expected interface{}
+	}{
+		{expression.NewLiteral(nil, sql.Null), nil},
+		{expression.NewLiteral("", sql.LongText), ""},
+	}
+
+	for _, test := range tests {
+		f := NewCompress(test.val)
+		t.Run(f.String(), func(t *testing.T) {
+			require.Equal(t, test.expected, eval(t, f, nil))
+		})
+	}
+
+	f := NewCompress(expression.NewLiteral(strings.Repeat("x", 1000), sql.LongText))
+	require.Less(t, len(eval(t, f, nil).(string)), 1000)
+}
+
+func TestUncompress(t *testing.T) {
+	tests := []struct {
+		name     string
+		val      sql.Expression
+		expected interface{}
+	}{
+		{"NULL", expression.NewLiteral(nil, sql.Null), nil},
+		{"empty string", expression.NewLiteral("", sql.LongBlob), ""},
+		{"compressed by MySQL", expression.NewLiteral(compressedA, sql.LongBlob), "a"},
+		{"compressed by MySQL, as []byte", expression.NewLiteral([]byte(compressedA), sql.LongBlob), "a"},
+		{"trailing '.'", expression.NewLiteral(compressedA+".", sql.LongBlob), "a"},
+		{"not compressed", expression.NewLiteral("abcdefgh", sql.LongText), nil},
+		{"only a length prefix", expression.NewLiteral("\x01\x00\x00\x00", sql.LongBlob), nil},
+		{"truncated", expression.NewLiteral(compressedA[:8], sql.LongBlob), nil},
+		{"length prefix too short", expression.NewLiteral("\x00\x00\x00\x00"+compressedA[4:], sql.LongBlob), nil},
+	}
+
+	for _, test := range tests {
+		f := NewUncompress(test.val)
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, eval(t, f, nil))
+		})
+	}
+}
+
+func TestUncompressedLength(t *testing.T) {
+	tests := []struct {
+		name     string
+		val      sql.Expression
+		expected interface{}
+	}{
+		{"NULL", expression.NewLiteral(nil, sql.Null), nil},
+		{"empty string", expression.NewLiteral("", sql.LongBlob), uint32(0)},
+		{"compressed by MySQL", expression.NewLiteral(compressedA, sql.LongBlob), uint32(1)},
+		{"too short", expression.NewLiteral("abc", sql.LongText), uint32(0)},
+		{"length prefix only", expression.NewLiteral("\x10\x27\x00\x00\x00", sql.LongBlob), uint32(10000)},
+		{"top bits of the length prefix are ignored", expression.NewLiteral("\x01\x00\x00\xc0\x00", sql.LongBlob), uint32(1)},
+	}
+
+	for _, test := range tests {
+		f := NewUncompressedLength(test.val)
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, eval(t, f, nil))
+		})
+	}
+}
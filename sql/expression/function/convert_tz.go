@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -121,12 +122,12 @@ func (c *ConvertTz) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 
 // convertTimeZone returns the conversion of t from timezone fromLocation to toLocation.
 func convertTimeZone(datetime time.Time, fromLocation string, toLocation string) (time.Time, bool) {
-	fLoc, err := time.LoadLocation(fromLocation)
+	fLoc, err := loadTimeZone(fromLocation)
 	if err != nil {
 		return time.Time{}, false
 	}
 
-	tLoc, err := time.LoadLocation(toLocation)
+	tLoc, err := loadTimeZone(toLocation)
 	if err != nil {
 		return time.Time{}, false
 	}
@@ -136,6 +137,15 @@ func convertTimeZone(datetime time.Time, fromLocation string, toLocation string)
 	return datetime.Add(delta), true
 }
 
+// loadTimeZone returns the location of the timezone given. As for the time_zone system variable, SYSTEM is the
+// timezone of the server.
+func loadTimeZone(name string) (*time.Location, error) {
+	if strings.EqualFold(name, "SYSTEM") {
+		return time.Local, nil
+	}
+	return time.LoadLocation(name)
+}
+
 // getCopy recreates the time t in the wanted timezone.
 func getCopy(t time.Time, loc *time.Location) time.Time {
 	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc).UTC()
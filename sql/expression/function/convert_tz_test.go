@@ -61,6 +61,13 @@ func TestConvertTz(t *testing.T) {
 			toTimeZone:     "US/Eastern",
 			expectedResult: time.Date(2004, 1, 1, 13, 0, 0, 0, time.UTC),
 		},
+		{
+			name:           "System timezone to itself",
+			datetime:       "2004-01-01 12:00:00",
+			fromTimeZone:   "SYSTEM",
+			toTimeZone:     "system",
+			expectedResult: time.Date(2004, 1, 1, 12, 0, 0, 0, time.UTC),
+		},
 		{
 			name:           "Simple time shift",
 			datetime:       "2004-01-01 12:00:00",
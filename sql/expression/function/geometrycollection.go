@@ -0,0 +1,83 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// GeometryCollection is a function that returns a geometrycollection type containing the given geometries.
+type GeometryCollection struct {
+	expression.NaryExpression
+}
+
+var _ sql.FunctionExpression = (*GeometryCollection)(nil)
+
+// NewGeometryCollection creates a new geometrycollection expression.
+func NewGeometryCollection(args ...sql.Expression) (sql.Expression, error) {
+	return &GeometryCollection{expression.NaryExpression{ChildExpressions: args}}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (g *GeometryCollection) FunctionName() string {
+	return "geometrycollection"
+}
+
+// Description implements sql.FunctionExpression
+func (g *GeometryCollection) Description() string {
+	return "returns a new geometrycollection."
+}
+
+// Type implements the sql.Expression interface.
+func (g *GeometryCollection) Type() sql.Type {
+	return sql.GeometryCollectionType{}
+}
+
+func (g *GeometryCollection) String() string {
+	var args = make([]string, len(g.ChildExpressions))
+	for i, arg := range g.ChildExpressions {
+		args[i] = arg.String()
+	}
+	return fmt.Sprintf("GEOMETRYCOLLECTION(%s)", strings.Join(args, ","))
+}
+
+// WithChildren implements the Expression interface.
+func (g *GeometryCollection) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewGeometryCollection(children...)
+}
+
+// Eval implements the sql.Expression interface.
+func (g *GeometryCollection) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	var geoms = make([]interface{}, len(g.ChildExpressions))
+	for i, arg := range g.ChildExpressions {
+		val, err := arg.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		// Must be a geometry type, throw error otherwise
+		switch v := val.(type) {
+		case sql.Point, sql.Linestring, sql.Polygon, sql.MultiPoint, sql.MultiLinestring, sql.MultiPolygon, sql.GeometryCollection:
+			geoms[i] = v
+		default:
+			return nil, sql.ErrIllegalGISValue.New(v)
+		}
+	}
+
+	return sql.GeometryCollection{Geometries: geoms}, nil
+}
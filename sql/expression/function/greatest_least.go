@@ -16,19 +16,15 @@ package function
 
 import (
 	"fmt"
-	"strconv"
 	"strings"
-	"time"
 
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
 )
 
-var ErrUintOverflow = errors.NewKind(
-	"Unsigned integer too big to fit on signed integer")
-
-// compEval is used to implement Greatest/Least Eval() using a comparison function
+// compEval is used to implement Greatest/Least Eval() using a comparison function. Each argument is converted to the
+// return type and compared with it, and NULL is returned if any argument is NULL.
 func compEval(
 	returnType sql.Type,
 	args []sql.Expression,
@@ -41,112 +37,53 @@ func compEval(
 		return nil, nil
 	}
 
-	var selectedNum float64
-	var selectedString string
-	var selectedTime time.Time
-
+	var selected interface{}
 	for i, arg := range args {
 		val, err := arg.Eval(ctx, row)
 		if err != nil {
 			return nil, err
 		}
-
-		switch t := val.(type) {
-		case int, int8, int16, int32, int64, uint,
-			uint8, uint16, uint32, uint64:
-			switch x := t.(type) {
-			case int:
-				t = int64(x)
-			case int8:
-				t = int64(x)
-			case int16:
-				t = int64(x)
-			case int32:
-				t = int64(x)
-			case uint:
-				i := int64(x)
-				if i < 0 {
-					return nil, ErrUintOverflow.New()
-				}
-				t = i
-			case uint64:
-				i := int64(x)
-				if i < 0 {
-					return nil, ErrUintOverflow.New()
-				}
-				t = i
-			case uint8:
-				t = int64(x)
-			case uint16:
-				t = int64(x)
-			case uint32:
-				t = int64(x)
-			}
-			ival := t.(int64)
-			if i == 0 || cmp(ival, int64(selectedNum)) {
-				selectedNum = float64(ival)
-			}
-		case float32, float64:
-			if x, ok := t.(float32); ok {
-				t = float64(x)
-			}
-
-			fval := t.(float64)
-			if i == 0 || cmp(fval, float64(selectedNum)) {
-				selectedNum = fval
-			}
-
-		case string:
-			if sql.IsTextOnly(returnType) && (i == 0 || cmp(t, selectedString)) {
-				selectedString = t
-			}
-
-			fval, err := strconv.ParseFloat(t, 64)
-			if err != nil {
-				// MySQL just ignores non numerically convertible string arguments
-				// when mixed with numeric ones
-				continue
-			}
-
-			if i == 0 || cmp(fval, selectedNum) {
-				selectedNum = fval
-			}
-		case time.Time:
-			// Since we deviate from MySQL with int -> time handling, we only set the selectedTime variable
-			if i == 0 || cmp(t, selectedTime) {
-				selectedTime = t
-			}
-		case nil:
+		if val == nil {
 			return nil, nil
-		default:
-			return nil, ErrUnsupportedType.New(t)
 		}
 
-	}
+		val, err = returnType.Convert(val)
+		if err != nil {
+			return nil, err
+		}
 
-	switch returnType {
-	case sql.Int64:
-		return int64(selectedNum), nil
-	case sql.LongText:
-		return selectedString, nil
-	case sql.Datetime:
-		return selectedTime, nil
+		if i == 0 {
+			selected = val
+			continue
+		}
+
+		res, err := returnType.Compare(val, selected)
+		if err != nil {
+			return nil, err
+		}
+		if cmp(res) {
+			selected = val
+		}
 	}
 
-	// sql.Float64
-	return float64(selectedNum), nil
+	return selected, nil
 }
 
-// compRetType is used to determine the type from args based on the rules described for
-// Greatest/Least
+// compRetType is used to determine the type from args based on the rules described for Greatest/Least: arguments that
+// are all integers are compared as integers (as decimals if both signed and unsigned ones are given), numbers are
+// compared as doubles if any is a double and as decimals if any is a decimal, and arguments that are all temporal are
+// compared as datetimes. In any other case, such as a mix of numbers and strings, they're compared as strings.
 func compRetType(args ...sql.Expression) (sql.Type, error) {
 	if len(args) == 0 {
 		return nil, sql.ErrInvalidArgumentNumber.New("LEAST", "1 or more", 0)
 	}
 
-	allString := true
-	allInt := true
+	allNumber := true
 	allDatetime := true
+	hasFloat := false
+	hasDecimal := false
+	hasSigned := false
+	hasUnsigned := false
 
 	for _, arg := range args {
 		if !arg.Resolved() {
@@ -156,18 +93,16 @@ func compRetType(args ...sql.Expression) (sql.Type, error) {
 		if sql.IsTuple(argType) {
 			return nil, sql.ErrInvalidType.New("tuple")
 		} else if sql.IsNumber(argType) {
-			allString = false
 			allDatetime = false
-			if sql.IsFloat(argType) {
-				allString = false
-				allInt = false
-			}
+			hasFloat = hasFloat || sql.IsFloat(argType)
+			hasDecimal = hasDecimal || sql.IsDecimal(argType)
+			hasSigned = hasSigned || sql.IsSigned(argType)
+			hasUnsigned = hasUnsigned || sql.IsUnsigned(argType)
 		} else if sql.IsText(argType) {
-			allInt = false
+			allNumber = false
 			allDatetime = false
 		} else if sql.IsTime(argType) {
-			allString = false
-			allInt = false
+			allNumber = false
 		} else if argType == sql.Null {
 			// When a Null is present the return will always be Null
 			return sql.Null, nil
@@ -176,22 +111,24 @@ func compRetType(args ...sql.Expression) (sql.Type, error) {
 		}
 	}
 
-	if allString {
-		return sql.LongText, nil
-	} else if allInt {
-		return sql.Int64, nil
-	} else if allDatetime {
+	switch {
+	case allDatetime:
 		return sql.Datetime, nil
-	} else {
+	case !allNumber:
+		return sql.LongText, nil
+	case hasFloat:
 		return sql.Float64, nil
+	case hasDecimal || (hasSigned && hasUnsigned):
+		return sql.InternalDecimalType, nil
+	case hasUnsigned:
+		return sql.Uint64, nil
+	default:
+		return sql.Int64, nil
 	}
 }
 
-// Greatest returns the argument with the greatest numerical or string value. It allows for
-// numeric (ints and floats) and string arguments and will return the used type
-// when all arguments are of the same type or floats if there are numerically
-// convertible strings or integers mixed with floats. When ints or floats
-// are mixed with non numerically convertible strings, those are ignored.
+// Greatest returns the argument with the greatest value. Its arguments are all compared as numbers, datetimes or
+// strings, depending on their types, and the result has the type they're compared as.
 type Greatest struct {
 	Args       []sql.Expression
 	returnType sql.Type
@@ -265,34 +202,15 @@ func (f *Greatest) Resolved() bool {
 // Children implements the Expression interface.
 func (f *Greatest) Children() []sql.Expression { return f.Args }
 
-type compareFn func(interface{}, interface{}) bool
-
-func greaterThan(a, b interface{}) bool {
-	switch i := a.(type) {
-	case int64:
-		return i > b.(int64)
-	case float64:
-		return i > b.(float64)
-	case string:
-		return i > b.(string)
-	case time.Time:
-		return i.After(b.(time.Time))
-	}
-	panic("Implementation error on greaterThan")
+// compareFn reports whether an argument should replace the one selected so far, given the result of comparing them.
+type compareFn func(int) bool
+
+func greaterThan(cmp int) bool {
+	return cmp > 0
 }
 
-func lessThan(a, b interface{}) bool {
-	switch i := a.(type) {
-	case int64:
-		return i < b.(int64)
-	case float64:
-		return i < b.(float64)
-	case string:
-		return i < b.(string)
-	case time.Time:
-		return i.Before(b.(time.Time))
-	}
-	panic("Implementation error on lessThan")
+func lessThan(cmp int) bool {
+	return cmp < 0
 }
 
 // Eval implements the Expression interface.
@@ -300,11 +218,8 @@ func (f *Greatest) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	return compEval(f.returnType, f.Args, ctx, row, greaterThan)
 }
 
-// Least returns the argument with the least numerical or string value. It allows for
-// numeric (ints anf floats) and string arguments and will return the used type
-// when all arguments are of the same type or floats if there are numerically
-// convertible strings or integers mixed with floats. When ints or floats
-// are mixed with non numerically convertible strings, those are ignored.
+// Least returns the argument with the least value. Its arguments are all compared as numbers, datetimes or strings,
+// depending on their types, and the result has the type they're compared as.
 type Least struct {
 	Args       []sql.Expression
 	returnType sql.Type
@@ -15,9 +15,11 @@
 package function
 
 import (
+	"math"
 	"testing"
-	"unsafe"
+	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -51,21 +53,21 @@ func TestGreatest(t *testing.T) {
 		{
 			"string mixed",
 			[]sql.Expression{
-				expression.NewLiteral(string("9"), sql.LongText),
-				expression.NewLiteral(int64(5), sql.Int64),
+				expression.NewLiteral(string("10"), sql.LongText),
+				expression.NewLiteral(int64(9), sql.Int64),
 				expression.NewLiteral(int64(1), sql.Int64),
 			},
-			float64(9),
+			"9",
 		},
 		{
-			"unconvertible string mixed ignored",
+			"unconvertible string mixed",
 			[]sql.Expression{
 				expression.NewLiteral(string("10.5"), sql.LongText),
-				expression.NewLiteral(string("foobar"), sql.Int64),
+				expression.NewLiteral(string("foobar"), sql.LongText),
 				expression.NewLiteral(int64(5), sql.Int64),
 				expression.NewLiteral(int64(1), sql.Int64),
 			},
-			float64(10.5),
+			"foobar",
 		},
 		{
 			"float mixed",
@@ -129,32 +131,115 @@ func TestGreatest(t *testing.T) {
 	}
 }
 
-func TestGreatestUnsignedOverflow(t *testing.T) {
-	require := require.New(t)
-	ctx := sql.NewEmptyContext()
-
-	var x int
-	var gr sql.Expression
-	var err error
-
-	switch unsafe.Sizeof(x) {
-	case 4:
-		gr, err = NewGreatest(expression.NewLiteral(int32(1), sql.Int32),
-			expression.NewLiteral(uint32(4294967295), sql.Uint32),
-		)
-		require.NoError(err)
-	case 8:
-		gr, err = NewGreatest(expression.NewLiteral(int64(1), sql.Int64),
-			expression.NewLiteral(uint64(18446744073709551615), sql.Uint64),
-		)
-		require.NoError(err)
-	default:
-		// non 32/64 bits??
-		return
+func TestGreatestLeastCoercion(t *testing.T) {
+	lit := func(v interface{}, typ sql.Type) sql.Expression {
+		return expression.NewLiteral(v, typ)
+	}
+	datetime := func(s string) time.Time {
+		d, err := time.Parse("2006-01-02 15:04:05", s)
+		require.NoError(t, err)
+		return d
 	}
 
-	_, err = gr.Eval(ctx, nil)
-	require.EqualError(err, "Unsigned integer too big to fit on signed integer")
+	testCases := []struct {
+		name     string
+		args     []sql.Expression
+		typ      sql.Type
+		greatest interface{}
+		least    interface{}
+	}{
+		{
+			"ints",
+			[]sql.Expression{lit(int64(10), sql.Int64), lit(int8(9), sql.Int8)},
+			sql.Int64, int64(10), int64(9),
+		},
+		{
+			"unsigned ints",
+			[]sql.Expression{lit(uint64(10), sql.Uint64), lit(uint8(9), sql.Uint8)},
+			sql.Uint64, uint64(10), uint64(9),
+		},
+		{
+			"signed and unsigned ints",
+			[]sql.Expression{lit(int64(-1), sql.Int64), lit(uint64(math.MaxUint64), sql.Uint64)},
+			sql.InternalDecimalType, "18446744073709551615", "-1",
+		},
+		{
+			"ints and decimals",
+			[]sql.Expression{lit(int64(2), sql.Int64), lit(decimal.New(25, -1), sql.MustCreateDecimalType(2, 1))},
+			sql.InternalDecimalType, "2.5", "2",
+		},
+		{
+			"ints and floats",
+			[]sql.Expression{lit(int64(2), sql.Int64), lit(float64(2.5), sql.Float64)},
+			sql.Float64, float64(2.5), float64(2),
+		},
+		{
+			"decimals and floats",
+			[]sql.Expression{lit(decimal.New(25, -1), sql.MustCreateDecimalType(2, 1)), lit(float64(3.5), sql.Float64)},
+			sql.Float64, float64(3.5), float64(2.5),
+		},
+		{
+			"strings and ints",
+			[]sql.Expression{lit("10", sql.LongText), lit(int64(9), sql.Int64)},
+			sql.LongText, "9", "10",
+		},
+		{
+			"strings and floats",
+			[]sql.Expression{lit("10", sql.LongText), lit(float64(9.5), sql.Float64)},
+			sql.LongText, "9.5", "10",
+		},
+		{
+			"strings",
+			[]sql.Expression{lit("10", sql.LongText), lit("9", sql.LongText)},
+			sql.LongText, "9", "10",
+		},
+		{
+			"datetimes",
+			[]sql.Expression{lit(datetime("2020-01-01 00:00:00"), sql.Datetime), lit(datetime("2019-01-01 00:00:00"), sql.Datetime)},
+			sql.Datetime, datetime("2020-01-01 00:00:00"), datetime("2019-01-01 00:00:00"),
+		},
+		{
+			"datetimes and strings",
+			[]sql.Expression{lit(datetime("2020-01-01 00:00:00"), sql.Datetime), lit("2019-12-31", sql.LongText)},
+			sql.LongText, "2020-01-01 00:00:00", "2019-12-31",
+		},
+		{
+			"null and ints",
+			[]sql.Expression{lit(int64(1), sql.Int64), lit(nil, sql.Null)},
+			sql.Null, nil, nil,
+		},
+		{
+			"null value of another type",
+			[]sql.Expression{lit("a", sql.LongText), lit(nil, sql.Int64)},
+			sql.LongText, nil, nil,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			ctx := sql.NewEmptyContext()
+
+			for _, f := range []struct {
+				fn       func(...sql.Expression) (sql.Expression, error)
+				expected interface{}
+			}{
+				{NewGreatest, tt.greatest},
+				{NewLeast, tt.least},
+			} {
+				e, err := f.fn(tt.args...)
+				require.NoError(err)
+				require.Equal(tt.typ, e.Type())
+
+				output, err := e.Eval(ctx, nil)
+				require.NoError(err)
+				if d, ok := output.(decimal.Decimal); ok {
+					output = d.String()
+				}
+				require.Equal(f.expected, output)
+			}
+		})
+	}
 }
 
 func TestLeast(t *testing.T) {
@@ -185,20 +270,20 @@ func TestLeast(t *testing.T) {
 			"string mixed",
 			[]sql.Expression{
 				expression.NewLiteral(string("10"), sql.LongText),
-				expression.NewLiteral(int64(5), sql.Int64),
-				expression.NewLiteral(int64(1), sql.Int64),
+				expression.NewLiteral(int64(9), sql.Int64),
+				expression.NewLiteral(int64(2), sql.Int64),
 			},
-			float64(1),
+			"10",
 		},
 		{
-			"unconvertible string mixed ignored",
+			"unconvertible string mixed",
 			[]sql.Expression{
 				expression.NewLiteral(string("10.5"), sql.LongText),
-				expression.NewLiteral(string("foobar"), sql.Int64),
+				expression.NewLiteral(string("foobar"), sql.LongText),
 				expression.NewLiteral(int64(5), sql.Int64),
 				expression.NewLiteral(int64(1), sql.Int64),
 			},
-			float64(1),
+			"1",
 		},
 		{
 			"float mixed",
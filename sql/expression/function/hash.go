@@ -181,6 +181,7 @@ func (f *SHA2) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	case 512:
 		h = sha512.New()
 	default:
+		ctx.Warn(1582, "Incorrect parameters in the call to native function 'sha2'")
 		return nil, nil
 	}
 
@@ -206,6 +207,11 @@ func (f *SHA2) Type() sql.Type {
 	return sql.LongText
 }
 
+// IsNullable implements sql.Expression. SHA2 returns NULL for hash lengths it doesn't support.
+func (f *SHA2) IsNullable() bool {
+	return true
+}
+
 // WithChildren implements sql.Expression
 func (f *SHA2) WithChildren(children ...sql.Expression) (sql.Expression, error) {
 	if len(children) != 2 {
@@ -274,3 +274,26 @@ func TestSHA2Null(t *testing.T) {
 		})
 	}
 }
+
+func TestSHA2UnsupportedLength(t *testing.T) {
+	f := NewSHA2(expression.NewLiteral("abcd", sql.Text), expression.NewLiteral(int64(128), sql.Int64))
+	require.True(t, f.IsNullable())
+
+	ctx := sql.NewEmptyContext()
+	res, err := f.Eval(ctx, nil)
+	require.NoError(t, err)
+	require.Nil(t, res)
+	require.Equal(t, uint16(1), ctx.WarningCount())
+	require.Equal(t, 1582, ctx.Warnings()[0].Code)
+}
+
+func TestHashBinaryInput(t *testing.T) {
+	// binary strings are hashed byte for byte, the same as the equivalent text
+	binary := expression.NewLiteral([]byte("abcd"), sql.LongBlob)
+	text := expression.NewLiteral("abcd", sql.Text)
+	count := expression.NewLiteral(int64(256), sql.Int64)
+
+	require.Equal(t, "e2fc714c4727ee9395f324cd2e7f331f", eval(t, NewMD5(binary), nil))
+	require.Equal(t, eval(t, NewSHA1(text), nil), eval(t, NewSHA1(binary), nil))
+	require.Equal(t, eval(t, NewSHA2(text, count), nil), eval(t, NewSHA2(binary, count), nil))
+}
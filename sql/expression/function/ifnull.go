@@ -21,9 +21,11 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/expression"
 )
 
-// IfNull function returns the specified value IF the expression is NULL, otherwise return the expression.
+// IfNull function returns the specified value IF the expression is NULL, otherwise return the expression. The value
+// isn't evaluated unless the expression is NULL.
 type IfNull struct {
 	expression.BinaryExpression
+	conv aggregateConverter
 }
 
 var _ sql.FunctionExpression = (*IfNull)(nil)
@@ -31,7 +33,7 @@ var _ sql.FunctionExpression = (*IfNull)(nil)
 // NewIfNull returns a new IFNULL UDF
 func NewIfNull(ex, value sql.Expression) sql.Expression {
 	return &IfNull{
-		expression.BinaryExpression{
+		BinaryExpression: expression.BinaryExpression{
 			Left:  ex,
 			Right: value,
 		},
@@ -55,36 +57,31 @@ func (f *IfNull) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, err
 	}
 	if left != nil {
-		return left, nil
+		return f.conv.convert(left, 0, f.Left, f.Right)
 	}
 
 	right, err := f.Right.Eval(ctx, row)
-	if err != nil {
+	if err != nil || right == nil {
 		return nil, err
 	}
-	return right, nil
+	return f.conv.convert(right, 1, f.Left, f.Right)
 }
 
 // Type implements the Expression interface.
+// The type is the aggregated type of both arguments, the same as for COALESCE.
 func (f *IfNull) Type() sql.Type {
-	if sql.IsNull(f.Left) {
-		if sql.IsNull(f.Right) {
-			return sql.Null
-		}
-		return f.Right.Type()
+	if t := aggregateType(f.Left, f.Right); t != nil {
+		return t
 	}
-	return f.Left.Type()
+	return sql.Null
 }
 
 // IsNullable implements the Expression interface.
 func (f *IfNull) IsNullable() bool {
 	if sql.IsNull(f.Left) {
-		if sql.IsNull(f.Right) {
-			return true
-		}
-		return f.Right.IsNullable()
+		return sql.IsNull(f.Right) || f.Right.IsNullable()
 	}
-	return f.Left.IsNullable()
+	return f.Left.IsNullable() && (sql.IsNull(f.Right) || f.Right.IsNullable())
 }
 
 func (f *IfNull) String() string {
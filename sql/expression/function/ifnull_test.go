@@ -49,3 +49,48 @@ func TestIfNull(t *testing.T) {
 		require.Equal(t, tc.expected, v)
 	}
 }
+
+func TestIfNullDecimalScale(t *testing.T) {
+	f := NewIfNull(
+		expression.NewGetField(0, sql.MustCreateDecimalType(5, 1), "expression", true),
+		expression.NewGetField(1, sql.MustCreateDecimalType(4, 3), "value", true),
+	)
+	require.Equal(t, sql.MustCreateDecimalType(7, 3), f.Type())
+
+	v, err := f.Eval(sql.NewEmptyContext(), sql.NewRow("12.5", "1.250"))
+	require.NoError(t, err)
+	require.Equal(t, "12.500", v)
+
+	v, err = f.Eval(sql.NewEmptyContext(), sql.NewRow(nil, "1.250"))
+	require.NoError(t, err)
+	require.Equal(t, "1.250", v)
+
+	v, err = f.Eval(sql.NewEmptyContext(), sql.NewRow(nil, nil))
+	require.NoError(t, err)
+	require.Nil(t, v)
+}
+
+func TestIfNullTypeAggregation(t *testing.T) {
+	f := NewIfNull(
+		expression.NewGetField(0, sql.Int32, "expression", true),
+		expression.NewGetField(1, sql.Float64, "value", false),
+	)
+	require.Equal(t, sql.Float64, f.Type())
+	require.False(t, f.IsNullable())
+
+	v, err := f.Eval(sql.NewEmptyContext(), sql.NewRow(int32(1), 2.5))
+	require.NoError(t, err)
+	require.Equal(t, float64(1), v)
+
+	v, err = f.Eval(sql.NewEmptyContext(), sql.NewRow(nil, 2.5))
+	require.NoError(t, err)
+	require.Equal(t, 2.5, v)
+}
+
+func TestIfNullShortCircuit(t *testing.T) {
+	f := NewIfNull(
+		expression.NewLiteral(int64(1), sql.Int64),
+		&failingExpr{expression.NewLiteral(int64(2), sql.Int64)},
+	)
+	require.Equal(t, int64(1), eval(t, f, nil))
+}
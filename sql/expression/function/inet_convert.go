@@ -17,10 +17,13 @@ package function
 import (
 	"encoding/binary"
 	"fmt"
+	"math"
 	"net"
 	"reflect"
 	"strings"
 
+	"github.com/dolthub/vitess/go/sqltypes"
+
 	"github.com/dolthub/go-mysql-server/sql/expression"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -81,24 +84,44 @@ func (i *InetAton) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 
 	// Parse IP address
 	ipstr := val.(string)
-	ip := net.ParseIP(ipstr)
-	if ip == nil {
+	ipv4int, ok := parseInetAton(ipstr)
+	if !ok {
 		// Failed to Parse IP correctly
 		ctx.Warn(1411, fmt.Sprintf("Incorrect string value: ''%s'' for function %s", ipstr, i.FunctionName()))
 		return nil, nil
 	}
 
-	// Expect an IPv4 address
-	ipv4 := ip.To4()
-	if ipv4 == nil {
-		// Received invalid IPv4 address (IPv6 address are invalid)
-		ctx.Warn(1411, fmt.Sprintf("Incorrect string value: ''%s'' for function %s", ipstr, i.FunctionName()))
-		return nil, nil
+	return ipv4int, nil
+}
+
+// parseInetAton parses an IPv4 address as INET_ATON does. As in MySQL, the last number of an address with fewer than
+// four is taken as the last byte, so that 127.1 is 127.0.0.1 and 10.5.10 is 10.5.0.10, and leading zeros are allowed.
+func parseInetAton(s string) (uint32, bool) {
+	parts := strings.Split(s, ".")
+	if len(parts) > 4 || parts[len(parts)-1] == "" {
+		return 0, false
 	}
 
-	// Return IPv4 address as uint32
-	ipv4int := binary.BigEndian.Uint32(ipv4)
-	return ipv4int, nil
+	var result uint32
+	for i, part := range parts {
+		var b uint32
+		for _, c := range part {
+			if c < '0' || c > '9' {
+				return 0, false
+			}
+			b = b*10 + uint32(c-'0')
+			if b > 255 {
+				return 0, false
+			}
+		}
+		if i == len(parts)-1 {
+			result <<= 8 * uint(5-len(parts))
+		} else {
+			result <<= 8
+		}
+		result += b
+	}
+	return result, true
 }
 
 type Inet6Aton struct {
@@ -126,7 +149,7 @@ func (i *Inet6Aton) String() string {
 }
 
 func (i *Inet6Aton) Type() sql.Type {
-	return sql.LongBlob
+	return sql.MustCreateBinary(sqltypes.VarBinary, 16)
 }
 
 func (i *Inet6Aton) WithChildren(children ...sql.Expression) (sql.Expression, error) {
@@ -148,6 +171,12 @@ func (i *Inet6Aton) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
+	// Expect to receive an IP address, so convert val into string
+	val, err = sql.LongText.Convert(val)
+	if err != nil {
+		return nil, sql.ErrInvalidType.New(reflect.TypeOf(val).String())
+	}
+
 	// Parse IP address
 	ipstr := val.(string)
 	ip := net.ParseIP(ipstr)
@@ -158,7 +187,7 @@ func (i *Inet6Aton) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	}
 
 	// if it doesn't contain colons, treat it as ipv4
-	if strings.Count(val.(string), ":") < 2 {
+	if strings.Count(ipstr, ":") < 2 {
 		ipv4 := ip.To4()
 		return []byte(ipv4), nil
 	}
@@ -222,22 +251,22 @@ func (i *InetNtoa) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
-	// Convert val into int
-	ipv4int, err := sql.Int32.Convert(val)
-	if ipv4int != nil && err != nil {
-		return nil, sql.ErrInvalidType.New(reflect.TypeOf(val).String())
+	// Convert val into int. Negative numbers and those too large for an IPv4 address aren't addresses, while values
+	// that aren't numbers at all are taken as 0
+	ipv4int, err := sql.Uint64.Convert(val)
+	if err != nil {
+		if n, err := sql.Int64.Convert(val); err == nil && n.(int64) < 0 {
+			return nil, nil
+		}
+		ipv4int = uint64(0)
 	}
-
-	// Received a hex string instead of int
-	if ipv4int == nil {
-		// Create new IPv4
-		var ipv4 net.IP = []byte{0, 0, 0, 0}
-		return ipv4.String(), nil
+	if ipv4int.(uint64) > math.MaxUint32 {
+		return nil, nil
 	}
 
 	// Create new IPv4, and fill with val
 	ipv4 := make(net.IP, 4)
-	binary.BigEndian.PutUint32(ipv4, uint32(ipv4int.(int32)))
+	binary.BigEndian.PutUint32(ipv4, uint32(ipv4int.(uint64)))
 
 	return ipv4.String(), nil
 }
@@ -289,7 +318,12 @@ func (i *Inet6Ntoa) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
-	// Only convert if received string as input
+	// Values of binary columns may be held as strings
+	if s, ok := val.(string); ok && sql.IsBlob(i.Child.Type()) {
+		val = []byte(s)
+	}
+
+	// Only convert if received binary string as input
 	switch val.(type) {
 	case []byte:
 		ipbytes := val.([]byte)
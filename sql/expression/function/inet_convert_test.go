@@ -17,6 +17,7 @@ package function
 import (
 	"testing"
 
+	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -33,9 +34,13 @@ func TestInetAton(t *testing.T) {
 	}{
 		{"null input", sql.NewRow(nil), nil, false},
 		{"valid ipv4 address", sql.NewRow("10.0.5.10"), uint32(167773450), false},
-		// Output does not match MySQL, but it also indicates it shouldn't be used for short-form anyway: https://dev.mysql.com/doc/refman/8.0/en/miscellaneous-functions.html#function_inet-aton
-		{"valid short-form ipv4 address", sql.NewRow("10.5.10"), nil, false},
-		{"valid short-form ip4 address (non-string)", sql.NewRow(10.0), nil, false},
+		{"valid ipv4 address with leading zeros", sql.NewRow("010.000.005.010"), uint32(167773450), false},
+		{"valid short-form ipv4 address", sql.NewRow("10.5.10"), uint32(168099850), false},
+		{"valid two-part short-form ipv4 address", sql.NewRow("127.1"), uint32(2130706433), false},
+		{"valid short-form ip4 address (non-string)", sql.NewRow(10.0), uint32(10), false},
+		{"empty string", sql.NewRow(""), nil, false},
+		{"trailing dot", sql.NewRow("10.0.5."), nil, false},
+		{"byte out of range", sql.NewRow("10.0.5.256"), nil, false},
 		{"valid ipv6 address", sql.NewRow("::10.0.5.10"), nil, false},
 		{"valid ipv6 address", sql.NewRow("fdfe::5a55:caff:fefa:9098"), nil, false},
 		{"invalid ipv4 address", sql.NewRow("1.10.0.5.10"), nil, false},
@@ -71,6 +76,10 @@ func TestInetNtoa(t *testing.T) {
 		{"valid ipv4 int as string", sql.NewRow("167773450"), "10.0.5.10", false},
 		{"floating point ipv4", sql.NewRow(10.1), "0.0.0.10", false},
 		{"valid ipv6 int", sql.NewRow("\000\000\000\000"), "0.0.0.0", false},
+		{"ipv4 int above 2^31", sql.NewRow(uint32(3232235777)), "192.168.1.1", false},
+		{"largest ipv4 int", sql.NewRow(int64(4294967295)), "255.255.255.255", false},
+		{"negative int", sql.NewRow(int64(-1)), nil, false},
+		{"int too large", sql.NewRow(int64(4294967296)), nil, false},
 	}
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
@@ -99,7 +108,8 @@ func TestInet6Aton(t *testing.T) {
 	}{
 		{"null input", sql.NewRow(nil), nil, false},
 		{"valid ipv4 address", sql.NewRow("10.0.5.10"), []byte{10, 0, 5, 10}, false},
-		{"valid ipv4-mapped ipv6 address", sql.NewRow("::10.0.5.10"), []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 0, 5, 10}, false},
+		{"valid ipv4-compatible ipv6 address", sql.NewRow("::10.0.5.10"), []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 0, 5, 10}, false},
+		{"valid ipv4-mapped ipv6 address", sql.NewRow("::ffff:10.0.5.10"), []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xff, 0xff, 10, 0, 5, 10}, false},
 		{"valid short-form ipv4 address", sql.NewRow("10.5.10"), nil, false},
 		{"valid ipv6 address", sql.NewRow("fdfe::5a55:caff:fefa:9098"), []byte{0xfd, 0xfe, 0, 0, 0, 0, 0, 0, 0x5a, 0x55, 0xca, 0xff, 0xfe, 0xfa, 0x90, 0x98}, false},
 		{"invalid ipv4 address", sql.NewRow("1.10.0.5.10"), nil, false},
@@ -122,6 +132,13 @@ func TestInet6Aton(t *testing.T) {
 	}
 }
 
+func TestInet6NtoaBinaryColumn(t *testing.T) {
+	f := NewInet6Ntoa(expression.NewGetField(0, sql.MustCreateBinary(sqltypes.VarBinary, 16), "", false))
+	v, err := f.Eval(sql.NewEmptyContext(), sql.NewRow(string([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xff, 0xff, 10, 0, 5, 10})))
+	require.NoError(t, err)
+	require.Equal(t, "::ffff:10.0.5.10", v)
+}
+
 func TestInet6Ntoa(t *testing.T) {
 	f := NewInet6Ntoa(expression.NewGetField(0, sql.LongText, "", false))
 	testCases := []struct {
@@ -140,6 +157,9 @@ func TestInet6Ntoa(t *testing.T) {
 		{"valid ipv4-compatible int", sql.NewRow([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 0, 5, 10}), "::10.0.5.10", false},
 		{"all zeros", sql.NewRow([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}), "::", false},
 		{"only last 4 bytes filled", sql.NewRow([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x12, 0x34}), "::1234", false},
+		{"longest run of zero groups compressed", sql.NewRow([]byte{0, 1, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 3}), "1:0:0:2::3", false},
+		{"first of equal runs of zero groups compressed", sql.NewRow([]byte{0x20, 0x01, 0x0d, 0xb8, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 1}), "2001:db8::1:0:0:1", false},
+		{"single zero group not compressed", sql.NewRow([]byte{0x20, 0x01, 0x0d, 0xb8, 0, 0, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1}), "2001:db8:0:1:1:1:1:1", false},
 	}
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
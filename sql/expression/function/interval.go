@@ -0,0 +1,127 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// Interval is the INTERVAL(N, N1, N2, ...) function, not to be confused with the INTERVAL of date arithmetic. It
+// returns the number of thresholds N1, N2, ... less than or equal to N, that is, the index of the range N falls in, or
+// -1 if N is NULL. The thresholds are assumed to be sorted, so they're binary searched, and a NULL threshold is taken
+// as less than N.
+type Interval struct {
+	args []sql.Expression
+}
+
+var _ sql.FunctionExpression = (*Interval)(nil)
+
+// NewInterval creates a new Interval expression.
+func NewInterval(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) < 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New("INTERVAL", "2 or more", len(args))
+	}
+	return &Interval{args: args}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (i *Interval) FunctionName() string {
+	return "interval"
+}
+
+// Description implements sql.FunctionExpression
+func (i *Interval) Description() string {
+	return "returns the index of the last argument that is less than or equal to the first argument."
+}
+
+// Type implements the sql.Expression interface.
+func (i *Interval) Type() sql.Type {
+	return sql.Int64
+}
+
+// IsNullable implements the sql.Expression interface.
+func (i *Interval) IsNullable() bool {
+	return false
+}
+
+func (i *Interval) String() string {
+	args := make([]string, len(i.args))
+	for j, arg := range i.args {
+		args[j] = arg.String()
+	}
+	return fmt.Sprintf("interval(%s)", strings.Join(args, ", "))
+}
+
+// WithChildren implements the Expression interface.
+func (i *Interval) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewInterval(children...)
+}
+
+// Resolved implements the sql.Expression interface.
+func (i *Interval) Resolved() bool {
+	for _, arg := range i.args {
+		if !arg.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// Children implements the sql.Expression interface.
+func (i *Interval) Children() []sql.Expression { return i.args }
+
+// Eval implements the sql.Expression interface.
+func (i *Interval) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	n, err := evalFloat64(ctx, row, i.args[0])
+	if err != nil {
+		return nil, err
+	}
+	if n == nil {
+		return int64(-1), nil
+	}
+
+	// Find the first threshold greater than N, evaluating only the thresholds the search visits
+	thresholds := i.args[1:]
+	var searchErr error
+	idx := sort.Search(len(thresholds), func(j int) bool {
+		if searchErr != nil {
+			return true
+		}
+		threshold, err := evalFloat64(ctx, row, thresholds[j])
+		if err != nil {
+			searchErr = err
+			return true
+		}
+		return threshold != nil && n.(float64) < threshold.(float64)
+	})
+	if searchErr != nil {
+		return nil, searchErr
+	}
+
+	return int64(idx), nil
+}
+
+// evalFloat64 evaluates the expression given and converts the result to a float64, or returns nil if it's NULL.
+func evalFloat64(ctx *sql.Context, row sql.Row, e sql.Expression) (interface{}, error) {
+	val, err := e.Eval(ctx, row)
+	if err != nil || val == nil {
+		return nil, err
+	}
+	return sql.Float64.Convert(val)
+}
@@ -0,0 +1,64 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestIntervalArguments(t *testing.T) {
+	_, err := NewInterval(expression.NewLiteral(1, sql.Int64))
+	require.True(t, sql.ErrInvalidArgumentNumber.Is(err))
+}
+
+func TestInterval(t *testing.T) {
+	lit := func(v interface{}) sql.Expression {
+		if v == nil {
+			return expression.NewLiteral(nil, sql.Null)
+		}
+		return expression.NewLiteral(v, sql.Int64)
+	}
+
+	testCases := []struct {
+		name     string
+		args     []sql.Expression
+		expected int64
+	}{
+		{"interval(23, 1, 15, 17, 30, 44, 200)", []sql.Expression{lit(23), lit(1), lit(15), lit(17), lit(30), lit(44), lit(200)}, 3},
+		{"interval(10, 1, 10, 100, 1000)", []sql.Expression{lit(10), lit(1), lit(10), lit(100), lit(1000)}, 2},
+		{"interval(22, 23, 30, 44, 200)", []sql.Expression{lit(22), lit(23), lit(30), lit(44), lit(200)}, 0},
+		{"interval(300, 23, 30, 44, 200)", []sql.Expression{lit(300), lit(23), lit(30), lit(44), lit(200)}, 4},
+		{"interval(NULL, 1, 2)", []sql.Expression{lit(nil), lit(1), lit(2)}, -1},
+		{"interval(5, NULL, 10)", []sql.Expression{lit(5), lit(nil), lit(10)}, 1},
+		{"interval(2.5, 1, 2, 3)", []sql.Expression{expression.NewLiteral(2.5, sql.Float64), lit(1), lit(2), lit(3)}, 2},
+		{"interval('7', 5, 8)", []sql.Expression{expression.NewLiteral("7", sql.LongText), lit(5), lit(8)}, 1},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := NewInterval(tt.args...)
+			require.NoError(t, err)
+
+			v, err := f.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, v)
+		})
+	}
+}
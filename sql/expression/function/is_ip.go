@@ -73,17 +73,54 @@ func (i *IsIPv4) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	// Must be of type string
 	switch val.(type) {
 	case string:
-		// Parse IP address, return false if not valid ip
-		ip := net.ParseIP(val.(string))
-		if ip == nil {
-			return false, nil
+		return isIPv4String(val.(string)), nil
+	default:
+		return false, nil
+	}
+}
+
+// isIPv4String returns whether the string given is an IPv4 address in the strict form IS_IPV4 accepts: four decimal
+// numbers from 0 to 255 separated by dots, without leading zeros. Unlike INET_ATON, short forms like 127.1 and leading
+// zeros like 010.0.0.1 aren't accepted.
+func isIPv4String(s string) bool {
+	parts := strings.Split(s, ".")
+	if len(parts) != 4 {
+		return false
+	}
+	for _, part := range parts {
+		if len(part) == 0 || len(part) > 3 || (len(part) > 1 && part[0] == '0') {
+			return false
 		}
+		n := 0
+		for _, c := range part {
+			if c < '0' || c > '9' {
+				return false
+			}
+			n = n*10 + int(c-'0')
+		}
+		if n > 255 {
+			return false
+		}
+	}
+	return true
+}
 
-		// Check if ip address is valid IPv4 address
-		return ip.To4() != nil, nil
+// binaryIPAddress returns the bytes of a binary IPv6 address given to IS_IPV4_COMPAT or IS_IPV4_MAPPED, which must be
+// exactly 16 bytes long. Values of binary columns may be held as strings.
+func binaryIPAddress(val interface{}, typ sql.Type) ([]byte, bool) {
+	var b []byte
+	switch v := val.(type) {
+	case []byte:
+		b = v
+	case string:
+		if !sql.IsBlob(typ) {
+			return nil, false
+		}
+		b = []byte(v)
 	default:
-		return false, nil
+		return nil, false
 	}
+	return b, len(b) == 16
 }
 
 type IsIPv6 struct {
@@ -194,24 +231,19 @@ func (i *IsIPv4Compat) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 		return nil, nil
 	}
 
-	// Expect to receive a hex encoded string
-	switch val.(type) {
-	case []byte:
-		// Must be of length 16
-		if len(val.([]byte)) != 16 {
-			return false, nil
-		}
+	// Expect to receive a 16 byte binary address
+	ip, ok := binaryIPAddress(val, i.Child.Type())
+	if !ok {
+		return false, nil
+	}
 
-		// Check if first 12 bytes are all 0
-		for _, b := range val.([]byte)[:12] {
-			if b != 0 {
-				return false, nil
-			}
+	// Check if first 12 bytes are all 0
+	for _, b := range ip[:12] {
+		if b != 0 {
+			return false, nil
 		}
-		return true, nil
-	default:
-		return false, nil
 	}
+	return true, nil
 }
 
 type IsIPv4Mapped struct {
@@ -260,24 +292,19 @@ func (i *IsIPv4Mapped) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 		return nil, nil
 	}
 
-	// Expect to receive a hex encoded string
-	switch val.(type) {
-	case []byte:
-		// Must be of length 16
-		if len(val.([]byte)) != 16 {
-			return false, nil
-		}
+	// Expect to receive a 16 byte binary address
+	ip, ok := binaryIPAddress(val, i.Child.Type())
+	if !ok {
+		return false, nil
+	}
 
-		// Check if first 10 bytes are all 0
-		for _, b := range val.([]byte)[:10] {
-			if b != 0 {
-				return false, nil
-			}
+	// Check if first 10 bytes are all 0
+	for _, b := range ip[:10] {
+		if b != 0 {
+			return false, nil
 		}
-
-		// Bytes 11 and 12 must be 0xFF
-		return val.([]byte)[10] == 0xFF && val.([]byte)[11] == 0xFF, nil
-	default:
-		return false, nil
 	}
+
+	// Bytes 11 and 12 must be 0xFF
+	return ip[10] == 0xFF && ip[11] == 0xFF, nil
 }
@@ -17,6 +17,7 @@ package function
 import (
 	"testing"
 
+	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -33,6 +34,12 @@ func TestIsIPv4(t *testing.T) {
 	}{
 		{"null input", sql.NewRow(nil), nil, false},
 		{"valid ipv4 address", sql.NewRow("10.0.5.10"), true, false},
+		{"all zeros", sql.NewRow("0.0.0.0"), true, false},
+		{"leading zeros", sql.NewRow("010.0.5.10"), false, false},
+		{"short-form ipv4 address", sql.NewRow("10.0.5"), false, false},
+		{"number out of range", sql.NewRow("10.0.5.256"), false, false},
+		{"trailing space", sql.NewRow("10.0.5.10 "), false, false},
+		{"ipv4-mapped ipv6 address", sql.NewRow("::ffff:10.0.5.10"), false, false},
 		{"valid ipv6 address", sql.NewRow("fdfe::5a55:caff:fefa:9098"), false, false},
 		{"malformed ipv4 address", sql.NewRow("1.10.0.5.10"), false, false},
 		{"malformed ipv6 address", sql.NewRow("::ffffff"), false, false},
@@ -66,6 +73,7 @@ func TestIsIPv6(t *testing.T) {
 		{"null input", sql.NewRow(nil), nil, false},
 		{"valid ipv4 address", sql.NewRow("10.0.5.10"), false, false},
 		{"valid ipv6 address", sql.NewRow("::10.0.5.10"), true, false},
+		{"ipv4-mapped ipv6 address", sql.NewRow("::ffff:10.0.5.10"), true, false},
 		{"valid ipv6 address", sql.NewRow("fdfe::5a55:caff:fefa:9098"), true, false},
 		{"malformed ipv4 address", sql.NewRow("1.10.0.5.10"), false, false},
 		{"malformed ipv6 address", sql.NewRow("::ffffff"), false, false},
@@ -120,6 +128,23 @@ func TestIsIPv4Compat(t *testing.T) {
 	}
 }
 
+func TestIsIPv4CompatAndMappedBinaryColumn(t *testing.T) {
+	col := expression.NewGetField(0, sql.MustCreateBinary(sqltypes.VarBinary, 16), "", false)
+	compat := string([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 0, 1, 10})
+	mapped := string([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xff, 0xff, 10, 0, 1, 10})
+	ctx := sql.NewEmptyContext()
+
+	v, err := NewIsIPv4Compat(col).Eval(ctx, sql.NewRow(compat))
+	require.NoError(t, err)
+	require.Equal(t, true, v)
+	v, err = NewIsIPv4Mapped(col).Eval(ctx, sql.NewRow(mapped))
+	require.NoError(t, err)
+	require.Equal(t, true, v)
+	v, err = NewIsIPv4Mapped(col).Eval(ctx, sql.NewRow(compat))
+	require.NoError(t, err)
+	require.Equal(t, false, v)
+}
+
 func TestIsIPv4Mapped(t *testing.T) {
 	f := NewIsIPv4Mapped(expression.NewGetField(0, sql.LongText, "", false))
 	testCases := []struct {
@@ -56,6 +56,12 @@ func (nl *NamedLockFunction) FunctionName() string {
 	return nl.funcName
 }
 
+// IsNonDeterministic implements sql.NonDeterministicExpression. The result depends on the state of the lock
+// subsystem, which can change between calls.
+func (nl *NamedLockFunction) IsNonDeterministic() bool {
+	return true
+}
+
 // Eval implements the Expression interface.
 func (nl *NamedLockFunction) GetLockName(ctx *sql.Context, row sql.Row) (*string, error) {
 	if nl.Child == nil {
@@ -252,6 +258,7 @@ type GetLock struct {
 }
 
 var _ sql.FunctionExpression = (*GetLock)(nil)
+var _ sql.NonDeterministicExpression = (*GetLock)(nil)
 
 // CreateNewGetLock returns a new GetLock object
 func CreateNewGetLock(ls *sql.LockSubsystem) func(e1, e2 sql.Expression) sql.Expression {
@@ -330,6 +337,12 @@ func (gl *GetLock) String() string {
 	return fmt.Sprintf("get_lock(%s, %s)", gl.Left.String(), gl.Right.String())
 }
 
+// IsNonDeterministic implements sql.NonDeterministicExpression. Acquiring a lock is a side effect that must happen
+// every time the function is called.
+func (gl *GetLock) IsNonDeterministic() bool {
+	return true
+}
+
 // IsNullable implements the Expression interface.
 func (gl *GetLock) IsNullable() bool {
 	return false
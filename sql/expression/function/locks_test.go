@@ -150,8 +150,9 @@ func TestReleaseAllLocks(t *testing.T) {
 	assert.Equal(t, sql.LockInUse, state)
 	assert.Equal(t, user0.ID(), owner)
 
-	_, err = releaseAllLocksForLS(ls)(user0, nil)
+	released, err := releaseAllLocksForLS(ls)(user0, nil)
 	require.NoError(t, err)
+	assert.Equal(t, 5, released)
 
 	count = 0
 	err = user0.IterLocks(func(name string) error {
@@ -159,7 +160,7 @@ func TestReleaseAllLocks(t *testing.T) {
 		return nil
 	})
 	require.NoError(t, err)
-	assert.Equal(t, 3, count)
+	assert.Equal(t, 0, count)
 
 	state, owner = ls.GetLockState("lock0")
 	assert.Equal(t, sql.LockFree, state)
@@ -18,21 +18,29 @@ import (
 	"fmt"
 	"hash/crc32"
 	"math"
+	"math/bits"
 	"math/rand"
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/shopspring/decimal"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 )
 
-// Rand returns a random float 0 <= x < 1. If it has an argument, that argument will be used to seed the random number
-// generator, effectively turning it into a hash on that value.
+// Rand returns a random float 0 <= x < 1. If it has an argument, that argument is used to seed MySQL's random number
+// generator, so that the values returned are the same as MySQL's. A seed that's the same for every row seeds the
+// generator once, and each row gets the next value of its sequence; a seed depending on the row reseeds it for every
+// row, effectively turning it into a hash on that value.
 type Rand struct {
 	Child sql.Expression
+
+	mu  sync.Mutex
+	rnd *mysqlRand
 }
 
 var _ sql.Expression = (*Rand)(nil)
@@ -65,9 +73,10 @@ func (r *Rand) Type() sql.Type {
 	return sql.Float64
 }
 
-// IsNonDeterministic implements sql.NonDeterministicExpression
+// IsNonDeterministic implements sql.NonDeterministicExpression. Even with a seed, RAND returns the next value of a
+// sequence for every row, so it can never be evaluated once for all rows.
 func (r *Rand) IsNonDeterministic() bool {
-	return r.Child == nil
+	return true
 }
 
 // IsNullable implements sql.Expression
@@ -113,23 +122,81 @@ func (r *Rand) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return rand.Float64(), nil
 	}
 
-	// For child expressions, the mysql semantics are to seed the PRNG with an int64 value of the expression given. For
-	// non-numeric types, the seed will always be 0, which means that rand() will always return the same result for all
-	// non-numeric seed arguments.
-	e, err := r.Child.Eval(ctx, row)
+	if isConstantSeed(r.Child) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if r.rnd == nil {
+			seed, err := r.seed(ctx, row)
+			if err != nil {
+				return nil, err
+			}
+			r.rnd = newMySQLRand(seed)
+		}
+		return r.rnd.Float64(), nil
+	}
+
+	seed, err := r.seed(ctx, row)
 	if err != nil {
 		return nil, err
 	}
+	return newMySQLRand(seed).Float64(), nil
+}
+
+// seed evaluates the seed of the random number generator. For compatibility with earlier releases, the seed of
+// non-numeric types is always 0, which means that rand() will always return the same result for all non-numeric seed
+// arguments. A NULL seed is 0 too.
+func (r *Rand) seed(ctx *sql.Context, row sql.Row) (int64, error) {
+	e, err := r.Child.Eval(ctx, row)
+	if err != nil || e == nil {
+		return 0, err
+	}
 
-	var seed int64
 	if sql.IsNumber(r.Child.Type()) {
 		e, err = sql.Int64.Convert(e)
 		if err == nil {
-			seed = e.(int64)
+			return e.(int64), nil
 		}
 	}
+	return 0, nil
+}
+
+// isConstantSeed returns whether the seed given is the same for every row of a query.
+func isConstantSeed(e sql.Expression) bool {
+	constant := true
+	sql.Inspect(e, func(e sql.Expression) bool {
+		switch e := e.(type) {
+		case *expression.GetField, *expression.BindVar:
+			constant = false
+		case sql.NonDeterministicExpression:
+			if e.IsNonDeterministic() {
+				constant = false
+			}
+		}
+		return constant
+	})
+	return constant
+}
 
-	return rand.New(rand.NewSource(seed)).Float64(), nil
+// mysqlRand is the linear congruential generator MySQL uses for RAND(seed), so that seeded sequences are the same.
+type mysqlRand struct {
+	seed1, seed2 uint64
+}
+
+const mysqlRandMax = 0x3FFFFFFF
+
+func newMySQLRand(seed int64) *mysqlRand {
+	s := uint32(seed)
+	return &mysqlRand{
+		seed1: uint64(s*0x10001+55555555) % mysqlRandMax,
+		seed2: uint64(s*0x10000001) % mysqlRandMax,
+	}
+}
+
+// Float64 returns the next value of the sequence, 0 <= x < 1.
+func (r *mysqlRand) Float64() float64 {
+	r.seed1 = (r.seed1*3 + r.seed2) % mysqlRandMax
+	r.seed2 = (r.seed1 + r.seed2 + 33) % mysqlRandMax
+	return float64(r.seed1) / float64(mysqlRandMax)
 }
 
 // Sin is the SIN function
@@ -599,6 +666,56 @@ func (c *Crc32) WithChildren(children ...sql.Expression) (sql.Expression, error)
 	return NewCrc32(children[0]), nil
 }
 
+// BitCount is the BIT_COUNT function
+type BitCount struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*BitCount)(nil)
+
+// NewBitCount returns a new BIT_COUNT function expression
+func NewBitCount(arg sql.Expression) sql.Expression {
+	return &BitCount{NewUnaryFunc(arg, "BIT_COUNT", sql.Int64)}
+}
+
+// Description implements sql.FunctionExpression
+func (b *BitCount) Description() string {
+	return "returns the number of bits that are set in the argument as a 64-bit unsigned integer."
+}
+
+// Eval implements sql.Expression
+func (b *BitCount) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	arg, err := b.EvalChild(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	if arg == nil {
+		return nil, nil
+	}
+
+	// As with CAST(... AS UNSIGNED), negative numbers are taken as their two's complement and other values that can't
+	// be converted are taken as 0
+	n, err := sql.Uint64.Convert(arg)
+	if err != nil {
+		i, err := sql.Int64.Convert(arg)
+		if err != nil {
+			return int64(0), nil
+		}
+		n = uint64(i.(int64))
+	}
+
+	return int64(bits.OnesCount64(n.(uint64))), nil
+}
+
+// WithChildren implements sql.Expression
+func (b *BitCount) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(b, len(children), 1)
+	}
+	return NewBitCount(children[0]), nil
+}
+
 func floatToString(f float64) string {
 	s := strconv.FormatFloat(f, 'f', -1, 32)
 	idx := strings.IndexRune(s, '.')
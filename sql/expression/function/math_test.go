@@ -55,34 +55,48 @@ func TestRandWithSeed(t *testing.T) {
 	assert.Equal(t, sql.Float64, r.Type())
 	assert.Equal(t, "RAND(10)", r.String())
 
-	f, err := r.Eval(nil, nil)
-	require.NoError(t, err)
-	f64 := f.(float64)
-
-	assert.GreaterOrEqual(t, f64, float64(0))
-	assert.Less(t, f64, float64(1))
-
-	f, err = r.Eval(nil, nil)
-	require.NoError(t, err)
-	f642 := f.(float64)
-
-	assert.Equal(t, f64, f642)
+	// A constant seed gives the same sequence as MySQL's
+	for _, expected := range []float64{0.6570515219653505, 0.12820613023657923, 0.6698761160204896} {
+		f, err := r.Eval(nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, expected, f)
+	}
 
 	r, _ = NewRand(expression.NewLiteral("not a number", sql.LongText))
 	assert.Equal(t, `RAND("not a number")`, r.String())
 
-	f, err = r.Eval(nil, nil)
+	for _, expected := range []float64{0.15522042769493574, 0.620881741513388} {
+		f, err := r.Eval(nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, expected, f)
+	}
+
+	r, _ = NewRand(expression.NewLiteral(nil, sql.Null))
+	f, err := r.Eval(nil, nil)
 	require.NoError(t, err)
-	f64 = f.(float64)
+	assert.Equal(t, 0.15522042769493574, f)
+}
 
-	assert.GreaterOrEqual(t, f64, float64(0))
-	assert.Less(t, f64, float64(1))
+func TestRandWithRowSeed(t *testing.T) {
+	// A seed depending on the row reseeds the generator for every row
+	r, _ := NewRand(expression.NewGetField(0, sql.Int64, "i", false))
 
-	f, err = r.Eval(nil, nil)
-	require.NoError(t, err)
-	f642 = f.(float64)
+	testCases := []struct {
+		seed     int64
+		expected float64
+	}{
+		{1, 0.40540353712197724},
+		{1, 0.40540353712197724},
+		{3, 0.9057697559760601},
+		{100, 0.17353134804734155},
+		{-1, 0.9050373219931845},
+	}
 
-	assert.Equal(t, f64, f642)
+	for _, tt := range testCases {
+		f, err := r.Eval(nil, sql.NewRow(tt.seed))
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, f)
+	}
 }
 
 func TestRadians(t *testing.T) {
@@ -169,6 +183,20 @@ func TestCRC32(t *testing.T) {
 	assert.Equal(t, nil, res)
 }
 
+func TestBitCount(t *testing.T) {
+	f := sql.Function1{Name: "bit_count", Fn: NewBitCount}
+	tf := NewTestFactory(f.Fn)
+	tf.AddSucceeding(int64(0), int64(0))
+	tf.AddSucceeding(int64(3), int64(7))
+	tf.AddSucceeding(int64(1), uint8(128))
+	tf.AddSucceeding(int64(64), int64(-1))
+	tf.AddSucceeding(int64(64), uint64(math.MaxUint64))
+	tf.AddSucceeding(int64(2), "5")
+	tf.AddSucceeding(int64(0), "abc")
+	tf.AddSucceeding(nil, nil)
+	tf.Test(t, nil, nil)
+}
+
 func TestTrigFunctions(t *testing.T) {
 	asin := sql.Function1{Name: "asin", Fn: NewAsin}
 	acos := sql.Function1{Name: "acos", Fn: NewAcos}
@@ -0,0 +1,88 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// MultiLinestring is a function that returns a multilinestring type containing the given linestrings.
+type MultiLinestring struct {
+	expression.NaryExpression
+}
+
+var _ sql.FunctionExpression = (*MultiLinestring)(nil)
+
+// NewMultiLinestring creates a new multilinestring expression.
+func NewMultiLinestring(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) < 1 {
+		return nil, sql.ErrInvalidArgumentNumber.New("MultiLinestring", "1 or more", len(args))
+	}
+	return &MultiLinestring{expression.NaryExpression{ChildExpressions: args}}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (m *MultiLinestring) FunctionName() string {
+	return "multilinestring"
+}
+
+// Description implements sql.FunctionExpression
+func (m *MultiLinestring) Description() string {
+	return "returns a new multilinestring."
+}
+
+// Type implements the sql.Expression interface.
+func (m *MultiLinestring) Type() sql.Type {
+	return sql.MultiLinestringType{}
+}
+
+func (m *MultiLinestring) String() string {
+	var args = make([]string, len(m.ChildExpressions))
+	for i, arg := range m.ChildExpressions {
+		args[i] = arg.String()
+	}
+	return fmt.Sprintf("MULTILINESTRING(%s)", strings.Join(args, ","))
+}
+
+// WithChildren implements the Expression interface.
+func (m *MultiLinestring) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewMultiLinestring(children...)
+}
+
+// Eval implements the sql.Expression interface.
+func (m *MultiLinestring) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	var lines = make([]sql.Linestring, len(m.ChildExpressions))
+	for i, arg := range m.ChildExpressions {
+		val, err := arg.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		// Must be of type linestring, throw error otherwise
+		switch v := val.(type) {
+		case sql.Linestring:
+			lines[i] = v
+		case sql.Point, sql.Polygon, sql.MultiPoint, sql.MultiLinestring, sql.MultiPolygon, sql.GeometryCollection:
+			return nil, ErrInvalidArgument.New(m.FunctionName())
+		default:
+			return nil, sql.ErrIllegalGISValue.New(v)
+		}
+	}
+
+	return sql.MultiLinestring{Lines: lines}, nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// MultiPoint is a function that returns a multipoint type containing the given points.
+type MultiPoint struct {
+	expression.NaryExpression
+}
+
+var _ sql.FunctionExpression = (*MultiPoint)(nil)
+
+// NewMultiPoint creates a new multipoint expression.
+func NewMultiPoint(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) < 1 {
+		return nil, sql.ErrInvalidArgumentNumber.New("MultiPoint", "1 or more", len(args))
+	}
+	return &MultiPoint{expression.NaryExpression{ChildExpressions: args}}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (m *MultiPoint) FunctionName() string {
+	return "multipoint"
+}
+
+// Description implements sql.FunctionExpression
+func (m *MultiPoint) Description() string {
+	return "returns a new multipoint."
+}
+
+// Type implements the sql.Expression interface.
+func (m *MultiPoint) Type() sql.Type {
+	return sql.MultiPointType{}
+}
+
+func (m *MultiPoint) String() string {
+	var args = make([]string, len(m.ChildExpressions))
+	for i, arg := range m.ChildExpressions {
+		args[i] = arg.String()
+	}
+	return fmt.Sprintf("MULTIPOINT(%s)", strings.Join(args, ","))
+}
+
+// WithChildren implements the Expression interface.
+func (m *MultiPoint) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewMultiPoint(children...)
+}
+
+// Eval implements the sql.Expression interface.
+func (m *MultiPoint) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	var points = make([]sql.Point, len(m.ChildExpressions))
+	for i, arg := range m.ChildExpressions {
+		val, err := arg.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		// Must be of type point, throw error otherwise
+		switch v := val.(type) {
+		case sql.Point:
+			points[i] = v
+		case sql.Linestring, sql.Polygon, sql.MultiPoint, sql.MultiLinestring, sql.MultiPolygon, sql.GeometryCollection:
+			return nil, ErrInvalidArgument.New(m.FunctionName())
+		default:
+			return nil, sql.ErrIllegalGISValue.New(v)
+		}
+	}
+
+	return sql.MultiPoint{Points: points}, nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// MultiPolygon is a function that returns a multipolygon type containing the given polygons.
+type MultiPolygon struct {
+	expression.NaryExpression
+}
+
+var _ sql.FunctionExpression = (*MultiPolygon)(nil)
+
+// NewMultiPolygon creates a new multipolygon expression.
+func NewMultiPolygon(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) < 1 {
+		return nil, sql.ErrInvalidArgumentNumber.New("MultiPolygon", "1 or more", len(args))
+	}
+	return &MultiPolygon{expression.NaryExpression{ChildExpressions: args}}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (m *MultiPolygon) FunctionName() string {
+	return "multipolygon"
+}
+
+// Description implements sql.FunctionExpression
+func (m *MultiPolygon) Description() string {
+	return "returns a new multipolygon."
+}
+
+// Type implements the sql.Expression interface.
+func (m *MultiPolygon) Type() sql.Type {
+	return sql.MultiPolygonType{}
+}
+
+func (m *MultiPolygon) String() string {
+	var args = make([]string, len(m.ChildExpressions))
+	for i, arg := range m.ChildExpressions {
+		args[i] = arg.String()
+	}
+	return fmt.Sprintf("MULTIPOLYGON(%s)", strings.Join(args, ","))
+}
+
+// WithChildren implements the Expression interface.
+func (m *MultiPolygon) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewMultiPolygon(children...)
+}
+
+// Eval implements the sql.Expression interface.
+func (m *MultiPolygon) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	var polygons = make([]sql.Polygon, len(m.ChildExpressions))
+	for i, arg := range m.ChildExpressions {
+		val, err := arg.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		// Must be of type polygon, throw error otherwise
+		switch v := val.(type) {
+		case sql.Polygon:
+			polygons[i] = v
+		case sql.Point, sql.Linestring, sql.MultiPoint, sql.MultiLinestring, sql.MultiPolygon, sql.GeometryCollection:
+			return nil, ErrInvalidArgument.New(m.FunctionName())
+		default:
+			return nil, sql.ErrIllegalGISValue.New(v)
+		}
+	}
+
+	return sql.MultiPolygon{Polygons: polygons}, nil
+}
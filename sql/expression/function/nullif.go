@@ -66,6 +66,8 @@ func (f *NullIf) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 }
 
 // Type implements the Expression interface.
+// The result is either NULL or the value of the first argument, so the type is that of the first argument, which is
+// what aggregating the types of the possible results as COALESCE and IFNULL do gives.
 func (f *NullIf) Type() sql.Type {
 	if sql.IsNull(f.Left) {
 		return sql.Null
@@ -0,0 +1,40 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestQueryTag(t *testing.T) {
+	require := require.New(t)
+
+	ctx := sql.NewContext(context.Background())
+	result, err := NewQueryTag().Eval(ctx, nil)
+	require.NoError(err)
+	require.Nil(result)
+
+	ctx = sql.NewContext(context.Background(), sql.WithQueryTag("trace-1234"))
+	result, err = NewQueryTag().Eval(ctx, nil)
+	require.NoError(err)
+	require.Equal("trace-1234", result)
+
+	require.Equal("trace-1234", ctx.GetLogger().Data[sql.QueryTagLogField])
+}
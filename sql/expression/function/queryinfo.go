@@ -1,6 +1,10 @@
 package function
 
-import "github.com/dolthub/go-mysql-server/sql"
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
 
 // RowCount implements the ROW_COUNT() function
 type RowCount struct{}
@@ -60,31 +64,44 @@ func (r RowCount) FunctionName() string {
 	return "row_count"
 }
 
-// LastInsertId implements the LAST_INSERT_ID() function
-type LastInsertId struct{}
+// LastInsertId implements the LAST_INSERT_ID() function. With an argument, it sets the value returned by the next
+// calls to LAST_INSERT_ID() in the session, and the insert id of the statement, to the value of its argument.
+type LastInsertId struct {
+	Child sql.Expression
+}
 
 func (r LastInsertId) IsNonDeterministic() bool {
 	return true
 }
 
-func NewLastInsertId() sql.Expression {
-	return LastInsertId{}
+// NewLastInsertId creates a new LastInsertId expression.
+func NewLastInsertId(exprs ...sql.Expression) (sql.Expression, error) {
+	if len(exprs) > 1 {
+		return nil, sql.ErrInvalidArgumentNumber.New("last_insert_id", "0 or 1", len(exprs))
+	}
+	if len(exprs) > 0 {
+		return LastInsertId{Child: exprs[0]}, nil
+	}
+	return LastInsertId{}, nil
 }
 
 var _ sql.FunctionExpression = LastInsertId{}
 
 // Description implements sql.FunctionExpression
 func (r LastInsertId) Description() string {
-	return "returns value of the AUTOINCREMENT column for the last INSERT."
+	return "returns value of the AUTOINCREMENT column for the last INSERT. If an argument is given, it sets the value returned by the next calls."
 }
 
 // Resolved implements sql.Expression
 func (r LastInsertId) Resolved() bool {
-	return true
+	return r.Child == nil || r.Child.Resolved()
 }
 
 // String implements sql.Expression
 func (r LastInsertId) String() string {
+	if r.Child != nil {
+		return fmt.Sprintf("LAST_INSERT_ID(%s)", r.Child)
+	}
 	return "LAST_INSERT_ID()"
 }
 
@@ -95,22 +112,46 @@ func (r LastInsertId) Type() sql.Type {
 
 // IsNullable implements sql.Expression
 func (r LastInsertId) IsNullable() bool {
-	return false
+	return r.Child != nil && r.Child.IsNullable()
 }
 
 // Eval implements sql.Expression
 func (r LastInsertId) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
-	return ctx.GetLastQueryInfo(sql.LastInsertId), nil
+	if r.Child == nil {
+		return ctx.GetLastQueryInfo(sql.LastInsertId), nil
+	}
+
+	v, err := r.Child.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if v == nil {
+		return nil, nil
+	}
+
+	v, err = sql.Int64.Convert(v)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.SetLastQueryInfo(sql.LastInsertId, v.(int64))
+	return v, nil
 }
 
 // Children implements sql.Expression
 func (r LastInsertId) Children() []sql.Expression {
-	return nil
+	if r.Child == nil {
+		return nil
+	}
+	return []sql.Expression{r.Child}
 }
 
 // WithChildren implements sql.Expression
 func (r LastInsertId) WithChildren(children ...sql.Expression) (sql.Expression, error) {
-	return sql.NillaryWithChildren(r, children...)
+	if len(children) > 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(r, len(children), 1)
+	}
+	return NewLastInsertId(children...)
 }
 
 // FunctionName implements sql.FunctionExpression
@@ -0,0 +1,84 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"crypto/rand"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// randomBytesMax is the most bytes RANDOM_BYTES returns.
+const randomBytesMax = 1024
+
+// RandomBytes function returns a binary string of the given number of random bytes, generated by a cryptographically
+// secure random number generator.
+// https://dev.mysql.com/doc/refman/8.0/en/encryption-functions.html#function_random-bytes
+type RandomBytes struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*RandomBytes)(nil)
+var _ sql.NonDeterministicExpression = (*RandomBytes)(nil)
+
+// NewRandomBytes returns a new RandomBytes function expression
+func NewRandomBytes(arg sql.Expression) sql.Expression {
+	return &RandomBytes{NewUnaryFunc(arg, "RANDOM_BYTES", sql.MustCreateBinary(sqltypes.VarBinary, randomBytesMax))}
+}
+
+// Description implements sql.FunctionExpression
+func (f *RandomBytes) Description() string {
+	return "returns a binary string of random bytes."
+}
+
+// IsNonDeterministic implements sql.NonDeterministicExpression
+func (f *RandomBytes) IsNonDeterministic() bool {
+	return true
+}
+
+// Eval implements sql.Expression
+func (f *RandomBytes) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	arg, err := f.EvalChild(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if arg == nil {
+		return nil, nil
+	}
+
+	length, err := sql.Int64.Convert(arg)
+	if err != nil {
+		return nil, err
+	}
+	if length.(int64) < 1 || length.(int64) > randomBytesMax {
+		return nil, sql.ErrRandomBytesLength.New()
+	}
+
+	b := make([]byte, length.(int64))
+	if _, err = rand.Read(b); err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// WithChildren implements sql.Expression
+func (f *RandomBytes) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 1)
+	}
+	return NewRandomBytes(children[0]), nil
+}
@@ -0,0 +1,55 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestRandomBytes(t *testing.T) {
+	testCases := []struct {
+		arg      sql.Expression
+		expected int
+	}{
+		{expression.NewLiteral(int64(1), sql.Int64), 1},
+		{expression.NewLiteral(int64(16), sql.Int64), 16},
+		{expression.NewLiteral("32", sql.LongText), 32},
+		{expression.NewLiteral(int64(1024), sql.Int64), 1024},
+	}
+
+	for _, tt := range testCases {
+		f := NewRandomBytes(tt.arg)
+		t.Run(f.String(), func(t *testing.T) {
+			first := eval(t, f, nil)
+			require.Len(t, first, tt.expected)
+			if tt.expected >= 16 {
+				require.NotEqual(t, first, eval(t, f, nil))
+			}
+		})
+	}
+
+	require.Nil(t, eval(t, NewRandomBytes(expression.NewLiteral(nil, sql.Null)), nil))
+	require.True(t, NewRandomBytes(expression.NewLiteral(int64(16), sql.Int64)).(sql.NonDeterministicExpression).IsNonDeterministic())
+
+	for _, length := range []int64{0, -1, 1025} {
+		_, err := NewRandomBytes(expression.NewLiteral(length, sql.Int64)).Eval(sql.NewEmptyContext(), nil)
+		require.True(t, sql.ErrRandomBytesLength.Is(err))
+	}
+}
@@ -33,19 +33,24 @@ var BuiltIns = []sql.Function{
 	// elt, find_in_set, insert, load_file, locate
 	sql.Function1{Name: "abs", Fn: NewAbsVal},
 	sql.Function1{Name: "acos", Fn: NewAcos},
+	sql.FunctionN{Name: "aes_decrypt", Fn: NewAESDecrypt},
+	sql.FunctionN{Name: "aes_encrypt", Fn: NewAESEncrypt},
 	sql.Function1{Name: "array_length", Fn: NewArrayLength},
 	sql.Function1{Name: "ascii", Fn: NewAscii},
 	sql.Function1{Name: "asin", Fn: NewAsin},
 	sql.Function1{Name: "atan", Fn: NewAtan},
 	sql.Function1{Name: "avg", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewAvg(e) }},
+	sql.Function2{Name: "benchmark", Fn: NewBenchmark},
 	sql.Function1{Name: "bin", Fn: NewBin},
 	sql.FunctionN{Name: "bin_to_uuid", Fn: NewBinToUUID},
+	sql.Function1{Name: "bit_count", Fn: NewBitCount},
 	sql.Function1{Name: "bit_length", Fn: NewBitlength},
 	sql.Function1{Name: "ceil", Fn: NewCeil},
 	sql.Function1{Name: "ceiling", Fn: NewCeil},
 	sql.Function1{Name: "char_length", Fn: NewCharLength},
 	sql.Function1{Name: "character_length", Fn: NewCharLength},
 	sql.FunctionN{Name: "coalesce", Fn: NewCoalesce},
+	sql.Function1{Name: "compress", Fn: NewCompress},
 	sql.FunctionN{Name: "concat", Fn: NewConcat},
 	sql.FunctionN{Name: "concat_ws", Fn: NewConcatWithSeparator},
 	sql.NewFunction0("connection_id", NewConnectionID),
@@ -56,6 +61,7 @@ var BuiltIns = []sql.Function{
 	sql.Function1{Name: "crc32", Fn: NewCrc32},
 	sql.NewFunction0("curdate", NewCurrDate),
 	sql.NewFunction0("current_date", NewCurrentDate),
+	sql.NewFunction0("current_query_tag", NewQueryTag),
 	sql.NewFunction0("current_time", NewCurrentTime),
 	sql.FunctionN{Name: "current_timestamp", Fn: NewCurrTimestamp},
 	sql.NewFunction0("current_user", NewCurrentUser),
@@ -80,6 +86,8 @@ var BuiltIns = []sql.Function{
 	sql.FunctionN{Name: "format", Fn: NewFormat},
 	sql.Function1{Name: "from_base64", Fn: NewFromBase64},
 	sql.Function1{Name: "from_unixtime", Fn: NewFromUnixtime},
+	sql.FunctionN{Name: "geomcollection", Fn: NewGeometryCollection},
+	sql.FunctionN{Name: "geometrycollection", Fn: NewGeometryCollection},
 	sql.FunctionN{Name: "greatest", Fn: NewGreatest},
 	sql.Function0{Name: "group_concat", Fn: aggregation.NewEmptyGroupConcat},
 	sql.Function1{Name: "hex", Fn: NewHex},
@@ -91,6 +99,7 @@ var BuiltIns = []sql.Function{
 	sql.Function1{Name: "inet6_aton", Fn: NewInet6Aton},
 	sql.Function1{Name: "inet6_ntoa", Fn: NewInet6Ntoa},
 	sql.Function2{Name: "instr", Fn: NewInstr},
+	sql.FunctionN{Name: "interval", Fn: NewInterval},
 	sql.Function1{Name: "is_binary", Fn: NewIsBinary},
 	sql.Function1{Name: "is_ipv4", Fn: NewIsIPv4},
 	sql.Function1{Name: "is_ipv4_compat", Fn: NewIsIPv4Compat},
@@ -131,7 +140,7 @@ var BuiltIns = []sql.Function{
 	sql.FunctionN{Name: "json_value", Fn: NewJSONValue},
 	sql.FunctionN{Name: "lag", Fn: func(e ...sql.Expression) (sql.Expression, error) { return window.NewLag(e...) }},
 	sql.Function1{Name: "last", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewLast(e) }},
-	sql.Function0{Name: "last_insert_id", Fn: NewLastInsertId},
+	sql.FunctionN{Name: "last_insert_id", Fn: NewLastInsertId},
 	sql.Function1{Name: "lcase", Fn: NewLower},
 	sql.FunctionN{Name: "least", Fn: NewLeast},
 	sql.Function2{Name: "left", Fn: NewLeft},
@@ -148,12 +157,16 @@ var BuiltIns = []sql.Function{
 	sql.Function1{Name: "ltrim", Fn: NewLeftTrim},
 	sql.Function1{Name: "max", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewMax(e) }},
 	sql.Function1{Name: "md5", Fn: NewMD5},
+	sql.Function1{Name: "median", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewMedian(e) }},
 	sql.Function1{Name: "microsecond", Fn: NewMicrosecond},
 	sql.FunctionN{Name: "mid", Fn: NewSubstring},
 	sql.Function1{Name: "min", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewMin(e) }},
 	sql.Function1{Name: "minute", Fn: NewMinute},
 	sql.Function1{Name: "month", Fn: NewMonth},
 	sql.Function1{Name: "monthname", Fn: NewMonthName},
+	sql.FunctionN{Name: "multilinestring", Fn: NewMultiLinestring},
+	sql.FunctionN{Name: "multipoint", Fn: NewMultiPoint},
+	sql.FunctionN{Name: "multipolygon", Fn: NewMultiPolygon},
 	sql.FunctionN{Name: "now", Fn: NewNow},
 	sql.Function2{Name: "nullif", Fn: NewNullIf},
 	sql.Function2{Name: "point", Fn: NewPoint},
@@ -162,6 +175,7 @@ var BuiltIns = []sql.Function{
 	sql.Function2{Name: "power", Fn: NewPower},
 	sql.Function1{Name: "radians", Fn: NewRadians},
 	sql.FunctionN{Name: "rand", Fn: NewRand},
+	sql.Function1{Name: "random_bytes", Fn: NewRandomBytes},
 	sql.FunctionN{Name: "regexp_like", Fn: NewRegexpLike},
 	sql.FunctionN{Name: "regexp_replace", Fn: NewRegexpReplace},
 	sql.Function2{Name: "repeat", Fn: NewRepeat},
@@ -187,6 +201,7 @@ var BuiltIns = []sql.Function{
 	sql.Function2{Name: "split", Fn: NewSplit},
 	sql.Function1{Name: "sqrt", Fn: NewSqrt},
 	sql.FunctionN{Name: "str_to_date", Fn: NewStrToDate},
+	sql.Function1{Name: "st_area", Fn: NewArea},
 	sql.Function1{Name: "st_asbinary", Fn: NewAsWKB},
 	sql.Function1{Name: "st_aswkb", Fn: NewAsWKB},
 	sql.Function1{Name: "st_aswkt", Fn: NewAsWKT},
@@ -197,6 +212,9 @@ var BuiltIns = []sql.Function{
 	sql.FunctionN{Name: "st_pointfromwkb", Fn: NewPointFromWKB},
 	sql.FunctionN{Name: "st_polyfromwkb", Fn: NewPolyFromWKB},
 	sql.FunctionN{Name: "st_geomfromwkt", Fn: NewGeomFromWKT},
+	sql.FunctionN{Name: "st_latitude", Fn: NewSTLatitude},
+	sql.Function1{Name: "st_length", Fn: NewSTLength},
+	sql.FunctionN{Name: "st_longitude", Fn: NewSTLongitude},
 	sql.FunctionN{Name: "st_linefromwkt", Fn: NewLineFromWKT},
 	sql.FunctionN{Name: "st_pointfromwkt", Fn: NewPointFromWKT},
 	sql.FunctionN{Name: "st_polyfromwkt", Fn: NewPolyFromWKT},
@@ -214,6 +232,8 @@ var BuiltIns = []sql.Function{
 	sql.Function3{Name: "timestampdiff", Fn: NewTimestampDiff},
 	sql.Function1{Name: "to_base64", Fn: NewToBase64},
 	sql.Function1{Name: "ucase", Fn: NewUpper},
+	sql.Function1{Name: "uncompress", Fn: NewUncompress},
+	sql.Function1{Name: "uncompressed_length", Fn: NewUncompressedLength},
 	sql.Function1{Name: "unhex", Fn: NewUnhex},
 	sql.FunctionN{Name: "unix_timestamp", Fn: NewUnixTimestamp},
 	sql.Function1{Name: "upper", Fn: NewUpper},
@@ -15,7 +15,6 @@
 package function
 
 import (
-	"context"
 	"fmt"
 	"time"
 
@@ -31,6 +30,7 @@ type Sleep struct {
 }
 
 var _ sql.FunctionExpression = (*Sleep)(nil)
+var _ sql.NonDeterministicExpression = (*Sleep)(nil)
 
 // NewSleep creates a new Sleep expression.
 func NewSleep(e sql.Expression) sql.Expression {
@@ -64,14 +64,15 @@ func (s *Sleep) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, err
 	}
 
-	t := time.NewTimer(time.Duration(child.(float64)*1000) * time.Millisecond)
+	t := time.NewTimer(time.Duration(child.(float64) * float64(time.Second)))
 	defer t.Stop()
 
+	// A cancelled or killed query must stop sleeping right away
 	select {
 	case <-ctx.Done():
-		return 0, context.Canceled
+		return nil, ctx.Err()
 	case <-t.C:
-		return 0, nil
+		return int32(0), nil
 	}
 }
 
@@ -80,6 +81,12 @@ func (s *Sleep) String() string {
 	return fmt.Sprintf("SLEEP(%s)", s.Child)
 }
 
+// IsNonDeterministic implements sql.NonDeterministicExpression. Sleeping is a side effect that must happen every
+// time the function is called.
+func (s *Sleep) IsNonDeterministic() bool {
+	return true
+}
+
 // IsNullable implements the Expression interface.
 func (s *Sleep) IsNullable() bool {
 	return false
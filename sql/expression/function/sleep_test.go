@@ -15,6 +15,7 @@
 package function
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -37,10 +38,11 @@ func TestSleep(t *testing.T) {
 	}{
 		{"null input", sql.NewRow(nil), nil, 0, false},
 		{"string input", sql.NewRow("foo"), nil, 0, true},
-		{"int input", sql.NewRow(3), int(0), 3.0, false},
-		{"number is zero", sql.NewRow(0), int(0), 0, false},
-		{"negative number", sql.NewRow(-4), int(0), 0, false},
-		{"positive number", sql.NewRow(4.48), int(0), 4.48, false},
+		{"int input", sql.NewRow(3), int32(0), 3.0, false},
+		{"number is zero", sql.NewRow(0), int32(0), 0, false},
+		{"negative number", sql.NewRow(-4), int32(0), 0, false},
+		{"positive number", sql.NewRow(4.48), int32(0), 4.48, false},
+		{"fractional number", sql.NewRow(0.25), int32(0), 0.25, false},
 	}
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
@@ -63,3 +65,22 @@ func TestSleep(t *testing.T) {
 		})
 	}
 }
+
+func TestSleepCancelled(t *testing.T) {
+	octx, cancel := context.WithCancel(context.Background())
+	ctx := sql.NewContext(octx)
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		cancel()
+	}()
+
+	t1 := time.Now()
+	_, err := NewSleep(expression.NewLiteral(60, sql.Int64)).Eval(ctx, nil)
+	require.Equal(t, context.Canceled, err)
+	require.Less(t, time.Since(t1).Seconds(), 5.0)
+
+	octx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = NewSleep(expression.NewLiteral(60, sql.Int64)).Eval(sql.NewContext(octx), nil)
+	require.Equal(t, context.DeadlineExceeded, err)
+}
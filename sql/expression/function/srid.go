@@ -101,6 +101,67 @@ func PolyWithSRID(p sql.Polygon, srid uint32) sql.Polygon {
 	return sql.Polygon{SRID: srid, Lines: lines}
 }
 
+// MultiPointWithSRID creates a deep copy of multipoint object with given SRID
+func MultiPointWithSRID(m sql.MultiPoint, srid uint32) sql.MultiPoint {
+	points := make([]sql.Point, len(m.Points))
+	for i, p := range m.Points {
+		points[i] = PointWithSRID(p, srid)
+	}
+	return sql.MultiPoint{SRID: srid, Points: points}
+}
+
+// MultiLineWithSRID creates a deep copy of multilinestring object with given SRID
+func MultiLineWithSRID(m sql.MultiLinestring, srid uint32) sql.MultiLinestring {
+	lines := make([]sql.Linestring, len(m.Lines))
+	for i, l := range m.Lines {
+		lines[i] = LineWithSRID(l, srid)
+	}
+	return sql.MultiLinestring{SRID: srid, Lines: lines}
+}
+
+// MultiPolyWithSRID creates a deep copy of multipolygon object with given SRID
+func MultiPolyWithSRID(m sql.MultiPolygon, srid uint32) sql.MultiPolygon {
+	polys := make([]sql.Polygon, len(m.Polygons))
+	for i, p := range m.Polygons {
+		polys[i] = PolyWithSRID(p, srid)
+	}
+	return sql.MultiPolygon{SRID: srid, Polygons: polys}
+}
+
+// GeomCollectionWithSRID creates a deep copy of geometrycollection object with given SRID
+func GeomCollectionWithSRID(g sql.GeometryCollection, srid uint32) (sql.GeometryCollection, error) {
+	geoms := make([]interface{}, len(g.Geometries))
+	for i, geom := range g.Geometries {
+		var err error
+		if geoms[i], err = geometryWithSRID(geom, srid); err != nil {
+			return sql.GeometryCollection{}, err
+		}
+	}
+	return sql.GeometryCollection{SRID: srid, Geometries: geoms}, nil
+}
+
+// geometryWithSRID creates a deep copy of any geometry object with given SRID
+func geometryWithSRID(g interface{}, srid uint32) (interface{}, error) {
+	switch g := g.(type) {
+	case sql.Point:
+		return PointWithSRID(g, srid), nil
+	case sql.Linestring:
+		return LineWithSRID(g, srid), nil
+	case sql.Polygon:
+		return PolyWithSRID(g, srid), nil
+	case sql.MultiPoint:
+		return MultiPointWithSRID(g, srid), nil
+	case sql.MultiLinestring:
+		return MultiLineWithSRID(g, srid), nil
+	case sql.MultiPolygon:
+		return MultiPolyWithSRID(g, srid), nil
+	case sql.GeometryCollection:
+		return GeomCollectionWithSRID(g, srid)
+	default:
+		return nil, sql.ErrIllegalGISValue.New(g)
+	}
+}
+
 // Eval implements the sql.Expression interface.
 func (s *SRID) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	// Evaluate geometry type
@@ -124,6 +185,14 @@ func (s *SRID) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 			return g.SRID, nil
 		case sql.Polygon:
 			return g.SRID, nil
+		case sql.MultiPoint:
+			return g.SRID, nil
+		case sql.MultiLinestring:
+			return g.SRID, nil
+		case sql.MultiPolygon:
+			return g.SRID, nil
+		case sql.GeometryCollection:
+			return g.SRID, nil
 		default:
 			return nil, sql.ErrIllegalGISValue.New(g)
 		}
@@ -155,14 +224,5 @@ func (s *SRID) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	}
 
 	// Create new geometry object with matching SRID
-	switch g := g.(type) {
-	case sql.Point:
-		return PointWithSRID(g, _srid), nil
-	case sql.Linestring:
-		return LineWithSRID(g, _srid), nil
-	case sql.Polygon:
-		return PolyWithSRID(g, _srid), nil
-	default:
-		return nil, sql.ErrIllegalGISValue.New(g)
-	}
+	return geometryWithSRID(g, _srid)
 }
@@ -105,6 +105,11 @@ func (s *STX) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, err
 	}
 
+	// Return null if point is null
+	if p == nil {
+		return nil, nil
+	}
+
 	// Check that it is a point
 	_p, ok := p.(sql.Point)
 	if !ok {
@@ -134,7 +139,7 @@ func (s *STX) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	}
 
 	// Create point with new X and old Y
-	return sql.Point{X: _x.(float64), Y: _p.Y}, nil
+	return sql.Point{SRID: _p.SRID, X: _x.(float64), Y: _p.Y}, nil
 }
 
 // STY is a function that the x value from a given point.
@@ -217,6 +222,11 @@ func (s *STY) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, err
 	}
 
+	// Return null if point is null
+	if p == nil {
+		return nil, nil
+	}
+
 	// Check that it is a point
 	_p, ok := p.(sql.Point)
 	if !ok {
@@ -245,6 +255,241 @@ func (s *STY) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, err
 	}
 
-	// Create point with old X and new Ys
-	return sql.Point{X: _p.X, Y: _y.(float64)}, nil
+	// Create point with old X and new Y
+	return sql.Point{SRID: _p.SRID, X: _p.X, Y: _y.(float64)}, nil
+}
+
+var ErrNonGeographic = errors.NewKind("function %s is only defined for geographic spatial reference systems, but one of its arguments is in SRID %d, which is not geographic")
+
+var ErrLatitudeOutOfRange = errors.NewKind("latitude %f is out of range in function %s. It must be within [-90.000000, 90.000000]")
+
+var ErrLongitudeOutOfRange = errors.NewKind("longitude %f is out of range in function %s. It must be within (-180.000000, 180.000000]")
+
+// STLatitude is a function that returns the latitude value from a given point with a geographic SRID.
+type STLatitude struct {
+	args []sql.Expression
+}
+
+var _ sql.FunctionExpression = (*STLatitude)(nil)
+
+// NewSTLatitude creates a new STLatitude expression.
+func NewSTLatitude(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) != 1 && len(args) != 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New("ST_LATITUDE", "1 or 2", len(args))
+	}
+	return &STLatitude{args}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (s *STLatitude) FunctionName() string {
+	return "st_latitude"
+}
+
+// Description implements sql.FunctionExpression
+func (s *STLatitude) Description() string {
+	return "returns the latitude value of given point. If given a second argument, returns a new point with second argument as latitude value."
+}
+
+// Children implements the sql.Expression interface.
+func (s *STLatitude) Children() []sql.Expression {
+	return s.args
+}
+
+// Resolved implements the sql.Expression interface.
+func (s *STLatitude) Resolved() bool {
+	for _, arg := range s.args {
+		if !arg.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// IsNullable implements the sql.Expression interface.
+func (s *STLatitude) IsNullable() bool {
+	for _, arg := range s.args {
+		if arg.IsNullable() {
+			return true
+		}
+	}
+	return false
+}
+
+// Type implements the sql.Expression interface.
+func (s *STLatitude) Type() sql.Type {
+	if len(s.args) == 1 {
+		return sql.Float64
+	} else {
+		return sql.PointType{}
+	}
+}
+
+func (s *STLatitude) String() string {
+	var args = make([]string, len(s.args))
+	for i, arg := range s.args {
+		args[i] = arg.String()
+	}
+	return fmt.Sprintf("ST_LATITUDE(%s)", strings.Join(args, ","))
+}
+
+// WithChildren implements the Expression interface.
+func (s *STLatitude) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewSTLatitude(children...)
+}
+
+// Eval implements the sql.Expression interface.
+func (s *STLatitude) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	p, err := evalGeographicPoint(ctx, row, s.args[0], s.FunctionName())
+	if err != nil || p == nil {
+		return nil, err
+	}
+
+	// Points with a geographic SRID store latitude first, which is the SRID-defined axis order
+	if len(s.args) == 1 {
+		return p.X, nil
+	}
+
+	lat, err := evalCoordinate(ctx, row, s.args[1])
+	if err != nil || lat == nil {
+		return nil, err
+	}
+
+	_lat := lat.(float64)
+	if _lat < -90 || _lat > 90 {
+		return nil, ErrLatitudeOutOfRange.New(_lat, s.FunctionName())
+	}
+
+	// Create point with new latitude and old longitude
+	return sql.Point{SRID: p.SRID, X: _lat, Y: p.Y}, nil
+}
+
+// STLongitude is a function that returns the longitude value from a given point with a geographic SRID.
+type STLongitude struct {
+	args []sql.Expression
+}
+
+var _ sql.FunctionExpression = (*STLongitude)(nil)
+
+// NewSTLongitude creates a new STLongitude expression.
+func NewSTLongitude(args ...sql.Expression) (sql.Expression, error) {
+	if len(args) != 1 && len(args) != 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New("ST_LONGITUDE", "1 or 2", len(args))
+	}
+	return &STLongitude{args}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (s *STLongitude) FunctionName() string {
+	return "st_longitude"
+}
+
+// Description implements sql.FunctionExpression
+func (s *STLongitude) Description() string {
+	return "returns the longitude value of given point. If given a second argument, returns a new point with second argument as longitude value."
+}
+
+// Children implements the sql.Expression interface.
+func (s *STLongitude) Children() []sql.Expression {
+	return s.args
+}
+
+// Resolved implements the sql.Expression interface.
+func (s *STLongitude) Resolved() bool {
+	for _, arg := range s.args {
+		if !arg.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// IsNullable implements the sql.Expression interface.
+func (s *STLongitude) IsNullable() bool {
+	for _, arg := range s.args {
+		if arg.IsNullable() {
+			return true
+		}
+	}
+	return false
+}
+
+// Type implements the sql.Expression interface.
+func (s *STLongitude) Type() sql.Type {
+	if len(s.args) == 1 {
+		return sql.Float64
+	} else {
+		return sql.PointType{}
+	}
+}
+
+func (s *STLongitude) String() string {
+	var args = make([]string, len(s.args))
+	for i, arg := range s.args {
+		args[i] = arg.String()
+	}
+	return fmt.Sprintf("ST_LONGITUDE(%s)", strings.Join(args, ","))
+}
+
+// WithChildren implements the Expression interface.
+func (s *STLongitude) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewSTLongitude(children...)
+}
+
+// Eval implements the sql.Expression interface.
+func (s *STLongitude) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	p, err := evalGeographicPoint(ctx, row, s.args[0], s.FunctionName())
+	if err != nil || p == nil {
+		return nil, err
+	}
+
+	// Points with a geographic SRID store longitude second, which is the SRID-defined axis order
+	if len(s.args) == 1 {
+		return p.Y, nil
+	}
+
+	long, err := evalCoordinate(ctx, row, s.args[1])
+	if err != nil || long == nil {
+		return nil, err
+	}
+
+	_long := long.(float64)
+	if _long <= -180 || _long > 180 {
+		return nil, ErrLongitudeOutOfRange.New(_long, s.FunctionName())
+	}
+
+	// Create point with old latitude and new longitude
+	return sql.Point{SRID: p.SRID, X: p.X, Y: _long}, nil
+}
+
+// evalGeographicPoint evaluates the expression given, which must be either null or a point with a geographic SRID.
+func evalGeographicPoint(ctx *sql.Context, row sql.Row, e sql.Expression, fnName string) (*sql.Point, error) {
+	p, err := e.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	if p == nil {
+		return nil, nil
+	}
+
+	_p, ok := p.(sql.Point)
+	if !ok {
+		return nil, ErrInvalidType.New(fnName)
+	}
+
+	if _p.SRID != GeoSpatialSRID {
+		return nil, ErrNonGeographic.New(fnName, _p.SRID)
+	}
+
+	return &_p, nil
+}
+
+// evalCoordinate evaluates the expression given and converts it to a float64, or returns nil if it is null.
+func evalCoordinate(ctx *sql.Context, row sql.Row, e sql.Expression) (interface{}, error) {
+	v, err := e.Eval(ctx, row)
+	if err != nil || v == nil {
+		return nil, err
+	}
+
+	return sql.Float64.Convert(v)
 }
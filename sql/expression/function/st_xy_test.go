@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
@@ -77,6 +78,29 @@ func TestSTX(t *testing.T) {
 		require.Equal(sql.Point{X: -123.456, Y: 0}, v)
 	})
 
+	t.Run("replace x value keeps srid", func(t *testing.T) {
+		require := require.New(t)
+		p := sql.Point{SRID: 4326, X: 1, Y: 2}
+		f, err := NewSTX(expression.NewLiteral(p, sql.PointType{}),
+			expression.NewLiteral(5, sql.Int64))
+		require.NoError(err)
+
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Equal(sql.Point{SRID: 4326, X: 5, Y: 2}, v)
+		require.Equal(sql.Point{SRID: 4326, X: 1, Y: 2}, p)
+	})
+
+	t.Run("null point", func(t *testing.T) {
+		require := require.New(t)
+		f, err := NewSTX(expression.NewLiteral(nil, sql.Null))
+		require.NoError(err)
+
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Nil(v)
+	})
+
 	t.Run("non-point provided", func(t *testing.T) {
 		require := require.New(t)
 		f, err := NewSTX(expression.NewLiteral("notapoint", sql.Blob))
@@ -141,6 +165,29 @@ func TestSTY(t *testing.T) {
 		require.Equal(sql.Point{X: 0, Y: -123.456}, v)
 	})
 
+	t.Run("replace y value keeps srid", func(t *testing.T) {
+		require := require.New(t)
+		p := sql.Point{SRID: 4326, X: 1, Y: 2}
+		f, err := NewSTY(expression.NewLiteral(p, sql.PointType{}),
+			expression.NewLiteral(5, sql.Int64))
+		require.NoError(err)
+
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Equal(sql.Point{SRID: 4326, X: 1, Y: 5}, v)
+		require.Equal(sql.Point{SRID: 4326, X: 1, Y: 2}, p)
+	})
+
+	t.Run("null point", func(t *testing.T) {
+		require := require.New(t)
+		f, err := NewSTY(expression.NewLiteral(nil, sql.Null))
+		require.NoError(err)
+
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Nil(v)
+	})
+
 	t.Run("non-point provided", func(t *testing.T) {
 		require := require.New(t)
 		f, err := NewSTY(expression.NewLiteral("notapoint", sql.Blob))
@@ -150,3 +197,45 @@ func TestSTY(t *testing.T) {
 		require.Error(err)
 	})
 }
+
+func TestSTLatitudeLongitude(t *testing.T) {
+	geo := expression.NewLiteral(sql.Point{SRID: GeoSpatialSRID, X: 45, Y: 90}, sql.PointType{})
+	cartesian := expression.NewLiteral(sql.Point{X: 45, Y: 90}, sql.PointType{})
+
+	testCases := []struct {
+		name     string
+		fn       func(...sql.Expression) (sql.Expression, error)
+		args     []sql.Expression
+		expected interface{}
+		err      *errors.Kind
+	}{
+		{"select latitude", NewSTLatitude, []sql.Expression{geo}, 45.0, nil},
+		{"select longitude", NewSTLongitude, []sql.Expression{geo}, 90.0, nil},
+		{"replace latitude", NewSTLatitude, []sql.Expression{geo, expression.NewLiteral(-10.5, sql.Float64)}, sql.Point{SRID: GeoSpatialSRID, X: -10.5, Y: 90}, nil},
+		{"replace longitude", NewSTLongitude, []sql.Expression{geo, expression.NewLiteral("180", sql.Blob)}, sql.Point{SRID: GeoSpatialSRID, X: 45, Y: 180}, nil},
+		{"null point", NewSTLatitude, []sql.Expression{expression.NewLiteral(nil, sql.Null)}, nil, nil},
+		{"null latitude", NewSTLatitude, []sql.Expression{geo, expression.NewLiteral(nil, sql.Null)}, nil, nil},
+		{"latitude out of range", NewSTLatitude, []sql.Expression{geo, expression.NewLiteral(90.5, sql.Float64)}, nil, ErrLatitudeOutOfRange},
+		{"longitude out of range", NewSTLongitude, []sql.Expression{geo, expression.NewLiteral(-180, sql.Int64)}, nil, ErrLongitudeOutOfRange},
+		{"cartesian latitude", NewSTLatitude, []sql.Expression{cartesian}, nil, ErrNonGeographic},
+		{"cartesian longitude", NewSTLongitude, []sql.Expression{cartesian, expression.NewLiteral(1, sql.Int64)}, nil, ErrNonGeographic},
+		{"non-point provided", NewSTLongitude, []sql.Expression{expression.NewLiteral("notapoint", sql.Blob)}, nil, ErrInvalidType},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			f, err := tt.fn(tt.args...)
+			require.NoError(err)
+
+			v, err := f.Eval(sql.NewEmptyContext(), nil)
+			if tt.err != nil {
+				require.Error(err)
+				require.True(tt.err.Is(err), err.Error())
+				return
+			}
+			require.NoError(err)
+			require.Equal(tt.expected, v)
+		})
+	}
+}
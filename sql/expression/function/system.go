@@ -100,3 +100,92 @@ func (c User) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 func (c User) WithChildren(children ...sql.Expression) (sql.Expression, error) {
 	return NoArgFuncWithChildren(c, children)
 }
+
+type QueryTag struct {
+	NoArgFunc
+}
+
+var _ sql.FunctionExpression = QueryTag{}
+
+func NewQueryTag() sql.Expression {
+	return QueryTag{
+		NoArgFunc: NoArgFunc{"current_query_tag", sql.LongText},
+	}
+}
+
+func (c QueryTag) IsNonDeterministic() bool {
+	return true
+}
+
+// FunctionName implements sql.FunctionExpression
+func (c QueryTag) FunctionName() string {
+	return "current_query_tag"
+}
+
+// Description implements sql.FunctionExpression
+func (c QueryTag) Description() string {
+	return "returns the tag of the current query, or NULL if it has none."
+}
+
+// Eval implements sql.Expression
+func (c QueryTag) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	if ctx.QueryTag() == "" {
+		return nil, nil
+	}
+	return ctx.QueryTag(), nil
+}
+
+// WithChildren implements sql.Expression
+func (c QueryTag) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NoArgFuncWithChildren(c, children)
+}
+
+// QueryFingerprint returns the fingerprint of the current query, as computed by the fingerprint function it was
+// created with.
+type QueryFingerprint struct {
+	NoArgFunc
+	fingerprint func(string) (string, error)
+}
+
+var _ sql.FunctionExpression = QueryFingerprint{}
+
+// NewQueryFingerprint returns a function creating a QueryFingerprint using the fingerprint function given. The
+// fingerprint function is injected, as the parser computing it depends on this package.
+func NewQueryFingerprint(fingerprint func(string) (string, error)) func(...sql.Expression) (sql.Expression, error) {
+	return func(args ...sql.Expression) (sql.Expression, error) {
+		if len(args) != 0 {
+			return nil, sql.ErrInvalidArgumentNumber.New("CURRENT_QUERY_FINGERPRINT", 0, len(args))
+		}
+		return QueryFingerprint{
+			NoArgFunc:   NoArgFunc{"current_query_fingerprint", sql.LongText},
+			fingerprint: fingerprint,
+		}, nil
+	}
+}
+
+func (c QueryFingerprint) IsNonDeterministic() bool {
+	return true
+}
+
+// FunctionName implements sql.FunctionExpression
+func (c QueryFingerprint) FunctionName() string {
+	return "current_query_fingerprint"
+}
+
+// Description implements sql.FunctionExpression
+func (c QueryFingerprint) Description() string {
+	return "returns the normalized fingerprint of the current query, with its literals replaced by placeholders."
+}
+
+// Eval implements sql.Expression
+func (c QueryFingerprint) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	if ctx.Query() == "" {
+		return nil, nil
+	}
+	return c.fingerprint(ctx.Query())
+}
+
+// WithChildren implements sql.Expression
+func (c QueryFingerprint) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NoArgFuncWithChildren(c, children)
+}
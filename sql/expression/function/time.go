@@ -101,6 +101,18 @@ func (y *Year) Type() sql.Type { return sql.Int32 }
 
 // Eval implements the Expression interface.
 func (y *Year) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	// A YEAR value is not a date, but it already holds the year that would be extracted from one
+	if sql.IsYear(y.Child.Type()) {
+		val, err := y.Child.Eval(ctx, row)
+		if err != nil || val == nil {
+			return nil, err
+		}
+		yr, err := sql.Year.Convert(val)
+		if err != nil {
+			return nil, err
+		}
+		return int32(yr.(int16)), nil
+	}
 	return getDatePart(ctx, y.UnaryExpression, row, year)
 }
 
@@ -558,8 +570,6 @@ func NewWeek(args ...sql.Expression) (sql.Expression, error) {
 	w := &Week{date: args[0]}
 	if len(args) > 1 && args[1].Resolved() && sql.IsInteger(args[1].Type()) {
 		w.mode = args[1]
-	} else {
-		w.mode = expression.NewLiteral(0, sql.Int64)
 	}
 
 	return w, nil
@@ -575,7 +585,12 @@ func (d *Week) Description() string {
 	return "returns the week number."
 }
 
-func (d *Week) String() string { return fmt.Sprintf("WEEK(%s, %d)", d.date, d.mode) }
+func (d *Week) String() string {
+	if d.mode == nil {
+		return fmt.Sprintf("WEEK(%s)", d.date)
+	}
+	return fmt.Sprintf("WEEK(%s, %s)", d.date, d.mode)
+}
 
 // Type implements the Expression interface.
 func (d *Week) Type() sql.Type { return sql.Int32 }
@@ -600,18 +615,10 @@ func (d *Week) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, ErrInvalidArgument.New("WEEK", "invalid day")
 	}
 
-	mode := int64(0)
-	val, err := d.mode.Eval(ctx, row)
+	mode, err := d.evalMode(ctx, row)
 	if err != nil {
 		return nil, err
 	}
-	if val != nil {
-		if i64, err := sql.Int64.Convert(val); err == nil {
-			if mode, ok = i64.(int64); ok {
-				mode %= 8 // mode in [0, 7]
-			}
-		}
-	}
 
 	yearForWeek, week := calcWeek(yyyy, mm, dd, weekMode(mode)|weekBehaviourYear)
 
@@ -624,13 +631,43 @@ func (d *Week) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	return week, nil
 }
 
+// evalMode returns the mode of the week computation: the one given as argument or, when there is none, the value of
+// the default_week_format system variable.
+func (d *Week) evalMode(ctx *sql.Context, row sql.Row) (int64, error) {
+	var val interface{}
+	var err error
+	if d.mode != nil {
+		val, err = d.mode.Eval(ctx, row)
+	} else {
+		val, err = ctx.GetSessionVariable(ctx, "default_week_format")
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	mode := int64(0)
+	if val != nil {
+		if i64, err := sql.Int64.Convert(val); err == nil {
+			if m, ok := i64.(int64); ok {
+				mode = m % 8 // mode in [0, 7]
+			}
+		}
+	}
+	return mode, nil
+}
+
 // Resolved implements the Expression interface.
 func (d *Week) Resolved() bool {
-	return d.date.Resolved() && d.mode.Resolved()
+	return d.date.Resolved() && (d.mode == nil || d.mode.Resolved())
 }
 
 // Children implements the Expression interface.
-func (d *Week) Children() []sql.Expression { return []sql.Expression{d.date, d.mode} }
+func (d *Week) Children() []sql.Expression {
+	if d.mode == nil {
+		return []sql.Expression{d.date}
+	}
+	return []sql.Expression{d.date, d.mode}
+}
 
 // IsNullable implements the Expression interface.
 func (d *Week) IsNullable() bool {
@@ -59,6 +59,23 @@ func TestTime_Year(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("year type", func(t *testing.T) {
+		require := require.New(t)
+		f := NewYear(expression.NewGetField(0, sql.Year, "foo", true))
+
+		val, err := f.Eval(ctx, sql.NewRow(int16(1999)))
+		require.NoError(err)
+		require.Equal(int32(1999), val)
+
+		val, err = f.Eval(ctx, sql.NewRow(int16(0)))
+		require.NoError(err)
+		require.Equal(int32(0), val)
+
+		val, err = f.Eval(ctx, sql.NewRow(nil))
+		require.NoError(err)
+		require.Nil(val)
+	})
 }
 
 func TestTime_Month(t *testing.T) {
@@ -53,12 +53,15 @@ import (
 
 type UUIDFunc struct{}
 
+var _ sql.FunctionExpression = &UUIDFunc{}
+var _ sql.NonDeterministicExpression = UUIDFunc{}
+
+// IsNonDeterministic implements sql.NonDeterministicExpression. Every call returns a new UUID, so the result must
+// never be folded into a constant or shared between calls.
 func (u UUIDFunc) IsNonDeterministic() bool {
 	return true
 }
 
-var _ sql.FunctionExpression = &UUIDFunc{}
-
 func NewUUIDFunc() sql.Expression {
 	return UUIDFunc{}
 }
@@ -157,26 +160,45 @@ func (u IsUUID) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
-	switch str := str.(type) {
-	case string:
-		_, err := uuid.Parse(str)
-		if err != nil {
-			return int8(0), nil
-		}
+	converted, err := sql.LongText.Convert(str)
+	if err != nil {
+		return int8(0), nil
+	}
 
+	if isValidUUIDString(converted.(string)) {
 		return int8(1), nil
-	case []byte:
-		_, err := uuid.ParseBytes(str)
-		if err != nil {
-			return int8(0), nil
-		}
+	}
 
-		return int8(1), nil
+	return int8(0), nil
+}
+
+// isValidUUIDString returns whether |s| is a UUID in one of the string formats MySQL accepts: 32 hex digits, the same
+// digits grouped with dashes as 8-4-4-4-12, or the dashed form wrapped in curly braces. The hex digits may be in any
+// lettercase. Unlike uuid.Parse, the "urn:uuid:" prefixed form is not accepted.
+func isValidUUIDString(s string) bool {
+	switch len(s) {
+	case 32:
+		return isHexDigits(s)
+	case 36:
+		return s[8] == '-' && s[13] == '-' && s[18] == '-' && s[23] == '-' &&
+			isHexDigits(s[:8]+s[9:13]+s[14:18]+s[19:23]+s[24:])
+	case 38:
+		return s[0] == '{' && s[37] == '}' && isValidUUIDString(s[1:37])
 	default:
-		return int8(0), nil
+		return false
 	}
 }
 
+func isHexDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+			return false
+		}
+	}
+	return true
+}
+
 func (u IsUUID) WithChildren(children ...sql.Expression) (sql.Expression, error) {
 	if len(children) != 1 {
 		return nil, sql.ErrInvalidChildrenNumber.New(u, len(children), 1)
@@ -196,7 +218,7 @@ func (u IsUUID) Children() []sql.Expression {
 
 // IsNullable returns whether the expression can be null.
 func (u IsUUID) IsNullable() bool {
-	return false
+	return u.child.IsNullable()
 }
 
 // UUID_TO_BIN(string_uuid), UUID_TO_BIN(string_uuid, swap_flag)
@@ -279,6 +301,10 @@ func (ub UUIDToBin) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, fmt.Errorf("invalid data format passed to UUID_TO_BIN")
 	}
 
+	if !isValidUUIDString(uuidAsStr) {
+		return nil, sql.ErrUuidUnableToParse.New(uuidAsStr, "invalid UUID format")
+	}
+
 	parsed, err := uuid.Parse(uuidAsStr)
 	if err != nil {
 		return nil, sql.ErrUuidUnableToParse.New(uuidAsStr, err.Error())
@@ -442,7 +468,6 @@ func (bu BinToUUID) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	parsed, err := uuid.FromBytes(asBytes)
 	if err != nil {
 		return nil, sql.ErrUuidUnableToParse.New(uuidAsByteString, err.Error())
-		return nil, err
 	}
 
 	// If no swap flag is passed we can return uuid's string format as is.
@@ -461,7 +486,7 @@ func (bu BinToUUID) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	}
 
 	// If the swap flag is 0 we can return uuid's string format as is.
-	if sf.(int8) == 0 {
+	if sf == nil || sf.(int8) == 0 {
 		return parsed.String(), nil
 	} else if sf.(int8) == 1 {
 		encoding := unswapUUIDBytes(parsed)
@@ -473,7 +498,7 @@ func (bu BinToUUID) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 
 		return parsed.String(), nil
 	} else {
-		return nil, fmt.Errorf("UUID_TO_BIN received invalid swap flag")
+		return nil, fmt.Errorf("BIN_TO_UUID received invalid swap flag")
 	}
 }
 
@@ -35,8 +35,15 @@ func TestUUID(t *testing.T) {
 	require.NoError(t, err)
 
 	myUUID := result.(string)
-	_, err = uuid.Parse(myUUID)
+	parsed, err := uuid.Parse(myUUID)
 	require.NoError(t, err)
+	require.Equal(t, uuid.Version(1), parsed.Version())
+
+	// every call returns a new UUID
+	other, err := uuidE.Eval(ctx, sql.Row{nil})
+	require.NoError(t, err)
+	require.NotEqual(t, myUUID, other)
+	require.True(t, uuidE.(sql.NonDeterministicExpression).IsNonDeterministic())
 
 	// validate that generated uuid is legitimate for IsUUID
 	val := NewIsUUID(uuidE)
@@ -62,6 +69,14 @@ func TestIsUUID(t *testing.T) {
 		{"random bool", sql.Boolean, false, int8(0)},
 		{"random string", sql.LongText, "12345678-dasd-fasdf8", int8(0)},
 		{"swapped uuid", sql.LongText, "5678-1234-12345678-1234-567812345678", int8(0)},
+		{"upper and mixed case", sql.LongText, "6CCD780C-Baba-1026-9564-5B8C656024DB", int8(1)},
+		{"braces without dashes", sql.LongText, "{12345678123456781234567812345678}", int8(0)},
+		{"unbalanced braces", sql.LongText, "{12345678-1234-5678-1234-567812345678", int8(0)},
+		{"urn prefix", sql.LongText, "urn:uuid:12345678-1234-5678-1234-567812345678", int8(0)},
+		{"non-hex digit", sql.LongText, "1234567g-1234-5678-1234-567812345678", int8(0)},
+		{"surrounding spaces", sql.LongText, " 12345678-1234-5678-1234-567812345678 ", int8(0)},
+		{"binary string", sql.LongBlob, []byte("12345678123456781234567812345678"), int8(1)},
+		{"random float", sql.Float64, float64(1), int8(0)},
 	}
 
 	for _, tt := range testCases {
@@ -167,6 +182,7 @@ func TestBinToUUID(t *testing.T) {
 		{"valid uuid; swap=0", sql.MustCreateBinary(query.Type_VARBINARY, int64(16)), []byte("lxºº & d[e`$Û"), true, sql.Int8, int8(0), "6c78c2ba-c2ba-2026-2064-5b656024c39b"},
 		{"valid uuid; swap=1", sql.MustCreateBinary(query.Type_VARBINARY, int64(16)), []byte("&ººlÍxd[e`$Û"), true, sql.Int8, int8(1), "ba6cc38d-bac2-26c2-7864-5b656024c39b"},
 		{"valid uuid; no swap", sql.MustCreateBinary(query.Type_VARBINARY, int64(16)), []byte("lxºº & d[e`$Û"), false, nil, nil, "6c78c2ba-c2ba-2026-2064-5b656024c39b"},
+		{"valid uuid; swap=nil", sql.MustCreateBinary(query.Type_VARBINARY, int64(16)), []byte("lxºº & d[e`$Û"), true, sql.Null, nil, "6c78c2ba-c2ba-2026-2064-5b656024c39b"},
 		{"null input", sql.Null, nil, false, nil, nil, nil},
 	}
 
@@ -68,61 +68,58 @@ func (a *AsWKB) WithChildren(children ...sql.Expression) (sql.Expression, error)
 	return NewAsWKB(children[0]), nil
 }
 
-// serializePoint fills in buf with the values from point
-func serializePoint(p sql.Point, buf []byte) {
-	// Assumes buf is correct size
-	binary.LittleEndian.PutUint64(buf[0:8], math.Float64bits(p.X))
-	binary.LittleEndian.PutUint64(buf[8:16], math.Float64bits(p.Y))
-}
-
 // PointToBytes converts a sql.Point to a byte array
 func PointToBytes(p sql.Point) []byte {
-	// Initialize point buffer
-	buf := make([]byte, 16)
-	serializePoint(p, buf)
-	return buf
-}
-
-// serializeLine fills in buf with values from linestring
-func serializeLine(l sql.Linestring, buf []byte) {
-	// Write number of points
-	binary.LittleEndian.PutUint32(buf[0:4], uint32(len(l.Points)))
-	// Append each point
-	for i, p := range l.Points {
-		start, stop := 4+16*i, 4+16*(i+1)
-		serializePoint(p, buf[start:stop])
-	}
+	buf, _ := sql.SerializeWKB(p)
+	return buf[WKBHeaderLength:]
 }
 
 // LineToBytes converts a sql.Linestring to a byte array
 func LineToBytes(l sql.Linestring) []byte {
-	// Initialize line buffer
-	buf := make([]byte, 4+16*len(l.Points))
-	serializeLine(l, buf)
-	return buf
+	buf, _ := sql.SerializeWKB(l)
+	return buf[WKBHeaderLength:]
+}
+
+// PolyToBytes converts a sql.Polygon to a byte array
+func PolyToBytes(p sql.Polygon) []byte {
+	buf, _ := sql.SerializeWKB(p)
+	return buf[WKBHeaderLength:]
+}
+
+// MultiPointToBytes converts a sql.MultiPoint to a byte array
+func MultiPointToBytes(m sql.MultiPoint) []byte {
+	buf, _ := sql.SerializeWKB(m)
+	return buf[WKBHeaderLength:]
+}
+
+// MultiLineToBytes converts a sql.MultiLinestring to a byte array
+func MultiLineToBytes(m sql.MultiLinestring) []byte {
+	buf, _ := sql.SerializeWKB(m)
+	return buf[WKBHeaderLength:]
 }
 
-func serializePoly(p sql.Polygon, buf []byte) {
-	// Write number of lines
-	binary.LittleEndian.PutUint32(buf[0:4], uint32(len(p.Lines)))
-	// Append each line
-	start, stop := 0, 4
-	for _, l := range p.Lines {
-		start, stop = stop, stop+4+16*len(l.Points)
-		serializeLine(l, buf[start:stop])
+// MultiPolyToBytes converts a sql.MultiPolygon to a byte array
+func MultiPolyToBytes(m sql.MultiPolygon) []byte {
+	buf, _ := sql.SerializeWKB(m)
+	return buf[WKBHeaderLength:]
+}
+
+// GeomCollectionToBytes converts a sql.GeometryCollection to a byte array
+func GeomCollectionToBytes(g sql.GeometryCollection) ([]byte, error) {
+	buf, err := sql.SerializeWKB(g)
+	if err != nil {
+		return nil, err
 	}
+	return buf[WKBHeaderLength:], nil
 }
 
-// PolyToBytes converts a sql.Polygon to a byte array
-func PolyToBytes(p sql.Polygon) []byte {
-	// Initialize polygon buffer
-	size := 0
-	for _, l := range p.Lines {
-		size += 4 + 16*len(l.Points)
+// GeometryToBytes converts any geometry to a byte array in WKB format, including the header
+func GeometryToBytes(val interface{}) ([]byte, error) {
+	buf, err := sql.SerializeWKB(val)
+	if sql.ErrNotGeometry.Is(err) {
+		return nil, sql.ErrInvalidGISData.New("ST_AsWKB")
 	}
-	buf := make([]byte, 4+size)
-	serializePoly(p, buf)
-	return buf
+	return buf, err
 }
 
 // Eval implements the sql.Expression interface.
@@ -137,45 +134,22 @@ func (a *AsWKB) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
-	// Initialize buf with space for endianness (1 byte) and type (4 bytes)
-	buf := make([]byte, 5)
-	// MySQL seems to always use Little Endian
-	buf[0] = 1
-	var data []byte
-
-	// Expect one of the geometry types
-	switch v := val.(type) {
-	case sql.Point:
-		// Mark as point type
-		binary.LittleEndian.PutUint32(buf[1:5], 1)
-		data = PointToBytes(v)
-	case sql.Linestring:
-		// Mark as linestring type
-		binary.LittleEndian.PutUint32(buf[1:5], 2)
-		data = LineToBytes(v)
-	case sql.Polygon:
-		// Mark as Polygon type
-		binary.LittleEndian.PutUint32(buf[1:5], 3)
-		data = PolyToBytes(v)
-	default:
-		return nil, sql.ErrInvalidGISData.New("ST_AsWKB")
-	}
-
-	// Append to header
-	buf = append(buf, data...)
-
-	return buf, nil
+	return GeometryToBytes(val)
 }
 
 // Header contains endianness (1 byte) and geometry type (4 bytes)
-const WKBHeaderLength = 5
+const WKBHeaderLength = sql.WKBHeaderLength
 
 // Type IDs
 const (
-	WKBUnknown = iota
-	WKBPointID
-	WKBLineID
-	WKBPolyID
+	WKBUnknown      = sql.WKBUnknown
+	WKBPointID      = sql.WKBPointID
+	WKBLineID       = sql.WKBLineID
+	WKBPolyID       = sql.WKBPolyID
+	WKBMultiPointID = sql.WKBMultiPointID
+	WKBMultiLineID  = sql.WKBMultiLineID
+	WKBMultiPolyID  = sql.WKBMultiPolyID
+	WKBGeomCollID   = sql.WKBGeomCollID
 )
 
 // GeomFromWKB is a function that returns a geometry type from a WKB byte array
@@ -340,6 +314,181 @@ func WKBToPoly(buf []byte, isBig bool, srid uint32, order bool) (sql.Polygon, er
 	return sql.Polygon{SRID: srid, Lines: lines}, nil
 }
 
+// wkbDataLength returns the number of bytes used by the data portion of the WKB representation of a geometry
+func wkbDataLength(g interface{}) int {
+	switch v := g.(type) {
+	case sql.Point:
+		return 16
+	case sql.Linestring:
+		return 4 + 16*len(v.Points)
+	case sql.Polygon:
+		size := 4
+		for _, l := range v.Lines {
+			size += wkbDataLength(l)
+		}
+		return size
+	case sql.MultiPoint:
+		return 4 + (WKBHeaderLength+16)*len(v.Points)
+	case sql.MultiLinestring:
+		size := 4
+		for _, l := range v.Lines {
+			size += WKBHeaderLength + wkbDataLength(l)
+		}
+		return size
+	case sql.MultiPolygon:
+		size := 4
+		for _, p := range v.Polygons {
+			size += WKBHeaderLength + wkbDataLength(p)
+		}
+		return size
+	case sql.GeometryCollection:
+		size := 4
+		for _, geom := range v.Geometries {
+			size += WKBHeaderLength + wkbDataLength(geom)
+		}
+		return size
+	default:
+		return 0
+	}
+}
+
+// WKBToGeometry parses a complete geometry in WKB format, including its header, and returns it along with the number
+// of bytes read
+func WKBToGeometry(buf []byte, srid uint32, order bool) (interface{}, int, error) {
+	isBig, geomType, err := ParseWKBHeader(buf)
+	if err != nil {
+		return nil, 0, err
+	}
+	data := buf[WKBHeaderLength:]
+
+	var geom interface{}
+	switch geomType {
+	case WKBPointID:
+		if len(data) < 16 {
+			return nil, 0, sql.ErrInvalidGISData.New("ST_GeomFromWKB")
+		}
+		geom, err = WKBToPoint(data[:16], isBig, srid, order)
+	case WKBLineID:
+		geom, err = WKBToLine(data, isBig, srid, order)
+	case WKBPolyID:
+		geom, err = WKBToPoly(data, isBig, srid, order)
+	case WKBMultiPointID:
+		geom, err = WKBToMultiPoint(data, isBig, srid, order)
+	case WKBMultiLineID:
+		geom, err = WKBToMultiLine(data, isBig, srid, order)
+	case WKBMultiPolyID:
+		geom, err = WKBToMultiPoly(data, isBig, srid, order)
+	case WKBGeomCollID:
+		geom, err = WKBToGeomCollection(data, isBig, srid, order)
+	default:
+		return nil, 0, sql.ErrInvalidGISData.New("ST_GeomFromWKB")
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return geom, WKBHeaderLength + wkbDataLength(geom), nil
+}
+
+// wkbToGeometries parses the data portion of a multi-geometry or geometry collection in WKB format, which is the
+// number of geometries followed by each complete geometry
+func wkbToGeometries(buf []byte, isBig bool, srid uint32, order bool) ([]interface{}, error) {
+	// Must be at least 4 bytes (number of geometries)
+	if len(buf) < 4 {
+		return nil, sql.ErrInvalidGISData.New("ST_GeomFromWKB")
+	}
+
+	var numGeoms uint32
+	if isBig {
+		numGeoms = binary.BigEndian.Uint32(buf[:4])
+	} else {
+		numGeoms = binary.LittleEndian.Uint32(buf[:4])
+	}
+
+	s := 4
+	var geoms []interface{}
+	for i := uint32(0); i < numGeoms; i++ {
+		if s >= len(buf) {
+			return nil, sql.ErrInvalidGISData.New("ST_GeomFromWKB")
+		}
+		geom, n, err := WKBToGeometry(buf[s:], srid, order)
+		if err != nil {
+			return nil, err
+		}
+		geoms = append(geoms, geom)
+		s += n
+	}
+
+	return geoms, nil
+}
+
+// WKBToMultiPoint parses the data portion of a byte array in WKB format to a multipoint object
+func WKBToMultiPoint(buf []byte, isBig bool, srid uint32, order bool) (sql.MultiPoint, error) {
+	geoms, err := wkbToGeometries(buf, isBig, srid, order)
+	if err != nil {
+		return sql.MultiPoint{}, sql.ErrInvalidGISData.New("ST_MPointFromWKB")
+	}
+
+	points := make([]sql.Point, len(geoms))
+	for i, g := range geoms {
+		p, ok := g.(sql.Point)
+		if !ok {
+			return sql.MultiPoint{}, sql.ErrInvalidGISData.New("ST_MPointFromWKB")
+		}
+		points[i] = p
+	}
+
+	return sql.MultiPoint{SRID: srid, Points: points}, nil
+}
+
+// WKBToMultiLine parses the data portion of a byte array in WKB format to a multilinestring object
+func WKBToMultiLine(buf []byte, isBig bool, srid uint32, order bool) (sql.MultiLinestring, error) {
+	geoms, err := wkbToGeometries(buf, isBig, srid, order)
+	if err != nil {
+		return sql.MultiLinestring{}, sql.ErrInvalidGISData.New("ST_MLineFromWKB")
+	}
+
+	lines := make([]sql.Linestring, len(geoms))
+	for i, g := range geoms {
+		l, ok := g.(sql.Linestring)
+		if !ok {
+			return sql.MultiLinestring{}, sql.ErrInvalidGISData.New("ST_MLineFromWKB")
+		}
+		lines[i] = l
+	}
+
+	return sql.MultiLinestring{SRID: srid, Lines: lines}, nil
+}
+
+// WKBToMultiPoly parses the data portion of a byte array in WKB format to a multipolygon object
+func WKBToMultiPoly(buf []byte, isBig bool, srid uint32, order bool) (sql.MultiPolygon, error) {
+	geoms, err := wkbToGeometries(buf, isBig, srid, order)
+	if err != nil {
+		return sql.MultiPolygon{}, sql.ErrInvalidGISData.New("ST_MPolyFromWKB")
+	}
+
+	polys := make([]sql.Polygon, len(geoms))
+	for i, g := range geoms {
+		p, ok := g.(sql.Polygon)
+		if !ok {
+			return sql.MultiPolygon{}, sql.ErrInvalidGISData.New("ST_MPolyFromWKB")
+		}
+		polys[i] = p
+	}
+
+	return sql.MultiPolygon{SRID: srid, Polygons: polys}, nil
+}
+
+// WKBToGeomCollection parses the data portion of a byte array in WKB format to a geometrycollection object
+func WKBToGeomCollection(buf []byte, isBig bool, srid uint32, order bool) (sql.GeometryCollection, error) {
+	geoms, err := wkbToGeometries(buf, isBig, srid, order)
+	if err != nil {
+		return sql.GeometryCollection{}, err
+	}
+
+	return sql.GeometryCollection{SRID: srid, Geometries: geoms}, nil
+}
+
 // ParseAxisOrder takes in a key, value string and determines the order of the xy coords
 func ParseAxisOrder(s string) (bool, error) {
 	// TODO: need to deal with whitespace, lowercase, and json-like parsing
@@ -427,6 +576,14 @@ func (g *GeomFromWKB) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return WKBToLine(v[WKBHeaderLength:], isBig, srid, order)
 	case WKBPolyID:
 		return WKBToPoly(v[WKBHeaderLength:], isBig, srid, order)
+	case WKBMultiPointID:
+		return WKBToMultiPoint(v[WKBHeaderLength:], isBig, srid, order)
+	case WKBMultiLineID:
+		return WKBToMultiLine(v[WKBHeaderLength:], isBig, srid, order)
+	case WKBMultiPolyID:
+		return WKBToMultiPoly(v[WKBHeaderLength:], isBig, srid, order)
+	case WKBGeomCollID:
+		return WKBToGeomCollection(v[WKBHeaderLength:], isBig, srid, order)
 	default:
 		return nil, sql.ErrInvalidGISData.New("ST_GeomFromWKB")
 	}
@@ -319,3 +319,58 @@ func TestGeomFromWKB(t *testing.T) {
 		require.Equal(nil, v)
 	})
 }
+
+func TestMultiGeometryWKB(t *testing.T) {
+	ring := sql.Linestring{Points: []sql.Point{{X: 0, Y: 0}, {X: 1, Y: 1}, {X: 1, Y: 0}, {X: 0, Y: 0}}}
+
+	t.Run("convert multipoint", func(t *testing.T) {
+		require := require.New(t)
+		f := NewAsWKB(expression.NewLiteral(sql.MultiPoint{Points: []sql.Point{{X: 1, Y: 2}, {X: 3, Y: 4}}}, sql.MultiPointType{}))
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		res, err := hex.DecodeString("0104000000020000000101000000000000000000F03F0000000000000040010100000000000000000008400000000000001040")
+		require.NoError(err)
+		require.Equal(res, v)
+	})
+
+	geoms := []struct {
+		name string
+		geom interface{}
+		typ  sql.Type
+	}{
+		{"multipoint", sql.MultiPoint{Points: []sql.Point{{X: 1, Y: 2}, {X: 3, Y: 4}}}, sql.MultiPointType{}},
+		{"multilinestring", sql.MultiLinestring{Lines: []sql.Linestring{ring, ring}}, sql.MultiLinestringType{}},
+		{"multipolygon", sql.MultiPolygon{Polygons: []sql.Polygon{{Lines: []sql.Linestring{ring, ring}}, {Lines: []sql.Linestring{ring}}}}, sql.MultiPolygonType{}},
+		{"geometrycollection", sql.GeometryCollection{Geometries: []interface{}{
+			sql.Point{X: 1, Y: 2},
+			ring,
+			sql.Polygon{Lines: []sql.Linestring{ring}},
+			sql.MultiPoint{Points: []sql.Point{{X: 1, Y: 2}}},
+			sql.GeometryCollection{Geometries: []interface{}{sql.Point{X: 3, Y: 4}}},
+		}}, sql.GeometryCollectionType{}},
+	}
+
+	for _, tt := range geoms {
+		t.Run("round trip "+tt.name, func(t *testing.T) {
+			require := require.New(t)
+			wkb, err := NewAsWKB(expression.NewLiteral(tt.geom, tt.typ)).Eval(sql.NewEmptyContext(), nil)
+			require.NoError(err)
+
+			f, err := NewGeomFromWKB(expression.NewLiteral(wkb, sql.Blob))
+			require.NoError(err)
+			v, err := f.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(err)
+			require.Equal(tt.geom, v)
+		})
+	}
+
+	t.Run("truncated multipoint", func(t *testing.T) {
+		require := require.New(t)
+		res, err := hex.DecodeString("0104000000020000000101000000000000000000F03F0000000000000040")
+		require.NoError(err)
+		f, err := NewGeomFromWKB(expression.NewLiteral(res, sql.Blob))
+		require.NoError(err)
+		_, err = f.Eval(sql.NewEmptyContext(), nil)
+		require.Error(err)
+	})
+}
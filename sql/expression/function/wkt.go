@@ -92,6 +92,72 @@ func PolygonToWKT(p sql.Polygon) string {
 	return strings.Join(lines, ",")
 }
 
+// MultiPointToWKT converts a sql.MultiPoint to a string
+func MultiPointToWKT(m sql.MultiPoint) string {
+	points := make([]string, len(m.Points))
+	for i, p := range m.Points {
+		points[i] = "(" + PointToWKT(p) + ")"
+	}
+	return strings.Join(points, ",")
+}
+
+// MultiLineToWKT converts a sql.MultiLinestring to a string
+func MultiLineToWKT(m sql.MultiLinestring) string {
+	lines := make([]string, len(m.Lines))
+	for i, l := range m.Lines {
+		lines[i] = "(" + LineToWKT(l) + ")"
+	}
+	return strings.Join(lines, ",")
+}
+
+// MultiPolygonToWKT converts a sql.MultiPolygon to a string
+func MultiPolygonToWKT(m sql.MultiPolygon) string {
+	polys := make([]string, len(m.Polygons))
+	for i, p := range m.Polygons {
+		polys[i] = "(" + PolygonToWKT(p) + ")"
+	}
+	return strings.Join(polys, ",")
+}
+
+// GeometryCollectionToWKT converts a sql.GeometryCollection to a string
+func GeometryCollectionToWKT(g sql.GeometryCollection) (string, error) {
+	geoms := make([]string, len(g.Geometries))
+	for i, geom := range g.Geometries {
+		geomType, data, err := GeometryToWKT(geom)
+		if err != nil {
+			return "", err
+		}
+		geoms[i] = fmt.Sprintf("%s(%s)", geomType, data)
+	}
+	return strings.Join(geoms, ","), nil
+}
+
+// GeometryToWKT returns the WKT type name and data for the given geometry
+func GeometryToWKT(val interface{}) (string, string, error) {
+	switch v := val.(type) {
+	case sql.Point:
+		return "POINT", PointToWKT(v), nil
+	case sql.Linestring:
+		return "LINESTRING", LineToWKT(v), nil
+	case sql.Polygon:
+		return "POLYGON", PolygonToWKT(v), nil
+	case sql.MultiPoint:
+		return "MULTIPOINT", MultiPointToWKT(v), nil
+	case sql.MultiLinestring:
+		return "MULTILINESTRING", MultiLineToWKT(v), nil
+	case sql.MultiPolygon:
+		return "MULTIPOLYGON", MultiPolygonToWKT(v), nil
+	case sql.GeometryCollection:
+		data, err := GeometryCollectionToWKT(v)
+		if err != nil {
+			return "", "", err
+		}
+		return "GEOMETRYCOLLECTION", data, nil
+	default:
+		return "", "", sql.ErrInvalidGISData.New("ST_AsWKT")
+	}
+}
+
 // Eval implements the sql.Expression interface.
 func (p *AsWKT) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	// Evaluate child
@@ -104,24 +170,10 @@ func (p *AsWKT) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
-	var geomType string
-	var data string
 	// Expect one of the geometry types
-	switch v := val.(type) {
-	case sql.Point:
-		// Mark as point type
-		geomType = "POINT"
-		data = PointToWKT(v)
-	case sql.Linestring:
-		// Mark as linestring type
-		geomType = "LINESTRING"
-		data = LineToWKT(v)
-	case sql.Polygon:
-		// Mark as Polygon type
-		geomType = "POLYGON"
-		data = PolygonToWKT(v)
-	default:
-		return nil, sql.ErrInvalidGISData.New("ST_AsWKT")
+	geomType, data, err := GeometryToWKT(val)
+	if err != nil {
+		return nil, err
 	}
 
 	return fmt.Sprintf("%s(%s)", geomType, data), nil
@@ -325,6 +377,185 @@ func WKTToPoly(s string, srid uint32, order bool) (sql.Polygon, error) {
 	return sql.Polygon{SRID: srid, Lines: lines}, nil
 }
 
+// splitWKTGroups splits a string like "(1 2, 3 4), (5 6)" into the contents of each top-level parenthesized group,
+// e.g. ["1 2, 3 4", "5 6"]
+func splitWKTGroups(s string) ([]string, error) {
+	var groups []string
+	depth, start := 0, -1
+	for i, c := range s {
+		switch c {
+		case '(':
+			if depth == 0 {
+				start = i + 1
+			}
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, sql.ErrInvalidGISData.New("ST_GeomFromText")
+			}
+			if depth == 0 {
+				groups = append(groups, strings.TrimSpace(s[start:i]))
+			}
+		case ',':
+			// Groups must be comma-separated
+		default:
+			if depth == 0 && c != ' ' && c != '\t' && c != '\n' {
+				return nil, sql.ErrInvalidGISData.New("ST_GeomFromText")
+			}
+		}
+	}
+	if depth != 0 || len(groups) == 0 {
+		return nil, sql.ErrInvalidGISData.New("ST_GeomFromText")
+	}
+	return groups, nil
+}
+
+// splitWKTGeometries splits a string like "POINT(1 2), LINESTRING(1 2, 3 4)" on the commas that separate each
+// geometry
+func splitWKTGeometries(s string) ([]string, error) {
+	var geoms []string
+	depth, start := 0, 0
+	for i, c := range s {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, sql.ErrInvalidGISData.New("ST_GeomFromText")
+			}
+		case ',':
+			if depth == 0 {
+				geoms = append(geoms, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, sql.ErrInvalidGISData.New("ST_GeomFromText")
+	}
+	return append(geoms, strings.TrimSpace(s[start:])), nil
+}
+
+// WKTToMultiPoint expects a string like "1 2, 3 4" or "(1 2), (3 4)"
+func WKTToMultiPoint(s string, srid uint32, order bool) (sql.MultiPoint, error) {
+	// Empty string is wrong
+	if len(s) == 0 {
+		return sql.MultiPoint{}, sql.ErrInvalidGISData.New("ST_MPointFromText")
+	}
+
+	pointStrs := strings.Split(s, ",")
+	var points = make([]sql.Point, len(pointStrs))
+	for i, ps := range pointStrs {
+		// Each point may optionally be surrounded by parentheses
+		ps = strings.TrimSpace(ps)
+		if len(ps) > 0 && ps[0] == '(' {
+			if ps[len(ps)-1] != ')' {
+				return sql.MultiPoint{}, sql.ErrInvalidGISData.New("ST_MPointFromText")
+			}
+			ps = strings.TrimSpace(ps[1 : len(ps)-1])
+		}
+
+		p, err := WKTToPoint(ps, srid, order)
+		if err != nil {
+			return sql.MultiPoint{}, sql.ErrInvalidGISData.New("ST_MPointFromText")
+		}
+		points[i] = p
+	}
+
+	return sql.MultiPoint{SRID: srid, Points: points}, nil
+}
+
+// WKTToMultiLine expects a string like "(1 2, 3 4), (5 6, 7 8), ..."
+func WKTToMultiLine(s string, srid uint32, order bool) (sql.MultiLinestring, error) {
+	lineStrs, err := splitWKTGroups(s)
+	if err != nil {
+		return sql.MultiLinestring{}, sql.ErrInvalidGISData.New("ST_MLineFromText")
+	}
+
+	var lines = make([]sql.Linestring, len(lineStrs))
+	for i, ls := range lineStrs {
+		l, err := WKTToLine(ls, srid, order)
+		if err != nil {
+			return sql.MultiLinestring{}, sql.ErrInvalidGISData.New("ST_MLineFromText")
+		}
+		lines[i] = l
+	}
+
+	return sql.MultiLinestring{SRID: srid, Lines: lines}, nil
+}
+
+// WKTToMultiPoly expects a string like "((1 2, 3 4, 5 6, 1 2)), ((7 8, 9 10, 11 12, 7 8)), ..."
+func WKTToMultiPoly(s string, srid uint32, order bool) (sql.MultiPolygon, error) {
+	polyStrs, err := splitWKTGroups(s)
+	if err != nil {
+		return sql.MultiPolygon{}, sql.ErrInvalidGISData.New("ST_MPolyFromText")
+	}
+
+	var polys = make([]sql.Polygon, len(polyStrs))
+	for i, ps := range polyStrs {
+		p, err := WKTToPoly(ps, srid, order)
+		if err != nil {
+			return sql.MultiPolygon{}, sql.ErrInvalidGISData.New("ST_MPolyFromText")
+		}
+		polys[i] = p
+	}
+
+	return sql.MultiPolygon{SRID: srid, Polygons: polys}, nil
+}
+
+// WKTToGeomCollection expects a string like "POINT(1 2), LINESTRING(1 2, 3 4), ..."
+func WKTToGeomCollection(s string, srid uint32, order bool) (sql.GeometryCollection, error) {
+	// An empty collection is allowed
+	if len(s) == 0 {
+		return sql.GeometryCollection{SRID: srid}, nil
+	}
+
+	geomStrs, err := splitWKTGeometries(s)
+	if err != nil {
+		return sql.GeometryCollection{}, err
+	}
+
+	var geoms = make([]interface{}, len(geomStrs))
+	for i, gs := range geomStrs {
+		geomType, data, err := ParseWKTHeader(gs)
+		if err != nil {
+			return sql.GeometryCollection{}, err
+		}
+		geoms[i], err = WKTToGeometry(geomType, data, srid, order)
+		if err != nil {
+			return sql.GeometryCollection{}, err
+		}
+	}
+
+	return sql.GeometryCollection{SRID: srid, Geometries: geoms}, nil
+}
+
+// WKTToGeometry parses the data portion of a WKT string according to the geometry type given, as returned by
+// ParseWKTHeader
+func WKTToGeometry(geomType, data string, srid uint32, order bool) (interface{}, error) {
+	// TODO: define consts instead of string comparison?
+	switch geomType {
+	case "point":
+		return WKTToPoint(data, srid, order)
+	case "linestring":
+		return WKTToLine(data, srid, order)
+	case "polygon":
+		return WKTToPoly(data, srid, order)
+	case "multipoint":
+		return WKTToMultiPoint(data, srid, order)
+	case "multilinestring":
+		return WKTToMultiLine(data, srid, order)
+	case "multipolygon":
+		return WKTToMultiPoly(data, srid, order)
+	case "geometrycollection", "geomcollection":
+		return WKTToGeomCollection(data, srid, order)
+	default:
+		return nil, sql.ErrInvalidGISData.New("ST_GeomFromText")
+	}
+}
+
 // Eval implements the sql.Expression interface.
 func (g *GeomFromText) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	// Evaluate child
@@ -388,17 +619,7 @@ func (g *GeomFromText) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 	}
 
 	// Parse accordingly
-	// TODO: define consts instead of string comparison?
-	switch geomType {
-	case "point":
-		return WKTToPoint(data, srid, order)
-	case "linestring":
-		return WKTToLine(data, srid, order)
-	case "polygon":
-		return WKTToPoly(data, srid, order)
-	default:
-		return nil, sql.ErrInvalidGISData.New("ST_GeomFromText")
-	}
+	return WKTToGeometry(geomType, data, srid, order)
 }
 
 // PointFromWKT is a function that returns a point type from a WKT string
@@ -321,3 +321,87 @@ func TestGeomFromText(t *testing.T) {
 		require.Equal(sql.Polygon{SRID: 4230, Lines: []sql.Linestring{{SRID: 4230, Points: []sql.Point{{SRID: 4230, X: 0, Y: 0}, {SRID: 4230, X: 1, Y: 0}, {SRID: 4230, X: 0, Y: 1}, {SRID: 4230, X: 0, Y: 0}}}}}, v)
 	})
 }
+
+func TestMultiGeometryWKT(t *testing.T) {
+	line1 := sql.Linestring{Points: []sql.Point{{X: 1, Y: 2}, {X: 3, Y: 4}}}
+	line2 := sql.Linestring{Points: []sql.Point{{X: 5, Y: 6}, {X: 7, Y: 8}}}
+	ring := sql.Linestring{Points: []sql.Point{{X: 0, Y: 0}, {X: 1, Y: 1}, {X: 1, Y: 0}, {X: 0, Y: 0}}}
+
+	testCases := []struct {
+		name string
+		wkt  string
+		geom interface{}
+		typ  sql.Type
+	}{
+		{
+			name: "multipoint",
+			wkt:  "MULTIPOINT((1 2),(3 4))",
+			geom: sql.MultiPoint{Points: []sql.Point{{X: 1, Y: 2}, {X: 3, Y: 4}}},
+			typ:  sql.MultiPointType{},
+		},
+		{
+			name: "multilinestring",
+			wkt:  "MULTILINESTRING((1 2,3 4),(5 6,7 8))",
+			geom: sql.MultiLinestring{Lines: []sql.Linestring{line1, line2}},
+			typ:  sql.MultiLinestringType{},
+		},
+		{
+			name: "multipolygon",
+			wkt:  "MULTIPOLYGON(((0 0,1 1,1 0,0 0)),((0 0,1 1,1 0,0 0)))",
+			geom: sql.MultiPolygon{Polygons: []sql.Polygon{{Lines: []sql.Linestring{ring}}, {Lines: []sql.Linestring{ring}}}},
+			typ:  sql.MultiPolygonType{},
+		},
+		{
+			name: "geometrycollection",
+			wkt:  "GEOMETRYCOLLECTION(POINT(1 2),LINESTRING(1 2,3 4),MULTIPOINT((1 2),(3 4)))",
+			geom: sql.GeometryCollection{Geometries: []interface{}{
+				sql.Point{X: 1, Y: 2},
+				line1,
+				sql.MultiPoint{Points: []sql.Point{{X: 1, Y: 2}, {X: 3, Y: 4}}},
+			}},
+			typ: sql.GeometryCollectionType{},
+		},
+		{
+			name: "empty geometrycollection",
+			wkt:  "GEOMETRYCOLLECTION()",
+			geom: sql.GeometryCollection{},
+			typ:  sql.GeometryCollectionType{},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run("convert "+tt.name, func(t *testing.T) {
+			require := require.New(t)
+			f := NewAsWKT(expression.NewLiteral(tt.geom, tt.typ))
+			v, err := f.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(err)
+			require.Equal(tt.wkt, v)
+		})
+
+		t.Run("create "+tt.name, func(t *testing.T) {
+			require := require.New(t)
+			f, err := NewGeomFromWKT(expression.NewLiteral(tt.wkt, sql.Blob))
+			require.NoError(err)
+			v, err := f.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(err)
+			require.Equal(tt.geom, v)
+		})
+	}
+
+	t.Run("create multipoint without inner parentheses", func(t *testing.T) {
+		require := require.New(t)
+		f, err := NewGeomFromWKT(expression.NewLiteral("MULTIPOINT(1 2, 3 4)", sql.Blob))
+		require.NoError(err)
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Equal(sql.MultiPoint{Points: []sql.Point{{X: 1, Y: 2}, {X: 3, Y: 4}}}, v)
+	})
+
+	t.Run("create multilinestring with bad string", func(t *testing.T) {
+		require := require.New(t)
+		f, err := NewGeomFromWKT(expression.NewLiteral("MULTILINESTRING((1 2, 3 4), 5 6)", sql.Blob))
+		require.NoError(err)
+		_, err = f.Eval(sql.NewEmptyContext(), nil)
+		require.Error(err)
+	})
+}
@@ -70,6 +70,7 @@ func (in *InTuple) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	// also if no match is found in the list and one of the expressions in the list is NULL.
 	rightNull := false
 
+	originalLeft := left
 	left, err = typ.Convert(left)
 	if err != nil {
 		return nil, err
@@ -94,6 +95,26 @@ func (in *InTuple) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 				continue
 			}
 
+			if comparesStringWithNumber(in.Left().Type(), el.Type()) {
+				l, err := numericContextValue(ctx, originalLeft)
+				if err != nil {
+					return nil, err
+				}
+				r, err := numericContextValue(ctx, right)
+				if err != nil {
+					return nil, err
+				}
+
+				cmp, err := sql.Float64.Compare(l, r)
+				if err != nil {
+					return nil, err
+				}
+				if cmp == 0 {
+					return true, nil
+				}
+				continue
+			}
+
 			right, err = typ.Convert(right)
 			if err != nil {
 				return nil, err
@@ -154,6 +175,25 @@ type HashInTuple struct {
 
 var _ Comparer = (*InTuple)(nil)
 
+// ComparesStringsWithNumbers returns whether some element of the list is a string compared with a numeric left
+// value, or a number compared with a string one. Those are compared as numbers, like in other comparisons.
+func (in *InTuple) ComparesStringsWithNumbers() bool {
+	right, ok := in.Right().(Tuple)
+	if !ok {
+		return false
+	}
+	for _, el := range right {
+		if comparesStringWithNumber(in.Left().Type(), el.Type()) {
+			return true
+		}
+	}
+	return false
+}
+
+func comparesStringWithNumber(left, right sql.Type) bool {
+	return (sql.IsNumber(left) && sql.IsText(right)) || (sql.IsText(left) && sql.IsNumber(right))
+}
+
 // NewHashInTuple creates an InTuple expression.
 func NewHashInTuple(left, right sql.Expression) (*HashInTuple, error) {
 	rightTup, ok := right.(Tuple)
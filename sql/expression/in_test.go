@@ -90,6 +90,59 @@ func TestInTuple(t *testing.T) {
 			false,
 			nil,
 		},
+		{
+			"number in strings",
+			expression.NewLiteral(int8(5), sql.Int8),
+			expression.NewTuple(
+				expression.NewLiteral("x", sql.LongText),
+				expression.NewLiteral("5", sql.LongText),
+			),
+			nil,
+			true,
+			nil,
+		},
+		{
+			"number in strings compared numerically",
+			expression.NewLiteral(int8(5), sql.Int8),
+			expression.NewTuple(
+				expression.NewLiteral("5.0", sql.LongText),
+			),
+			nil,
+			true,
+			nil,
+		},
+		{
+			"string in numbers",
+			expression.NewLiteral("5", sql.LongText),
+			expression.NewTuple(
+				expression.NewLiteral(int8(5), sql.Int8),
+				expression.NewLiteral(int8(6), sql.Int8),
+			),
+			nil,
+			true,
+			nil,
+		},
+		{
+			"string in numbers and strings",
+			expression.NewLiteral("5.0", sql.LongText),
+			expression.NewTuple(
+				expression.NewLiteral("5", sql.LongText),
+				expression.NewLiteral(int8(6), sql.Int8),
+			),
+			nil,
+			false,
+			nil,
+		},
+		{
+			"strings compared as strings",
+			expression.NewLiteral("5.0", sql.LongText),
+			expression.NewTuple(
+				expression.NewLiteral("5", sql.LongText),
+			),
+			nil,
+			false,
+			nil,
+		},
 	}
 
 	for _, tt := range testCases {
@@ -75,9 +75,7 @@ func (l *Like) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	}
 
 	createMatcher := newDefaultLikeMatcher
-	lType := l.Left.Type()
-	lm, likeOK := lType.(sql.LikeMatcher)
-	if likeOK {
+	if lm := l.likeMatcher(); lm != nil {
 		createMatcher = lm.CreateMatcher
 	}
 
@@ -120,6 +118,27 @@ func (l *Like) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	return ok, nil
 }
 
+// likeMatcher returns the type whose collation determines how the pattern is matched, or nil if neither operand has
+// one. As in MySQL, the collation of a column takes precedence over the collation of a literal, so that
+// 'ABC' LIKE col matches according to the collation of col. Otherwise, the collation of the left operand is used.
+func (l *Like) likeMatcher() sql.LikeMatcher {
+	lm, leftOK := l.Left.Type().(sql.LikeMatcher)
+	rm, rightOK := l.Right.Type().(sql.LikeMatcher)
+
+	if rightOK && (!leftOK || isLiteral(l.Left) && !isLiteral(l.Right)) {
+		return rm
+	}
+	if leftOK {
+		return lm
+	}
+	return nil
+}
+
+func isLiteral(e sql.Expression) bool {
+	_, ok := e.(*Literal)
+	return ok
+}
+
 func (l *Like) evalRight(ctx *sql.Context, row sql.Row) (*string, error) {
 	v, err := l.Right.Eval(ctx, row)
 	if err != nil {
@@ -75,9 +75,10 @@ func TestCustomPatternToRegex(t *testing.T) {
 }
 
 func TestLike(t *testing.T) {
+	ci := sql.CreateText(sql.Collation_utf8mb4_0900_ai_ci)
 	f := NewLike(
-		NewGetField(0, sql.Text, "", false),
-		NewGetField(1, sql.Text, "", false),
+		NewGetField(0, ci, "", false),
+		NewGetField(1, ci, "", false),
 		nil,
 	)
 
@@ -106,3 +107,32 @@ func TestLike(t *testing.T) {
 		})
 	}
 }
+
+func TestLikeCollation(t *testing.T) {
+	ci := sql.CreateText(sql.Collation_utf8mb4_0900_ai_ci)
+	bin := sql.CreateText(sql.Collation_utf8mb4_0900_bin)
+
+	testCases := []struct {
+		name        string
+		left, right sql.Expression
+		ok          bool
+	}{
+		{"ci literals", NewLiteral("ABC", ci), NewLiteral("abc%", ci), true},
+		{"bin literals", NewLiteral("ABC", bin), NewLiteral("abc%", bin), false},
+		{"bin literals same case", NewLiteral("ABC", bin), NewLiteral("AB%", bin), true},
+		{"ci column", NewGetField(0, ci, "", false), NewLiteral("abc%", bin), true},
+		{"bin column", NewGetField(0, bin, "", false), NewLiteral("abc%", ci), false},
+		{"ci column on right", NewLiteral("abc", bin), NewGetField(0, ci, "", false), true},
+		{"bin column on right", NewLiteral("abc", ci), NewGetField(0, bin, "", false), false},
+		{"cs collation", NewLiteral("ABC", sql.CreateText(sql.Collation_utf8mb4_0900_as_cs)), NewLiteral("abc%", ci), false},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewLike(tt.left, tt.right, nil)
+			value, err := f.Eval(sql.NewEmptyContext(), sql.NewRow("ABC"))
+			require.NoError(t, err)
+			require.Equal(t, tt.ok, value)
+		})
+	}
+}
@@ -16,6 +16,7 @@ package expression
 
 import (
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -23,7 +24,9 @@ import (
 
 // ProcedureParamReference contains the references to the parameters for a single CALL statement.
 type ProcedureParamReference struct {
-	nameToParam map[string]*procedureParamReferenceValue
+	nameToParam  map[string]*procedureParamReferenceValue
+	nameToCursor map[string]*procedureCursor
+	handlers     []procedureHandler
 }
 type procedureParamReferenceValue struct {
 	Name       string
@@ -99,8 +102,119 @@ func (ppr *ProcedureParamReference) HasBeenSet(name string) bool {
 	return paramRefVal.HasBeenSet
 }
 
+// procedureCursor is a cursor declared within a stored procedure. The row iterator is only set while it is open.
+type procedureCursor struct {
+	Name       string
+	SelectStmt sql.Node
+	RowIter    sql.RowIter
+}
+
+// procedureHandler is a handler declared within a stored procedure for the given SQLSTATE.
+type procedureHandler struct {
+	SqlState string
+	Handler  sql.Node
+}
+
+// InitializeCursor declares a cursor over the given SELECT statement. Name is case-insensitive.
+func (ppr *ProcedureParamReference) InitializeCursor(name string, selectStmt sql.Node) error {
+	name = strings.ToLower(name)
+	if cursor, ok := ppr.nameToCursor[name]; ok && cursor.RowIter != nil {
+		return sql.ErrCursorAlreadyOpen.New()
+	}
+	ppr.nameToCursor[name] = &procedureCursor{
+		Name:       name,
+		SelectStmt: selectStmt,
+	}
+	return nil
+}
+
+// OpenCursor starts the iteration over the rows of the given cursor. Name is case-insensitive.
+func (ppr *ProcedureParamReference) OpenCursor(ctx *sql.Context, name string, row sql.Row) error {
+	cursor, ok := ppr.nameToCursor[strings.ToLower(name)]
+	if !ok {
+		return sql.ErrCursorNotFound.New(name)
+	}
+	if cursor.RowIter != nil {
+		return sql.ErrCursorAlreadyOpen.New()
+	}
+	iter, err := cursor.SelectStmt.RowIter(ctx, row)
+	if err != nil {
+		return err
+	}
+	cursor.RowIter = iter
+	return nil
+}
+
+// FetchCursor returns the next row of the given cursor, or sql.ErrFetchNoData once all rows have been fetched. Name
+// is case-insensitive.
+func (ppr *ProcedureParamReference) FetchCursor(ctx *sql.Context, name string) (sql.Row, sql.Schema, error) {
+	cursor, ok := ppr.nameToCursor[strings.ToLower(name)]
+	if !ok {
+		return nil, nil, sql.ErrCursorNotFound.New(name)
+	}
+	if cursor.RowIter == nil {
+		return nil, nil, sql.ErrCursorNotOpen.New()
+	}
+	row, err := cursor.RowIter.Next(ctx)
+	if err == io.EOF {
+		return nil, nil, sql.ErrFetchNoData.New()
+	} else if err != nil {
+		return nil, nil, err
+	}
+	return row, cursor.SelectStmt.Schema(), nil
+}
+
+// CloseCursor ends the iteration over the rows of the given cursor. Name is case-insensitive.
+func (ppr *ProcedureParamReference) CloseCursor(ctx *sql.Context, name string) error {
+	cursor, ok := ppr.nameToCursor[strings.ToLower(name)]
+	if !ok {
+		return sql.ErrCursorNotFound.New(name)
+	}
+	if cursor.RowIter == nil {
+		return sql.ErrCursorNotOpen.New()
+	}
+	err := cursor.RowIter.Close(ctx)
+	cursor.RowIter = nil
+	return err
+}
+
+// CloseAllCursors closes every cursor that is still open, which happens when the procedure ends.
+func (ppr *ProcedureParamReference) CloseAllCursors(ctx *sql.Context) error {
+	var err error
+	for _, cursor := range ppr.nameToCursor {
+		if cursor.RowIter != nil {
+			if nErr := cursor.RowIter.Close(ctx); nErr != nil && err == nil {
+				err = nErr
+			}
+			cursor.RowIter = nil
+		}
+	}
+	return err
+}
+
+// InitializeHandler declares a handler for the given SQLSTATE.
+func (ppr *ProcedureParamReference) InitializeHandler(sqlState string, handler sql.Node) {
+	ppr.handlers = append(ppr.handlers, procedureHandler{
+		SqlState: sqlState,
+		Handler:  handler,
+	})
+}
+
+// Handler returns the most recently declared handler for the given SQLSTATE, or nil if there is none.
+func (ppr *ProcedureParamReference) Handler(sqlState string) sql.Node {
+	for i := len(ppr.handlers) - 1; i >= 0; i-- {
+		if ppr.handlers[i].SqlState == sqlState {
+			return ppr.handlers[i].Handler
+		}
+	}
+	return nil
+}
+
 func NewProcedureParamReference() *ProcedureParamReference {
-	return &ProcedureParamReference{make(map[string]*procedureParamReferenceValue)}
+	return &ProcedureParamReference{
+		nameToParam:  make(map[string]*procedureParamReferenceValue),
+		nameToCursor: make(map[string]*procedureCursor),
+	}
 }
 
 // ProcedureParam represents the parameter of a stored procedure or stored function.
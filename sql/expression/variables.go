@@ -92,12 +92,18 @@ func (v *SystemVar) WithChildren(children ...sql.Expression) (sql.Expression, er
 // UserVar is an expression that returns the value of a user variable. It's also used as the expression on the left hand
 // side of a SET statement for a user var.
 type UserVar struct {
-	Name string
+	Name     string
+	exprType sql.Type
 }
 
 // NewUserVar creates a new UserVar expression.
 func NewUserVar(name string) *UserVar {
-	return &UserVar{name}
+	return &UserVar{Name: name}
+}
+
+// NewUserVarWithType creates a new UserVar expression with the type of the value last assigned to the variable.
+func NewUserVarWithType(name string, t sql.Type) *UserVar {
+	return &UserVar{Name: name, exprType: t}
 }
 
 // Children implements the sql.Expression interface.
@@ -113,8 +119,12 @@ func (v *UserVar) Eval(ctx *sql.Context, _ sql.Row) (interface{}, error) {
 }
 
 // Type implements the sql.Expression interface.
-// TODO: type checking based on type of user var
-func (v *UserVar) Type() sql.Type { return sql.Boolean }
+func (v *UserVar) Type() sql.Type {
+	if v.exprType == nil {
+		return sql.Boolean
+	}
+	return v.exprType
+}
 
 // IsNullable implements the sql.Expression interface.
 func (v *UserVar) IsNullable() bool { return true }
@@ -132,3 +142,48 @@ func (v *UserVar) WithChildren(children ...sql.Expression) (sql.Expression, erro
 	}
 	return v, nil
 }
+
+// UserVarAssignment is an expression assigning the value of its child to a user variable and returning it, as in
+// `@var := expr`. The expressions of a row are evaluated from left to right, so the expressions after an assignment see
+// the new value of the variable.
+type UserVarAssignment struct {
+	UnaryExpression
+	Var *UserVar
+}
+
+var _ sql.NonDeterministicExpression = (*UserVarAssignment)(nil)
+
+// NewUserVarAssignment creates a new UserVarAssignment expression.
+func NewUserVarAssignment(v *UserVar, child sql.Expression) *UserVarAssignment {
+	return &UserVarAssignment{UnaryExpression{child}, v}
+}
+
+// Eval implements the sql.Expression interface.
+func (a *UserVarAssignment) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	val, err := a.Child.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if err = ctx.SetUserVariable(ctx, a.Var.Name, val); err != nil {
+		return nil, err
+	}
+	return val, nil
+}
+
+// Type implements the sql.Expression interface.
+func (a *UserVarAssignment) Type() sql.Type { return a.Child.Type() }
+
+// IsNonDeterministic implements the sql.NonDeterministicExpression interface. Assignments have side effects, so they
+// must be evaluated for every row.
+func (a *UserVarAssignment) IsNonDeterministic() bool { return true }
+
+// String implements the sql.Expression interface.
+func (a *UserVarAssignment) String() string { return fmt.Sprintf("%s := %s", a.Var, a.Child) }
+
+// WithChildren implements the Expression interface.
+func (a *UserVarAssignment) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(a, len(children), 1)
+	}
+	return NewUserVarAssignment(a.Var, children[0]), nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestUserVar(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	val, err := NewUserVar("undefined").Eval(ctx, nil)
+	require.NoError(err)
+	require.Nil(val)
+
+	require.NoError(ctx.SetUserVariable(ctx, "foo", int64(42)))
+	val, err = NewUserVar("FOO").Eval(ctx, nil)
+	require.NoError(err)
+	require.Equal(int64(42), val)
+
+	require.Equal(sql.Boolean, NewUserVar("foo").Type())
+	require.Equal(sql.Int64, NewUserVarWithType("foo", sql.Int64).Type())
+}
+
+func TestUserVarAssignment(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+	require.NoError(ctx.SetUserVariable(ctx, "total", int64(0)))
+
+	// SELECT @total := @total + amount, @total * 10 FROM ...
+	total := NewUserVarWithType("total", sql.Int64)
+	exprs := []sql.Expression{
+		NewUserVarAssignment(total, NewPlus(total, NewGetField(0, sql.Int64, "amount", false))),
+		NewMult(total, NewLiteral(int64(10), sql.Int64)),
+	}
+	require.Equal("@total := (@total + amount)", exprs[0].String())
+
+	var results []sql.Row
+	for _, row := range []sql.Row{{int64(1)}, {int64(2)}, {int64(3)}} {
+		var result sql.Row
+		for _, e := range exprs {
+			val, err := e.Eval(ctx, row)
+			require.NoError(err)
+			result = append(result, val)
+		}
+		results = append(results, result)
+	}
+
+	require.Equal([]sql.Row{
+		{int64(1), int64(10)},
+		{int64(3), int64(30)},
+		{int64(6), int64(60)},
+	}, results)
+
+	_, val, err := ctx.GetUserVariable(ctx, "total")
+	require.NoError(err)
+	require.Equal(int64(6), val)
+}
@@ -0,0 +1,188 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+)
+
+// Represents the GeometryCollection type. Each element of Geometries is one of Point, Linestring, Polygon,
+// MultiPoint, MultiLinestring, MultiPolygon or GeometryCollection.
+// https://dev.mysql.com/doc/refman/8.0/en/gis-class-geometrycollection.html
+type GeometryCollection struct {
+	SRID       uint32
+	Geometries []interface{}
+}
+
+type GeometryCollectionType struct{}
+
+var _ Type = GeometryCollectionType{}
+
+var ErrNotGeometryCollection = errors.NewKind("value of type %T is not a geometrycollection")
+
+// geometryTypeFor returns the Type for the geometry value given, or nil if the value is not a geometry.
+func geometryTypeFor(v interface{}) Type {
+	switch v.(type) {
+	case Point:
+		return PointType{}
+	case Linestring:
+		return LinestringType{}
+	case Polygon:
+		return PolygonType{}
+	case MultiPoint:
+		return MultiPointType{}
+	case MultiLinestring:
+		return MultiLinestringType{}
+	case MultiPolygon:
+		return MultiPolygonType{}
+	case GeometryCollection:
+		return GeometryCollectionType{}
+	default:
+		return nil
+	}
+}
+
+// geometryOrder returns the position of the geometry value's type in the order used to compare geometries of
+// different types.
+func geometryOrder(v interface{}) int {
+	switch v.(type) {
+	case Point:
+		return 1
+	case Linestring:
+		return 2
+	case Polygon:
+		return 3
+	case MultiPoint:
+		return 4
+	case MultiLinestring:
+		return 5
+	case MultiPolygon:
+		return 6
+	case GeometryCollection:
+		return 7
+	default:
+		return 0
+	}
+}
+
+// Compare implements Type interface.
+func (t GeometryCollectionType) Compare(a interface{}, b interface{}) (int, error) {
+	// Compare nulls
+	if hasNulls, res := compareNulls(a, b); hasNulls {
+		return res, nil
+	}
+
+	// Expect to receive a GeometryCollection, throw error otherwise
+	_a, ok := a.(GeometryCollection)
+	if !ok {
+		return 0, ErrNotGeometryCollection.New(a)
+	}
+	_b, ok := b.(GeometryCollection)
+	if !ok {
+		return 0, ErrNotGeometryCollection.New(b)
+	}
+
+	// Get shorter length
+	var n int
+	lenA := len(_a.Geometries)
+	lenB := len(_b.Geometries)
+	if lenA < lenB {
+		n = lenA
+	} else {
+		n = lenB
+	}
+
+	// Compare each geometry until there's a difference, ordering geometries of different types by their type
+	for i := 0; i < n; i++ {
+		ga, gb := _a.Geometries[i], _b.Geometries[i]
+		oa, ob := geometryOrder(ga), geometryOrder(gb)
+		if oa > ob {
+			return 1, nil
+		}
+		if oa < ob {
+			return -1, nil
+		}
+
+		typ := geometryTypeFor(ga)
+		if typ == nil {
+			return 0, ErrNotGeometryCollection.New(ga)
+		}
+		diff, err := typ.Compare(ga, gb)
+		if err != nil {
+			return 0, err
+		}
+		if diff != 0 {
+			return diff, nil
+		}
+	}
+
+	// Determine based off length
+	if lenA > lenB {
+		return 1, nil
+	}
+	if lenA < lenB {
+		return -1, nil
+	}
+
+	// Geometry collections must be the same
+	return 0, nil
+}
+
+// Convert implements Type interface.
+func (t GeometryCollectionType) Convert(v interface{}) (interface{}, error) {
+	// Must be a GeometryCollection, fail otherwise
+	if v, ok := v.(GeometryCollection); ok {
+		return v, nil
+	}
+
+	return nil, ErrNotGeometryCollection.New(v)
+}
+
+// Promote implements the Type interface.
+func (t GeometryCollectionType) Promote() Type {
+	return t
+}
+
+// SQL implements Type interface.
+func (t GeometryCollectionType) SQL(v interface{}) (sqltypes.Value, error) {
+	if v == nil {
+		return sqltypes.NULL, nil
+	}
+
+	pv, err := t.Convert(v)
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+
+	return geometrySQL(pv.(GeometryCollection).SRID, pv)
+}
+
+// String implements Type interface.
+func (t GeometryCollectionType) String() string {
+	return "GEOMETRYCOLLECTION"
+}
+
+// Type implements Type interface.
+func (t GeometryCollectionType) Type() query.Type {
+	return sqltypes.Geometry
+}
+
+// Zero implements Type interface.
+func (t GeometryCollectionType) Zero() interface{} {
+	return GeometryCollection{}
+}
@@ -51,6 +51,31 @@ type Index interface {
 	ColumnExpressionTypes(ctx *Context) []ColumnExpressionType
 }
 
+// IndexOrder represents the order in which an index returns rows when it is read.
+type IndexOrder byte
+
+const (
+	// IndexOrderNone means that rows are not returned in any particular order.
+	IndexOrderNone IndexOrder = iota
+	// IndexOrderAsc means that rows are returned in ascending order of the index expressions.
+	IndexOrderAsc
+	// IndexOrderDesc means that rows are returned in descending order of the index expressions.
+	IndexOrderDesc
+)
+
+// OrderedIndex is an extension of Index for indexes that return rows in a defined order. The analyzer may use an
+// OrderedIndex to satisfy an ORDER BY without sorting, so integrators must guarantee that all rows returned for a
+// lookup on this index, across all partitions, are returned in index order.
+type OrderedIndex interface {
+	Index
+	// Order returns the order of results for reads from this index.
+	Order() IndexOrder
+	// NullOrdering returns where this index stores NULL values relative to non-NULL values. It has the same meaning as
+	// the NullOrdering of a SortField: NullsFirst means that NULL values are considered smaller than all other values,
+	// which is how MySQL sorts them.
+	NullOrdering() NullOrdering
+}
+
 // IndexLookup is the implementation-specific definition of an index lookup. The IndexLookup must contain all necessary
 // information to retrieve exactly the rows in the table as specified by the ranges given to their parent index.
 // Implementors are responsible for all semantics of correctly returning rows that match an index lookup.
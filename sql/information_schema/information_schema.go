@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 	"time"
 
@@ -73,6 +74,10 @@ const (
 	PartitionsTableName = "partitions"
 	// InnoDBTempTableName is the name of the INNODB_TEMP_TABLE_INFO table
 	InnoDBTempTableName = "innodb_temp_table_info"
+	// ProcessListTableName is the name of the processlist table
+	ProcessListTableName = "processlist"
+	// ParametersTableName is the name of the parameters table
+	ParametersTableName = "parameters"
 )
 
 var _ Database = (*informationSchemaDatabase)(nil)
@@ -365,6 +370,25 @@ var routinesSchema = Schema{
 	{Name: "database_collation", Type: LongText, Default: nil, Nullable: false, Source: RoutinesTableName},
 }
 
+var parametersSchema = Schema{
+	{Name: "specific_catalog", Type: LongText, Default: nil, Nullable: false, Source: ParametersTableName},
+	{Name: "specific_schema", Type: LongText, Default: nil, Nullable: false, Source: ParametersTableName},
+	{Name: "specific_name", Type: LongText, Default: nil, Nullable: false, Source: ParametersTableName},
+	{Name: "ordinal_position", Type: Uint64, Default: nil, Nullable: false, Source: ParametersTableName},
+	{Name: "parameter_mode", Type: LongText, Default: nil, Nullable: true, Source: ParametersTableName},
+	{Name: "parameter_name", Type: LongText, Default: nil, Nullable: true, Source: ParametersTableName},
+	{Name: "data_type", Type: LongText, Default: nil, Nullable: true, Source: ParametersTableName},
+	{Name: "character_maximum_length", Type: Int64, Default: nil, Nullable: true, Source: ParametersTableName},
+	{Name: "character_octet_length", Type: Int64, Default: nil, Nullable: true, Source: ParametersTableName},
+	{Name: "numeric_precision", Type: Int64, Default: nil, Nullable: true, Source: ParametersTableName},
+	{Name: "numeric_scale", Type: Int64, Default: nil, Nullable: true, Source: ParametersTableName},
+	{Name: "datetime_precision", Type: Int64, Default: nil, Nullable: true, Source: ParametersTableName},
+	{Name: "character_set_name", Type: LongText, Default: nil, Nullable: true, Source: ParametersTableName},
+	{Name: "collation_name", Type: LongText, Default: nil, Nullable: true, Source: ParametersTableName},
+	{Name: "dtd_identifier", Type: LongText, Default: nil, Nullable: false, Source: ParametersTableName},
+	{Name: "routine_type", Type: LongText, Default: nil, Nullable: false, Source: ParametersTableName},
+}
+
 var viewsSchema = Schema{
 	{Name: "table_catalog", Type: LongText, Default: nil, Nullable: true, Source: ViewsTableName},
 	{Name: "table_schema", Type: LongText, Default: nil, Nullable: true, Source: ViewsTableName},
@@ -443,6 +467,17 @@ var innoDBTempTableSchema = Schema{
 	{Name: "space", Type: Uint64, Default: nil, Nullable: false, Source: InnoDBTempTableName},
 }
 
+var processListSchema = Schema{
+	{Name: "id", Type: Uint64, Default: nil, Nullable: false, Source: ProcessListTableName},
+	{Name: "user", Type: MustCreateStringWithDefaults(sqltypes.VarChar, 32), Default: nil, Nullable: false, Source: ProcessListTableName},
+	{Name: "host", Type: MustCreateStringWithDefaults(sqltypes.VarChar, 261), Default: nil, Nullable: false, Source: ProcessListTableName},
+	{Name: "db", Type: MustCreateStringWithDefaults(sqltypes.VarChar, 64), Default: nil, Nullable: true, Source: ProcessListTableName},
+	{Name: "command", Type: MustCreateStringWithDefaults(sqltypes.VarChar, 16), Default: nil, Nullable: false, Source: ProcessListTableName},
+	{Name: "time", Type: Int32, Default: nil, Nullable: false, Source: ProcessListTableName},
+	{Name: "state", Type: MustCreateStringWithDefaults(sqltypes.VarChar, 64), Default: nil, Nullable: true, Source: ProcessListTableName},
+	{Name: "info", Type: LongText, Default: nil, Nullable: true, Source: ProcessListTableName},
+}
+
 func tablesRowIter(ctx *Context, cat Catalog) (RowIter, error) {
 	var rows []Row
 	for _, db := range cat.AllDatabases() {
@@ -458,6 +493,22 @@ func tablesRowIter(ctx *Context, cat Catalog) (RowIter, error) {
 		y2k, _ := Timestamp.Convert("2000-01-01 00:00:00")
 		err := DBTableIter(ctx, db, func(t Table) (cont bool, err error) {
 			autoVal := getAutoIncrementValue(ctx, t)
+			tableType := tableType
+			if IsSystemVersioned(t) {
+				tableType = "SYSTEM VERSIONED"
+			}
+
+			var tableRows, avgRowLength, dataLength interface{}
+			numRows, length, ok, err := plan.TableSizes(ctx, t)
+			if err != nil {
+				return false, err
+			}
+			if ok {
+				tableRows, dataLength, avgRowLength = numRows, length, uint64(0)
+				if numRows > 0 {
+					avgRowLength = length / numRows
+				}
+			}
 			rows = append(rows, Row{
 				"def",                      // table_catalog
 				db.Name(),                  // table_schema
@@ -466,9 +517,9 @@ func tablesRowIter(ctx *Context, cat Catalog) (RowIter, error) {
 				engine,                     // engine
 				10,                         // version (protocol, always 10)
 				rowFormat,                  // row_format
-				nil,                        // table_rows
-				nil,                        // avg_row_length
-				nil,                        // data_length
+				tableRows,                  // table_rows
+				avgRowLength,               // avg_row_length
+				dataLength,                 // data_length
 				nil,                        // max_data_length
 				nil,                        // max_data_length
 				nil,                        // data_free
@@ -689,13 +740,16 @@ func triggersRowIter(ctx *Context, c Catalog) (RowIter, error) {
 				}
 			}
 
-			// These are grouped as such just to use the index as the action order. No special importance on the arrangement,
-			// or the fact that these are slices in a larger slice rather than separate counts.
+			// These are grouped as such to count the action order of the triggers of each table. No special importance on
+			// the arrangement, or the fact that these are slices in a larger slice rather than separate counts.
 			for _, planGroup := range [][]*plan.CreateTrigger{beforeDelete, beforeInsert, beforeUpdate, afterDelete, afterInsert, afterUpdate} {
-				for order, triggerPlan := range planGroup {
+				tableOrders := make(map[string]int64)
+				for _, triggerPlan := range planGroup {
 					triggerEvent := strings.ToUpper(triggerPlan.TriggerEvent)
 					triggerTime := strings.ToUpper(triggerPlan.TriggerTime)
 					tableName := triggerPlan.Table.(*plan.UnresolvedTable).Name()
+					tableOrders[strings.ToLower(tableName)]++
+					order := tableOrders[strings.ToLower(tableName)]
 					characterSetClient, err := ctx.GetSessionVariable(ctx, "character_set_client")
 					if err != nil {
 						return nil, err
@@ -716,7 +770,7 @@ func triggersRowIter(ctx *Context, c Catalog) (RowIter, error) {
 						"def",                   // event_object_catalog
 						triggerDb.Name(),        // event_object_schema //TODO: table may be in a different db
 						tableName,               // event_object_table
-						int64(order + 1),        // action_order
+						order,                   // action_order
 						nil,                     // action_condition
 						triggerPlan.BodyString,  // action_statement
 						"ROW",                   // action_orientation
@@ -844,6 +898,102 @@ func tableConstraintRowIter(ctx *Context, c Catalog) (RowIter, error) {
 	return RowsToRowIter(rows...), nil
 }
 
+// statisticsRowIter returns a row for each column of each index, in the order of the columns in the index.
+func statisticsRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	var rows []Row
+	for _, db := range c.AllDatabases() {
+		tableNames, err := db.GetTableNames(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tableName := range tableNames {
+			tbl, _, err := c.Table(ctx, db.Name(), tableName)
+			if err != nil {
+				return nil, err
+			}
+
+			indexTable, ok := tbl.(IndexedTable)
+			if !ok {
+				continue
+			}
+
+			indexes, err := indexTable.GetIndexes(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			// The cardinality is only known for unique indexes, which have as many distinct keys as rows.
+			var numRows interface{}
+			if st, ok := tbl.(StatisticsTable); ok {
+				n, err := st.NumRows(ctx)
+				if err != nil {
+					return nil, err
+				}
+				numRows = int64(n)
+			}
+
+			for _, index := range indexes {
+				if index.IsGenerated() {
+					continue
+				}
+
+				nonUnique := int64(1)
+				if index.IsUnique() {
+					nonUnique = 0
+				}
+
+				var collation interface{}
+				if strings.EqualFold(index.IndexType(), "BTREE") {
+					collation = "A"
+				}
+
+				exprs := index.Expressions()
+				for i, expr := range exprs {
+					var columnName, expression interface{}
+					nullable := ""
+					if col := plan.GetColumnFromIndexExpr(expr, tbl); col != nil {
+						columnName = col.Name
+						if col.Nullable {
+							nullable = "YES"
+						}
+					} else {
+						expression = expr
+					}
+
+					var cardinality interface{}
+					if index.IsUnique() && i == len(exprs)-1 {
+						cardinality = numRows
+					}
+
+					rows = append(rows, Row{
+						"def",             // table_catalog
+						db.Name(),         // table_schema
+						tbl.Name(),        // table_name
+						nonUnique,         // non_unique
+						db.Name(),         // index_schema
+						index.ID(),        // index_name
+						int64(i + 1),      // seq_in_index
+						columnName,        // column_name
+						collation,         // collation
+						cardinality,       // cardinality
+						nil,               // sub_part
+						nil,               // packed
+						nullable,          // nullable
+						index.IndexType(), // index_type
+						"",                // comment
+						index.Comment(),   // index_comment
+						"YES",             // is_visible
+						expression,        // expression
+					})
+				}
+			}
+		}
+	}
+
+	return RowsToRowIter(rows...), nil
+}
+
 func getColumnNamesFromIndex(idx Index, table Table) []string {
 	var indexCols []string
 	for _, expr := range idx.Expressions() {
@@ -923,6 +1073,319 @@ func keyColumnConstraintRowIter(ctx *Context, c Catalog) (RowIter, error) {
 	return RowsToRowIter(rows...), nil
 }
 
+// storedProcedure is a stored procedure parsed from its create statement, along with the times it was created and
+// last modified.
+type storedProcedure struct {
+	*plan.CreateProcedure
+	details StoredProcedureDetails
+}
+
+// storedProcedures returns the stored procedures of the database given, parsed from their create statements.
+func storedProcedures(ctx *Context, db Database) ([]storedProcedure, error) {
+	pdb, ok := db.(StoredProcedureDatabase)
+	if !ok {
+		return nil, nil
+	}
+
+	details, err := pdb.GetStoredProcedures(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	procedures := make([]storedProcedure, len(details))
+	for i, detail := range details {
+		parsed, err := parse.Parse(ctx, detail.CreateStatement)
+		if err != nil {
+			return nil, err
+		}
+		cp, ok := parsed.(*plan.CreateProcedure)
+		if !ok {
+			return nil, ErrProcedureCreateStatementInvalid.New(detail.CreateStatement)
+		}
+		procedures[i] = storedProcedure{CreateProcedure: cp, details: detail}
+	}
+	return procedures, nil
+}
+
+// routinesRowIter returns a row for each stored procedure.
+func routinesRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	characterSetClient, err := ctx.GetSessionVariable(ctx, "character_set_client")
+	if err != nil {
+		return nil, err
+	}
+	collationConnection, err := ctx.GetSessionVariable(ctx, "collation_connection")
+	if err != nil {
+		return nil, err
+	}
+	collationServer, err := ctx.GetSessionVariable(ctx, "collation_server")
+	if err != nil {
+		return nil, err
+	}
+	sqlMode, err := ctx.GetSessionVariable(ctx, "sql_mode")
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []Row
+	for _, db := range c.AllDatabases() {
+		procedures, err := storedProcedures(ctx, db)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, procedure := range procedures {
+			securityType := "DEFINER"
+			if procedure.SecurityContext == plan.ProcedureSecurityContext_Invoker {
+				securityType = "INVOKER"
+			}
+
+			isDeterministic := "NO"
+			sqlDataAccess := "CONTAINS SQL"
+			for _, characteristic := range procedure.Characteristics {
+				switch characteristic {
+				case plan.Characteristic_Deterministic:
+					isDeterministic = "YES"
+				case plan.Characteristic_NotDeterministic:
+					isDeterministic = "NO"
+				case plan.Characteristic_ContainsSql:
+					sqlDataAccess = "CONTAINS SQL"
+				case plan.Characteristic_NoSql:
+					sqlDataAccess = "NO SQL"
+				case plan.Characteristic_ReadsSqlData:
+					sqlDataAccess = "READS SQL DATA"
+				case plan.Characteristic_ModifiesSqlData:
+					sqlDataAccess = "MODIFIES SQL DATA"
+				}
+			}
+
+			rows = append(rows, Row{
+				procedure.Name,                     // specific_name
+				"def",                              // routine_catalog
+				db.Name(),                          // routine_schema
+				procedure.Name,                     // routine_name
+				"PROCEDURE",                        // routine_type
+				"",                                 // data_type
+				nil,                                // character_maximum_length
+				nil,                                // character_octet_length
+				nil,                                // numeric_precision
+				nil,                                // numeric_scale
+				nil,                                // datetime_precision
+				nil,                                // character_set_name
+				nil,                                // collation_name
+				nil,                                // dtd_identifier
+				"SQL",                              // routine_body
+				procedure.BodyString,               // routine_definition
+				nil,                                // external_name
+				"SQL",                              // external_language
+				"SQL",                              // parameter_style
+				isDeterministic,                    // is_deterministic
+				sqlDataAccess,                      // sql_data_access
+				nil,                                // sql_path
+				securityType,                       // security_type
+				procedure.details.CreatedAt.UTC(),  // created
+				procedure.details.ModifiedAt.UTC(), // last_altered
+				sqlMode,                            // sql_mode
+				procedure.Comment,                  // routine_comment
+				procedure.Definer,                  // definer
+				characterSetClient,                 // character_set_client
+				collationConnection,                // collation_connection
+				collationServer,                    // database_collation
+			})
+		}
+	}
+	return RowsToRowIter(rows...), nil
+}
+
+// parametersRowIter returns a row for each parameter of each stored procedure.
+func parametersRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	var rows []Row
+	for _, db := range c.AllDatabases() {
+		procedures, err := storedProcedures(ctx, db)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, procedure := range procedures {
+			for i, param := range procedure.Params {
+				mode := "IN"
+				switch param.Direction {
+				case plan.ProcedureParamDirection_Inout:
+					mode = "INOUT"
+				case plan.ProcedureParamDirection_Out:
+					mode = "OUT"
+				}
+
+				var charName, collName interface{}
+				if IsText(param.Type) {
+					charName = Collation_Default.CharacterSet().String()
+					collName = Collation_Default.String()
+				}
+
+				rows = append(rows, Row{
+					"def",                                // specific_catalog
+					db.Name(),                            // specific_schema
+					procedure.Name,                       // specific_name
+					uint64(i + 1),                        // ordinal_position
+					mode,                                 // parameter_mode
+					param.Name,                           // parameter_name
+					strings.ToLower(param.Type.String()), // data_type
+					nil,                                  // character_maximum_length
+					nil,                                  // character_octet_length
+					nil,                                  // numeric_precision
+					nil,                                  // numeric_scale
+					nil,                                  // datetime_precision
+					charName,                             // character_set_name
+					collName,                             // collation_name
+					strings.ToLower(param.Type.String()), // dtd_identifier
+					"PROCEDURE",                          // routine_type
+				})
+			}
+		}
+	}
+	return RowsToRowIter(rows...), nil
+}
+
+// referentialConstraintsRowIter returns a row for each foreign key, with the index of the referenced table it relies on
+// and its referential actions.
+func referentialConstraintsRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	var rows []Row
+	for _, db := range c.AllDatabases() {
+		tableNames, err := db.GetTableNames(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tableName := range tableNames {
+			tbl, _, err := c.Table(ctx, db.Name(), tableName)
+			if err != nil {
+				return nil, err
+			}
+
+			fkTable, ok := tbl.(ForeignKeyTable)
+			if !ok {
+				continue
+			}
+
+			fks, err := fkTable.GetForeignKeys(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, fk := range fks {
+				uniqueConstraintName, err := referencedIndexName(ctx, c, db.Name(), fk)
+				if err != nil {
+					return nil, err
+				}
+
+				rows = append(rows, Row{
+					"def",                        // constraint_catalog
+					db.Name(),                    // constraint_schema
+					fk.Name,                      // constraint_name
+					"def",                        // unique_constraint_catalog
+					db.Name(),                    // unique_constraint_schema
+					uniqueConstraintName,         // unique_constraint_name
+					"NONE",                       // match_option
+					referentialRule(fk.OnUpdate), // update_rule
+					referentialRule(fk.OnDelete), // delete_rule
+					tbl.Name(),                   // table_name
+					fk.ReferencedTable,           // referenced_table_name
+				})
+			}
+		}
+	}
+
+	return RowsToRowIter(rows...), nil
+}
+
+// referencedIndexName returns the name of the index of the referenced table of the foreign key given that starts with
+// the referenced columns, preferring unique indexes. Returns nil if there is no such index.
+func referencedIndexName(ctx *Context, c Catalog, dbName string, fk ForeignKeyConstraint) (interface{}, error) {
+	tbl, _, err := c.Table(ctx, dbName, fk.ReferencedTable)
+	if err != nil {
+		if ErrTableNotFound.Is(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	indexTable, ok := tbl.(IndexedTable)
+	if !ok {
+		return nil, nil
+	}
+
+	indexes, err := indexTable.GetIndexes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var name interface{}
+	for _, index := range indexes {
+		colNames := getColumnNamesFromIndex(index, tbl)
+		if len(colNames) < len(fk.ReferencedColumns) {
+			continue
+		}
+
+		matches := true
+		for i, refCol := range fk.ReferencedColumns {
+			if !strings.EqualFold(strings.Replace(colNames[i], "`", "", -1), strings.Replace(refCol, "`", "", -1)) {
+				matches = false
+				break
+			}
+		}
+		if !matches {
+			continue
+		}
+
+		if index.IsUnique() {
+			return index.ID(), nil
+		}
+		if name == nil {
+			name = index.ID()
+		}
+	}
+
+	return name, nil
+}
+
+// referentialRule returns the rule of the referential action given, as listed in information_schema.
+func referentialRule(option ForeignKeyReferenceOption) string {
+	if option == ForeignKeyReferenceOption_DefaultAction {
+		return string(ForeignKeyReferenceOption_NoAction)
+	}
+	return string(option)
+}
+
+// processListRowIter returns info on the connections and queries of the process list, ordered by connection.
+func processListRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	processes := ctx.ProcessList.Processes()
+	sort.Slice(processes, func(i, j int) bool {
+		return processes[i].Connection < processes[j].Connection
+	})
+
+	var rows []Row
+	for _, proc := range processes {
+		var db, state, info interface{}
+		if proc.Database != "" {
+			db = proc.Database
+		}
+		if proc.Command == ProcessCommandQuery {
+			state = "executing"
+			info = proc.Query
+		}
+		rows = append(rows, Row{
+			uint64(proc.Connection),
+			proc.User,
+			proc.Host,
+			db,
+			string(proc.Command),
+			int32(proc.Seconds()),
+			state,
+			info,
+		})
+	}
+	return RowsToRowIter(rows...), nil
+}
+
 // innoDBTempTableIter returns info on the temporary tables stored in the session.
 // TODO: Since Table ids and Space are not yet supported this table is not completely accurate yet.
 func innoDBTempTableIter(ctx *Context, c Catalog) (RowIter, error) {
@@ -991,7 +1454,7 @@ func NewInformationSchemaDatabase() Database {
 			StatisticsTableName: &informationSchemaTable{
 				name:    StatisticsTableName,
 				schema:  statisticsSchema,
-				rowIter: emptyRowIter,
+				rowIter: statisticsRowIter,
 			},
 			TableConstraintsTableName: &informationSchemaTable{
 				name:    TableConstraintsTableName,
@@ -1001,7 +1464,7 @@ func NewInformationSchemaDatabase() Database {
 			ReferentialConstraintsTableName: &informationSchemaTable{
 				name:    ReferentialConstraintsTableName,
 				schema:  referentialConstraintsSchema,
-				rowIter: emptyRowIter,
+				rowIter: referentialConstraintsRowIter,
 			},
 			KeyColumnUsageTableName: &informationSchemaTable{
 				name:    KeyColumnUsageTableName,
@@ -1021,7 +1484,12 @@ func NewInformationSchemaDatabase() Database {
 			RoutinesTableName: &informationSchemaTable{
 				name:    RoutinesTableName,
 				schema:  routinesSchema,
-				rowIter: emptyRowIter,
+				rowIter: routinesRowIter,
+			},
+			ParametersTableName: &informationSchemaTable{
+				name:    ParametersTableName,
+				schema:  parametersSchema,
+				rowIter: parametersRowIter,
 			},
 			ViewsTableName: &informationSchemaTable{
 				name:    ViewsTableName,
@@ -1053,6 +1521,11 @@ func NewInformationSchemaDatabase() Database {
 				schema:  innoDBTempTableSchema,
 				rowIter: innoDBTempTableIter,
 			},
+			ProcessListTableName: &informationSchemaTable{
+				name:    ProcessListTableName,
+				schema:  processListSchema,
+				rowIter: processListRowIter,
+			},
 		},
 	}
 }
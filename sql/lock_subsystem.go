@@ -71,7 +71,8 @@ func (ls *LockSubsystem) createLock(name string) **ownedLock {
 }
 
 // Lock attempts to acquire a lock with a given name for the Id associated with the given ctx.Session within the given
-// timeout
+// timeout. A negative timeout waits forever, or until the context is cancelled, such as by KILL QUERY, in which case
+// the context's error is returned.
 func (ls *LockSubsystem) Lock(ctx *Context, name string, timeout time.Duration) error {
 	nl := ls.getNamedLock(name)
 
@@ -97,7 +98,11 @@ func (ls *LockSubsystem) Lock(ctx *Context, name string, timeout time.Duration)
 			}
 		}
 
-		time.Sleep(100 * time.Microsecond)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(100 * time.Microsecond):
+		}
 	}
 
 	return ErrLockTimeout.New(name)
@@ -137,32 +142,41 @@ func (ls *LockSubsystem) Unlock(ctx *Context, name string) error {
 }
 
 // ReleaseAll releases all locks the ID associated with the given ctx.Session, and returns the number of locks that were
-// succeessfully released.
+// succeessfully released. Like MySQL, a lock acquired more than once counts once for each time it was acquired.
 func (ls *LockSubsystem) ReleaseAll(ctx *Context) (int, error) {
-	releaseCount := 0
+	// The session's locks can't be removed while iterating over them
+	var names []string
 	_ = ctx.Session.IterLocks(func(name string) error {
+		names = append(names, name)
+		return nil
+	})
+
+	releaseCount := 0
+	userId := int64(ctx.Session.ID())
+	for _, name := range names {
 		nl := ls.getNamedLock(name)
 
 		if nl != nil {
-			userId := ctx.Session.ID()
 			for {
 				dest := (*unsafe.Pointer)(unsafe.Pointer(nl))
 				curr := atomic.LoadPointer(dest)
 				currLock := *(*ownedLock)(curr)
 
-				if currLock.Owner != int64(userId) {
+				if currLock.Owner != userId {
 					break
 				}
 
 				if atomic.CompareAndSwapPointer(dest, curr, unsafe.Pointer(&ownedLock{})) {
-					releaseCount++
+					releaseCount += int(currLock.Count)
 					break
 				}
 			}
 		}
 
-		return nil
-	})
+		if err := ctx.Session.DelLock(name); err != nil {
+			return releaseCount, err
+		}
+	}
 
 	return releaseCount, nil
 }
@@ -15,6 +15,7 @@
 package sql
 
 import (
+	"context"
 	"math/rand"
 	"sync"
 	"testing"
@@ -187,3 +188,51 @@ func TestGetLockState(t *testing.T) {
 	assert.Equal(t, LockFree, state)
 	assert.Equal(t, uint32(0), owner)
 }
+
+func TestLockCancelled(t *testing.T) {
+	ls := NewLockSubsystem()
+	user1 := NewEmptyContext()
+
+	err := ls.Lock(user1, testLockName, 0)
+	assert.NoError(t, err)
+
+	octx, cancel := context.WithCancel(context.Background())
+	user2 := NewContext(octx)
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+
+	err = ls.Lock(user2, testLockName, -1)
+	assert.Equal(t, context.Canceled, err)
+	assert.Nil(t, getLockDiffs(user2))
+}
+
+func TestReleaseAll(t *testing.T) {
+	ls := NewLockSubsystem()
+	user1 := NewEmptyContext()
+	user2 := NewEmptyContext()
+
+	assert.NoError(t, ls.Lock(user1, "lock1", 0))
+	assert.NoError(t, ls.Lock(user1, "lock1", 0))
+	assert.NoError(t, ls.Lock(user1, "lock2", 0))
+	assert.NoError(t, ls.Lock(user2, "lock3", 0))
+
+	count, err := ls.ReleaseAll(user1)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, count)
+	assert.Nil(t, getLockDiffs(user1))
+	assert.Nil(t, getLockDiffs(user2, "lock3"))
+
+	state, _ := ls.GetLockState("lock1")
+	assert.Equal(t, LockFree, state)
+	state, _ = ls.GetLockState("lock2")
+	assert.Equal(t, LockFree, state)
+	state, owner := ls.GetLockState("lock3")
+	assert.Equal(t, LockInUse, state)
+	assert.Equal(t, user2.Session.ID(), owner)
+
+	count, err = ls.ReleaseAll(user1)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
@@ -0,0 +1,129 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+)
+
+// Represents the MultiLinestring type.
+// https://dev.mysql.com/doc/refman/8.0/en/gis-class-multilinestring.html
+type MultiLinestring struct {
+	SRID  uint32
+	Lines []Linestring
+}
+
+type MultiLinestringType struct{}
+
+var _ Type = MultiLinestringType{}
+
+var ErrNotMultiLinestring = errors.NewKind("value of type %T is not a multilinestring")
+
+// Compare implements Type interface.
+func (t MultiLinestringType) Compare(a interface{}, b interface{}) (int, error) {
+	// Compare nulls
+	if hasNulls, res := compareNulls(a, b); hasNulls {
+		return res, nil
+	}
+
+	// Expect to receive a MultiLinestring, throw error otherwise
+	_a, ok := a.(MultiLinestring)
+	if !ok {
+		return 0, ErrNotMultiLinestring.New(a)
+	}
+	_b, ok := b.(MultiLinestring)
+	if !ok {
+		return 0, ErrNotMultiLinestring.New(b)
+	}
+
+	// Get shorter length
+	var n int
+	lenA := len(_a.Lines)
+	lenB := len(_b.Lines)
+	if lenA < lenB {
+		n = lenA
+	} else {
+		n = lenB
+	}
+
+	// Compare each line until there's a difference
+	for i := 0; i < n; i++ {
+		diff, err := LinestringType{}.Compare(_a.Lines[i], _b.Lines[i])
+		if err != nil {
+			return 0, err
+		}
+		if diff != 0 {
+			return diff, nil
+		}
+	}
+
+	// Determine based off length
+	if lenA > lenB {
+		return 1, nil
+	}
+	if lenA < lenB {
+		return -1, nil
+	}
+
+	// Multilinestrings must be the same
+	return 0, nil
+}
+
+// Convert implements Type interface.
+func (t MultiLinestringType) Convert(v interface{}) (interface{}, error) {
+	// Must be a MultiLinestring, fail otherwise
+	if v, ok := v.(MultiLinestring); ok {
+		return v, nil
+	}
+
+	return nil, ErrNotMultiLinestring.New(v)
+}
+
+// Promote implements the Type interface.
+func (t MultiLinestringType) Promote() Type {
+	return t
+}
+
+// SQL implements Type interface.
+func (t MultiLinestringType) SQL(v interface{}) (sqltypes.Value, error) {
+	if v == nil {
+		return sqltypes.NULL, nil
+	}
+
+	pv, err := t.Convert(v)
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+
+	return geometrySQL(pv.(MultiLinestring).SRID, pv)
+}
+
+// String implements Type interface.
+func (t MultiLinestringType) String() string {
+	return "MULTILINESTRING"
+}
+
+// Type implements Type interface.
+func (t MultiLinestringType) Type() query.Type {
+	return sqltypes.Geometry
+}
+
+// Zero implements Type interface.
+func (t MultiLinestringType) Zero() interface{} {
+	return MultiLinestring{Lines: []Linestring{{}}}
+}
@@ -0,0 +1,129 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+)
+
+// Represents the MultiPolygon type.
+// https://dev.mysql.com/doc/refman/8.0/en/gis-class-multipolygon.html
+type MultiPolygon struct {
+	SRID     uint32
+	Polygons []Polygon
+}
+
+type MultiPolygonType struct{}
+
+var _ Type = MultiPolygonType{}
+
+var ErrNotMultiPolygon = errors.NewKind("value of type %T is not a multipolygon")
+
+// Compare implements Type interface.
+func (t MultiPolygonType) Compare(a interface{}, b interface{}) (int, error) {
+	// Compare nulls
+	if hasNulls, res := compareNulls(a, b); hasNulls {
+		return res, nil
+	}
+
+	// Expect to receive a MultiPolygon, throw error otherwise
+	_a, ok := a.(MultiPolygon)
+	if !ok {
+		return 0, ErrNotMultiPolygon.New(a)
+	}
+	_b, ok := b.(MultiPolygon)
+	if !ok {
+		return 0, ErrNotMultiPolygon.New(b)
+	}
+
+	// Get shorter length
+	var n int
+	lenA := len(_a.Polygons)
+	lenB := len(_b.Polygons)
+	if lenA < lenB {
+		n = lenA
+	} else {
+		n = lenB
+	}
+
+	// Compare each polygon until there's a difference
+	for i := 0; i < n; i++ {
+		diff, err := PolygonType{}.Compare(_a.Polygons[i], _b.Polygons[i])
+		if err != nil {
+			return 0, err
+		}
+		if diff != 0 {
+			return diff, nil
+		}
+	}
+
+	// Determine based off length
+	if lenA > lenB {
+		return 1, nil
+	}
+	if lenA < lenB {
+		return -1, nil
+	}
+
+	// Multipolygons must be the same
+	return 0, nil
+}
+
+// Convert implements Type interface.
+func (t MultiPolygonType) Convert(v interface{}) (interface{}, error) {
+	// Must be a MultiPolygon, fail otherwise
+	if v, ok := v.(MultiPolygon); ok {
+		return v, nil
+	}
+
+	return nil, ErrNotMultiPolygon.New(v)
+}
+
+// Promote implements the Type interface.
+func (t MultiPolygonType) Promote() Type {
+	return t
+}
+
+// SQL implements Type interface.
+func (t MultiPolygonType) SQL(v interface{}) (sqltypes.Value, error) {
+	if v == nil {
+		return sqltypes.NULL, nil
+	}
+
+	pv, err := t.Convert(v)
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+
+	return geometrySQL(pv.(MultiPolygon).SRID, pv)
+}
+
+// String implements Type interface.
+func (t MultiPolygonType) String() string {
+	return "MULTIPOLYGON"
+}
+
+// Type implements Type interface.
+func (t MultiPolygonType) Type() query.Type {
+	return sqltypes.Geometry
+}
+
+// Zero implements Type interface.
+func (t MultiPolygonType) Zero() interface{} {
+	return MultiPolygon{Polygons: []Polygon{{}}}
+}
@@ -65,7 +65,7 @@ var (
 	Float64 = MustCreateNumberType(sqltypes.Float64)
 
 	// decimal that represents the max value an uint64 can hold
-	dec_uint64_max = decimal.NewFromInt(math.MaxInt64).Mul(decimal.NewFromInt(2).Add(decimal.NewFromInt(1)))
+	dec_uint64_max = decimal.NewFromInt(math.MaxInt64).Mul(decimal.NewFromInt(2)).Add(decimal.NewFromInt(1))
 	// decimal that represents the max value an int64 can hold
 	dec_int64_max = decimal.NewFromInt(math.MaxInt64)
 	// decimal that represents the min value an int64 can hold
@@ -471,12 +471,12 @@ func convertToInt64(t numberTypeImpl, v interface{}) (int64, error) {
 		}
 		return int64(v), nil
 	case float32:
-		if float32(math.MaxInt64) >= v && v >= float32(math.MinInt64) {
+		if float32(math.MaxInt64) > v && v >= float32(math.MinInt64) {
 			return int64(v), nil
 		}
 		return 0, ErrOutOfRange.New(v, t)
 	case float64:
-		if float64(math.MaxInt64) >= v && v >= float64(math.MinInt64) {
+		if float64(math.MaxInt64) > v && v >= float64(math.MinInt64) {
 			return int64(v), nil
 		}
 		return 0, ErrOutOfRange.New(v, t)
@@ -553,12 +553,12 @@ func convertToUint64(t numberTypeImpl, v interface{}) (uint64, error) {
 	case uint64:
 		return v, nil
 	case float32:
-		if float32(math.MaxUint64) >= v && v >= 0 {
+		if float32(math.MaxUint64) > v && v >= 0 {
 			return uint64(v), nil
 		}
 		return 0, ErrOutOfRange.New(v, t)
 	case float64:
-		if float64(math.MaxUint64) >= v && v >= 0 {
+		if float64(math.MaxUint64) > v && v >= 0 {
 			return uint64(v), nil
 		}
 		return 0, ErrOutOfRange.New(v, t)
@@ -566,9 +566,8 @@ func convertToUint64(t numberTypeImpl, v interface{}) (uint64, error) {
 		if v.GreaterThan(dec_uint64_max) || v.LessThan(dec_zero) {
 			return 0, ErrOutOfRange.New(v.String(), t)
 		}
-		// TODO: If we ever internally switch to using Decimal for large numbers, this will need to be updated
-		f, _ := v.Float64()
-		return uint64(f), nil
+		// Going through float64 would lose precision for values above 2^53
+		return v.BigInt().Uint64(), nil
 	case []byte:
 		i, err := strconv.ParseUint(string(v), 10, 64)
 		if err != nil {
@@ -23,6 +23,7 @@ import (
 
 	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/dolthub/vitess/go/vt/proto/query"
+	"github.com/shopspring/decimal"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -188,6 +189,8 @@ func TestNumberConvert(t *testing.T) {
 		{Uint64, "01000", uint64(1000), false},
 		{Uint64, true, uint64(1), false},
 		{Uint64, false, uint64(0), false},
+		{Uint64, decimal.RequireFromString("18446744073709551615"), uint64(math.MaxUint64), false},
+		{Uint64, decimal.RequireFromString("9007199254740993"), uint64(9007199254740993), false},
 		{Float32, "22.25", float32(22.25), false},
 		{Float64, float32(893.875), float64(893.875), false},
 
@@ -210,6 +213,9 @@ func TestNumberConvert(t *testing.T) {
 		{Uint32, math.MaxUint32 + 1, nil, true},
 		{Uint32, -1, nil, true},
 		{Uint64, -1, nil, true},
+		{Uint64, decimal.RequireFromString("18446744073709551616"), nil, true},
+		{Uint64, float64(1 << 64), nil, true},
+		{Int64, float64(1 << 63), nil, true},
 		{Float32, math.MaxFloat32 * 2, nil, true},
 		{Float32, []byte{0}, nil, true},
 		{Uint8, -1, nil, true},
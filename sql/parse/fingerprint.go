@@ -0,0 +1,83 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+// fingerprintPlaceholder is the placeholder replacing literals in query fingerprints.
+const fingerprintPlaceholder = "?"
+
+// Fingerprint returns the fingerprint of the query given: the query formatted from its parsed statement, with its
+// literals replaced by placeholders. Lists of literals, such as the ones of IN or of the rows of an INSERT, are
+// replaced by a single placeholder. Queries that only differ by their literals, formatting or comments have the same
+// fingerprint, which can be used to group queries in metrics or as the key of a cache.
+func Fingerprint(query string) (string, error) {
+	s := strings.TrimSpace(query)
+	if strings.HasSuffix(s, ";") {
+		s = s[:len(s)-1]
+	}
+
+	stmt, err := sqlparser.Parse(s)
+	if err != nil {
+		return "", err
+	}
+
+	err = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.SQLVal:
+			node.Type = sqlparser.ValArg
+			node.Val = []byte(fingerprintPlaceholder)
+		case *sqlparser.ComparisonExpr:
+			if tuple, ok := node.Right.(sqlparser.ValTuple); ok && isLiteralTuple(tuple) {
+				node.Right = tuple[:1]
+			}
+		case *sqlparser.Insert:
+			if values, ok := node.Rows.(sqlparser.Values); ok && len(values) > 1 {
+				literals := true
+				for _, row := range values {
+					literals = literals && isLiteralTuple(row)
+				}
+				if literals {
+					node.Rows = values[:1]
+				}
+			}
+		}
+		return true, nil
+	}, stmt)
+	if err != nil {
+		return "", err
+	}
+
+	return sqlparser.String(stmt), nil
+}
+
+// isLiteralTuple returns whether the tuple given only holds literals.
+func isLiteralTuple(tuple sqlparser.ValTuple) bool {
+	if len(tuple) == 0 {
+		return false
+	}
+	for _, expr := range tuple {
+		switch expr.(type) {
+		case *sqlparser.SQLVal, *sqlparser.NullVal, sqlparser.BoolVal:
+		default:
+			return false
+		}
+	}
+	return true
+}
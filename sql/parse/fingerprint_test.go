@@ -0,0 +1,71 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFingerprint(t *testing.T) {
+	tests := []struct {
+		query string
+		same  string
+		other string
+	}{
+		{
+			query: "SELECT * FROM mytable WHERE i = 1 AND s = 'first row'",
+			same:  "select *   from mytable where i = 42 and s = 'other'; ",
+			other: "SELECT * FROM mytable WHERE i = 1 OR s = 'first row'",
+		},
+		{
+			query: "SELECT i FROM mytable WHERE i IN (1, 2, 3)",
+			same:  "SELECT i FROM mytable WHERE i IN (4)",
+			other: "SELECT i FROM mytable WHERE i IN (SELECT 1)",
+		},
+		{
+			query: "INSERT INTO mytable VALUES (1, 'a'), (2, 'b')",
+			same:  "INSERT INTO mytable VALUES (3, 'c')",
+			other: "INSERT INTO othertable VALUES (3, 'c')",
+		},
+		{
+			query: "SELECT i + 1 FROM mytable LIMIT 10",
+			same:  "/* comment */ SELECT i + 2 FROM mytable LIMIT 5",
+			other: "SELECT i - 1 FROM mytable LIMIT 10",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			require := require.New(t)
+
+			fingerprint, err := Fingerprint(tt.query)
+			require.NoError(err)
+			require.NotContains(fingerprint, "'")
+
+			same, err := Fingerprint(tt.same)
+			require.NoError(err)
+			require.Equal(fingerprint, same)
+
+			other, err := Fingerprint(tt.other)
+			require.NoError(err)
+			require.NotEqual(fingerprint, other)
+		})
+	}
+
+	_, err := Fingerprint("SELECT * FROM")
+	require.Error(t, err)
+}
@@ -17,12 +17,14 @@ package parse
 import (
 	goerrors "errors"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
 	"unicode"
 
 	"github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/dolthub/vitess/go/vt/vterrors"
 	"github.com/opentracing/opentracing-go"
 	"gopkg.in/src-d/go-errors.v1"
 
@@ -41,7 +43,29 @@ var (
 	ErrPrimaryKeyOnNullField = errors.NewKind("All parts of PRIMARY KEY must be NOT NULL")
 )
 
-var describeSupportedFormats = []string{"tree"}
+var describeSupportedFormats = []string{"tree", "json"}
+
+// unsupportedClauses are clauses of MySQL that the vitess parser has no grammar for yet. A query failing to parse
+// because of one is reported as using an unsupported feature, rather than as a syntax error. Each clause is recognized
+// by its sequence of tokens, where a token may list alternatives separated by "|" and an empty token matches any token.
+var unsupportedClauses = []struct {
+	name   string
+	tokens []string
+}{
+	{name: "FILTER clause of aggregate functions", tokens: []string{"filter", "(", "where"}},
+	{name: "WITHIN GROUP clause of ordered-set aggregate functions", tokens: []string{"within", "group", "("}},
+	{name: "WITH RECURSIVE", tokens: []string{"with", "recursive"}},
+	{name: "INTERSECT", tokens: []string{"intersect", "select|all|distinct|("}},
+	{name: "EXCEPT", tokens: []string{"except", "select|all|distinct|("}},
+	{name: "WINDOW clause", tokens: []string{"window", "", "as", "("}},
+	{name: "WITH ROLLUP", tokens: []string{"with", "rollup"}},
+	{name: "GROUPING function", tokens: []string{"grouping", "("}},
+	{name: "LATERAL derived tables", tokens: []string{"lateral", "("}},
+}
+
+// straightJoinHint is the optimizer hint attached to a STRAIGHT_JOIN, which
+// keeps the analyzer from reordering the joined tables.
+const straightJoinHint = "/*+ JOIN_FIXED_ORDER() */"
 
 // These constants aren't exported from vitess for some reason. This could be removed if we changed this.
 const (
@@ -124,6 +148,9 @@ func parse(ctx *sql.Context, query string, multi bool) (sql.Node, string, string
 			ctx.Warn(0, "query was empty after trimming comments, so it will be ignored")
 			return plan.Nothing, parsed, remainder, nil
 		}
+		if clause := unsupportedClause(s, err); clause != "" {
+			return nil, parsed, remainder, sql.ErrUnsupportedFeature.New(clause)
+		}
 		return nil, parsed, remainder, sql.ErrSyntaxError.New(err.Error())
 	}
 
@@ -132,6 +159,73 @@ func parse(ctx *sql.Context, query string, multi bool) (sql.Node, string, string
 	return node, parsed, remainder, err
 }
 
+// unsupportedClause returns the name of the unsupported clause that the syntax error given was found in, or an empty
+// string if it wasn't found in one. Only the tokens around the one the parser failed at are matched, so that the
+// keywords of a clause appearing elsewhere in the query, like in a string or as a column name, aren't mistaken for it.
+func unsupportedClause(query string, err error) string {
+	se, ok := vterrors.AsSyntaxError(err)
+	if !ok {
+		return ""
+	}
+
+	var tokens []string
+	failed := -1
+	tokenizer := sqlparser.NewStringTokenizer(query)
+	for {
+		typ, val := tokenizer.Scan()
+		if typ == 0 || typ == sqlparser.LEX_ERROR {
+			break
+		}
+		switch {
+		case typ == sqlparser.COMMENT:
+			continue
+		case typ == sqlparser.STRING:
+			// Quoted strings never start a clause
+			tokens = append(tokens, "'")
+		case val == nil:
+			tokens = append(tokens, string(rune(typ)))
+		default:
+			tokens = append(tokens, strings.ToLower(string(val)))
+		}
+		// The parser reports the position of the tokenizer after the token it failed at
+		if failed < 0 && tokenizer.Position >= se.Position {
+			failed = len(tokens) - 1
+		}
+	}
+	if failed < 0 {
+		return ""
+	}
+
+	for _, clause := range unsupportedClauses {
+	TokenLoop:
+		for i := failed - len(clause.tokens) + 1; i <= failed; i++ {
+			if i < 0 || i+len(clause.tokens) > len(tokens) {
+				continue
+			}
+			for j, token := range clause.tokens {
+				if !matchesClauseToken(tokens[i+j], token) {
+					continue TokenLoop
+				}
+			}
+			return clause.name
+		}
+	}
+	return ""
+}
+
+// matchesClauseToken returns whether the token of a query given matches a token of one of the unsupportedClauses.
+func matchesClauseToken(token, clauseToken string) bool {
+	if clauseToken == "" {
+		return true
+	}
+	for _, alt := range strings.Split(clauseToken, "|") {
+		if token == alt {
+			return true
+		}
+	}
+	return false
+}
+
 // ParseColumnTypeString will return a SQL type for the given string that represents a column type.
 // For example, giving the string `VARCHAR(255)` will return the string SQL type with the internal type set to Varchar
 // and the length set to 255 with the default collation.
@@ -348,12 +442,18 @@ func convertExplain(ctx *sql.Context, n *sqlparser.Explain) (sql.Node, error) {
 		return nil, err
 	}
 
+	if n.Analyze {
+		return plan.NewDescribeQuery(plan.DescribeFormatAnalyze, child), nil
+	}
+
 	explainFmt := sqlparser.TreeStr
 	switch strings.ToLower(n.ExplainFormat) {
 	case "", sqlparser.TreeStr:
 	// tree format, do nothing
 	case "debug":
 		explainFmt = "debug"
+	case sqlparser.JsonStr:
+		explainFmt = plan.DescribeFormatJSON
 	default:
 		return nil, errInvalidDescribeFormat.New(
 			n.ExplainFormat,
@@ -445,6 +545,18 @@ func isCharset(exprs sqlparser.SetVarExprs) bool {
 	return strings.ToLower(exprs[0].Name.String()) == "charset"
 }
 
+// showWarningCount returns the node of SHOW COUNT(*) WARNINGS, which counts the warnings of the session like
+// SELECT @@session.warning_count does in MySQL.
+func showWarningCount(ctx *sql.Context) sql.Node {
+	return plan.NewGroupBy(
+		[]sql.Expression{
+			expression.NewAlias("@@session.warning_count", aggregation.NewCount(expression.NewLiteral(1, sql.Int64))),
+		},
+		nil,
+		plan.ShowWarnings(ctx.Session.Warnings()),
+	)
+}
+
 func convertShow(ctx *sql.Context, s *sqlparser.Show, query string) (sql.Node, error) {
 	showType := strings.ToLower(s.Type)
 	switch showType {
@@ -519,7 +631,15 @@ func convertShow(ctx *sql.Context, s *sqlparser.Show, query string) (sql.Node, e
 		}
 		return node, nil
 	case "index":
-		return plan.NewShowIndexes(plan.NewUnresolvedTable(s.Table.Name.String(), s.Database)), nil
+		var node sql.Node = plan.NewShowIndexes(plan.NewUnresolvedTable(s.Table.Name.String(), s.Database))
+		if s.ShowIndexFilterOpt != nil {
+			filter, err := ExprToExpression(ctx, s.ShowIndexFilterOpt)
+			if err != nil {
+				return nil, err
+			}
+			node = plan.NewFilter(filter, node)
+		}
+		return node, nil
 	case sqlparser.KeywordString(sqlparser.VARIABLES):
 		var likepattern string
 		if s.Filter != nil {
@@ -607,8 +727,7 @@ func convertShow(ctx *sql.Context, s *sqlparser.Show, query string) (sql.Node, e
 		return node, nil
 	case sqlparser.KeywordString(sqlparser.WARNINGS):
 		if s.CountStar {
-			unsupportedShow := fmt.Sprintf("SHOW COUNT(*) WARNINGS")
-			return nil, sql.ErrUnsupportedFeature.New(unsupportedShow)
+			return showWarningCount(ctx), nil
 		}
 		var node sql.Node
 		var err error
@@ -717,14 +836,20 @@ func convertUnion(ctx *sql.Context, u *sqlparser.Union) (sql.Node, error) {
 	if u.Type == sqlparser.UnionAllStr {
 		return plan.NewUnion(left, right), nil
 	} else { // default is DISTINCT (either explicit or implicit)
-		// TODO: this creates redundant Distinct nodes that we can't easily remove after the fact. With this construct,
-		//  we can't in all cases tell the difference between `union distinct (select ...)` and
-		//  `union (select distinct ...)`. We need something like a Distinct property on Union nodes to be able to prune
-		//  redundant Distinct nodes and thereby avoid doing extra work.
-		return plan.NewDistinct(plan.NewUnion(left, right)), nil
+		// The Distinct node on top deduplicates every row of the union, so any Distinct directly below it, such as the
+		// one of a nested UNION DISTINCT, is redundant and would only hold another set of row hashes.
+		return plan.NewDistinct(plan.NewUnion(stripDistinct(left), stripDistinct(right))), nil
 	}
 }
 
+// stripDistinct returns the child of the node given if it's a Distinct node, or the node itself otherwise.
+func stripDistinct(n sql.Node) sql.Node {
+	if d, ok := n.(*plan.Distinct); ok {
+		return d.Child
+	}
+	return n
+}
+
 func convertSelect(ctx *sql.Context, s *sqlparser.Select) (sql.Node, error) {
 	node, err := tableExprsToTable(ctx, s.From)
 	if err != nil {
@@ -1690,7 +1815,12 @@ func convertDelete(ctx *sql.Context, d *sqlparser.Delete) (sql.Node, error) {
 		}
 	}
 
-	return plan.NewDeleteFrom(node), nil
+	targets := make([]string, len(d.Targets))
+	for i, t := range d.Targets {
+		targets[i] = t.Name.String()
+	}
+
+	return plan.NewDeleteFrom(node, targets...), nil
 }
 
 func convertUpdate(ctx *sql.Context, d *sqlparser.Update) (sql.Node, error) {
@@ -2273,6 +2403,8 @@ func tableExprToTable(
 		switch strings.ToLower(t.Join) {
 		case sqlparser.JoinStr:
 			return plan.NewInnerJoin(left, right, cond), nil
+		case sqlparser.StraightJoinStr:
+			return plan.NewInnerJoin(left, right, cond).WithComment(straightJoinHint), nil
 		case sqlparser.LeftJoinStr:
 			return plan.NewLeftJoin(left, right, cond), nil
 		case sqlparser.RightJoinStr:
@@ -2445,9 +2577,9 @@ func selectToSelectionNode(
 		for _, e := range selectExprs {
 			if isAggregateExpr(e) {
 				sql.Inspect(e, func(e sql.Expression) bool {
-					if uf, ok := e.(*expression.UnresolvedFunction); ok {
-						if uf.Window == nil || len(uf.Window.PartitionBy) > 0 || len(uf.Window.OrderBy) > 0 {
-							err = sql.ErrUnsupportedFeature.New("aggregate functions appearing alongside window functions must have an empty OVER () clause")
+					if uf, ok := e.(*expression.UnresolvedFunction); ok && uf.IsAggregate {
+						if uf.Window == nil {
+							err = sql.ErrUnsupportedFeature.New("aggregate functions appearing alongside window functions must have an OVER clause")
 							return false
 						}
 					}
@@ -2673,8 +2805,13 @@ func ExprToExpression(ctx *sql.Context, e sqlparser.Expr) (sql.Expression, error
 			exprs[0] = expression.NewDistinctExpression(exprs[0])
 		}
 
+		over, err := overToWindow(ctx, v.Over)
+		if err != nil {
+			return nil, err
+		}
+
 		return expression.NewUnresolvedFunction(v.Name.Lowered(),
-			isAggregateFunc(v), overToWindow(ctx, v.Over), exprs...), nil
+			isAggregateFunc(v), over, exprs...), nil
 	case *sqlparser.GroupConcatExpr:
 		exprs, err := selectExprsToExpressions(ctx, v.Exprs)
 		if err != nil {
@@ -2731,7 +2868,22 @@ func ExprToExpression(ctx *sql.Context, e sqlparser.Expr) (sql.Expression, error
 			return nil, err
 		}
 
-		return expression.NewConvert(expr, v.Type.Type), nil
+		typeLength, typeScale, err := convertTypeLengthAndScale(v.Type)
+		if err != nil {
+			return nil, err
+		}
+		return expression.NewConvertWithLengthAndScale(expr, v.Type.Type, typeLength, typeScale), nil
+	case *sqlparser.ConvertUsingExpr:
+		expr, err := ExprToExpression(ctx, v.Expr)
+		if err != nil {
+			return nil, err
+		}
+
+		// Strings are only held in one character set, so only the character set given is checked
+		if _, err := sql.ParseCharacterSet(v.Type); err != nil {
+			return nil, err
+		}
+		return expression.NewConvert(expr, expression.ConvertToChar), nil
 	case *sqlparser.RangeCond:
 		val, err := ExprToExpression(ctx, v.Left)
 		if err != nil {
@@ -2785,8 +2937,15 @@ func ExprToExpression(ctx *sql.Context, e sqlparser.Expr) (sql.Expression, error
 	case *sqlparser.IntervalExpr:
 		return intervalExprToExpression(ctx, v)
 	case *sqlparser.CollateExpr:
-		// TODO: handle collation
-		return ExprToExpression(ctx, v.Expr)
+		expr, err := ExprToExpression(ctx, v.Expr)
+		if err != nil {
+			return nil, err
+		}
+		collation, err := sql.ParseCollation(nil, &v.Charset, false)
+		if err != nil {
+			return nil, err
+		}
+		return expression.NewCollate(expr, collation), nil
 	case *sqlparser.ValuesFuncExpr:
 		col, err := ExprToExpression(ctx, v.Name)
 		if err != nil {
@@ -2821,14 +2980,14 @@ func ExprToExpression(ctx *sql.Context, e sqlparser.Expr) (sql.Expression, error
 	}
 }
 
-func overToWindow(ctx *sql.Context, over *sqlparser.Over) *sql.Window {
+func overToWindow(ctx *sql.Context, over *sqlparser.Over) (*sql.Window, error) {
 	if over == nil {
-		return nil
+		return nil, nil
 	}
 
 	sortFields, err := orderByToSortFields(ctx, over.OrderBy)
 	if err != nil {
-		return nil
+		return nil, err
 	}
 
 	partitions := make([]sql.Expression, len(over.PartitionBy))
@@ -2836,16 +2995,79 @@ func overToWindow(ctx *sql.Context, over *sqlparser.Over) *sql.Window {
 		var err error
 		partitions[i], err = ExprToExpression(ctx, expr)
 		if err != nil {
-			return nil
+			return nil, err
 		}
 	}
 
-	return sql.NewWindow(partitions, sortFields)
+	frame, err := frameToWindowFrame(ctx, over.Frame)
+	if err != nil {
+		return nil, err
+	}
+
+	w := sql.NewWindow(partitions, sortFields).WithFrame(frame)
+	if !over.WindowName.IsEmpty() {
+		w = w.WithRef(over.WindowName.String())
+	}
+	return w, nil
+}
+
+// frameToWindowFrame converts the frame clause of a window. A frame with only a start bound ends at the current row.
+func frameToWindowFrame(ctx *sql.Context, frame *sqlparser.Frame) (*sql.WindowFrame, error) {
+	if frame == nil || frame.Extent == nil {
+		return nil, nil
+	}
+
+	unit := sql.WindowFrameUnitRange
+	if frame.Unit == sqlparser.RowsUnit {
+		unit = sql.WindowFrameUnitRows
+	}
+
+	start, err := frameBoundToWindowFrameBound(ctx, frame.Extent.Start)
+	if err != nil {
+		return nil, err
+	}
+
+	end := sql.WindowFrameBound{Type: sql.WindowFrameCurrentRow}
+	if frame.Extent.End != nil {
+		end, err = frameBoundToWindowFrameBound(ctx, frame.Extent.End)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return sql.NewWindowFrame(unit, start, end, sql.WindowFrameExcludeNoOthers), nil
+}
+
+func frameBoundToWindowFrameBound(ctx *sql.Context, bound *sqlparser.FrameBound) (sql.WindowFrameBound, error) {
+	var b sql.WindowFrameBound
+	switch bound.Type {
+	case sqlparser.UnboundedPreceding:
+		b.Type = sql.WindowFrameUnboundedPreceding
+	case sqlparser.ExprPreceding:
+		b.Type = sql.WindowFramePreceding
+	case sqlparser.CurrentRow:
+		b.Type = sql.WindowFrameCurrentRow
+	case sqlparser.ExprFollowing:
+		b.Type = sql.WindowFrameFollowing
+	case sqlparser.UnboundedFollowing:
+		b.Type = sql.WindowFrameUnboundedFollowing
+	default:
+		return b, sql.ErrUnsupportedSyntax.New(sqlparser.String(bound))
+	}
+
+	if bound.Expr != nil {
+		offset, err := ExprToExpression(ctx, bound.Expr)
+		if err != nil {
+			return b, err
+		}
+		b.Offset = offset
+	}
+	return b, nil
 }
 
 func isAggregateFunc(v *sqlparser.FuncExpr) bool {
 	switch v.Name.Lowered() {
-	case "first", "last":
+	case "first", "last", "median":
 		return true
 	}
 
@@ -3096,6 +3318,12 @@ func unaryExprToExpression(ctx *sql.Context, e *sqlparser.UnaryExpr) (sql.Expres
 	case sqlparser.PlusStr:
 		// Unary plus expressions do nothing (do not turn the expression positive). Just return the underlying expression.
 		return ExprToExpression(ctx, e.Expr)
+	case sqlparser.TildaStr:
+		expr, err := ExprToExpression(ctx, e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return expression.NewBitNot(expr), nil
 	case sqlparser.BinaryStr:
 		expr, err := ExprToExpression(ctx, e.Expr)
 		if err != nil {
@@ -3118,6 +3346,47 @@ func unaryExprToExpression(ctx *sql.Context, e *sqlparser.UnaryExpr) (sql.Expres
 	}
 }
 
+// convertTypeLengthAndScale returns the length, or precision and scale, of the type of a CAST or CONVERT, which are 0
+// if it doesn't have them.
+func convertTypeLengthAndScale(t *sqlparser.ConvertType) (int, int, error) {
+	var length, scale int64
+	var err error
+	if t.Length != nil {
+		length, err = strconv.ParseInt(string(t.Length.Val), 10, 64)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	if t.Scale != nil {
+		scale, err = strconv.ParseInt(string(t.Scale.Val), 10, 64)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	switch strings.ToLower(t.Type) {
+	case expression.ConvertToDecimal:
+		if length == 0 {
+			return 0, 0, nil
+		}
+		if length > math.MaxUint8 {
+			length = math.MaxUint8
+		}
+		if scale > math.MaxUint8 {
+			scale = math.MaxUint8
+		}
+		if _, err := sql.CreateDecimalType(uint8(length), uint8(scale)); err != nil {
+			return 0, 0, err
+		}
+	case expression.ConvertToChar, expression.ConvertToNChar, expression.ConvertToBinary:
+		scale = 0
+	default:
+		// Fractional seconds precision isn't supported
+		return 0, 0, nil
+	}
+	return int(length), int(scale), nil
+}
+
 func binaryExprToExpression(ctx *sql.Context, be *sqlparser.BinaryExpr) (sql.Expression, error) {
 	switch strings.ToLower(be.Operator) {
 	case
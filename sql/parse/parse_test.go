@@ -1216,6 +1216,16 @@ CREATE TABLE t2
 			[]sql.Expression{expression.NewStar()},
 			plan.NewUnresolvedTable("foo", "")),
 	),
+	"EXPLAIN ANALYZE SELECT * FROM foo": plan.NewDescribeQuery(
+		"analyze", plan.NewProject(
+			[]sql.Expression{expression.NewStar()},
+			plan.NewUnresolvedTable("foo", "")),
+	),
+	"EXPLAIN FORMAT=JSON SELECT * FROM foo": plan.NewDescribeQuery(
+		"json", plan.NewProject(
+			[]sql.Expression{expression.NewStar()},
+			plan.NewUnresolvedTable("foo", "")),
+	),
 	"DESCRIBE SELECT * FROM foo": plan.NewDescribeQuery(
 		"tree", plan.NewProject(
 			[]sql.Expression{expression.NewStar()},
@@ -2251,6 +2261,13 @@ CREATE TABLE t2
 	`SHOW INDEXES IN foo`:   plan.NewShowIndexes(plan.NewUnresolvedTable("foo", "")),
 	`SHOW INDEX IN foo`:     plan.NewShowIndexes(plan.NewUnresolvedTable("foo", "")),
 	`SHOW KEYS IN foo`:      plan.NewShowIndexes(plan.NewUnresolvedTable("foo", "")),
+	`SHOW INDEX FROM foo WHERE Key_name = 'bar'`: plan.NewFilter(
+		expression.NewEquals(
+			expression.NewUnresolvedColumn("Key_name"),
+			expression.NewLiteral("bar", sql.LongText),
+		),
+		plan.NewShowIndexes(plan.NewUnresolvedTable("foo", "")),
+	),
 	`SHOW FULL PROCESSLIST`: plan.NewShowProcessList(),
 	`SHOW PROCESSLIST`:      plan.NewShowProcessList(),
 	`SELECT @@allowed_max_packet`: plan.NewProject([]sql.Expression{
@@ -2329,6 +2346,19 @@ CREATE TABLE t2
 			).WithComment("/*+ JOIN_ORDER(a,b) */"),
 		),
 	),
+	`SELECT * FROM b STRAIGHT_JOIN a on c = d`: plan.NewProject(
+		[]sql.Expression{
+			expression.NewStar(),
+		},
+		plan.NewInnerJoin(
+			plan.NewUnresolvedTable("b", ""),
+			plan.NewUnresolvedTable("a", ""),
+			expression.NewEquals(
+				expression.NewUnresolvedColumn("c"),
+				expression.NewUnresolvedColumn("d"),
+			),
+		).WithComment("/*+ JOIN_FIXED_ORDER() */"),
+	),
 	`SHOW DATABASES`: plan.NewShowDatabases(),
 	`SELECT * FROM foo WHERE i LIKE 'foo'`: plan.NewProject(
 		[]sql.Expression{expression.NewStar()},
@@ -2437,13 +2467,20 @@ CREATE TABLE t2
 		{Table: plan.NewUnresolvedTable("bar", ""), Write: true},
 		{Table: plan.NewUnresolvedTable("baz", "")},
 	}),
-	`SHOW CREATE DATABASE foo`:                 plan.NewShowCreateDatabase(sql.UnresolvedDatabase("foo"), false),
-	`SHOW CREATE SCHEMA foo`:                   plan.NewShowCreateDatabase(sql.UnresolvedDatabase("foo"), false),
-	`SHOW CREATE DATABASE IF NOT EXISTS foo`:   plan.NewShowCreateDatabase(sql.UnresolvedDatabase("foo"), true),
-	`SHOW CREATE SCHEMA IF NOT EXISTS foo`:     plan.NewShowCreateDatabase(sql.UnresolvedDatabase("foo"), true),
-	`SHOW WARNINGS`:                            plan.ShowWarnings(sql.NewEmptyContext().Warnings()),
-	`SHOW WARNINGS LIMIT 10`:                   plan.NewLimit(expression.NewLiteral(int8(10), sql.Int8), plan.ShowWarnings(sql.NewEmptyContext().Warnings())),
-	`SHOW WARNINGS LIMIT 5,10`:                 plan.NewLimit(expression.NewLiteral(int8(10), sql.Int8), plan.NewOffset(expression.NewLiteral(int8(5), sql.Int8), plan.ShowWarnings(sql.NewEmptyContext().Warnings()))),
+	`SHOW CREATE DATABASE foo`:               plan.NewShowCreateDatabase(sql.UnresolvedDatabase("foo"), false),
+	`SHOW CREATE SCHEMA foo`:                 plan.NewShowCreateDatabase(sql.UnresolvedDatabase("foo"), false),
+	`SHOW CREATE DATABASE IF NOT EXISTS foo`: plan.NewShowCreateDatabase(sql.UnresolvedDatabase("foo"), true),
+	`SHOW CREATE SCHEMA IF NOT EXISTS foo`:   plan.NewShowCreateDatabase(sql.UnresolvedDatabase("foo"), true),
+	`SHOW WARNINGS`:                          plan.ShowWarnings(sql.NewEmptyContext().Warnings()),
+	`SHOW WARNINGS LIMIT 10`:                 plan.NewLimit(expression.NewLiteral(int8(10), sql.Int8), plan.ShowWarnings(sql.NewEmptyContext().Warnings())),
+	`SHOW WARNINGS LIMIT 5,10`:               plan.NewLimit(expression.NewLiteral(int8(10), sql.Int8), plan.NewOffset(expression.NewLiteral(int8(5), sql.Int8), plan.ShowWarnings(sql.NewEmptyContext().Warnings()))),
+	`SHOW COUNT(*) WARNINGS`: plan.NewGroupBy(
+		[]sql.Expression{
+			expression.NewAlias("@@session.warning_count", aggregation.NewCount(expression.NewLiteral(1, sql.Int64))),
+		},
+		nil,
+		plan.ShowWarnings(sql.NewEmptyContext().Warnings()),
+	),
 	"SHOW CREATE DATABASE `foo`":               plan.NewShowCreateDatabase(sql.UnresolvedDatabase("foo"), false),
 	"SHOW CREATE SCHEMA `foo`":                 plan.NewShowCreateDatabase(sql.UnresolvedDatabase("foo"), false),
 	"SHOW CREATE DATABASE IF NOT EXISTS `foo`": plan.NewShowCreateDatabase(sql.UnresolvedDatabase("foo"), true),
@@ -2815,6 +2852,43 @@ CREATE TABLE t2
 		},
 		plan.NewUnresolvedTable("foo", ""),
 	),
+	`SELECT a, sum(b) over (partition by c order by a rows between 1 preceding and current row) FROM foo`: plan.NewWindow(
+		[]sql.Expression{
+			expression.NewUnresolvedColumn("a"),
+			expression.NewAlias("sum(b) over (partition by c order by a rows between 1 preceding and current row)",
+				expression.NewUnresolvedFunction("sum", true, sql.NewWindow(
+					[]sql.Expression{
+						expression.NewUnresolvedColumn("c"),
+					},
+					sql.SortFields{
+						{
+							Column:       expression.NewUnresolvedColumn("a"),
+							Order:        sql.Ascending,
+							NullOrdering: sql.NullsFirst,
+						},
+					},
+				).WithFrame(sql.NewWindowFrame(
+					sql.WindowFrameUnitRows,
+					sql.WindowFrameBound{Type: sql.WindowFramePreceding, Offset: expression.NewLiteral(int8(1), sql.Int8)},
+					sql.WindowFrameBound{Type: sql.WindowFrameCurrentRow},
+					sql.WindowFrameExcludeNoOthers,
+				)), expression.NewUnresolvedColumn("b")),
+			),
+		},
+		plan.NewUnresolvedTable("foo", ""),
+	),
+	`SELECT a, row_number() over w FROM foo`: plan.NewWindow(
+		[]sql.Expression{
+			expression.NewUnresolvedColumn("a"),
+			expression.NewAlias("row_number() over w",
+				expression.NewUnresolvedFunction("row_number", false, sql.NewWindow(
+					[]sql.Expression{},
+					nil,
+				).WithRef("w")),
+			),
+		},
+		plan.NewUnresolvedTable("foo", ""),
+	),
 	`SELECT a, count(i) over () FROM foo`: plan.NewWindow(
 		[]sql.Expression{
 			expression.NewUnresolvedColumn("a"),
@@ -3192,16 +3266,14 @@ CREATE TABLE t2
 	),
 	`SELECT 2 UNION SELECT 3 UNION SELECT 4`: plan.NewDistinct(
 		plan.NewUnion(
-			plan.NewDistinct(
-				plan.NewUnion(
-					plan.NewProject(
-						[]sql.Expression{expression.NewLiteral(int8(2), sql.Int8)},
-						plan.NewUnresolvedTable("dual", ""),
-					),
-					plan.NewProject(
-						[]sql.Expression{expression.NewLiteral(int8(3), sql.Int8)},
-						plan.NewUnresolvedTable("dual", ""),
-					),
+			plan.NewUnion(
+				plan.NewProject(
+					[]sql.Expression{expression.NewLiteral(int8(2), sql.Int8)},
+					plan.NewUnresolvedTable("dual", ""),
+				),
+				plan.NewProject(
+					[]sql.Expression{expression.NewLiteral(int8(3), sql.Int8)},
+					plan.NewUnresolvedTable("dual", ""),
 				),
 			),
 			plan.NewProject(
@@ -3216,16 +3288,14 @@ CREATE TABLE t2
 				[]sql.Expression{expression.NewLiteral(int8(2), sql.Int8)},
 				plan.NewUnresolvedTable("dual", ""),
 			),
-			plan.NewDistinct(
-				plan.NewUnion(
-					plan.NewProject(
-						[]sql.Expression{expression.NewLiteral(int8(3), sql.Int8)},
-						plan.NewUnresolvedTable("dual", ""),
-					),
-					plan.NewProject(
-						[]sql.Expression{expression.NewLiteral(int8(4), sql.Int8)},
-						plan.NewUnresolvedTable("dual", ""),
-					),
+			plan.NewUnion(
+				plan.NewProject(
+					[]sql.Expression{expression.NewLiteral(int8(3), sql.Int8)},
+					plan.NewUnresolvedTable("dual", ""),
+				),
+				plan.NewProject(
+					[]sql.Expression{expression.NewLiteral(int8(4), sql.Int8)},
+					plan.NewUnresolvedTable("dual", ""),
 				),
 			),
 		),
@@ -3339,11 +3409,22 @@ var fixturesErrors = map[string]*errors.Kind{
 	`SELECT a, count(i) over (partition by y) FROM foo`:         sql.ErrUnsupportedFeature,
 	`SELECT i, row_number() over (order by a) group by 1`:       sql.ErrUnsupportedFeature,
 	`SELECT i, row_number() over (order by a), max(b)`:          sql.ErrUnsupportedFeature,
-	`SHOW COUNT(*) WARNINGS`:                                    sql.ErrUnsupportedFeature,
-	`SHOW ERRORS`:                                               sql.ErrUnsupportedFeature,
-	`SHOW VARIABLES WHERE Variable_name = 'autocommit'`:         sql.ErrUnsupportedFeature,
-	`SHOW SESSION VARIABLES WHERE Variable_name IS NOT NULL`:    sql.ErrUnsupportedFeature,
-	`KILL CONNECTION 4294967296`:                                sql.ErrUnsupportedFeature,
+	`SHOW ERRORS`: sql.ErrUnsupportedFeature,
+	`SHOW VARIABLES WHERE Variable_name = 'autocommit'`:      sql.ErrUnsupportedFeature,
+	`SHOW SESSION VARIABLES WHERE Variable_name IS NOT NULL`: sql.ErrUnsupportedFeature,
+	`KILL CONNECTION 4294967296`:                             sql.ErrUnsupportedFeature,
+
+	`SELECT SUM(a) FILTER (WHERE b > 0) FROM foo`:                    sql.ErrUnsupportedFeature,
+	`SELECT 'filter (where' FROM foo WHERE`:                          sql.ErrSyntaxError,
+	`SELECT except, lateral FROM foo`:                                sql.ErrSyntaxError,
+	`SELECT PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY a) FROM foo`: sql.ErrUnsupportedFeature,
+	`WITH RECURSIVE t AS (SELECT 1) SELECT * FROM t`:                 sql.ErrUnsupportedFeature,
+	`SELECT a FROM foo INTERSECT SELECT a FROM bar`:                  sql.ErrUnsupportedFeature,
+	`SELECT a FROM foo EXCEPT ALL SELECT a FROM bar`:                 sql.ErrUnsupportedFeature,
+	`SELECT SUM(a) OVER w FROM foo WINDOW w AS (ORDER BY b)`:         sql.ErrUnsupportedFeature,
+	`SELECT a, SUM(b) FROM foo GROUP BY a WITH ROLLUP`:               sql.ErrUnsupportedFeature,
+	`SELECT a, GROUPING(a) FROM foo GROUP BY a`:                      sql.ErrUnsupportedFeature,
+	`SELECT * FROM foo, LATERAL (SELECT foo.a) AS l`:                 sql.ErrUnsupportedFeature,
 }
 
 func TestParseOne(t *testing.T) {
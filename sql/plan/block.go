@@ -137,7 +137,9 @@ func (b *Block) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 			}
 			return nil
 		}()
-		if err != nil {
+		if exitErr, ok := err.(exitHandlerError); ok && b.declaresHandler(exitErr.handler) {
+			break
+		} else if err != nil {
 			return nil, err
 		}
 	}
@@ -150,6 +152,16 @@ func (b *Block) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	}, nil
 }
 
+// declaresHandler returns whether the given handler is one of the statements of this block.
+func (b *Block) declaresHandler(handler *DeclareHandler) bool {
+	for _, s := range b.statements {
+		if s == sql.Node(handler) {
+			return true
+		}
+	}
+	return false
+}
+
 // blockIter is a sql.RowIter that iterates over the given rows.
 type blockIter struct {
 	internalIter sql.RowIter
@@ -155,6 +155,10 @@ func (iter *callIter) Close(ctx *sql.Context) error {
 	if err != nil {
 		return err
 	}
+	err = iter.call.pRef.CloseAllCursors(ctx)
+	if err != nil {
+		return err
+	}
 
 	// Set all user and system variables from INOUT and OUT params
 	for i, param := range iter.call.proc.Params {
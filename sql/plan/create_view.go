@@ -42,6 +42,10 @@ func NewCreateView(
 	definition *SubqueryAlias,
 	isReplace bool,
 ) *CreateView {
+	if len(columns) > 0 {
+		definition = viewWithColumns(definition, columns)
+	}
+
 	return &CreateView{
 		UnaryNode:  UnaryNode{Child: definition},
 		database:   database,
@@ -52,6 +56,20 @@ func NewCreateView(
 	}
 }
 
+// viewWithColumns returns the definition of a view renaming the columns of its SELECT to the columns given. The text
+// definition selects from the original one as a derived table with those columns, so that the names are kept when
+// it's persisted.
+func viewWithColumns(definition *SubqueryAlias, columns []string) *SubqueryAlias {
+	definition = definition.WithColumns(columns)
+	definition.TextDefinition = fmt.Sprintf(
+		"SELECT * FROM (%s) AS `%s` (%s)",
+		definition.TextDefinition,
+		definition.Name(),
+		strings.Join(quoteIdentifiers(columns), ", "),
+	)
+	return definition
+}
+
 // View returns the view that will be created by this node.
 func (cv *CreateView) View() *sql.View {
 	return cv.Definition.AsView()
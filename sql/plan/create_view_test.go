@@ -179,3 +179,41 @@ func TestReplaceExistingViewWithRegistry(t *testing.T) {
 	require.NoError(err)
 	require.Equal(expectedView, actualView)
 }
+
+// Tests that the columns of a view rename the columns of its definition, in the session registry and in the
+// persisted definition
+func TestCreateViewWithColumns(t *testing.T) {
+	require := require.New(t)
+
+	subqueryAlias := NewSubqueryAlias("myview", "select i from mytable",
+		NewProject(
+			[]sql.Expression{
+				expression.NewGetFieldWithTable(0, sql.Int32, "mytable", "i", true),
+			},
+			NewUnresolvedTable("mytable", ""),
+		),
+	)
+
+	db := memory.NewDatabase("mydb")
+	createView := NewCreateView(db, subqueryAlias.Name(), []string{"a"}, subqueryAlias, false)
+	require.Equal([]string{"a"}, createView.Definition.Columns)
+	require.Equal("select i from mytable", subqueryAlias.TextDefinition)
+
+	ctx := sql.NewContext(context.Background())
+	_, err := createView.RowIter(ctx, nil)
+	require.NoError(err)
+
+	view, ok, err := db.GetView(ctx, createView.Name)
+	require.NoError(err)
+	require.True(ok)
+	require.Equal("SELECT * FROM (select i from mytable) AS `myview` (`a`)", view)
+
+	viewlessDb := memory.NewViewlessDatabase("mydb")
+	createView = NewCreateView(viewlessDb, subqueryAlias.Name(), []string{"a"}, subqueryAlias, false)
+	_, err = createView.RowIter(ctx, nil)
+	require.NoError(err)
+
+	registered, err := ctx.GetViewRegistry().View(viewlessDb.Name(), createView.Name)
+	require.NoError(err)
+	require.Equal("a", registered.Definition().Schema()[0].Name)
+}
@@ -0,0 +1,311 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// ProcedureReferencable is a node that needs the state of the CALL statement that it is executed within, such as
+// the cursors and handlers declared by the procedure.
+type ProcedureReferencable interface {
+	sql.Node
+	// WithParamReference returns a copy of the node with the given *expression.ProcedureParamReference.
+	WithParamReference(pRef *expression.ProcedureParamReference) sql.Node
+}
+
+// DeclareCursor represents the DECLARE ... CURSOR FOR statement.
+type DeclareCursor struct {
+	Name   string
+	Select sql.Node
+	pRef   *expression.ProcedureParamReference
+}
+
+var _ sql.Node = (*DeclareCursor)(nil)
+var _ ProcedureReferencable = (*DeclareCursor)(nil)
+
+// NewDeclareCursor creates a new *DeclareCursor node.
+func NewDeclareCursor(name string, selectStatement sql.Node) *DeclareCursor {
+	return &DeclareCursor{
+		Name:   strings.ToLower(name),
+		Select: selectStatement,
+	}
+}
+
+// Resolved implements the sql.Node interface.
+func (d *DeclareCursor) Resolved() bool {
+	return d.Select.Resolved()
+}
+
+// String implements the sql.Node interface.
+func (d *DeclareCursor) String() string {
+	return fmt.Sprintf("DECLARE %s CURSOR FOR %s", d.Name, d.Select.String())
+}
+
+// Schema implements the sql.Node interface.
+func (d *DeclareCursor) Schema() sql.Schema {
+	return nil
+}
+
+// Children implements the sql.Node interface.
+func (d *DeclareCursor) Children() []sql.Node {
+	return []sql.Node{d.Select}
+}
+
+// WithChildren implements the sql.Node interface.
+func (d *DeclareCursor) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(d, len(children), 1)
+	}
+
+	nd := *d
+	nd.Select = children[0]
+	return &nd, nil
+}
+
+// WithParamReference implements the ProcedureReferencable interface.
+func (d *DeclareCursor) WithParamReference(pRef *expression.ProcedureParamReference) sql.Node {
+	nd := *d
+	nd.pRef = pRef
+	return &nd
+}
+
+// RowIter implements the sql.Node interface.
+func (d *DeclareCursor) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	if err := d.pRef.InitializeCursor(d.Name, d.Select); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Open represents the OPEN statement, which starts the iteration over the rows of a cursor.
+type Open struct {
+	Name string
+	pRef *expression.ProcedureParamReference
+}
+
+var _ sql.Node = (*Open)(nil)
+var _ ProcedureReferencable = (*Open)(nil)
+
+// NewOpen creates a new *Open node.
+func NewOpen(name string) *Open {
+	return &Open{Name: strings.ToLower(name)}
+}
+
+// Resolved implements the sql.Node interface.
+func (o *Open) Resolved() bool {
+	return true
+}
+
+// String implements the sql.Node interface.
+func (o *Open) String() string {
+	return fmt.Sprintf("OPEN %s", o.Name)
+}
+
+// Schema implements the sql.Node interface.
+func (o *Open) Schema() sql.Schema {
+	return nil
+}
+
+// Children implements the sql.Node interface.
+func (o *Open) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (o *Open) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(o, children...)
+}
+
+// WithParamReference implements the ProcedureReferencable interface.
+func (o *Open) WithParamReference(pRef *expression.ProcedureParamReference) sql.Node {
+	no := *o
+	no.pRef = pRef
+	return &no
+}
+
+// RowIter implements the sql.Node interface.
+func (o *Open) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	if err := o.pRef.OpenCursor(ctx, o.Name, row); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Fetch represents the FETCH statement, which assigns the next row of a cursor to the given variables. When the
+// cursor has no rows left, the handler declared for the NOT FOUND condition is run, if there is one.
+type Fetch struct {
+	Name string
+	Into []sql.Expression
+	pRef *expression.ProcedureParamReference
+}
+
+var _ sql.Node = (*Fetch)(nil)
+var _ sql.Expressioner = (*Fetch)(nil)
+var _ ProcedureReferencable = (*Fetch)(nil)
+
+// NewFetch creates a new *Fetch node.
+func NewFetch(name string, into []sql.Expression) *Fetch {
+	return &Fetch{
+		Name: strings.ToLower(name),
+		Into: into,
+	}
+}
+
+// Resolved implements the sql.Node interface.
+func (f *Fetch) Resolved() bool {
+	for _, e := range f.Into {
+		if !e.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// String implements the sql.Node interface.
+func (f *Fetch) String() string {
+	vars := make([]string, len(f.Into))
+	for i, e := range f.Into {
+		vars[i] = e.String()
+	}
+	return fmt.Sprintf("FETCH %s INTO %s", f.Name, strings.Join(vars, ", "))
+}
+
+// Schema implements the sql.Node interface.
+func (f *Fetch) Schema() sql.Schema {
+	return nil
+}
+
+// Children implements the sql.Node interface.
+func (f *Fetch) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (f *Fetch) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(f, children...)
+}
+
+// Expressions implements the sql.Expressioner interface.
+func (f *Fetch) Expressions() []sql.Expression {
+	return f.Into
+}
+
+// WithExpressions implements the sql.Expressioner interface.
+func (f *Fetch) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != len(f.Into) {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(exprs), len(f.Into))
+	}
+
+	nf := *f
+	nf.Into = exprs
+	return &nf, nil
+}
+
+// WithParamReference implements the ProcedureReferencable interface.
+func (f *Fetch) WithParamReference(pRef *expression.ProcedureParamReference) sql.Node {
+	nf := *f
+	nf.pRef = pRef
+	return &nf
+}
+
+// RowIter implements the sql.Node interface.
+func (f *Fetch) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	fetchedRow, sch, err := f.pRef.FetchCursor(ctx, f.Name)
+	if sql.ErrFetchNoData.Is(err) {
+		if handler, ok := f.pRef.Handler(HandlerConditionNotFound).(*DeclareHandler); ok {
+			return handler.handle(ctx, row)
+		}
+		return nil, err
+	} else if err != nil {
+		return nil, err
+	}
+
+	if len(fetchedRow) != len(f.Into) {
+		return nil, sql.ErrFetchIncorrectCount.New()
+	}
+	for i, e := range f.Into {
+		switch e := e.(type) {
+		case *expression.ProcedureParam:
+			err = e.Set(fetchedRow[i], sch[i].Type)
+		case *expression.UserVar:
+			err = ctx.SetUserVariable(ctx, e.Name, fetchedRow[i])
+		default:
+			err = fmt.Errorf("unable to FETCH into `%s` as it is not a variable", e.String())
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Close represents the CLOSE statement, which ends the iteration over the rows of a cursor.
+type Close struct {
+	Name string
+	pRef *expression.ProcedureParamReference
+}
+
+var _ sql.Node = (*Close)(nil)
+var _ ProcedureReferencable = (*Close)(nil)
+
+// NewClose creates a new *Close node.
+func NewClose(name string) *Close {
+	return &Close{Name: strings.ToLower(name)}
+}
+
+// Resolved implements the sql.Node interface.
+func (c *Close) Resolved() bool {
+	return true
+}
+
+// String implements the sql.Node interface.
+func (c *Close) String() string {
+	return fmt.Sprintf("CLOSE %s", c.Name)
+}
+
+// Schema implements the sql.Node interface.
+func (c *Close) Schema() sql.Schema {
+	return nil
+}
+
+// Children implements the sql.Node interface.
+func (c *Close) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (c *Close) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(c, children...)
+}
+
+// WithParamReference implements the ProcedureReferencable interface.
+func (c *Close) WithParamReference(pRef *expression.ProcedureParamReference) sql.Node {
+	nc := *c
+	nc.pRef = pRef
+	return &nc
+}
+
+// RowIter implements the sql.Node interface.
+func (c *Close) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	if err := c.pRef.CloseCursor(ctx, c.Name); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
@@ -0,0 +1,154 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestCursor(t *testing.T) {
+	tbl := memory.NewTable("test", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "i", Type: sql.Int64, Source: "test", PrimaryKey: true},
+	}))
+	for _, i := range []int64{1, 2, 3} {
+		require.NoError(t, tbl.Insert(sql.NewEmptyContext(), sql.NewRow(i)))
+	}
+
+	lit := func(v int64) sql.Expression {
+		return expression.NewLiteral(v, sql.Int64)
+	}
+	set := func(name string, e sql.Expression) sql.Node {
+		return NewSet([]sql.Expression{expression.NewSetField(expression.NewUserVar(name), e)})
+	}
+	withParamReference := func(n sql.Node) sql.Node {
+		pRef := expression.NewProcedureParamReference()
+		n, err := TransformUp(n, func(n sql.Node) (sql.Node, error) {
+			if n, ok := n.(ProcedureReferencable); ok {
+				return n.WithParamReference(pRef), nil
+			}
+			return n, nil
+		})
+		require.NoError(t, err)
+		return n
+	}
+	getUserVar := func(ctx *sql.Context, name string) interface{} {
+		_, v, err := ctx.GetUserVariable(ctx, name)
+		require.NoError(t, err)
+		return v
+	}
+	v := expression.NewUserVar("v")
+	sum := expression.NewUserVar("sum")
+	done := expression.NewUserVar("done")
+
+	t.Run("continue handler", func(t *testing.T) {
+		require := require.New(t)
+		ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession()))
+
+		proc := withParamReference(NewBeginEndBlock(NewBlock([]sql.Node{
+			NewDeclareCursor("cur", NewResolvedTable(tbl, nil, nil)),
+			NewDeclareHandler(DeclareHandlerAction_Continue, HandlerConditionNotFound, set("done", lit(1))),
+			set("done", lit(0)),
+			set("sum", lit(0)),
+			NewOpen("cur"),
+			NewLoop("read_loop", NewBlock([]sql.Node{
+				NewFetch("cur", []sql.Expression{v}),
+				NewIfElse([]*IfConditional{
+					NewIfConditional(expression.NewEquals(done, lit(1)), NewLeave("read_loop")),
+				}, NewBlock(nil)),
+				set("sum", expression.NewPlus(sum, v)),
+			})),
+			NewClose("cur"),
+		})))
+		_, err := proc.RowIter(ctx, nil)
+		require.NoError(err)
+		require.Equal(int64(6), getUserVar(ctx, "sum"))
+		require.Equal(int64(1), getUserVar(ctx, "done"))
+	})
+
+	t.Run("exit handler", func(t *testing.T) {
+		require := require.New(t)
+		ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession()))
+
+		proc := withParamReference(NewBlock([]sql.Node{
+			set("sum", lit(0)),
+			NewBeginEndBlock(NewBlock([]sql.Node{
+				NewDeclareCursor("cur", NewResolvedTable(tbl, nil, nil)),
+				NewDeclareHandler(DeclareHandlerAction_Exit, HandlerConditionNotFound, set("done", lit(1))),
+				NewOpen("cur"),
+				NewLoop("read_loop", NewBlock([]sql.Node{
+					NewFetch("cur", []sql.Expression{v}),
+					set("sum", expression.NewPlus(sum, v)),
+				})),
+				set("sum", lit(-1)),
+			})),
+			set("after", lit(1)),
+		}))
+		_, err := proc.RowIter(ctx, nil)
+		require.NoError(err)
+		require.Equal(int64(6), getUserVar(ctx, "sum"))
+		require.Equal(int64(1), getUserVar(ctx, "done"))
+		require.Equal(int64(1), getUserVar(ctx, "after"))
+	})
+
+	t.Run("fetch without handler", func(t *testing.T) {
+		require := require.New(t)
+		ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession()))
+
+		proc := withParamReference(NewBlock([]sql.Node{
+			NewDeclareCursor("cur", NewResolvedTable(tbl, nil, nil)),
+			NewOpen("cur"),
+			NewLoop("read_loop", NewBlock([]sql.Node{
+				NewFetch("cur", []sql.Expression{v}),
+			})),
+		}))
+		_, err := proc.RowIter(ctx, nil)
+		require.True(sql.ErrFetchNoData.Is(err))
+	})
+
+	t.Run("cursor errors", func(t *testing.T) {
+		require := require.New(t)
+		ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession()))
+
+		_, err := withParamReference(NewBlock([]sql.Node{NewOpen("cur")})).RowIter(ctx, nil)
+		require.True(sql.ErrCursorNotFound.Is(err))
+
+		_, err = withParamReference(NewBlock([]sql.Node{
+			NewDeclareCursor("cur", NewResolvedTable(tbl, nil, nil)),
+			NewFetch("cur", []sql.Expression{v}),
+		})).RowIter(ctx, nil)
+		require.True(sql.ErrCursorNotOpen.Is(err))
+
+		_, err = withParamReference(NewBlock([]sql.Node{
+			NewDeclareCursor("cur", NewResolvedTable(tbl, nil, nil)),
+			NewOpen("cur"),
+			NewOpen("cur"),
+		})).RowIter(ctx, nil)
+		require.True(sql.ErrCursorAlreadyOpen.Is(err))
+
+		_, err = withParamReference(NewBlock([]sql.Node{
+			NewDeclareCursor("cur", NewResolvedTable(tbl, nil, nil)),
+			NewOpen("cur"),
+			NewFetch("cur", []sql.Expression{v, sum}),
+		})).RowIter(ctx, nil)
+		require.True(sql.ErrFetchIncorrectCount.Is(err))
+	})
+}
@@ -0,0 +1,153 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// HandlerConditionNotFound is the SQLSTATE of the NOT FOUND condition, which is raised when a cursor has no rows left.
+const HandlerConditionNotFound = "02000"
+
+// DeclareHandlerAction is the action taken once a handler's statement has run.
+type DeclareHandlerAction byte
+
+const (
+	// DeclareHandlerAction_Continue resumes execution after the statement that raised the condition.
+	DeclareHandlerAction_Continue DeclareHandlerAction = iota
+	// DeclareHandlerAction_Exit ends the BEGIN/END block that declared the handler.
+	DeclareHandlerAction_Exit
+)
+
+// String returns the action as it is written in a DECLARE ... HANDLER statement.
+func (a DeclareHandlerAction) String() string {
+	switch a {
+	case DeclareHandlerAction_Continue:
+		return "CONTINUE"
+	case DeclareHandlerAction_Exit:
+		return "EXIT"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// DeclareHandler represents the DECLARE ... HANDLER statement, which runs its statement when the given SQLSTATE
+// condition is raised.
+type DeclareHandler struct {
+	Action    DeclareHandlerAction
+	SqlState  string
+	Statement sql.Node
+	pRef      *expression.ProcedureParamReference
+}
+
+var _ sql.Node = (*DeclareHandler)(nil)
+var _ ProcedureReferencable = (*DeclareHandler)(nil)
+
+// NewDeclareHandler creates a new *DeclareHandler node.
+func NewDeclareHandler(action DeclareHandlerAction, sqlState string, statement sql.Node) *DeclareHandler {
+	return &DeclareHandler{
+		Action:    action,
+		SqlState:  sqlState,
+		Statement: statement,
+	}
+}
+
+// Resolved implements the sql.Node interface.
+func (d *DeclareHandler) Resolved() bool {
+	return d.Statement.Resolved()
+}
+
+// String implements the sql.Node interface.
+func (d *DeclareHandler) String() string {
+	condition := fmt.Sprintf("SQLSTATE '%s'", d.SqlState)
+	if d.SqlState == HandlerConditionNotFound {
+		condition = "NOT FOUND"
+	}
+	return fmt.Sprintf("DECLARE %s HANDLER FOR %s %s", d.Action, condition, d.Statement.String())
+}
+
+// Schema implements the sql.Node interface.
+func (d *DeclareHandler) Schema() sql.Schema {
+	return nil
+}
+
+// Children implements the sql.Node interface.
+func (d *DeclareHandler) Children() []sql.Node {
+	return []sql.Node{d.Statement}
+}
+
+// WithChildren implements the sql.Node interface.
+func (d *DeclareHandler) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(d, len(children), 1)
+	}
+
+	nd := *d
+	nd.Statement = children[0]
+	return &nd, nil
+}
+
+// WithParamReference implements the ProcedureReferencable interface.
+func (d *DeclareHandler) WithParamReference(pRef *expression.ProcedureParamReference) sql.Node {
+	nd := *d
+	nd.pRef = pRef
+	return &nd
+}
+
+// RowIter implements the sql.Node interface.
+func (d *DeclareHandler) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	d.pRef.InitializeHandler(d.SqlState, d)
+	return sql.RowsToRowIter(), nil
+}
+
+// handle runs the handler's statement. For an EXIT handler, the returned error unwinds the execution up to the block
+// that declared the handler.
+func (d *DeclareHandler) handle(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	iter, err := d.Statement.RowIter(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		_, err = iter.Next(ctx)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			_ = iter.Close(ctx)
+			return nil, err
+		}
+	}
+	if err = iter.Close(ctx); err != nil {
+		return nil, err
+	}
+
+	if d.Action == DeclareHandlerAction_Exit {
+		return nil, exitHandlerError{handler: d}
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// exitHandlerError is returned once an EXIT handler has run, and is caught by the block that declared the handler.
+type exitHandlerError struct {
+	handler *DeclareHandler
+}
+
+// Error implements the error interface.
+func (e exitHandlerError) Error() string {
+	return fmt.Sprintf("exit handler for SQLSTATE '%s' was not declared in an enclosing block", e.handler.SqlState)
+}
@@ -15,6 +15,9 @@
 package plan
 
 import (
+	"io"
+	"strings"
+
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -25,11 +28,18 @@ var ErrDeleteFromNotSupported = errors.NewKind("table doesn't support DELETE FRO
 // DeleteFrom is a node describing a deletion from some table.
 type DeleteFrom struct {
 	UnaryNode
+	// Targets are the names or aliases of the tables to delete from in a multi-table DELETE, whose child joins them with
+	// other tables. It's empty for a single-table DELETE.
+	Targets []string
+	// ForeignKeys are the foreign keys enforced on the rows deleted by a single-table DELETE.
+	ForeignKeys *ForeignKeyHandler
+	// TargetForeignKeys are the foreign keys enforced on the rows deleted by a multi-table DELETE, by lowercased target.
+	TargetForeignKeys map[string]*ForeignKeyHandler
 }
 
-// NewDeleteFrom creates a DeleteFrom node.
-func NewDeleteFrom(n sql.Node) *DeleteFrom {
-	return &DeleteFrom{UnaryNode{n}}
+// NewDeleteFrom creates a DeleteFrom node, with the tables to delete from given for a multi-table DELETE.
+func NewDeleteFrom(n sql.Node, targets ...string) *DeleteFrom {
+	return &DeleteFrom{UnaryNode: UnaryNode{n}, Targets: targets}
 }
 
 func getDeletable(node sql.Node) (sql.DeletableTable, error) {
@@ -101,6 +111,10 @@ func (p *DeleteFrom) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error)
 		return sql.RowsToRowIter(), nil
 	}
 
+	if len(p.Targets) > 0 {
+		return p.joinRowIter(ctx, row)
+	}
+
 	deletable, err := getDeletable(p.Child)
 	if err != nil {
 		return nil, err
@@ -112,6 +126,9 @@ func (p *DeleteFrom) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error)
 	}
 
 	deleter := deletable.Deleter(ctx)
+	if p.ForeignKeys != nil && foreignKeyChecksEnabled(ctx) {
+		deleter = newForeignKeyStatement(p.ForeignKeys).wrap(p.ForeignKeys, deleter)
+	}
 
 	return newDeleteIter(iter, deleter, deletable.Schema()), nil
 }
@@ -158,12 +175,336 @@ func newDeleteIter(childIter sql.RowIter, deleter sql.RowDeleter, schema sql.Sch
 	})
 }
 
+// joinRowIter returns the iterator of a multi-table DELETE, which deletes the rows of the target tables in the rows of
+// the join.
+func (p *DeleteFrom) joinRowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	deletables := make(map[string]sql.DeletableTable)
+	for _, target := range p.Targets {
+		deletable, err := getDeleteTarget(p.Child, target)
+		if err != nil {
+			return nil, err
+		}
+		deletables[strings.ToLower(target)] = deletable
+	}
+
+	// The copies of a row of a keyless table can't be told apart, so they are counted before any of them is deleted
+	copies := make(map[string]map[uint64]int)
+	for target, deletable := range deletables {
+		if !sql.IsKeyless(deletable.Schema()) {
+			continue
+		}
+		counts, err := countRowCopies(ctx, deletable)
+		if err != nil {
+			return nil, err
+		}
+		copies[target] = counts
+	}
+
+	iter, err := p.Child.RowIter(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	// The deletes of all the targets go through the same foreign key statement, so that each target sees the rows deleted
+	// from the others
+	var statement *foreignKeyStatement
+	deleters := make(map[string]sql.RowDeleter)
+	for target, deletable := range deletables {
+		deleter := deletable.Deleter(ctx)
+		if fks := p.TargetForeignKeys[target]; fks != nil && foreignKeyChecksEnabled(ctx) {
+			if statement == nil {
+				statement = newForeignKeyStatement(fks)
+			}
+			deleter = statement.wrap(fks, deleter)
+		}
+		deleters[target] = deleter
+	}
+	targets := make([]string, len(p.Targets))
+	for i, target := range p.Targets {
+		targets[i] = strings.ToLower(target)
+	}
+	editor := &joinDeleter{targets: targets, deleters: deleters}
+
+	return NewTableEditorIter(editor, &deleteJoinIter{
+		editor:     editor,
+		childIter:  iter,
+		join:       p.Child,
+		joinSchema: p.Child.Schema(),
+		deleted:    make(map[string]map[uint64]struct{}),
+		copies:     copies,
+	}), nil
+}
+
+// getDeleteTarget returns the table with the name or alias given among the tables of the node given. The analyzer
+// validates that every target of a multi-table DELETE is one of them.
+func getDeleteTarget(node sql.Node, target string) (sql.DeletableTable, error) {
+	var deletable sql.DeletableTable
+	var err error
+	found := false
+	Inspect(node, func(n sql.Node) bool {
+		if found {
+			return false
+		}
+		switch n := n.(type) {
+		case *TableAlias:
+			// An aliased table can only be referred to by its alias
+			if strings.EqualFold(n.Name(), target) {
+				found = true
+				deletable, err = getDeletable(n.Child)
+			}
+			return false
+		case *IndexedTableAccess:
+			if strings.EqualFold(n.Name(), target) {
+				found = true
+				deletable, err = getDeletableTable(n.ResolvedTable.Table)
+			}
+			return false
+		case *ResolvedTable:
+			if strings.EqualFold(n.Name(), target) {
+				found = true
+				deletable, err = getDeletableTable(n.Table)
+			}
+			return false
+		case *SubqueryAlias:
+			return false
+		}
+		return true
+	})
+
+	if !found {
+		return nil, ErrDeleteFromNotSupported.New()
+	}
+	return deletable, err
+}
+
+// countRowCopies returns the number of copies of each row of the table given, by the hash of the row.
+func countRowCopies(ctx *sql.Context, table sql.Table) (map[uint64]int, error) {
+	partitions, err := table.Partitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	iter := sql.NewTableRowIter(ctx, table, partitions)
+	defer iter.Close(ctx)
+
+	counts := make(map[uint64]int)
+	for {
+		row, err := iter.Next(ctx)
+		if err == io.EOF {
+			return counts, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		hash, err := sql.HashOf(row)
+		if err != nil {
+			return nil, err
+		}
+		counts[hash]++
+	}
+}
+
+// deleteJoinIter deletes the rows of the target tables of a multi-table DELETE found in the rows of its child, which
+// joins them. It returns each row deleted. A row matched by several rows of the join is only deleted once, except for
+// the copies of a row of a keyless table: they are equal, so a join row matching one of them matches all of them, and
+// all of them are deleted the first time one is found.
+type deleteJoinIter struct {
+	editor     *joinDeleter
+	childIter  sql.RowIter
+	join       sql.Node
+	joinSchema sql.Schema
+	// deleted holds the hashes of the rows already deleted, by target table
+	deleted map[string]map[uint64]struct{}
+	// copies holds the number of copies of each row of the keyless target tables, by the hash of the row
+	copies  map[string]map[uint64]int
+	pending []sql.Row
+	closed  bool
+}
+
+func (d *deleteJoinIter) Next(ctx *sql.Context) (sql.Row, error) {
+	for len(d.pending) == 0 {
+		row, err := d.childIter.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// Reduce the row to the length of the schema, like for a single-table DELETE.
+		if len(d.joinSchema) < len(row) {
+			row = row[len(row)-len(d.joinSchema):]
+		}
+
+		tableRows := make(map[string]sql.Row)
+		for source, tableRow := range splitRowIntoTableRowMap(row, d.joinSchema) {
+			tableRows[strings.ToLower(source)] = tableRow
+		}
+
+		// Only a row of NULLs may have been filled in by an outer join without coming from its table
+		var unmatched map[string]bool
+		for _, target := range d.editor.targets {
+			if tableRow, ok := tableRows[target]; ok && isNullRow(tableRow) {
+				unmatched = make(map[string]bool)
+				if err := unmatchedOuterTables(ctx, d.join, row, unmatched); err != nil {
+					return nil, err
+				}
+				break
+			}
+		}
+
+		for _, target := range d.editor.targets {
+			deleter := d.editor.deleters[target]
+			tableRow, ok := tableRows[target]
+			if !ok || unmatched[target] {
+				continue
+			}
+
+			hash, err := sql.HashOf(tableRow)
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := d.deleted[target][hash]; ok {
+				continue
+			}
+			if d.deleted[target] == nil {
+				d.deleted[target] = make(map[uint64]struct{})
+			}
+			d.deleted[target][hash] = struct{}{}
+
+			count := 1
+			if counts, ok := d.copies[target]; ok && counts[hash] > 1 {
+				count = counts[hash]
+			}
+			for i := 0; i < count; i++ {
+				if err := deleter.Delete(ctx, tableRow); err != nil {
+					return nil, err
+				}
+				d.pending = append(d.pending, tableRow)
+			}
+		}
+	}
+
+	row := d.pending[0]
+	d.pending = d.pending[1:]
+	return row, nil
+}
+
+func (d *deleteJoinIter) Close(ctx *sql.Context) error {
+	if !d.closed {
+		d.closed = true
+		if err := d.editor.Close(ctx); err != nil {
+			return err
+		}
+		return d.childIter.Close(ctx)
+	}
+	return nil
+}
+
+// unmatchedOuterTables adds to the set given the lowercased names of the tables whose values in the row given, returned
+// by the node given, were filled with NULLs by an outer join because it found no matching row, rather than read from
+// the tables. Every row an outer join builds from a match satisfies its condition, so a side of it made only of NULLs
+// was filled in when the row doesn't.
+func unmatchedOuterTables(ctx *sql.Context, n sql.Node, row sql.Row, tables map[string]bool) error {
+	switch n := n.(type) {
+	case JoinNode:
+		leftLen := len(n.Left().Schema())
+		left, right := row[:leftLen], row[leftLen:]
+
+		var outer sql.Node
+		var outerRow sql.Row
+		switch n.JoinType() {
+		case JoinTypeLeft:
+			outer, outerRow = n.Right(), right
+		case JoinTypeRight:
+			outer, outerRow = n.Left(), left
+		}
+		if outer != nil && isNullRow(outerRow) && n.JoinCond() != nil {
+			matched, err := conditionIsTrue(ctx, row, n.JoinCond())
+			if err != nil {
+				return err
+			}
+			if !matched {
+				for _, col := range outer.Schema() {
+					tables[strings.ToLower(col.Source)] = true
+				}
+			}
+		}
+
+		if err := unmatchedOuterTables(ctx, n.Left(), left, tables); err != nil {
+			return err
+		}
+		return unmatchedOuterTables(ctx, n.Right(), right, tables)
+	default:
+		// Nodes over a join that return its rows unchanged, such as filters
+		children := n.Children()
+		if len(children) == 1 && len(children[0].Schema()) == len(n.Schema()) {
+			return unmatchedOuterTables(ctx, children[0], row, tables)
+		}
+		return nil
+	}
+}
+
+func isNullRow(row sql.Row) bool {
+	for _, v := range row {
+		if v != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// joinDeleter manages the deleters of the target tables of a multi-table DELETE as a single table editor. The rows of
+// each join row are deleted in the order of the targets, so a row referenced by a row of a later target gets the ON
+// DELETE action of the foreign key applied to it.
+type joinDeleter struct {
+	targets  []string
+	deleters map[string]sql.RowDeleter
+}
+
+var _ sql.TableEditor = (*joinDeleter)(nil)
+
+// StatementBegin implements the sql.TableEditor interface.
+func (j *joinDeleter) StatementBegin(ctx *sql.Context) {
+	for _, d := range j.deleters {
+		d.StatementBegin(ctx)
+	}
+}
+
+// DiscardChanges implements the sql.TableEditor interface.
+func (j *joinDeleter) DiscardChanges(ctx *sql.Context, errorEncountered error) error {
+	for _, d := range j.deleters {
+		if err := d.DiscardChanges(ctx, errorEncountered); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StatementComplete implements the sql.TableEditor interface.
+func (j *joinDeleter) StatementComplete(ctx *sql.Context) error {
+	for _, d := range j.deleters {
+		if err := d.StatementComplete(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close closes the deleters of every table.
+func (j *joinDeleter) Close(ctx *sql.Context) error {
+	for _, d := range j.deleters {
+		if err := d.Close(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // WithChildren implements the Node interface.
 func (p *DeleteFrom) WithChildren(children ...sql.Node) (sql.Node, error) {
 	if len(children) != 1 {
 		return nil, sql.ErrInvalidChildrenNumber.New(p, len(children), 1)
 	}
-	return NewDeleteFrom(children[0]), nil
+	np := *p
+	np.Child = children[0]
+	return &np, nil
 }
 
 func (p DeleteFrom) String() string {
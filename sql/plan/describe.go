@@ -82,6 +82,10 @@ func (i *describeIter) Close(*sql.Context) error {
 	return nil
 }
 
+// DescribeFormatJSON is the format of a DescribeQuery that describes the query plan as a single JSON document, with
+// the ExplainNode of each node.
+const DescribeFormatJSON = "json"
+
 // DescribeQuery returns the description of the query plan.
 type DescribeQuery struct {
 	child  sql.Node
@@ -120,10 +124,24 @@ func (d *DescribeQuery) Schema() sql.Schema {
 
 // RowIter implements the Node interface.
 func (d *DescribeQuery) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	if d.Format == DescribeFormatJSON {
+		plan, err := ExplainJSON(ctx, d.child)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowsToRowIter(sql.NewRow(plan)), nil
+	}
+
 	var rows []sql.Row
 	var formatString string
 	if d.Format == "debug" {
 		formatString = sql.DebugString(d.child)
+	} else if d.Format == DescribeFormatAnalyze {
+		var err error
+		formatString, err = ExplainAnalyze(ctx, d.child)
+		if err != nil {
+			return nil, err
+		}
 	} else {
 		formatString = d.child.String()
 	}
@@ -15,7 +15,9 @@
 package plan
 
 import (
+	"encoding/json"
 	"io"
+	"regexp"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -104,3 +106,121 @@ func TestDescribeQuery(t *testing.T) {
 
 	require.Equal(expected, rows)
 }
+
+func TestDescribeQueryJSON(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	foo := memory.NewTable("foo", sql.NewPrimaryKeySchema(sql.Schema{
+		{Source: "foo", Name: "a", Type: sql.Int64},
+	}))
+	bar := memory.NewTable("bar", sql.NewPrimaryKeySchema(sql.Schema{
+		{Source: "bar", Name: "b", Type: sql.Int64},
+	}))
+	for i := int64(1); i <= 3; i++ {
+		require.NoError(foo.Insert(ctx, sql.NewRow(i)))
+	}
+	for i := int64(1); i <= 2; i++ {
+		require.NoError(bar.Insert(ctx, sql.NewRow(i)))
+	}
+
+	node := NewDescribeQuery(DescribeFormatJSON, NewLimit(
+		expression.NewLiteral(int8(2), sql.Int8),
+		NewFilter(
+			expression.NewEquals(
+				expression.NewGetFieldWithTable(0, sql.Int64, "foo", "a", false),
+				expression.NewGetFieldWithTable(1, sql.Int64, "bar", "b", false),
+			),
+			NewCrossJoin(NewResolvedTable(foo, nil, nil), NewResolvedTable(bar, nil, nil)),
+		),
+	))
+
+	iter, err := node.RowIter(ctx, nil)
+	require.NoError(err)
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Len(rows, 1)
+
+	var actual ExplainNode
+	require.NoError(json.Unmarshal([]byte(rows[0][0].(string)), &actual))
+
+	count := func(n uint64) *uint64 {
+		return &n
+	}
+	expected := ExplainNode{
+		Type:          "Limit",
+		EstimatedRows: count(2),
+		EstimatedCost: count(9),
+		Children: []*ExplainNode{{
+			Type:          "Filter",
+			Condition:     "(foo.a = bar.b)",
+			EstimatedRows: count(6),
+			EstimatedCost: count(9),
+			Children: []*ExplainNode{{
+				Type:          "CrossJoin",
+				JoinType:      "CrossJoin",
+				EstimatedRows: count(6),
+				EstimatedCost: count(9),
+				Children: []*ExplainNode{{
+					Type:          "ResolvedTable",
+					Table:         "foo",
+					AccessType:    AccessTypeFullScan,
+					EstimatedRows: count(3),
+					EstimatedCost: count(3),
+				}, {
+					Type:          "ResolvedTable",
+					Table:         "bar",
+					AccessType:    AccessTypeFullScan,
+					EstimatedRows: count(2),
+					EstimatedCost: count(2),
+				}},
+			}},
+		}},
+	}
+	require.Equal(expected, actual)
+}
+
+func TestDescribeQueryAnalyze(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	foo := memory.NewTable("foo", sql.NewPrimaryKeySchema(sql.Schema{
+		{Source: "foo", Name: "a", Type: sql.Int64},
+	}))
+	bar := memory.NewTable("bar", sql.NewPrimaryKeySchema(sql.Schema{
+		{Source: "bar", Name: "b", Type: sql.Int64},
+	}))
+	for i := int64(1); i <= 3; i++ {
+		require.NoError(foo.Insert(ctx, sql.NewRow(i)))
+	}
+	for i := int64(1); i <= 2; i++ {
+		require.NoError(bar.Insert(ctx, sql.NewRow(i)))
+	}
+
+	node := NewDescribeQuery(DescribeFormatAnalyze, NewFilter(
+		expression.NewEquals(
+			expression.NewGetFieldWithTable(0, sql.Int64, "foo", "a", false),
+			expression.NewGetFieldWithTable(1, sql.Int64, "bar", "b", false),
+		),
+		NewCrossJoin(NewResolvedTable(foo, nil, nil), NewResolvedTable(bar, nil, nil)),
+	))
+
+	iter, err := node.RowIter(ctx, nil)
+	require.NoError(err)
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+
+	// Timings vary from run to run
+	times := regexp.MustCompile(`time=[^)]*`)
+	for _, row := range rows {
+		row[0] = times.ReplaceAllString(row[0].(string), "time=X")
+	}
+
+	expected := []sql.Row{
+		{"Filter(foo.a = bar.b) (estimated rows=6) (actual rows=2 loops=1 time=X)"},
+		{" └─ CrossJoin (estimated rows=6) (actual rows=6 loops=1 time=X)"},
+		{"     ├─ Table(foo) (estimated rows=3) (actual rows=3 loops=1 time=X)"},
+		{"     └─ Table(bar) (estimated rows=2) (actual rows=6 loops=3 time=X)"},
+	}
+	require.Equal(expected, rows)
+}
@@ -47,7 +47,7 @@ func (d *Distinct) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 		return nil, err
 	}
 
-	return sql.NewSpanIter(span, newDistinctIter(ctx, it)), nil
+	return sql.NewSpanIter(span, newDistinctIter(ctx, it, d.Child.Schema())), nil
 }
 
 // WithChildren implements the Node interface.
@@ -80,14 +80,16 @@ func (d Distinct) DebugString() string {
 // result sets.
 type distinctIter struct {
 	childIter sql.RowIter
+	schema    sql.Schema
 	seen      sql.KeyValueCache
 	dispose   sql.DisposeFunc
 }
 
-func newDistinctIter(ctx *sql.Context, child sql.RowIter) *distinctIter {
+func newDistinctIter(ctx *sql.Context, child sql.RowIter, schema sql.Schema) *distinctIter {
 	cache, dispose := ctx.Memory.NewHistoryCache()
 	return &distinctIter{
 		childIter: child,
+		schema:    schema,
 		seen:      cache,
 		dispose:   dispose,
 	}
@@ -103,7 +105,7 @@ func (di *distinctIter) Next(ctx *sql.Context) (sql.Row, error) {
 			return nil, err
 		}
 
-		hash, err := sql.HashOf(row)
+		hash, err := sql.HashOf(collationKeys(row, di.schema))
 		if err != nil {
 			return nil, err
 		}
@@ -120,6 +122,32 @@ func (di *distinctIter) Next(ctx *sql.Context) (sql.Row, error) {
 	}
 }
 
+// collationKeys returns the row given with each string replaced by its key under the collation of its column, so that
+// rows equal under the collations of their columns hash the same.
+func collationKeys(row sql.Row, schema sql.Schema) sql.Row {
+	var keys sql.Row
+	for i, v := range row {
+		s, ok := v.(string)
+		if !ok || i >= len(schema) {
+			continue
+		}
+		st, ok := schema[i].Type.(sql.StringType)
+		if !ok {
+			continue
+		}
+		if k := st.Collation().Key(s); k != s {
+			if keys == nil {
+				keys = row.Copy()
+			}
+			keys[i] = k
+		}
+	}
+	if keys == nil {
+		return row
+	}
+	return keys
+}
+
 func (di *distinctIter) Close(ctx *sql.Context) error {
 	di.Dispose()
 	return di.childIter.Close(ctx)
@@ -119,6 +119,68 @@ func TestOrderedDistinct(t *testing.T) {
 	require.Equal([]string{"jane", "john", "martha"}, results)
 }
 
+// countingIter counts the rows read from the iterator it wraps.
+type countingIter struct {
+	sql.RowIter
+	count int
+}
+
+func (i *countingIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, err := i.RowIter.Next(ctx)
+	if err == nil {
+		i.count++
+	}
+	return row, err
+}
+
+func TestOrderedDistinctMatchesDistinct(t *testing.T) {
+	require := require.New(t)
+
+	schema := sql.Schema{
+		{Name: "a", Type: sql.Int64, Nullable: true},
+		{Name: "b", Type: sql.Text, Nullable: true},
+	}
+	rows := []sql.Row{
+		sql.NewRow(nil, nil),
+		sql.NewRow(nil, nil),
+		sql.NewRow(int64(1), "x"),
+		sql.NewRow(int64(1), "x"),
+		sql.NewRow(int64(1), "y"),
+		sql.NewRow(int64(2), "x"),
+		sql.NewRow(int64(2), "x"),
+		sql.NewRow(int64(2), "x"),
+		sql.NewRow(int64(3), nil),
+	}
+
+	ctx := sql.NewEmptyContext()
+	distinct := newDistinctIter(ctx, sql.RowsToRowIter(rows...), schema)
+	// The hash distinct keeps a cache of every row seen
+	require.Equal(1, ctx.Memory.NumCaches())
+	expected, err := sql.RowIterToRows(ctx, distinct)
+	require.NoError(err)
+
+	child := &countingIter{RowIter: sql.RowsToRowIter(rows...)}
+	ordered := newOrderedDistinctIter(child, schema)
+
+	// Rows are emitted as soon as their value changes, without reading ahead
+	row, err := ordered.Next(ctx)
+	require.NoError(err)
+	require.Equal(sql.NewRow(nil, nil), row)
+	require.Equal(1, child.count)
+
+	row, err = ordered.Next(ctx)
+	require.NoError(err)
+	require.Equal(sql.NewRow(int64(1), "x"), row)
+	require.Equal(3, child.count)
+
+	rest, err := sql.RowIterToRows(ctx, ordered)
+	require.NoError(err)
+	require.Equal(len(rows), child.count)
+	require.Equal(0, ctx.Memory.NumCaches())
+
+	require.Equal(expected, append([]sql.Row{sql.NewRow(nil, nil), sql.NewRow(int64(1), "x")}, rest...))
+}
+
 func BenchmarkDistinct(b *testing.B) {
 	require := require.New(b)
 	ctx := sql.NewEmptyContext()
@@ -0,0 +1,193 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// DescribeFormatAnalyze is the format of a DescribeQuery for EXPLAIN ANALYZE, which runs the query and describes its
+// plan as a tree, with the rows that each node returned, the number of times it was run, and the time spent in it.
+const DescribeFormatAnalyze = "analyze"
+
+// ExplainAnalyze runs the query plan given, discarding its rows, and returns its description as a tree, with the
+// estimated and actual rows of each node. Nodes below an Exchange are run concurrently on copies of the plan, so they
+// aren't instrumented; the time and rows of the Exchange include theirs.
+func ExplainAnalyze(ctx *sql.Context, n sql.Node) (string, error) {
+	estimates, err := explainNode(ctx, n)
+	if err != nil {
+		return "", err
+	}
+	n, err = instrumentNode(n, estimates, true)
+	if err != nil {
+		return "", err
+	}
+
+	iter, err := n.RowIter(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	for {
+		_, err = iter.Next(ctx)
+		if err != nil {
+			break
+		}
+	}
+	if err != io.EOF {
+		_ = iter.Close(ctx)
+		return "", err
+	}
+	if err = iter.Close(ctx); err != nil {
+		return "", err
+	}
+
+	return n.String(), nil
+}
+
+// instrumentNode returns the node given, and all of its descendants, wrapped in analyzedNodes that record their
+// executions, with the estimates given. If instrument is false, the node itself isn't wrapped, because its parent
+// depends on its type when run.
+func instrumentNode(n sql.Node, estimates *ExplainNode, instrument bool) (sql.Node, error) {
+	// The decorations of a node are described with the node they decorate
+	if d, ok := n.(*DecoratedNode); ok {
+		child, err := instrumentNode(d.Child, estimates, instrument)
+		if err != nil {
+			return nil, err
+		}
+		return d.WithChildren(child)
+	}
+
+	children := n.Children()
+	instrumentChildren := true
+	switch n.(type) {
+	case *Exchange:
+		// Its child is copied and run once per partition
+		children = nil
+	case *HashLookup:
+		// It depends on the type of its child
+		instrumentChildren = false
+	}
+
+	if len(children) > 0 && len(children) == len(estimates.Children) {
+		newChildren := make([]sql.Node, len(children))
+		for i, child := range children {
+			c, err := instrumentNode(child, estimates.Children[i], instrumentChildren)
+			if err != nil {
+				return nil, err
+			}
+			newChildren[i] = c
+		}
+		var err error
+		n, err = n.WithChildren(newChildren...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !instrument {
+		return n, nil
+	}
+	return &analyzedNode{Node: n, stats: &nodeStats{}, estimatedRows: estimates.EstimatedRows}, nil
+}
+
+// nodeStats are the statistics of the executions of a node of a plan run by EXPLAIN ANALYZE.
+type nodeStats struct {
+	// loops is the number of times the node was run, rows the number of rows it returned in all of them, and elapsed
+	// the time spent in its iterators, including the time spent in its children.
+	loops   uint64
+	rows    uint64
+	elapsed time.Duration
+}
+
+// analyzedNode is a node of a plan run by EXPLAIN ANALYZE, which records the executions of the node it wraps.
+type analyzedNode struct {
+	sql.Node
+	stats         *nodeStats
+	estimatedRows *uint64
+}
+
+var _ sql.Node = (*analyzedNode)(nil)
+
+// RowIter implements the sql.Node interface.
+func (n *analyzedNode) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	start := time.Now()
+	n.stats.loops++
+	iter, err := n.Node.RowIter(ctx, row)
+	n.stats.elapsed += time.Since(start)
+	if err != nil {
+		return nil, err
+	}
+	return &analyzedIter{iter: iter, stats: n.stats}, nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (n *analyzedNode) WithChildren(children ...sql.Node) (sql.Node, error) {
+	node, err := n.Node.WithChildren(children...)
+	if err != nil {
+		return nil, err
+	}
+	return &analyzedNode{Node: node, stats: n.stats, estimatedRows: n.estimatedRows}, nil
+}
+
+// String implements the sql.Node interface. The first line of the description of the node is annotated with its
+// estimated and actual rows.
+func (n *analyzedNode) String() string {
+	var annotation string
+	if n.estimatedRows != nil {
+		annotation = fmt.Sprintf(" (estimated rows=%d)", *n.estimatedRows)
+	}
+	if n.stats.loops == 0 {
+		annotation += " (never executed)"
+	} else {
+		annotation += fmt.Sprintf(" (actual rows=%d loops=%d time=%s)", n.stats.rows, n.stats.loops, n.stats.elapsed)
+	}
+
+	s := n.Node.String()
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		return s[:i] + annotation + s[i:]
+	}
+	return s + annotation
+}
+
+// analyzedIter is the iterator of an analyzedNode, which counts the rows returned by the iterator it wraps, and the
+// time spent in it.
+type analyzedIter struct {
+	iter  sql.RowIter
+	stats *nodeStats
+}
+
+var _ sql.RowIter = (*analyzedIter)(nil)
+
+func (i *analyzedIter) Next(ctx *sql.Context) (sql.Row, error) {
+	start := time.Now()
+	row, err := i.iter.Next(ctx)
+	i.stats.elapsed += time.Since(start)
+	if err == nil {
+		i.stats.rows++
+	}
+	return row, err
+}
+
+func (i *analyzedIter) Close(ctx *sql.Context) error {
+	start := time.Now()
+	err := i.iter.Close(ctx)
+	i.stats.elapsed += time.Since(start)
+	return err
+}
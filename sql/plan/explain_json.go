@@ -0,0 +1,207 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+const (
+	// AccessTypeFullScan is the access type of a table whose rows are all read.
+	AccessTypeFullScan = "full_scan"
+	// AccessTypeIndexLookup is the access type of a table whose rows are read through one of its indexes.
+	AccessTypeIndexLookup = "index_lookup"
+)
+
+// ExplainNode is the description of a node of a query plan in the JSON format of EXPLAIN.
+type ExplainNode struct {
+	// Type is the name of the type of the node, such as Filter or InnerJoin.
+	Type string `json:"type"`
+	// Table is the name of the table, or of the alias, that the node reads.
+	Table string `json:"table,omitempty"`
+	// AccessType is how the rows of a table are read, AccessTypeFullScan or AccessTypeIndexLookup.
+	AccessType string `json:"access_type,omitempty"`
+	// Index is the columns of the index used to read the rows of a table.
+	Index []string `json:"index,omitempty"`
+	// Condition is the condition of a Filter node.
+	Condition string `json:"condition,omitempty"`
+	// JoinType and JoinCondition describe a join node.
+	JoinType      string `json:"join_type,omitempty"`
+	JoinCondition string `json:"join_condition,omitempty"`
+	// EstimatedRows is an upper bound of the number of rows that the node returns, and EstimatedCost an estimate of the
+	// number of rows it reads to do so. They're only given when the tables involved can tell their number of rows.
+	EstimatedRows *uint64 `json:"estimated_rows,omitempty"`
+	EstimatedCost *uint64 `json:"estimated_cost,omitempty"`
+	// Decorations are the decorations that the analyzer added to the node, such as the columns projected by a table.
+	Decorations []string `json:"decorations,omitempty"`
+	// Children are the descriptions of the children of the node.
+	Children []*ExplainNode `json:"children,omitempty"`
+}
+
+// ExplainJSON returns the description of the query plan given, indented as MySQL does for EXPLAIN FORMAT=JSON.
+func ExplainJSON(ctx *sql.Context, n sql.Node) (string, error) {
+	node, err := explainNode(ctx, n)
+	if err != nil {
+		return "", err
+	}
+	b, err := json.MarshalIndent(node, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func explainNode(ctx *sql.Context, n sql.Node) (*ExplainNode, error) {
+	if d, ok := n.(*DecoratedNode); ok {
+		e, err := explainNode(ctx, d.Child)
+		if err != nil {
+			return nil, err
+		}
+		e.Decorations = append([]string{d.decoration}, e.Decorations...)
+		return e, nil
+	}
+
+	e := &ExplainNode{Type: nodeTypeName(n)}
+	for _, child := range n.Children() {
+		c, err := explainNode(ctx, child)
+		if err != nil {
+			return nil, err
+		}
+		e.Children = append(e.Children, c)
+	}
+
+	switch n := n.(type) {
+	case *IndexedTableAccess:
+		e.Table = n.Name()
+		e.AccessType = AccessTypeIndexLookup
+		e.Index = n.Index().Expressions()
+	case *ResolvedTable:
+		e.Table = n.Name()
+		e.AccessType = AccessTypeFullScan
+		if st, ok := statisticsTable(n.Table); ok {
+			rows, err := st.NumRows(ctx)
+			if err != nil {
+				return nil, err
+			}
+			e.EstimatedRows, e.EstimatedCost = &rows, &rows
+		}
+	case *TableAlias:
+		e.Table = n.Name()
+		e.estimateFromChild()
+	case *Filter:
+		e.Condition = n.Expression.String()
+		e.estimateFromChild()
+	case JoinNode:
+		e.JoinType = n.JoinType().String()
+		if n.JoinCond() != nil {
+			e.JoinCondition = n.JoinCond().String()
+		}
+		e.estimateJoin()
+	case *IndexedJoin:
+		e.JoinType = n.JoinType().String()
+		e.JoinCondition = n.Cond.String()
+		e.estimateJoin()
+	case *CrossJoin:
+		e.JoinType = "CrossJoin"
+		e.estimateJoin()
+	case *Limit:
+		e.estimateFromChild()
+		e.estimateLimit(n.Limit)
+	case *TopN:
+		e.estimateFromChild()
+		e.estimateLimit(n.Limit)
+	default:
+		if len(e.Children) == 1 {
+			e.estimateFromChild()
+		}
+	}
+	return e, nil
+}
+
+// statisticsTable returns the table given, or the table it wraps, if it can tell its number of rows.
+func statisticsTable(t sql.Table) (sql.StatisticsTable, bool) {
+	switch t := t.(type) {
+	case sql.StatisticsTable:
+		return t, true
+	case sql.TableWrapper:
+		return statisticsTable(t.Underlying())
+	default:
+		return nil, false
+	}
+}
+
+// nodeTypeName returns the name of the type of the node given, without its package.
+func nodeTypeName(n sql.Node) string {
+	t := reflect.TypeOf(n)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Name()
+}
+
+// estimateFromChild takes the estimates of the only child of the node, which returns at most as many rows.
+func (e *ExplainNode) estimateFromChild() {
+	if len(e.Children) == 1 {
+		e.EstimatedRows, e.EstimatedCost = e.Children[0].EstimatedRows, e.Children[0].EstimatedCost
+	}
+}
+
+// estimateJoin estimates the rows of a join as those of the cross product of its children, and its cost as reading
+// the left child once and the right child once per row of the left child.
+func (e *ExplainNode) estimateJoin() {
+	if len(e.Children) != 2 {
+		return
+	}
+	l, r := e.Children[0], e.Children[1]
+	if l.EstimatedRows == nil || r.EstimatedRows == nil {
+		return
+	}
+	rows := saturatingMul(*l.EstimatedRows, *r.EstimatedRows)
+	cost := saturatingAdd(*l.EstimatedCost, saturatingMul(*l.EstimatedRows, *r.EstimatedCost))
+	e.EstimatedRows, e.EstimatedCost = &rows, &cost
+}
+
+// estimateLimit caps the estimated rows of the node at its limit, when it's a literal.
+func (e *ExplainNode) estimateLimit(limit sql.Expression) {
+	lit, ok := limit.(*expression.Literal)
+	if !ok {
+		return
+	}
+	l, err := getInt64Value(nil, lit)
+	if err != nil || l < 0 {
+		return
+	}
+	if rows := uint64(l); e.EstimatedRows == nil || rows < *e.EstimatedRows {
+		e.EstimatedRows = &rows
+	}
+}
+
+func saturatingMul(a, b uint64) uint64 {
+	if a != 0 && b > ^uint64(0)/a {
+		return ^uint64(0)
+	}
+	return a * b
+}
+
+func saturatingAdd(a, b uint64) uint64 {
+	if a+b < a {
+		return ^uint64(0)
+	}
+	return a + b
+}
@@ -0,0 +1,800 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// foreignKeyMaxDepth is the maximum number of foreign keys a cascade may go through, like in MySQL. It stops cycles of
+// foreign keys from cascading forever.
+const foreignKeyMaxDepth = 15
+
+// ForeignKeyHandler holds the foreign keys enforced on the rows written to a table: the foreign keys of the table on the
+// tables it references, and the foreign keys of the tables referencing it. It is loaded by the analyzer for INSERT,
+// UPDATE and DELETE statements.
+type ForeignKeyHandler struct {
+	Database sql.Database
+	Table    sql.Table
+	// Parents are the foreign keys of Table.
+	Parents []*ForeignKeyReference
+	// Children are the foreign keys referencing Table, including the ones of Table itself.
+	Children []*ForeignKeyReference
+}
+
+// ForeignKeyReference is a foreign key between the child table it is declared on and the parent table it references.
+// Parent is nil if the referenced table doesn't exist, which is only possible if it was dropped while foreign key checks
+// were disabled.
+type ForeignKeyReference struct {
+	sql.ForeignKeyConstraint
+	Child      sql.Table
+	Parent     sql.Table
+	childCols  []int
+	parentCols []int
+}
+
+// LoadForeignKeyHandler returns the foreign keys of the table with the name given, and of the tables of the database
+// referencing it. It returns nil if there are none, or if the table isn't one of the database.
+func LoadForeignKeyHandler(ctx *sql.Context, db sql.Database, name string) (*ForeignKeyHandler, error) {
+	table, ok, err := db.GetTableInsensitive(ctx, name)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	handler := &ForeignKeyHandler{Database: db, Table: table}
+	if fkTable, ok := table.(sql.ForeignKeyTable); ok {
+		fks, err := fkTable.GetForeignKeys(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, fk := range fks {
+			parent := table
+			if !strings.EqualFold(fk.ReferencedTable, table.Name()) {
+				parent, ok, err = db.GetTableInsensitive(ctx, fk.ReferencedTable)
+				if err != nil {
+					return nil, err
+				}
+				if !ok {
+					parent = nil
+				}
+			}
+
+			ref, err := newForeignKeyReference(fk, table, parent)
+			if err != nil {
+				return nil, err
+			}
+			handler.Parents = append(handler.Parents, ref)
+		}
+	}
+
+	names, err := db.GetTableNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, childName := range names {
+		child := table
+		if !strings.EqualFold(childName, table.Name()) {
+			child, ok, err = db.GetTableInsensitive(ctx, childName)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+		}
+
+		fkTable, ok := child.(sql.ForeignKeyTable)
+		if !ok {
+			continue
+		}
+		fks, err := fkTable.GetForeignKeys(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, fk := range fks {
+			if !strings.EqualFold(fk.ReferencedTable, table.Name()) {
+				continue
+			}
+			ref, err := newForeignKeyReference(fk, child, table)
+			if err != nil {
+				return nil, err
+			}
+			handler.Children = append(handler.Children, ref)
+		}
+	}
+
+	if len(handler.Parents) == 0 && len(handler.Children) == 0 {
+		return nil, nil
+	}
+	return handler, nil
+}
+
+func newForeignKeyReference(fk sql.ForeignKeyConstraint, child, parent sql.Table) (*ForeignKeyReference, error) {
+	ref := &ForeignKeyReference{ForeignKeyConstraint: fk, Child: child, Parent: parent}
+
+	var err error
+	ref.childCols, err = foreignKeyColumns(child, fk.Columns)
+	if err != nil {
+		return nil, err
+	}
+	if parent != nil {
+		ref.parentCols, err = foreignKeyColumns(parent, fk.ReferencedColumns)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ref, nil
+}
+
+// foreignKeyColumns returns the indexes of the columns given in the schema of the table given.
+func foreignKeyColumns(table sql.Table, columns []string) ([]int, error) {
+	indexes := make([]int, len(columns))
+	for i, name := range columns {
+		indexes[i] = -1
+		for j, col := range table.Schema() {
+			if strings.EqualFold(col.Name, name) {
+				indexes[i] = j
+				break
+			}
+		}
+		if indexes[i] == -1 {
+			return nil, sql.ErrTableColumnNotFound.New(table.Name(), name)
+		}
+	}
+	return indexes, nil
+}
+
+// key returns the values of the columns given of the row given.
+func (r *ForeignKeyReference) key(row sql.Row, cols []int) []interface{} {
+	key := make([]interface{}, len(cols))
+	for i, col := range cols {
+		key[i] = row[col]
+	}
+	return key
+}
+
+// keyChanged returns whether the values of the columns given differ between the rows given.
+func keyChanged(schema sql.Schema, cols []int, old, new sql.Row) (bool, error) {
+	for _, col := range cols {
+		if old[col] == nil || new[col] == nil {
+			if old[col] != nil || new[col] != nil {
+				return true, nil
+			}
+			continue
+		}
+		cmp, err := schema[col].Type.Compare(old[col], new[col])
+		if err != nil {
+			return false, err
+		}
+		if cmp != 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// keyHasNull returns whether any value of the key given is NULL, in which case it doesn't reference any row.
+func keyHasNull(key []interface{}) bool {
+	for _, v := range key {
+		if v == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// foreignKeyChecksEnabled returns whether foreign keys are enforced in the session.
+func foreignKeyChecksEnabled(ctx *sql.Context) bool {
+	val, err := ctx.GetSessionVariable(ctx, "foreign_key_checks")
+	return err != nil || val != int8(0)
+}
+
+// foreignKeyWrites are the rows a statement wrote to a table. Table editors may only apply their writes once they are
+// closed, so reading a table during a statement has to take them into account.
+type foreignKeyWrites struct {
+	inserted []sql.Row
+	deleted  map[uint64]int
+	// cascaded counts the rows deleted by cascades, which the statement skips if it deletes them itself afterwards
+	cascaded map[uint64]int
+}
+
+func (w *foreignKeyWrites) insert(row sql.Row) {
+	w.inserted = append(w.inserted, row)
+}
+
+func (w *foreignKeyWrites) delete(row sql.Row) error {
+	hash, err := sql.HashOf(row)
+	if err != nil {
+		return err
+	}
+	for i, inserted := range w.inserted {
+		if h, err := sql.HashOf(inserted); err == nil && h == hash {
+			w.inserted = append(w.inserted[:i], w.inserted[i+1:]...)
+			return nil
+		}
+	}
+	w.deleted[hash]++
+	return nil
+}
+
+func (w *foreignKeyWrites) cascade(row sql.Row) error {
+	hash, err := sql.HashOf(row)
+	if err != nil {
+		return err
+	}
+	w.cascaded[hash]++
+	return nil
+}
+
+// takeCascaded returns whether a copy of the row given was deleted by a cascade, in which case the statement deleting it
+// again is a no-op and is counted against that copy.
+func (w *foreignKeyWrites) takeCascaded(row sql.Row) (bool, error) {
+	hash, err := sql.HashOf(row)
+	if err != nil {
+		return false, err
+	}
+	if w.cascaded[hash] == 0 {
+		return false, nil
+	}
+	w.cascaded[hash]--
+	return true, nil
+}
+
+// foreignKeyStatement enforces foreign keys on the rows written by a statement, and cascades the writes to the tables
+// referencing them, through a single editor per table.
+type foreignKeyStatement struct {
+	db       sql.Database
+	handlers map[string]*ForeignKeyHandler
+	writes   map[string]*foreignKeyWrites
+	// editors are the editors cascades write to a table with
+	editors map[string]sql.TableEditor
+	// opened are the editors opened for cascades, which the statement has to complete and close
+	opened []sql.TableEditor
+	// finished is set once the statement is completed or discarded, which each editor wrapped in it asks for
+	finished bool
+	closed   bool
+}
+
+func newForeignKeyStatement(handler *ForeignKeyHandler) *foreignKeyStatement {
+	s := &foreignKeyStatement{
+		db:       handler.Database,
+		handlers: make(map[string]*ForeignKeyHandler),
+		writes:   make(map[string]*foreignKeyWrites),
+		editors:  make(map[string]sql.TableEditor),
+	}
+	s.handlers[strings.ToLower(handler.Table.Name())] = handler
+	return s
+}
+
+// wrap returns an editor enforcing the foreign keys of the handler on the writes of the editor given, which is also used
+// by the cascades writing to its table if it can update and delete rows. The editors of all the tables a statement
+// writes to are wrapped in the same foreignKeyStatement.
+func (s *foreignKeyStatement) wrap(handler *ForeignKeyHandler, editor sql.TableEditor) *foreignKeyEditor {
+	name := strings.ToLower(handler.Table.Name())
+	if _, ok := s.handlers[name]; !ok {
+		s.handlers[name] = handler
+	}
+	if _, ok := s.editors[name]; !ok {
+		_, canUpdate := editor.(sql.RowUpdater)
+		_, canDelete := editor.(sql.RowDeleter)
+		if canUpdate && canDelete {
+			s.editors[name] = editor
+		}
+	}
+	return &foreignKeyEditor{editor: editor, handler: handler, statement: s}
+}
+
+func (s *foreignKeyStatement) writesTo(table sql.Table) *foreignKeyWrites {
+	name := strings.ToLower(table.Name())
+	w, ok := s.writes[name]
+	if !ok {
+		w = &foreignKeyWrites{deleted: make(map[uint64]int), cascaded: make(map[uint64]int)}
+		s.writes[name] = w
+	}
+	return w
+}
+
+func (s *foreignKeyStatement) handler(ctx *sql.Context, table sql.Table) (*ForeignKeyHandler, error) {
+	name := strings.ToLower(table.Name())
+	if h, ok := s.handlers[name]; ok {
+		return h, nil
+	}
+
+	h, err := LoadForeignKeyHandler(ctx, s.db, table.Name())
+	if err != nil {
+		return nil, err
+	}
+	if h == nil {
+		h = &ForeignKeyHandler{Database: s.db, Table: table}
+	}
+	s.handlers[name] = h
+	return h, nil
+}
+
+// cascadeEditor returns the editor cascades write to the table given with, opening one if needed.
+func (s *foreignKeyStatement) cascadeEditor(ctx *sql.Context, table sql.Table) (sql.TableEditor, error) {
+	name := strings.ToLower(table.Name())
+	if editor, ok := s.editors[name]; ok {
+		return editor, nil
+	}
+
+	updatable, ok := table.(sql.UpdatableTable)
+	if !ok {
+		return nil, ErrUpdateNotSupported.New()
+	}
+	editor := updatable.Updater(ctx)
+	if _, ok := editor.(sql.RowDeleter); !ok {
+		return nil, ErrDeleteFromNotSupported.New()
+	}
+
+	editor.StatementBegin(ctx)
+	s.editors[name] = editor
+	s.opened = append(s.opened, editor)
+	return editor, nil
+}
+
+// rows returns the rows of the table given, including the writes of the statement, whose columns given have the
+// values of the key given.
+func (s *foreignKeyStatement) rows(ctx *sql.Context, table sql.Table, cols []int, key []interface{}) ([]sql.Row, error) {
+	deleted := make(map[uint64]int)
+	var inserted []sql.Row
+	if w, ok := s.writes[strings.ToLower(table.Name())]; ok {
+		for hash, count := range w.deleted {
+			deleted[hash] = count
+		}
+		inserted = w.inserted
+	}
+
+	lookupTable, err := keyLookupTable(ctx, table, cols, key)
+	if err != nil {
+		return nil, err
+	}
+	partitions, err := lookupTable.Partitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	iter := sql.NewTableRowIter(ctx, lookupTable, partitions)
+	defer iter.Close(ctx)
+
+	var rows []sql.Row
+	for {
+		row, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if len(deleted) > 0 {
+			hash, err := sql.HashOf(row)
+			if err != nil {
+				return nil, err
+			}
+			if deleted[hash] > 0 {
+				deleted[hash]--
+				continue
+			}
+		}
+
+		ok, err := keyMatches(table.Schema(), cols, row, key)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			rows = append(rows, row)
+		}
+	}
+
+	for _, row := range inserted {
+		ok, err := keyMatches(table.Schema(), cols, row, key)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+// keyLookupTable returns the table given restricted to the rows whose columns given have the values of the key given,
+// using an index whose first expressions are those columns. It returns the table itself if it has no such index, in
+// which case all of its rows are read.
+func keyLookupTable(ctx *sql.Context, table sql.Table, cols []int, key []interface{}) (sql.Table, error) {
+	indexedTable, ok := table.(sql.IndexedTable)
+	if !ok {
+		return table, nil
+	}
+	indexes, err := indexedTable.GetIndexes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := table.Schema()
+IndexLoop:
+	for _, idx := range indexes {
+		exprs := idx.ColumnExpressions()
+		if len(exprs) < len(cols) {
+			continue
+		}
+
+		rang := make(sql.Range, len(exprs))
+		for i, expr := range exprs {
+			if i >= len(cols) {
+				rang[i] = sql.AllRangeColumnExpr(expr.Type())
+				continue
+			}
+			gf, ok := expr.(*expression.GetField)
+			if !ok {
+				continue IndexLoop
+			}
+			keyIndex := -1
+			for j, col := range cols {
+				if strings.EqualFold(schema[col].Name, gf.Name()) {
+					keyIndex = j
+					break
+				}
+			}
+			if keyIndex == -1 {
+				continue IndexLoop
+			}
+			rang[i] = sql.ClosedRangeColumnExpr(key[keyIndex], key[keyIndex], gf.Type())
+		}
+
+		lookup, err := idx.NewLookup(ctx, rang)
+		if err != nil {
+			return nil, err
+		}
+		if lookup != nil {
+			return indexedTable.WithIndexLookup(lookup), nil
+		}
+	}
+	return table, nil
+}
+
+func keyMatches(schema sql.Schema, cols []int, row sql.Row, key []interface{}) (bool, error) {
+	for i, col := range cols {
+		if row[col] == nil {
+			return false, nil
+		}
+		cmp, err := schema[col].Type.Compare(row[col], key[i])
+		if err != nil {
+			return false, err
+		}
+		if cmp != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// insert inserts a row into the table of the handler with the insert function given, if the rows it references exist.
+// The row is checked before it's inserted, so that INSERT IGNORE can skip it, but may reference itself.
+func (s *foreignKeyStatement) insert(ctx *sql.Context, h *ForeignKeyHandler, row sql.Row, insert func() error) error {
+	w := s.writesTo(h.Table)
+	w.insert(row)
+
+	err := s.checkParents(ctx, h, nil, row)
+	if err == nil {
+		err = insert()
+	}
+	if err != nil {
+		if deleteErr := w.delete(row); deleteErr != nil {
+			return deleteErr
+		}
+		return err
+	}
+	return nil
+}
+
+// update updates a row of the table of the handler with the update function given, if the rows it references exist,
+// and applies the ON UPDATE actions of the foreign keys referencing it.
+func (s *foreignKeyStatement) update(ctx *sql.Context, h *ForeignKeyHandler, old, new sql.Row, depth int, update func() error) error {
+	w := s.writesTo(h.Table)
+	if err := w.delete(old); err != nil {
+		return err
+	}
+	w.insert(new)
+
+	err := s.checkParents(ctx, h, old, new)
+	if err == nil {
+		err = update()
+	}
+	if err != nil {
+		if deleteErr := w.delete(new); deleteErr != nil {
+			return deleteErr
+		}
+		w.insert(old)
+		return err
+	}
+
+	for _, ref := range h.Children {
+		changed, err := keyChanged(h.Table.Schema(), ref.parentCols, old, new)
+		if err != nil {
+			return err
+		}
+		if !changed {
+			continue
+		}
+		err = s.onParentWrite(ctx, ref, ref.key(old, ref.parentCols), ref.key(new, ref.parentCols), ref.OnUpdate, depth)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// delete deletes a row of the table of the handler with the delete function given, and applies the ON DELETE actions
+// of the foreign keys referencing it.
+func (s *foreignKeyStatement) delete(ctx *sql.Context, h *ForeignKeyHandler, row sql.Row, depth int, delete func() error) error {
+	if err := delete(); err != nil {
+		return err
+	}
+	w := s.writesTo(h.Table)
+	if err := w.delete(row); err != nil {
+		return err
+	}
+	if depth > 0 {
+		if err := w.cascade(row); err != nil {
+			return err
+		}
+	}
+
+	for _, ref := range h.Children {
+		if err := s.onParentWrite(ctx, ref, ref.key(row, ref.parentCols), nil, ref.OnDelete, depth); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkParents returns an error if the row given, written to the table of the handler, references a row that doesn't
+// exist. If the old row is given, only the keys that changed from it are checked.
+func (s *foreignKeyStatement) checkParents(ctx *sql.Context, h *ForeignKeyHandler, old, row sql.Row) error {
+	for _, ref := range h.Parents {
+		key := ref.key(row, ref.childCols)
+		if keyHasNull(key) {
+			continue
+		}
+		if old != nil {
+			changed, err := keyChanged(h.Table.Schema(), ref.childCols, old, row)
+			if err != nil {
+				return err
+			}
+			if !changed {
+				continue
+			}
+		}
+
+		if ref.Parent != nil {
+			parents, err := s.rows(ctx, ref.Parent, ref.parentCols, key)
+			if err != nil {
+				return err
+			}
+			if len(parents) > 0 {
+				continue
+			}
+		}
+		return sql.ErrForeignKeyChildViolation.New(ref.Name, ref.Child.Name(), ref.ReferencedTable, formatForeignKey(key))
+	}
+	return nil
+}
+
+// onParentWrite applies the action given of a foreign key to the rows of its child table referencing a key of its
+// parent table that was deleted, or updated to the new key if it's given.
+func (s *foreignKeyStatement) onParentWrite(
+	ctx *sql.Context,
+	ref *ForeignKeyReference,
+	key, newKey []interface{},
+	action sql.ForeignKeyReferenceOption,
+	depth int,
+) error {
+	if keyHasNull(key) {
+		return nil
+	}
+
+	// The rows referencing the key are still valid if another row of the parent table has it
+	parents, err := s.rows(ctx, ref.Parent, ref.parentCols, key)
+	if err != nil {
+		return err
+	}
+	if len(parents) > 0 {
+		return nil
+	}
+
+	children, err := s.rows(ctx, ref.Child, ref.childCols, key)
+	if err != nil {
+		return err
+	}
+	if len(children) == 0 {
+		return nil
+	}
+
+	switch action {
+	case sql.ForeignKeyReferenceOption_Cascade, sql.ForeignKeyReferenceOption_SetNull:
+	default:
+		return sql.ErrForeignKeyParentViolation.New(ref.Name, ref.Child.Name(), ref.Parent.Name(), formatForeignKey(key))
+	}
+
+	if depth >= foreignKeyMaxDepth {
+		return sql.ErrForeignKeyCascadeDepth.New(foreignKeyMaxDepth)
+	}
+
+	h, err := s.handler(ctx, ref.Child)
+	if err != nil {
+		return err
+	}
+	editor, err := s.cascadeEditor(ctx, ref.Child)
+	if err != nil {
+		return err
+	}
+
+	for _, child := range children {
+		child := child
+		if action == sql.ForeignKeyReferenceOption_Cascade && newKey == nil {
+			err = s.delete(ctx, h, child, depth+1, func() error {
+				return editor.(sql.RowDeleter).Delete(ctx, child)
+			})
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		newChild := child.Copy()
+		for i, col := range ref.childCols {
+			if action == sql.ForeignKeyReferenceOption_SetNull {
+				newChild[col] = nil
+				continue
+			}
+			newChild[col], err = ref.Child.Schema()[col].Type.Convert(newKey[i])
+			if err != nil {
+				return err
+			}
+		}
+		err = s.update(ctx, h, child, newChild, depth+1, func() error {
+			return editor.(sql.RowUpdater).Update(ctx, child, newChild)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func formatForeignKey(key []interface{}) string {
+	return fmt.Sprint(key)
+}
+
+func (s *foreignKeyStatement) complete(ctx *sql.Context) error {
+	if s.finished {
+		return nil
+	}
+	s.finished = true
+
+	for _, editor := range s.opened {
+		if err := editor.StatementComplete(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *foreignKeyStatement) discard(ctx *sql.Context, errorEncountered error) error {
+	if s.finished {
+		return nil
+	}
+	s.finished = true
+
+	// Editors of the same table restore the state they began with, so the first one opened must be discarded last
+	for i := len(s.opened) - 1; i >= 0; i-- {
+		if err := s.opened[i].DiscardChanges(ctx, errorEncountered); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *foreignKeyStatement) close(ctx *sql.Context) error {
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+
+	var err error
+	for _, editor := range s.opened {
+		if closeErr := editor.(sql.Closer).Close(ctx); err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+// foreignKeyEditor wraps the editor of a table written to by an INSERT, UPDATE or DELETE statement, enforcing the
+// foreign keys of its handler on the rows it writes.
+type foreignKeyEditor struct {
+	editor    sql.TableEditor
+	handler   *ForeignKeyHandler
+	statement *foreignKeyStatement
+}
+
+var _ sql.RowInserter = (*foreignKeyEditor)(nil)
+var _ sql.RowReplacer = (*foreignKeyEditor)(nil)
+var _ sql.RowUpdater = (*foreignKeyEditor)(nil)
+var _ sql.RowDeleter = (*foreignKeyEditor)(nil)
+
+// StatementBegin implements the sql.TableEditor interface.
+func (e *foreignKeyEditor) StatementBegin(ctx *sql.Context) {
+	e.editor.StatementBegin(ctx)
+}
+
+// DiscardChanges implements the sql.TableEditor interface.
+func (e *foreignKeyEditor) DiscardChanges(ctx *sql.Context, errorEncountered error) error {
+	err := e.editor.DiscardChanges(ctx, errorEncountered)
+	if discardErr := e.statement.discard(ctx, errorEncountered); err == nil {
+		err = discardErr
+	}
+	return err
+}
+
+// StatementComplete implements the sql.TableEditor interface.
+func (e *foreignKeyEditor) StatementComplete(ctx *sql.Context) error {
+	if err := e.editor.StatementComplete(ctx); err != nil {
+		return err
+	}
+	return e.statement.complete(ctx)
+}
+
+// Insert implements the sql.RowInserter interface.
+func (e *foreignKeyEditor) Insert(ctx *sql.Context, row sql.Row) error {
+	return e.statement.insert(ctx, e.handler, row, func() error {
+		return e.editor.(sql.RowInserter).Insert(ctx, row)
+	})
+}
+
+// Update implements the sql.RowUpdater interface.
+func (e *foreignKeyEditor) Update(ctx *sql.Context, old sql.Row, new sql.Row) error {
+	return e.statement.update(ctx, e.handler, old, new, 0, func() error {
+		return e.editor.(sql.RowUpdater).Update(ctx, old, new)
+	})
+}
+
+// Delete implements the sql.RowDeleter interface.
+func (e *foreignKeyEditor) Delete(ctx *sql.Context, row sql.Row) error {
+	// The row may have been deleted already by a cascade of the statement
+	cascaded, err := e.statement.writesTo(e.handler.Table).takeCascaded(row)
+	if err != nil || cascaded {
+		return err
+	}
+
+	return e.statement.delete(ctx, e.handler, row, 0, func() error {
+		return e.editor.(sql.RowDeleter).Delete(ctx, row)
+	})
+}
+
+// Close implements the sql.Closer interface.
+func (e *foreignKeyEditor) Close(ctx *sql.Context) error {
+	err := e.editor.(sql.Closer).Close(ctx)
+	if closeErr := e.statement.close(ctx); err == nil {
+		err = closeErr
+	}
+	return err
+}
@@ -57,8 +57,13 @@ func (g *GroupBy) Resolved() bool {
 
 // Schema implements the Node interface.
 func (g *GroupBy) Schema() sql.Schema {
-	var s = make(sql.Schema, len(g.SelectedExprs))
-	for i, e := range g.SelectedExprs {
+	return groupBySchema(g.SelectedExprs)
+}
+
+// groupBySchema returns the schema of a node that groups rows and selects the expressions given for each group.
+func groupBySchema(selectedExprs []sql.Expression) sql.Schema {
+	var s = make(sql.Schema, len(selectedExprs))
+	for i, e := range selectedExprs {
 		var name string
 		if n, ok := e.(sql.Nameable); ok {
 			name = n.Name()
@@ -95,8 +100,21 @@ func (g *GroupBy) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 		return nil, err
 	}
 
-	aggs := make([]*aggregation.Aggregation, len(g.SelectedExprs))
-	for i, e := range g.SelectedExprs {
+	aggs, err := groupByAggregations(g.SelectedExprs)
+	if err != nil {
+		span.Finish()
+		return nil, err
+	}
+	iter := aggregation.NewWindowBlockIter(g.GroupByExprs, nil, aggs, i)
+
+	return sql.NewSpanIter(span, iter), nil
+}
+
+// groupByAggregations returns the aggregations computing the selected expressions given for each group. Expressions
+// that aren't aggregate functions take their value from the last row of the group.
+func groupByAggregations(selectedExprs []sql.Expression) ([]*aggregation.Aggregation, error) {
+	aggs := make([]*aggregation.Aggregation, len(selectedExprs))
+	for i, e := range selectedExprs {
 		switch a := e.(type) {
 		case sql.WindowAdaptableExpression:
 			fn, err := a.NewWindowFunction()
@@ -112,9 +130,7 @@ func (g *GroupBy) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 			aggs[i] = aggregation.NewAggregation(fn, aggregation.NewGroupByFramer())
 		}
 	}
-	iter := aggregation.NewWindowBlockIter(g.GroupByExprs, nil, aggs, i)
-
-	return sql.NewSpanIter(span, iter), nil
+	return aggs, nil
 }
 
 // WithChildren implements the Node interface.
@@ -143,45 +159,40 @@ func (g *GroupBy) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
 }
 
 func (g *GroupBy) String() string {
-	pr := sql.NewTreePrinter()
-	_ = pr.WriteNode("GroupBy")
+	return groupByString("GroupBy", g.SelectedExprs, g.GroupByExprs, g.Child, false)
+}
 
-	var selectedExprs = make([]string, len(g.SelectedExprs))
-	for i, e := range g.SelectedExprs {
-		selectedExprs[i] = e.String()
-	}
+func (g *GroupBy) DebugString() string {
+	return groupByString("GroupBy", g.SelectedExprs, g.GroupByExprs, g.Child, true)
+}
 
-	var grouping = make([]string, len(g.GroupByExprs))
-	for i, g := range g.GroupByExprs {
-		grouping[i] = g.String()
+// groupByString returns the tree representation of a node with the name, selected expressions, grouping expressions
+// and child given, using the debug strings of the expressions and child if |debug| is true.
+func groupByString(name string, selectedExprs, groupByExprs []sql.Expression, child sql.Node, debug bool) string {
+	str := func(v interface{}) string {
+		if debug {
+			return sql.DebugString(v)
+		}
+		return fmt.Sprint(v)
 	}
 
-	_ = pr.WriteChildren(
-		fmt.Sprintf("SelectedExprs(%s)", strings.Join(selectedExprs, ", ")),
-		fmt.Sprintf("Grouping(%s)", strings.Join(grouping, ", ")),
-		g.Child.String(),
-	)
-	return pr.String()
-}
-
-func (g *GroupBy) DebugString() string {
 	pr := sql.NewTreePrinter()
-	_ = pr.WriteNode("GroupBy")
+	_ = pr.WriteNode(name)
 
-	var selectedExprs = make([]string, len(g.SelectedExprs))
-	for i, e := range g.SelectedExprs {
-		selectedExprs[i] = sql.DebugString(e)
+	var selected = make([]string, len(selectedExprs))
+	for i, e := range selectedExprs {
+		selected[i] = str(e)
 	}
 
-	var grouping = make([]string, len(g.GroupByExprs))
-	for i, g := range g.GroupByExprs {
-		grouping[i] = sql.DebugString(g)
+	var grouping = make([]string, len(groupByExprs))
+	for i, g := range groupByExprs {
+		grouping[i] = str(g)
 	}
 
 	_ = pr.WriteChildren(
-		fmt.Sprintf("SelectedExprs(%s)", strings.Join(selectedExprs, ", ")),
+		fmt.Sprintf("SelectedExprs(%s)", strings.Join(selected, ", ")),
 		fmt.Sprintf("Grouping(%s)", strings.Join(grouping, ", ")),
-		sql.DebugString(g.Child),
+		str(child),
 	)
 	return pr.String()
 }
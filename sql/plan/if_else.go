@@ -18,6 +18,7 @@ import (
 	"fmt"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 )
 
 // IfConditional represents IF statements only.
@@ -118,6 +119,23 @@ func NewIfElse(ifConditionals []*IfConditional, elseStatement sql.Node) *IfElseB
 	}
 }
 
+// NewCaseStatement creates a new *IfElseBlock node for a CASE statement. When caseExpr is given, each conditional is
+// taken by comparing its condition with caseExpr, otherwise its condition is evaluated on its own. When elseStatement
+// is nil and no conditional is taken, the statement returns an error.
+func NewCaseStatement(caseExpr sql.Expression, ifConditionals []*IfConditional, elseStatement sql.Node) *IfElseBlock {
+	if caseExpr != nil {
+		newConditionals := make([]*IfConditional, len(ifConditionals))
+		for i, ifConditional := range ifConditionals {
+			newConditionals[i] = NewIfConditional(expression.NewEquals(caseExpr, ifConditional.Condition), ifConditional.Body)
+		}
+		ifConditionals = newConditionals
+	}
+	if elseStatement == nil {
+		elseStatement = caseNotFound{}
+	}
+	return NewIfElse(ifConditionals, elseStatement)
+}
+
 // Resolved implements the sql.Node interface.
 func (ieb *IfElseBlock) Resolved() bool {
 	for _, s := range ieb.IfConditionals {
@@ -277,3 +295,38 @@ func (i *ifElseIter) RepresentingNode() sql.Node {
 func (i *ifElseIter) Schema() sql.Schema {
 	return i.sch
 }
+
+// caseNotFound is the ELSE branch of a CASE statement without one, as reaching it is an error.
+type caseNotFound struct{}
+
+var _ sql.Node = caseNotFound{}
+
+// Resolved implements the sql.Node interface.
+func (caseNotFound) Resolved() bool {
+	return true
+}
+
+// String implements the sql.Node interface.
+func (caseNotFound) String() string {
+	return "CASE NOT FOUND"
+}
+
+// Schema implements the sql.Node interface.
+func (caseNotFound) Schema() sql.Schema {
+	return nil
+}
+
+// Children implements the sql.Node interface.
+func (caseNotFound) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (c caseNotFound) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(c, children...)
+}
+
+// RowIter implements the sql.Node interface.
+func (caseNotFound) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	return nil, sql.ErrCaseNotFound.New()
+}
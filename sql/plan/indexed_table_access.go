@@ -133,6 +133,11 @@ func (i *IndexedTableAccess) getLookup(ctx *sql.Context, row sql.Row) (sql.Index
 	return lookup, nil
 }
 
+// Index returns the index used by this node.
+func (i *IndexedTableAccess) Index() sql.Index {
+	return i.index
+}
+
 func (i *IndexedTableAccess) String() string {
 	return fmt.Sprintf("IndexedTableAccess(%s on %s)", i.Name(), formatIndexDecoratorString(i.index))
 }
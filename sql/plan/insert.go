@@ -70,6 +70,7 @@ type InsertInto struct {
 	IsReplace   bool
 	OnDupExprs  []sql.Expression
 	Checks      sql.CheckConstraints
+	ForeignKeys *ForeignKeyHandler
 	Ignore      bool
 }
 
@@ -206,6 +207,10 @@ type insertIter struct {
 	tableNode           sql.Node
 	closed              bool
 	ignore              bool
+	// uniqueIndexes are the unique secondary indexes of the table, used to find the rows a REPLACE deletes or an
+	// ON DUPLICATE KEY UPDATE applies to
+	uniqueIndexes []sql.Index
+	indexedTable  sql.IndexedTable
 }
 
 func GetInsertable(node sql.Node) (sql.InsertableTable, error) {
@@ -245,6 +250,7 @@ func newInsertIter(
 	isReplace bool,
 	onDupUpdateExpr []sql.Expression,
 	checks sql.CheckConstraints,
+	fks *ForeignKeyHandler,
 	row sql.Row,
 	ignore bool,
 ) (sql.RowIter, error) {
@@ -260,6 +266,8 @@ func newInsertIter(
 
 	var replacer sql.RowReplacer
 	var updater sql.RowUpdater
+	var uniqueIndexes []sql.Index
+	var indexedTable sql.IndexedTable
 	// These type casts have already been asserted in the analyzer
 	if isReplace {
 		replacer = insertable.(sql.ReplaceableTable).Replacer(ctx)
@@ -269,6 +277,23 @@ func newInsertIter(
 			updater = insertable.(sql.UpdatableTable).Updater(ctx)
 		}
 	}
+	if fks != nil && foreignKeyChecksEnabled(ctx) {
+		statement := newForeignKeyStatement(fks)
+		if replacer != nil {
+			replacer = statement.wrap(fks, replacer)
+		} else {
+			inserter = statement.wrap(fks, inserter)
+			if updater != nil {
+				updater = statement.wrap(fks, updater)
+			}
+		}
+	}
+	if isReplace || len(onDupUpdateExpr) > 0 {
+		indexedTable, uniqueIndexes, err = getUniqueIndexes(ctx, insertable)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	rowIter, err := values.RowIter(ctx, row)
 	if err != nil {
@@ -288,6 +313,9 @@ func newInsertIter(
 		checks:      checks,
 		ctx:         ctx,
 		ignore:      ignore,
+
+		uniqueIndexes: uniqueIndexes,
+		indexedTable:  indexedTable,
 	}
 
 	if replacer != nil {
@@ -368,10 +396,22 @@ func (i *insertIter) Next(ctx *sql.Context) (returnRow sql.Row, returnErr error)
 	}
 
 	if i.replacer != nil {
-		toReturn := make(sql.Row, len(row)*2)
-		for i := 0; i < len(row); i++ {
-			toReturn[i+len(row)] = row[i]
+		// Rows with the same values for a unique index are deleted first, since not every table reports those
+		// conflicts on insert
+		deleted, err := i.findUniqueKeyConflicts(ctx, row)
+		if err != nil {
+			i.rowSource.Close(ctx)
+			i.rowSource = nil
+			return nil, sql.NewWrappedInsertError(row, err)
 		}
+		for _, r := range deleted {
+			if err := i.replacer.Delete(ctx, r); err != nil {
+				i.rowSource.Close(ctx)
+				i.rowSource = nil
+				return nil, sql.NewWrappedInsertError(row, err)
+			}
+		}
+
 		// May have multiple duplicate pk & unique errors due to multiple indexes
 		//TODO: how does this interact with triggers?
 		for {
@@ -388,16 +428,23 @@ func (i *insertIter) Next(ctx *sql.Context) (returnRow sql.Row, returnErr error)
 					i.rowSource = nil
 					return nil, sql.NewWrappedInsertError(row, err)
 				}
-				// the row had to be deleted, write the values into the toReturn row
-				for i := 0; i < len(ue.Existing); i++ {
-					toReturn[i] = ue.Existing[i]
-				}
+				deleted = append(deleted, ue.Existing)
 			} else {
 				break
 			}
 		}
-		return toReturn, nil
+		return replaceResultRow(deleted, row), nil
 	} else {
+		if len(i.updateExprs) > 0 {
+			conflicts, err := i.findUniqueKeyConflicts(ctx, row)
+			if err != nil {
+				return nil, err
+			}
+			if len(conflicts) > 0 {
+				return i.handleOnDuplicateKeyUpdate(ctx, row, conflicts[0])
+			}
+		}
+
 		if err := i.inserter.Insert(ctx, row); err != nil {
 			if (!sql.ErrPrimaryKeyViolation.Is(err) && !sql.ErrUniqueKeyViolation.Is(err) && !sql.ErrDuplicateEntry.Is(err)) || len(i.updateExprs) == 0 {
 				return i.ignoreOrClose(ctx, row, err)
@@ -441,6 +488,22 @@ func (i *insertIter) handleOnDuplicateKeyUpdate(ctx *sql.Context, row, rowToUpda
 		newRow = val.(sql.Row)
 	}
 
+	// The updated row must satisfy the check constraints just like an inserted one
+	for _, check := range i.checks {
+		if !check.Enforced {
+			continue
+		}
+
+		res, err := sql.EvaluateCondition(ctx, check.Expr, newRow)
+		if err != nil {
+			return nil, err
+		}
+
+		if sql.IsFalse(res) {
+			return nil, sql.NewWrappedInsertError(row, sql.ErrCheckConstraintViolated.New(check.Name))
+		}
+	}
+
 	err = i.updater.Update(ctx, rowToUpdate, newRow)
 	if err != nil {
 		return nil, err
@@ -450,6 +513,99 @@ func (i *insertIter) handleOnDuplicateKeyUpdate(ctx *sql.Context, row, rowToUpda
 	return rowToUpdate.Append(newRow), nil
 }
 
+// replaceResultRow returns the row returned by a REPLACE of the row given: the rows it deleted, or a row of NULLs if
+// there were none, followed by the row inserted.
+func replaceResultRow(deleted []sql.Row, row sql.Row) sql.Row {
+	if len(deleted) == 0 {
+		return append(make(sql.Row, len(row)), row...)
+	}
+
+	var result sql.Row
+	for _, r := range deleted {
+		result = append(result, r...)
+	}
+	return append(result, row...)
+}
+
+// getUniqueIndexes returns the unique indexes of the table given other than its primary key, whose collisions are
+// reported by the table's inserter.
+func getUniqueIndexes(ctx *sql.Context, table sql.Table) (sql.IndexedTable, []sql.Index, error) {
+	indexedTable, ok := table.(sql.IndexedTable)
+	if !ok {
+		return nil, nil, nil
+	}
+
+	indexes, err := indexedTable.GetIndexes(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var unique []sql.Index
+	for _, idx := range indexes {
+		if idx.IsUnique() && idx.ID() != "PRIMARY" {
+			unique = append(unique, idx)
+		}
+	}
+	return indexedTable, unique, nil
+}
+
+// findUniqueKeyConflicts returns the existing rows with the same values as the row given for the columns of a unique
+// index, one for each index at most. Rows with a NULL value in the index never conflict.
+func (i *insertIter) findUniqueKeyConflicts(ctx *sql.Context, row sql.Row) ([]sql.Row, error) {
+	var conflicts []sql.Row
+IndexLoop:
+	for _, idx := range i.uniqueIndexes {
+		exprs := idx.ColumnExpressions()
+		rang := make(sql.Range, len(exprs))
+		for j, expr := range exprs {
+			gf, ok := expr.(*expression.GetField)
+			if !ok || gf.Index() >= len(row) || row[gf.Index()] == nil {
+				continue IndexLoop
+			}
+			val := row[gf.Index()]
+			rang[j] = sql.ClosedRangeColumnExpr(val, val, gf.Type())
+		}
+
+		lookup, err := idx.NewLookup(ctx, rang)
+		if err != nil {
+			return nil, err
+		}
+		if lookup == nil {
+			continue
+		}
+
+		table := i.indexedTable.WithIndexLookup(lookup)
+		partitions, err := table.Partitions(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		iter := sql.NewTableRowIter(ctx, table, partitions)
+		existing, err := iter.Next(ctx)
+		if cerr := iter.Close(ctx); err == nil {
+			err = cerr
+		}
+		if err == io.EOF {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// Different indexes can conflict with the same row
+		for _, c := range conflicts {
+			if eq, err := c.Equals(existing, i.schema); err != nil {
+				return nil, err
+			} else if eq {
+				continue IndexLoop
+			}
+		}
+		conflicts = append(conflicts, existing)
+	}
+
+	return conflicts, nil
+}
+
 func getFieldIndexFromUpdateExpr(updateExpr sql.Expression) (int, bool) {
 	setField, ok := updateExpr.(*expression.SetField)
 	if !ok {
@@ -641,7 +797,7 @@ func toInt64(x interface{}) int64 {
 
 // RowIter implements the Node interface.
 func (ii *InsertInto) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
-	return newInsertIter(ctx, ii.Destination, ii.Source, ii.IsReplace, ii.OnDupExprs, ii.Checks, row, ii.Ignore)
+	return newInsertIter(ctx, ii.Destination, ii.Source, ii.IsReplace, ii.OnDupExprs, ii.Checks, ii.ForeignKeys, row, ii.Ignore)
 }
 
 // WithChildren implements the Node interface.
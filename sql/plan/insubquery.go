@@ -68,7 +68,7 @@ func (in *InSubquery) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 			return nil, sql.ErrInvalidOperandColumns.New(sql.NumColumns(typ), sql.NumColumns(right.Type()))
 		}
 
-		typ := right.Type()
+		rightTyp := right.Type()
 
 		values, err := right.HashMultiple(ctx, row)
 		if err != nil {
@@ -83,36 +83,55 @@ func (in *InSubquery) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 			return nil, nil
 		}
 
-		key, err := sql.HashOf(sql.NewRow(left))
+		found, err := hashedResultsContain(values, typ, rightTyp, left)
 		if err != nil {
 			return nil, err
 		}
 
-		val, notFoundErr := values.Get(key)
-		if notFoundErr != nil {
+		if !found {
 			if _, nilValNotFoundErr := values.Get(nilKey); nilValNotFoundErr == nil {
 				return nil, nil
 			}
 			return false, nil
 		}
 
-		val, err = typ.Convert(val)
-		if err != nil {
-			return nil, err
-		}
-
-		cmp, err := typ.Compare(left, val)
-		if err != nil {
-			return nil, err
-		}
-
-		return cmp == 0, nil
+		return true, nil
 
 	default:
 		return nil, expression.ErrUnsupportedInOperand.New(right)
 	}
 }
 
+// hashedResultsContain returns whether |values|, the hashed results of a subquery of type |rightTyp|, contain |left|,
+// a value of type |leftTyp|. The subquery results are hashed as values of the subquery's type, so |left| is converted
+// to that type to find a match. A match is then compared in the type of |left|, which rejects values that only matched
+// because the conversion lost information.
+func hashedResultsContain(values sql.KeyValueCache, leftTyp, rightTyp sql.Type, left interface{}) (bool, error) {
+	converted, err := rightTyp.Convert(left)
+	if err != nil || converted == nil {
+		return false, nil
+	}
+	key, err := sql.HashOf(sql.NewRow(converted))
+	if err != nil {
+		return false, err
+	}
+
+	val, err := values.Get(key)
+	if err != nil {
+		return false, nil
+	}
+	val, err = leftTyp.Convert(val)
+	if err != nil {
+		return false, nil
+	}
+
+	cmp, err := leftTyp.Compare(left, val)
+	if err != nil {
+		return false, err
+	}
+	return cmp == 0, nil
+}
+
 // WithChildren implements the Expression interface.
 func (in *InSubquery) WithChildren(children ...sql.Expression) (sql.Expression, error) {
 	if len(children) != 2 {
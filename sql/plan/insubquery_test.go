@@ -93,6 +93,26 @@ func TestInSubquery(t *testing.T) {
 			false,
 			nil,
 		},
+		{
+			"left is in right of a different type",
+			expression.NewLiteral(int64(2), sql.Int64),
+			project(
+				expression.NewLiteral(uint64(2), sql.Uint64),
+			),
+			nil,
+			true,
+			nil,
+		},
+		{
+			"left only matches right after a lossy conversion",
+			expression.NewLiteral(2.5, sql.Float64),
+			project(
+				expression.NewLiteral(int64(2), sql.Int64),
+			),
+			nil,
+			false,
+			nil,
+		},
 	}
 
 	for _, tt := range testCases {
@@ -722,7 +722,7 @@ func nodeHasJoin(node sql.Node) bool {
 	hasJoinNode := false
 	Inspect(node, func(node sql.Node) bool {
 		switch node.(type) {
-		case JoinNode, *CrossJoin, *IndexedJoin:
+		case JoinNode, *CrossJoin, *IndexedJoin, *MergeJoin:
 			hasJoinNode = true
 			return false
 		default:
@@ -237,6 +237,66 @@ func BenchmarkInnerJoin(b *testing.B) {
 	})
 }
 
+func BenchmarkHashLookupJoin(b *testing.B) {
+	const numRows = 1000
+	t1 := memory.NewTable("foo", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Source: "foo", Type: sql.Int64},
+		{Name: "b", Source: "foo", Type: sql.Text},
+	}))
+
+	t2 := memory.NewTable("bar", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Source: "bar", Type: sql.Int64},
+		{Name: "b", Source: "bar", Type: sql.Text},
+	}))
+
+	for i := 0; i < numRows; i++ {
+		t1.Insert(sql.NewEmptyContext(), sql.NewRow(int64(i), fmt.Sprintf("t1_%d", i)))
+		t2.Insert(sql.NewEmptyContext(), sql.NewRow(int64(numRows-i-1), fmt.Sprintf("t2_%d", i)))
+	}
+
+	cond := expression.NewEquals(
+		expression.NewGetField(0, sql.Int64, "a", false),
+		expression.NewGetField(2, sql.Int64, "a", false),
+	)
+
+	ctx := sql.NewEmptyContext()
+	b.Run("nested loop join", func(b *testing.B) {
+		require := require.New(b)
+
+		for i := 0; i < b.N; i++ {
+			n := NewInnerJoin(
+				NewResolvedTable(t1, nil, nil),
+				NewResolvedTable(t2, nil, nil),
+				cond,
+			).WithMultipassMode()
+
+			rows, err := sql.NodeToRows(ctx, n)
+			require.NoError(err)
+			require.Len(rows, numRows)
+		}
+	})
+
+	b.Run("hash lookup join", func(b *testing.B) {
+		require := require.New(b)
+
+		for i := 0; i < b.N; i++ {
+			n := NewInnerJoin(
+				NewResolvedTable(t1, nil, nil),
+				NewHashLookup(
+					NewCachedResults(NewResolvedTable(t2, nil, nil)),
+					expression.NewTuple(expression.NewGetField(0, sql.Int64, "a", false)),
+					expression.NewTuple(expression.NewGetField(0, sql.Int64, "a", false)),
+				),
+				cond,
+			).WithMultipassMode()
+
+			rows, err := sql.NodeToRows(ctx, n)
+			require.NoError(err)
+			require.Len(rows, numRows)
+		}
+	})
+}
+
 func TestLeftJoin(t *testing.T) {
 	require := require.New(t)
 
@@ -66,8 +66,24 @@ func (l *LoadData) String() string {
 	return pr.String()
 }
 
+// Schema implements the Node interface. The fields of each line are loaded into the columns named in the statement,
+// in that order, or into every column of the destination table if none are named.
 func (l *LoadData) Schema() sql.Schema {
-	return l.Destination.Schema()
+	dstSchema := l.Destination.Schema()
+	if len(l.ColumnNames) == 0 {
+		return dstSchema
+	}
+
+	schema := make(sql.Schema, 0, len(l.ColumnNames))
+	for _, name := range l.ColumnNames {
+		for _, col := range dstSchema {
+			if strings.EqualFold(col.Name, name) {
+				schema = append(schema, col)
+				break
+			}
+		}
+	}
+	return schema
 }
 
 func (l *LoadData) Children() []sql.Node {
@@ -82,7 +98,7 @@ func (l *LoadData) splitLines(data []byte, atEOF bool) (advance int, token []byt
 
 	// Find the index of the LINES TERMINATED BY delim.
 	if i := strings.Index(string(data), l.linesTerminatedByDelim); i >= 0 {
-		return i + 1, data[0:i], nil
+		return i + len(l.linesTerminatedByDelim), data[0:i], nil
 	}
 
 	// If at end of file with data return the data.
@@ -197,7 +213,7 @@ func (l *LoadData) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	}
 
 	return &loadDataIter{
-		destination:             l.Destination,
+		schema:                  l.Schema(),
 		reader:                  reader,
 		scanner:                 scanner,
 		fieldsTerminatedByDelim: l.fieldsTerminatedByDelim,
@@ -211,7 +227,7 @@ func (l *LoadData) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 
 type loadDataIter struct {
 	scanner                 *bufio.Scanner
-	destination             sql.Node
+	schema                  sql.Schema
 	reader                  io.ReadCloser
 	fieldsTerminatedByDelim string
 	fieldsEnclosedByDelim   string
@@ -255,8 +271,7 @@ func (l loadDataIter) Next(ctx *sql.Context) (returnRow sql.Row, returnErr error
 		}
 	}
 
-	// TODO: Match schema with column order
-	// return the exprs as a row
+	// return the exprs as a row, in the order of the schema
 	vals := make([]interface{}, len(exprs))
 	for i, expr := range exprs {
 		if expr != nil {
@@ -300,14 +315,11 @@ func (l loadDataIter) parseFields(line string) ([]sql.Expression, error) {
 	// Step 2: Split the lines into fields given the delim
 	fields := strings.Split(line, l.fieldsTerminatedByDelim)
 
-	// Step 3: Go through each field and see if it was enclosed by something
-	// TODO: Support the OPTIONALLY parameter.
+	// Step 3: Go through each field and see if it was enclosed by something. Enclosing is optional when reading.
 	if l.fieldsEnclosedByDelim != "" {
 		for i, field := range fields {
-			if string(field[0]) == l.fieldsEnclosedByDelim && string(field[len(field)-1]) == l.fieldsEnclosedByDelim {
+			if len(field) >= 2 && strings.HasPrefix(field, l.fieldsEnclosedByDelim) && strings.HasSuffix(field, l.fieldsEnclosedByDelim) {
 				fields[i] = field[1 : len(field)-1]
-			} else {
-				return nil, fmt.Errorf("error: field not properly enclosed")
 			}
 		}
 	}
@@ -327,7 +339,7 @@ func (l loadDataIter) parseFields(line string) ([]sql.Expression, error) {
 		}
 	}
 
-	exprs := make([]sql.Expression, len(l.destination.Schema()))
+	exprs := make([]sql.Expression, len(l.schema))
 
 	limit := len(exprs)
 	if len(fields) < limit {
@@ -336,7 +348,7 @@ func (l loadDataIter) parseFields(line string) ([]sql.Expression, error) {
 
 	for i := 0; i < limit; i++ {
 		field := fields[i]
-		dSchema := l.destination.Schema()[i]
+		dSchema := l.schema[i]
 		// Replace the empty string with defaults
 		if field == "" {
 			_, ok := dSchema.Type.(sql.StringType)
@@ -0,0 +1,258 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// Loop represents the LOOP, WHILE and REPEAT statements, which run their statements for as long as their condition
+// holds, or until a LEAVE statement with their label is reached.
+type Loop struct {
+	Label     string
+	Condition sql.Expression
+	// OnceBeforeEval is set when the statements run once before the condition is evaluated, as in REPEAT.
+	OnceBeforeEval bool
+	*Block
+}
+
+var _ sql.Node = (*Loop)(nil)
+var _ sql.DebugStringer = (*Loop)(nil)
+var _ sql.Expressioner = (*Loop)(nil)
+
+// NewLoop creates a new *Loop node for a LOOP statement, which only ends on a LEAVE statement with its label.
+func NewLoop(label string, block *Block) *Loop {
+	return &Loop{
+		Label:     strings.ToLower(label),
+		Condition: expression.NewLiteral(true, sql.Boolean),
+		Block:     block,
+	}
+}
+
+// NewWhile creates a new *Loop node for a WHILE statement, which runs its statements while its condition holds.
+func NewWhile(label string, condition sql.Expression, block *Block) *Loop {
+	return &Loop{
+		Label:     strings.ToLower(label),
+		Condition: condition,
+		Block:     block,
+	}
+}
+
+// NewRepeat creates a new *Loop node for a REPEAT statement, which runs its statements until its condition holds,
+// evaluating it after each run.
+func NewRepeat(label string, condition sql.Expression, block *Block) *Loop {
+	return &Loop{
+		Label:          strings.ToLower(label),
+		Condition:      expression.NewNot(condition),
+		OnceBeforeEval: true,
+		Block:          block,
+	}
+}
+
+// String implements the sql.Node interface.
+func (l *Loop) String() string {
+	p := sql.NewTreePrinter()
+	_ = p.WriteNode("LOOP %s(%s)", l.Label, l.Condition.String())
+	var children []string
+	for _, s := range l.statements {
+		children = append(children, s.String())
+	}
+	_ = p.WriteChildren(children...)
+	return p.String()
+}
+
+// DebugString implements the sql.DebugStringer interface.
+func (l *Loop) DebugString() string {
+	p := sql.NewTreePrinter()
+	_ = p.WriteNode("LOOP %s(%s)", l.Label, sql.DebugString(l.Condition))
+	var children []string
+	for _, s := range l.statements {
+		children = append(children, sql.DebugString(s))
+	}
+	_ = p.WriteChildren(children...)
+	return p.String()
+}
+
+// Resolved implements the sql.Node interface.
+func (l *Loop) Resolved() bool {
+	return l.Condition.Resolved() && l.Block.Resolved()
+}
+
+// WithChildren implements the sql.Node interface.
+func (l *Loop) WithChildren(children ...sql.Node) (sql.Node, error) {
+	nl := *l
+	nl.Block = NewBlock(children)
+	return &nl, nil
+}
+
+// Expressions implements the sql.Expressioner interface.
+func (l *Loop) Expressions() []sql.Expression {
+	return []sql.Expression{l.Condition}
+}
+
+// WithExpressions implements the sql.Expressioner interface.
+func (l *Loop) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(l, len(exprs), 1)
+	}
+
+	nl := *l
+	nl.Condition = exprs[0]
+	return &nl, nil
+}
+
+// RowIter implements the sql.Node interface. The statements run before the rows are returned, and the rows returned
+// are the ones of the last run of the statements.
+func (l *Loop) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	var iter sql.RowIter = &blockIter{internalIter: sql.RowsToRowIter()}
+	for first := true; ; first = false {
+		if !first || !l.OnceBeforeEval {
+			condition, err := l.Condition.Eval(ctx, row)
+			if err != nil {
+				return nil, err
+			}
+			var passedCondition bool
+			if condition != nil {
+				passedCondition, err = sql.ConvertToBool(condition)
+				if err != nil {
+					return nil, err
+				}
+			}
+			if !passedCondition {
+				break
+			}
+		}
+
+		blockIter, err := l.Block.RowIter(ctx, row)
+		if loopErr, ok := err.(loopError); ok && loopErr.Label == l.Label {
+			if loopErr.IsExit {
+				break
+			}
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		if err = iter.Close(ctx); err != nil {
+			return nil, err
+		}
+		iter = blockIter
+	}
+	return iter, nil
+}
+
+// loopError is returned by LEAVE and ITERATE statements, and unwinds the execution of the statements up to the loop
+// with the same label.
+type loopError struct {
+	Label  string
+	IsExit bool
+}
+
+// Error implements the error interface. It is only seen when no enclosing loop has the label.
+func (e loopError) Error() string {
+	statement := "ITERATE"
+	if e.IsExit {
+		statement = "LEAVE"
+	}
+	return sql.ErrLoopLabelNotFound.New(statement, e.Label).Error()
+}
+
+// Leave represents the LEAVE statement, which ends the execution of the loop with its label.
+type Leave struct {
+	Label string
+}
+
+var _ sql.Node = (*Leave)(nil)
+
+// NewLeave creates a new *Leave node.
+func NewLeave(label string) *Leave {
+	return &Leave{Label: strings.ToLower(label)}
+}
+
+// Resolved implements the sql.Node interface.
+func (l *Leave) Resolved() bool {
+	return true
+}
+
+// String implements the sql.Node interface.
+func (l *Leave) String() string {
+	return fmt.Sprintf("LEAVE %s", l.Label)
+}
+
+// Schema implements the sql.Node interface.
+func (l *Leave) Schema() sql.Schema {
+	return nil
+}
+
+// Children implements the sql.Node interface.
+func (l *Leave) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (l *Leave) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(l, children...)
+}
+
+// RowIter implements the sql.Node interface.
+func (l *Leave) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	return nil, loopError{Label: l.Label, IsExit: true}
+}
+
+// Iterate represents the ITERATE statement, which starts the next run of the loop with its label.
+type Iterate struct {
+	Label string
+}
+
+var _ sql.Node = (*Iterate)(nil)
+
+// NewIterate creates a new *Iterate node.
+func NewIterate(label string) *Iterate {
+	return &Iterate{Label: strings.ToLower(label)}
+}
+
+// Resolved implements the sql.Node interface.
+func (i *Iterate) Resolved() bool {
+	return true
+}
+
+// String implements the sql.Node interface.
+func (i *Iterate) String() string {
+	return fmt.Sprintf("ITERATE %s", i.Label)
+}
+
+// Schema implements the sql.Node interface.
+func (i *Iterate) Schema() sql.Schema {
+	return nil
+}
+
+// Children implements the sql.Node interface.
+func (i *Iterate) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (i *Iterate) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(i, children...)
+}
+
+// RowIter implements the sql.Node interface.
+func (i *Iterate) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	return nil, loopError{Label: i.Label, IsExit: false}
+}
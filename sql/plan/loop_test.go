@@ -0,0 +1,144 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestLoop(t *testing.T) {
+	i := expression.NewUserVar("i")
+	lit := func(v int64) sql.Expression {
+		return expression.NewLiteral(v, sql.Int64)
+	}
+	set := func(v *expression.UserVar, e sql.Expression) sql.Node {
+		return NewSet([]sql.Expression{expression.NewSetField(v, e)})
+	}
+	increment := set(i, expression.NewPlus(i, lit(1)))
+
+	tests := []struct {
+		name     string
+		node     sql.Node
+		expected int64
+		err      bool
+	}{
+		{
+			name:     "while",
+			node:     NewWhile("", expression.NewLessThan(i, lit(5)), NewBlock([]sql.Node{increment})),
+			expected: 5,
+		},
+		{
+			name:     "while with false condition",
+			node:     NewWhile("", expression.NewLessThan(i, lit(0)), NewBlock([]sql.Node{increment})),
+			expected: 0,
+		},
+		{
+			name:     "repeat runs once before the condition",
+			node:     NewRepeat("", expression.NewGreaterThan(i, lit(-1)), NewBlock([]sql.Node{increment})),
+			expected: 1,
+		},
+		{
+			name: "loop with leave",
+			node: NewLoop("l1", NewBlock([]sql.Node{
+				increment,
+				NewIfElse([]*IfConditional{
+					NewIfConditional(expression.NewGreaterThanOrEqual(i, lit(3)), NewLeave("L1")),
+				}, NewBlock(nil)),
+			})),
+			expected: 3,
+		},
+		{
+			name: "while with iterate",
+			node: NewWhile("l1", expression.NewLessThan(i, lit(10)), NewBlock([]sql.Node{
+				increment,
+				NewIfElse([]*IfConditional{
+					NewIfConditional(expression.NewLessThan(i, lit(7)), NewIterate("l1")),
+				}, NewBlock(nil)),
+				NewLeave("l1"),
+			})),
+			expected: 7,
+		},
+		{
+			name: "leave an outer loop",
+			node: NewLoop("outer", NewBlock([]sql.Node{
+				NewLoop("inner", NewBlock([]sql.Node{
+					increment,
+					NewLeave("outer"),
+				})),
+			})),
+			expected: 1,
+		},
+		{
+			name: "leave with unknown label",
+			node: NewLoop("l1", NewBlock([]sql.Node{NewLeave("l2")})),
+			err:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession()))
+			_, err := set(i, lit(0)).RowIter(ctx, nil)
+			require.NoError(err)
+
+			_, err = test.node.RowIter(ctx, nil)
+			if test.err {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+
+			_, v, err := ctx.GetUserVariable(ctx, "i")
+			require.NoError(err)
+			require.Equal(test.expected, v)
+		})
+	}
+}
+
+func TestCaseStatement(t *testing.T) {
+	x := expression.NewUserVar("x")
+	lit := func(v int64) sql.Expression {
+		return expression.NewLiteral(v, sql.Int64)
+	}
+	set := func(e sql.Expression) sql.Node {
+		return NewSet([]sql.Expression{expression.NewSetField(x, e)})
+	}
+
+	require := require.New(t)
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession()))
+
+	caseStatement := NewCaseStatement(lit(2), []*IfConditional{
+		NewIfConditional(lit(1), set(lit(10))),
+		NewIfConditional(lit(2), set(lit(20))),
+	}, nil)
+	_, err := caseStatement.RowIter(ctx, nil)
+	require.NoError(err)
+	_, v, err := ctx.GetUserVariable(ctx, "x")
+	require.NoError(err)
+	require.Equal(int64(20), v)
+
+	caseStatement = NewCaseStatement(lit(3), []*IfConditional{
+		NewIfConditional(lit(1), set(lit(10))),
+	}, nil)
+	_, err = caseStatement.RowIter(ctx, nil)
+	require.True(sql.ErrCaseNotFound.Is(err))
+}
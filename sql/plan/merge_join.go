@@ -0,0 +1,331 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// MergeJoin is an inner or left join between two children that both return their rows in ascending order of their
+// join keys. The children are iterated once, in lockstep, and each run of right rows with the same key is buffered so
+// that it can be matched against every left row with that key. The join keys are pairs of expressions that are equal
+// in the join condition, and the join condition is still evaluated on every pair of rows with matching keys.
+type MergeJoin struct {
+	BinaryNode
+	// The join condition.
+	Cond sql.Expression
+	// LeftKeys are evaluated on rows of the left child, and RightKeys on rows of the right child.
+	LeftKeys  []sql.Expression
+	RightKeys []sql.Expression
+	joinType  JoinType
+}
+
+var _ sql.Node = (*MergeJoin)(nil)
+var _ sql.DebugStringer = (*MergeJoin)(nil)
+var _ sql.Expressioner = (*MergeJoin)(nil)
+
+// NewMergeJoin creates a new *MergeJoin node. Only inner and left joins are supported.
+func NewMergeJoin(left, right sql.Node, joinType JoinType, cond sql.Expression, leftKeys, rightKeys []sql.Expression) *MergeJoin {
+	return &MergeJoin{
+		BinaryNode: BinaryNode{left, right},
+		Cond:       cond,
+		LeftKeys:   leftKeys,
+		RightKeys:  rightKeys,
+		joinType:   joinType,
+	}
+}
+
+// JoinType returns the join type for this merge join.
+func (j *MergeJoin) JoinType() JoinType {
+	return j.joinType
+}
+
+// String implements the sql.Node interface.
+func (j *MergeJoin) String() string {
+	pr := sql.NewTreePrinter()
+	joinType := ""
+	if j.joinType == JoinTypeLeft {
+		joinType = "Left"
+	}
+	_ = pr.WriteNode("%sMergeJoin%s", joinType, j.Cond)
+	_ = pr.WriteChildren(j.left.String(), j.right.String())
+	return pr.String()
+}
+
+// DebugString implements the sql.DebugStringer interface.
+func (j *MergeJoin) DebugString() string {
+	pr := sql.NewTreePrinter()
+	joinType := ""
+	if j.joinType == JoinTypeLeft {
+		joinType = "Left"
+	}
+	_ = pr.WriteNode("%sMergeJoin%s", joinType, sql.DebugString(j.Cond))
+	_ = pr.WriteChildren(sql.DebugString(j.left), sql.DebugString(j.right))
+	return pr.String()
+}
+
+// Schema implements the sql.Node interface.
+func (j *MergeJoin) Schema() sql.Schema {
+	if j.joinType == JoinTypeLeft {
+		return append(j.left.Schema(), makeNullable(j.right.Schema())...)
+	}
+	return append(j.left.Schema(), j.right.Schema()...)
+}
+
+// Resolved implements the sql.Node interface.
+func (j *MergeJoin) Resolved() bool {
+	return j.left.Resolved() && j.right.Resolved() && j.Cond.Resolved()
+}
+
+// WithChildren implements the sql.Node interface.
+func (j *MergeJoin) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(j, len(children), 2)
+	}
+	return NewMergeJoin(children[0], children[1], j.joinType, j.Cond, j.LeftKeys, j.RightKeys), nil
+}
+
+// Expressions implements the sql.Expressioner interface.
+func (j *MergeJoin) Expressions() []sql.Expression {
+	exprs := append([]sql.Expression{j.Cond}, j.LeftKeys...)
+	return append(exprs, j.RightKeys...)
+}
+
+// WithExpressions implements the sql.Expressioner interface.
+func (j *MergeJoin) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	expected := 1 + len(j.LeftKeys) + len(j.RightKeys)
+	if len(exprs) != expected {
+		return nil, sql.ErrInvalidChildrenNumber.New(j, len(exprs), expected)
+	}
+	numKeys := len(j.LeftKeys)
+	return NewMergeJoin(j.left, j.right, j.joinType, exprs[0], exprs[1:1+numKeys], exprs[1+numKeys:]), nil
+}
+
+// RowIter implements the sql.Node interface.
+func (j *MergeJoin) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	if j.joinType != JoinTypeInner && j.joinType != JoinTypeLeft {
+		return nil, fmt.Errorf("%T: unsupported join type %s", j, j.joinType)
+	}
+
+	span, ctx := ctx.Span("plan.MergeJoin")
+	l, err := j.left.RowIter(ctx, row)
+	if err != nil {
+		span.Finish()
+		return nil, err
+	}
+	r, err := j.right.RowIter(ctx, row)
+	if err != nil {
+		span.Finish()
+		_ = l.Close(ctx)
+		return nil, err
+	}
+	return sql.NewSpanIter(span, &mergeJoinIter{
+		join:      j,
+		left:      l,
+		right:     r,
+		rightSize: len(j.right.Schema()),
+	}), nil
+}
+
+// mergeJoinIter is the row iterator for *MergeJoin.
+type mergeJoinIter struct {
+	join      *MergeJoin
+	left      sql.RowIter
+	right     sql.RowIter
+	rightSize int
+
+	// The current left row, and whether it matched any right row so far.
+	leftRow    sql.Row
+	leftKey    []interface{}
+	foundMatch bool
+	// The buffered right rows with the same key, and whether they match the current left row.
+	group    []sql.Row
+	groupKey []interface{}
+	matching bool
+	pos      int
+	// The next right row that is not part of the buffered group.
+	rightRow  sql.Row
+	rightKey  []interface{}
+	rightDone bool
+}
+
+// Next implements the sql.RowIter interface.
+func (i *mergeJoinIter) Next(ctx *sql.Context) (sql.Row, error) {
+	for {
+		if i.leftRow == nil {
+			row, err := i.left.Next(ctx)
+			if err != nil {
+				return nil, err
+			}
+			key, err := evalKeys(ctx, i.join.LeftKeys, row)
+			if err != nil {
+				return nil, err
+			}
+			i.leftRow, i.leftKey, i.foundMatch, i.pos = row, key, false, 0
+
+			// Rows with a NULL key never match. Skipping them keeps the other rows in order.
+			i.matching = false
+			if !hasNullKey(key) {
+				if err = i.loadGroup(ctx); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if i.matching && i.pos < len(i.group) {
+			row := append(append(make(sql.Row, 0, len(i.leftRow)+i.rightSize), i.leftRow...), i.group[i.pos]...)
+			i.pos++
+			matches, err := conditionIsTrue(ctx, row, i.join.Cond)
+			if err != nil {
+				return nil, err
+			}
+			if matches {
+				i.foundMatch = true
+				return row, nil
+			}
+			continue
+		}
+
+		leftRow := i.leftRow
+		i.leftRow = nil
+		if !i.foundMatch && i.join.joinType == JoinTypeLeft {
+			return append(append(make(sql.Row, 0, len(leftRow)+i.rightSize), leftRow...), make(sql.Row, i.rightSize)...), nil
+		}
+	}
+}
+
+// loadGroup buffers the right rows with the same key as the current left row. As both children are ordered on their
+// keys, the right rows with smaller keys can be discarded, and the buffered group can be reused for the following left
+// rows with the same key.
+func (i *mergeJoinIter) loadGroup(ctx *sql.Context) error {
+	if i.group != nil {
+		cmp, err := i.compareKeys(i.groupKey, i.leftKey)
+		if err != nil {
+			return err
+		}
+		if cmp == 0 {
+			i.matching = true
+			return nil
+		}
+	}
+
+	i.group, i.groupKey = nil, nil
+	for {
+		if err := i.loadRight(ctx); err != nil {
+			return err
+		}
+		if i.rightDone {
+			return nil
+		}
+
+		cmp, err := i.compareKeys(i.rightKey, i.leftKey)
+		if err != nil {
+			return err
+		}
+		if cmp > 0 {
+			return nil
+		}
+		if cmp == 0 {
+			break
+		}
+		i.rightRow = nil
+	}
+
+	i.group, i.groupKey, i.matching = []sql.Row{i.rightRow}, i.rightKey, true
+	i.rightRow = nil
+	for {
+		if err := i.loadRight(ctx); err != nil {
+			return err
+		}
+		if i.rightDone {
+			return nil
+		}
+
+		cmp, err := i.compareKeys(i.rightKey, i.groupKey)
+		if err != nil {
+			return err
+		}
+		if cmp != 0 {
+			return nil
+		}
+		i.group = append(i.group, i.rightRow)
+		i.rightRow = nil
+	}
+}
+
+// loadRight loads the next right row without a NULL key, unless one is already loaded.
+func (i *mergeJoinIter) loadRight(ctx *sql.Context) error {
+	for i.rightRow == nil && !i.rightDone {
+		row, err := i.right.Next(ctx)
+		if err == io.EOF {
+			i.rightDone = true
+			return nil
+		} else if err != nil {
+			return err
+		}
+		key, err := evalKeys(ctx, i.join.RightKeys, row)
+		if err != nil {
+			return err
+		}
+		if !hasNullKey(key) {
+			i.rightRow, i.rightKey = row, key
+		}
+	}
+	return nil
+}
+
+// compareKeys compares two keys column by column, using the types of the left keys.
+func (i *mergeJoinIter) compareKeys(a, b []interface{}) (int, error) {
+	for idx, key := range i.join.LeftKeys {
+		cmp, err := key.Type().Compare(a[idx], b[idx])
+		if err != nil || cmp != 0 {
+			return cmp, err
+		}
+	}
+	return 0, nil
+}
+
+// Close implements the sql.RowIter interface.
+func (i *mergeJoinIter) Close(ctx *sql.Context) error {
+	err := i.left.Close(ctx)
+	if rErr := i.right.Close(ctx); err == nil {
+		err = rErr
+	}
+	return err
+}
+
+func evalKeys(ctx *sql.Context, keys []sql.Expression, row sql.Row) ([]interface{}, error) {
+	vals := make([]interface{}, len(keys))
+	for i, key := range keys {
+		var err error
+		vals[i], err = key.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return vals, nil
+}
+
+func hasNullKey(key []interface{}) bool {
+	for _, v := range key {
+		if v == nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,122 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestMergeJoin(t *testing.T) {
+	// Both tables store their rows in ascending order of the join columns.
+	left := memory.NewTable("l", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Source: "l", Type: sql.Int64, Nullable: true},
+	}))
+	for _, a := range []interface{}{nil, int64(1), int64(2), int64(2), int64(3), int64(5)} {
+		require.NoError(t, left.Insert(sql.NewEmptyContext(), sql.NewRow(a)))
+	}
+	right := memory.NewTable("r", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "c", Source: "r", Type: sql.Int64, Nullable: true},
+		{Name: "d", Source: "r", Type: sql.Text},
+	}))
+	for _, row := range []sql.Row{{nil, "n"}, {int64(2), "x"}, {int64(2), "y"}, {int64(3), "z"}, {int64(4), "v"}, {int64(5), "w"}} {
+		require.NoError(t, right.Insert(sql.NewEmptyContext(), row))
+	}
+
+	a := expression.NewGetFieldWithTable(0, sql.Int64, "l", "a", true)
+	c := expression.NewGetFieldWithTable(1, sql.Int64, "r", "c", true)
+	d := expression.NewGetFieldWithTable(2, sql.Text, "r", "d", false)
+	eq := expression.NewEquals(a, c)
+	residual := expression.NewAnd(eq, expression.NewNot(expression.NewEquals(d, expression.NewLiteral("y", sql.Text))))
+	leftKeys := []sql.Expression{a}
+	rightKeys := []sql.Expression{expression.NewGetFieldWithTable(0, sql.Int64, "r", "c", true)}
+
+	testCases := []struct {
+		name     string
+		joinType JoinType
+		cond     sql.Expression
+		expected []sql.Row
+	}{
+		{
+			name:     "inner join",
+			joinType: JoinTypeInner,
+			cond:     eq,
+			expected: []sql.Row{
+				{int64(2), int64(2), "x"},
+				{int64(2), int64(2), "y"},
+				{int64(2), int64(2), "x"},
+				{int64(2), int64(2), "y"},
+				{int64(3), int64(3), "z"},
+				{int64(5), int64(5), "w"},
+			},
+		},
+		{
+			name:     "left join",
+			joinType: JoinTypeLeft,
+			cond:     eq,
+			expected: []sql.Row{
+				{nil, nil, nil},
+				{int64(1), nil, nil},
+				{int64(2), int64(2), "x"},
+				{int64(2), int64(2), "y"},
+				{int64(2), int64(2), "x"},
+				{int64(2), int64(2), "y"},
+				{int64(3), int64(3), "z"},
+				{int64(5), int64(5), "w"},
+			},
+		},
+		{
+			name:     "inner join with residual condition",
+			joinType: JoinTypeInner,
+			cond:     residual,
+			expected: []sql.Row{
+				{int64(2), int64(2), "x"},
+				{int64(2), int64(2), "x"},
+				{int64(3), int64(3), "z"},
+				{int64(5), int64(5), "w"},
+			},
+		},
+		{
+			name:     "left join with residual condition",
+			joinType: JoinTypeLeft,
+			cond:     residual,
+			expected: []sql.Row{
+				{nil, nil, nil},
+				{int64(1), nil, nil},
+				{int64(2), int64(2), "x"},
+				{int64(2), int64(2), "x"},
+				{int64(3), int64(3), "z"},
+				{int64(5), int64(5), "w"},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			ctx := sql.NewEmptyContext()
+
+			j := NewMergeJoin(NewResolvedTable(left, nil, nil), NewResolvedTable(right, nil, nil), tt.joinType, tt.cond, leftKeys, rightKeys)
+			rows, err := sql.NodeToRows(ctx, j)
+			require.NoError(err)
+			require.Equal(tt.expected, rows)
+		})
+	}
+}
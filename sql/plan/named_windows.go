@@ -0,0 +1,88 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// NamedWindows holds the window definitions of a WINDOW clause, such as `WINDOW w AS (PARTITION BY a)`, for the
+// window functions of its child to refer to by name. It's removed by the analyzer, which replaces those references with
+// the definitions.
+type NamedWindows struct {
+	UnaryNode
+	WindowDefs map[string]*sql.Window
+}
+
+var _ sql.Node = (*NamedWindows)(nil)
+
+// NewNamedWindows returns a NamedWindows node defining the windows given over |child|.
+func NewNamedWindows(windowDefs map[string]*sql.Window, child sql.Node) *NamedWindows {
+	return &NamedWindows{
+		UnaryNode:  UnaryNode{Child: child},
+		WindowDefs: windowDefs,
+	}
+}
+
+// Resolved implements sql.Node
+func (n *NamedWindows) Resolved() bool {
+	return false
+}
+
+// RowIter implements sql.Node
+func (n *NamedWindows) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	return nil, fmt.Errorf("%T has no execution iterator", n)
+}
+
+// WithChildren implements sql.Node
+func (n *NamedWindows) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(n, len(children), 1)
+	}
+	return NewNamedWindows(n.WindowDefs, children[0]), nil
+}
+
+func (n *NamedWindows) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("NamedWindows(%s)", strings.Join(n.definitions((*sql.Window).String), ", "))
+	_ = pr.WriteChildren(n.Child.String())
+	return pr.String()
+}
+
+func (n *NamedWindows) DebugString() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("NamedWindows(%s)", strings.Join(n.definitions((*sql.Window).DebugString), ", "))
+	_ = pr.WriteChildren(sql.DebugString(n.Child))
+	return pr.String()
+}
+
+// definitions returns the window definitions in the order of their names, formatted with the function given.
+func (n *NamedWindows) definitions(format func(*sql.Window) string) []string {
+	names := make([]string, 0, len(n.WindowDefs))
+	for name := range n.WindowDefs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	defs := make([]string, len(names))
+	for i, name := range names {
+		defs[i] = fmt.Sprintf("%s as %s", name, strings.TrimPrefix(format(n.WindowDefs[name]), "over "))
+	}
+	return defs
+}
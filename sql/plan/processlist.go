@@ -29,7 +29,7 @@ type process struct {
 	command string
 	time    int64
 	state   string
-	info    string
+	info    interface{}
 }
 
 func (p process) toRow() sql.Row {
@@ -53,13 +53,11 @@ var processListSchema = sql.Schema{
 	{Name: "Command", Type: sql.LongText},
 	{Name: "Time", Type: sql.Int64},
 	{Name: "State", Type: sql.LongText},
-	{Name: "Info", Type: sql.LongText},
+	{Name: "Info", Type: sql.LongText, Nullable: true},
 }
 
 // ShowProcessList shows a list of all current running processes.
-type ShowProcessList struct {
-	Database string
-}
+type ShowProcessList struct{}
 
 // NewShowProcessList creates a new ProcessList node.
 func NewShowProcessList() *ShowProcessList { return new(ShowProcessList) }
@@ -88,6 +86,18 @@ func (p *ShowProcessList) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, e
 	var rows = make([]sql.Row, len(processes))
 
 	for i, proc := range processes {
+		if proc.Command == sql.ProcessCommandSleep {
+			rows[i] = process{
+				id:      int64(proc.Connection),
+				user:    proc.User,
+				host:    proc.Host,
+				db:      proc.Database,
+				command: string(proc.Command),
+				time:    int64(proc.Seconds()),
+			}.toRow()
+			continue
+		}
+
 		var status []string
 		var names []string
 		for name := range proc.Progress {
@@ -119,10 +129,10 @@ func (p *ShowProcessList) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, e
 			user:    proc.User,
 			time:    int64(proc.Seconds()),
 			state:   strings.Join(status, ""),
-			command: "Query",
-			host:    ctx.Session.Client().Address,
+			command: string(proc.Command),
+			host:    proc.Host,
 			info:    proc.Query,
-			db:      p.Database,
+			db:      proc.Database,
 		}.toRow()
 	}
 
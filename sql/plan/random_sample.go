@@ -0,0 +1,179 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"io"
+	"math/rand"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// RandomSample returns Limit rows picked at random among the rows of its child, in random order. It replaces ORDER BY
+// RAND() LIMIT, reading the child once with reservoir sampling instead of sorting all of its rows. If Seed is set, the
+// random number generator is seeded with its value, like RAND(seed), and the sample is the same for the same rows.
+type RandomSample struct {
+	UnaryNode
+	Limit sql.Expression
+	Seed  sql.Expression
+}
+
+var _ sql.Node = (*RandomSample)(nil)
+
+// NewRandomSample creates a new RandomSample node. The seed may be nil for a sample that differs on every execution.
+func NewRandomSample(limit, seed sql.Expression, child sql.Node) *RandomSample {
+	return &RandomSample{
+		UnaryNode: UnaryNode{child},
+		Limit:     limit,
+		Seed:      seed,
+	}
+}
+
+// Resolved implements the Resolvable interface.
+func (n *RandomSample) Resolved() bool {
+	return n.Child.Resolved() && (n.Seed == nil || n.Seed.Resolved())
+}
+
+// RowIter implements the Node interface.
+func (n *RandomSample) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	span, ctx := ctx.Span("plan.RandomSample")
+
+	limit, err := getInt64Value(ctx, n.Limit)
+	if err != nil {
+		span.Finish()
+		return nil, err
+	}
+
+	seed := time.Now().UnixNano()
+	if n.Seed != nil {
+		seed, err = randomSampleSeed(ctx, n.Seed)
+		if err != nil {
+			span.Finish()
+			return nil, err
+		}
+	}
+
+	i, err := n.Child.RowIter(ctx, row)
+	if err != nil {
+		span.Finish()
+		return nil, err
+	}
+
+	return sql.NewSpanIter(span, &randomSampleIter{
+		childIter: i,
+		limit:     limit,
+		rand:      rand.New(rand.NewSource(seed)),
+	}), nil
+}
+
+// randomSampleSeed returns the seed of the expression given with the semantics of RAND(seed): the value of a numeric
+// expression converted to an integer, and 0 for any other one.
+func randomSampleSeed(ctx *sql.Context, e sql.Expression) (int64, error) {
+	v, err := e.Eval(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	if sql.IsNumber(e.Type()) {
+		v, err = sql.Int64.Convert(v)
+		if err == nil && v != nil {
+			return v.(int64), nil
+		}
+	}
+	return 0, nil
+}
+
+func (n *RandomSample) String() string {
+	pr := sql.NewTreePrinter()
+	if n.Seed != nil {
+		_ = pr.WriteNode("RandomSample(Limit: [%s]; Seed: [%s])", n.Limit, n.Seed)
+	} else {
+		_ = pr.WriteNode("RandomSample(Limit: [%s])", n.Limit)
+	}
+	_ = pr.WriteChildren(n.Child.String())
+	return pr.String()
+}
+
+// WithChildren implements the Node interface.
+func (n *RandomSample) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(n, len(children), 1)
+	}
+
+	return NewRandomSample(n.Limit, n.Seed, children[0]), nil
+}
+
+type randomSampleIter struct {
+	childIter sql.RowIter
+	limit     int64
+	rand      *rand.Rand
+	sample    []sql.Row
+	idx       int
+	sampled   bool
+}
+
+func (i *randomSampleIter) Next(ctx *sql.Context) (sql.Row, error) {
+	if !i.sampled {
+		if err := i.computeSample(ctx); err != nil {
+			return nil, err
+		}
+		i.sampled = true
+	}
+
+	if i.idx >= len(i.sample) {
+		return nil, io.EOF
+	}
+	row := i.sample[i.idx]
+	i.idx++
+	return row, nil
+}
+
+// computeSample keeps the first limit rows of the child, then replaces a random one of them with its n-th row with
+// probability limit/n, so that every row has the same chance to be in the sample.
+func (i *randomSampleIter) computeSample(ctx *sql.Context) error {
+	if i.limit <= 0 {
+		return nil
+	}
+
+	var n int64
+	for {
+		row, err := i.childIter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		n++
+
+		if int64(len(i.sample)) < i.limit {
+			i.sample = append(i.sample, row)
+		} else if j := i.rand.Int63n(n); j < i.limit {
+			i.sample[j] = row
+		}
+	}
+
+	// The first rows keep their order until they're replaced, so shuffle them as sorting on RAND() would
+	i.rand.Shuffle(len(i.sample), func(a, b int) {
+		i.sample[a], i.sample[b] = i.sample[b], i.sample[a]
+	})
+	return nil
+}
+
+func (i *randomSampleIter) Close(ctx *sql.Context) error {
+	i.sample = nil
+	return i.childIter.Close(ctx)
+}
@@ -16,6 +16,7 @@ package plan
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
 )
@@ -40,6 +41,11 @@ func (*ResolvedTable) Resolved() bool {
 }
 
 func (t *ResolvedTable) String() string {
+	if pt, ok := t.Table.(sql.PrunedPartitionsTable); ok {
+		if partitions, ok := pt.PrunedPartitions(); ok {
+			return fmt.Sprintf("Table(%s, partitions=[%s])", t.Table.Name(), strings.Join(partitions, ","))
+		}
+	}
 	return fmt.Sprintf("Table(%s)", t.Table.Name())
 }
 
@@ -33,6 +33,7 @@ const (
 	UpdateTypeUpdate
 	UpdateTypeDelete
 	UpdateTypeJoinUpdate
+	UpdateTypeLoadData
 )
 
 // RowUpdateAccumulator wraps other nodes that update tables, and returns their results as OKResults with the appropriate
@@ -96,17 +97,26 @@ func (i *insertRowHandler) okResult() sql.OkResult {
 
 type replaceRowHandler struct {
 	rowsAffected int
+	// rowLen is the number of columns of the table, which the length of the rows returned by REPLACE is a multiple of
+	rowLen int
 }
 
 func (r *replaceRowHandler) handleRowUpdate(row sql.Row) error {
 	r.rowsAffected++
 
-	// If a row was deleted as well as inserted, increment the counter again. A row was deleted if at least one column in
-	// the first half of the row is non-null.
-	for i := 0; i < len(row)/2; i++ {
-		if row[i] != nil {
-			r.rowsAffected++
-			break
+	rowLen := r.rowLen
+	if rowLen == 0 || len(row)%rowLen != 0 {
+		rowLen = len(row) / 2
+	}
+
+	// The row inserted is preceded by the rows deleted. Increment the counter again for each of them. There was no row
+	// deleted if every column of the first row is null.
+	for start := 0; start+rowLen < len(row); start += rowLen {
+		for i := start; i < start+rowLen; i++ {
+			if row[i] != nil {
+				r.rowsAffected++
+				break
+			}
 		}
 	}
 
@@ -269,6 +279,45 @@ func (u *deleteRowHandler) okResult() sql.OkResult {
 	return sql.NewOkResult(u.rowsAffected)
 }
 
+// LoadDataInfo is the summary of a LOAD DATA statement reported to the client.
+type LoadDataInfo struct {
+	Records, Deleted, Skipped, Warnings int
+}
+
+// String implements fmt.Stringer
+func (li LoadDataInfo) String() string {
+	return fmt.Sprintf("Records: %d  Deleted: %d  Skipped: %d  Warnings: %d", li.Records, li.Deleted, li.Skipped, li.Warnings)
+}
+
+// loadDataRowHandler counts the rows read by a LOAD DATA statement. Rows that aren't inserted because of IGNORE are
+// skipped.
+type loadDataRowHandler struct {
+	session sql.Session
+	records int
+	skipped int
+}
+
+func (l *loadDataRowHandler) handleRowUpdate(row sql.Row) error {
+	l.records++
+	return nil
+}
+
+func (l *loadDataRowHandler) handleRowSkip() {
+	l.records++
+	l.skipped++
+}
+
+func (l *loadDataRowHandler) okResult() sql.OkResult {
+	return sql.OkResult{
+		RowsAffected: uint64(l.records - l.skipped),
+		Info: LoadDataInfo{
+			Records:  l.records,
+			Skipped:  l.skipped,
+			Warnings: int(l.session.WarningCount()),
+		},
+	}
+}
+
 type accumulatorIter struct {
 	iter             sql.RowIter
 	once             sync.Once
@@ -330,6 +379,9 @@ func (a *accumulatorIter) Next(ctx *sql.Context) (r sql.Row, err error) {
 
 			return sql.NewRow(res), nil
 		} else if isIg {
+			if sa, ok := a.updateRowHandler.(skippingAccumulator); ok {
+				sa.handleRowSkip()
+			}
 			continue
 		} else if err != nil {
 			return nil, err
@@ -350,6 +402,11 @@ type matchingAccumulator interface {
 	RowsMatched() int64
 }
 
+// skippingAccumulator is an accumulatorRowHandler that counts the rows skipped because of INSERT IGNORE.
+type skippingAccumulator interface {
+	handleRowSkip()
+}
+
 func (r RowUpdateAccumulator) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	rowIter, err := r.Child.RowIter(ctx, row)
 	if err != nil {
@@ -363,7 +420,8 @@ func (r RowUpdateAccumulator) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIte
 	case UpdateTypeInsert:
 		rowHandler = &insertRowHandler{}
 	case UpdateTypeReplace:
-		rowHandler = &replaceRowHandler{}
+		// the schema of the replace node is a self-concatenation of the underlying table's
+		rowHandler = &replaceRowHandler{rowLen: len(r.Child.Schema()) / 2}
 	case UpdateTypeDuplicateKeyUpdate:
 		rowHandler = &onDuplicateUpdateHandler{schema: r.Child.Schema(), clientFoundRowsCapability: clientFoundRowsToggled}
 	case UpdateTypeUpdate:
@@ -373,6 +431,8 @@ func (r RowUpdateAccumulator) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIte
 		rowHandler = &updateRowHandler{schema: schema[:len(schema)/2], clientFoundRowsCapability: clientFoundRowsToggled}
 	case UpdateTypeDelete:
 		rowHandler = &deleteRowHandler{}
+	case UpdateTypeLoadData:
+		rowHandler = &loadDataRowHandler{session: ctx.Session}
 	case UpdateTypeJoinUpdate:
 		var schema sql.Schema
 		var updaterMap map[string]sql.RowUpdater
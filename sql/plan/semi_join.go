@@ -0,0 +1,212 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// SemiJoinType is the kind of match a SemiJoin requires for the rows it returns.
+type SemiJoinType byte
+
+const (
+	// SemiJoinTypeSemi returns the rows with a key that is in the subquery results, like `key IN (SELECT ...)` in a
+	// filter.
+	SemiJoinTypeSemi SemiJoinType = iota
+	// SemiJoinTypeAnti returns the rows with a key that isn't in the subquery results, including rows with a NULL key,
+	// like a NOT EXISTS subquery correlated by an equality.
+	SemiJoinTypeAnti
+	// SemiJoinTypeNullAwareAnti returns the rows for which `key NOT IN (SELECT ...)` is true. Every row is returned if
+	// the subquery has no results. Otherwise, rows with a NULL key aren't returned, and no rows are returned at all if
+	// the results contain a NULL.
+	SemiJoinTypeNullAwareAnti
+)
+
+func (t SemiJoinType) String() string {
+	switch t {
+	case SemiJoinTypeSemi:
+		return "Semi"
+	case SemiJoinTypeAnti:
+		return "Anti"
+	case SemiJoinTypeNullAwareAnti:
+		return "NullAwareAnti"
+	default:
+		return "Invalid"
+	}
+}
+
+// SemiJoin returns the rows of its child whose key does or doesn't have a match in the results of a subquery, which
+// must not reference the child's rows. The subquery results are hashed once for each call to RowIter, so every child
+// row is matched with a single lookup rather than by evaluating the subquery again. Like IndexedInSubqueryFilter, the
+// subquery is evaluated with the scope row padded by one NULL for each column of the child, as it expects to be
+// evaluated in the scope of a child row.
+type SemiJoin struct {
+	UnaryNode
+	Key      sql.Expression
+	Subquery *Subquery
+	JoinType SemiJoinType
+}
+
+var _ sql.Node = (*SemiJoin)(nil)
+var _ sql.Expressioner = (*SemiJoin)(nil)
+
+// NewSemiJoin returns a SemiJoin of the given type, matching the value of |key| for each row of |child| against the
+// results of |subquery|.
+func NewSemiJoin(child sql.Node, key sql.Expression, subquery *Subquery, joinType SemiJoinType) *SemiJoin {
+	return &SemiJoin{
+		UnaryNode: UnaryNode{Child: child},
+		Key:       key,
+		Subquery:  subquery,
+		JoinType:  joinType,
+	}
+}
+
+// Resolved implements the Resolvable interface.
+func (s *SemiJoin) Resolved() bool {
+	return s.Child.Resolved() && s.Key.Resolved() && s.Subquery.Resolved()
+}
+
+// RowIter implements the Node interface.
+func (s *SemiJoin) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	span, ctx := ctx.Span("plan.SemiJoin")
+
+	padded := make(sql.Row, len(row)+len(s.Child.Schema()))
+	copy(padded, row)
+	values, err := s.Subquery.HashMultiple(ctx, padded)
+	if err != nil {
+		span.Finish()
+		return nil, err
+	}
+
+	childIter, err := s.Child.RowIter(ctx, row)
+	if err != nil {
+		span.Finish()
+		return nil, err
+	}
+
+	_, nilErr := values.Get(nilKey)
+	return sql.NewSpanIter(span, &semiJoinIter{
+		childIter: childIter,
+		key:       s.Key,
+		keyTyp:    s.Key.Type().Promote(),
+		valuesTyp: s.Subquery.Type(),
+		values:    values,
+		hasNull:   nilErr == nil,
+		joinType:  s.JoinType,
+	}), nil
+}
+
+// WithChildren implements the Node interface.
+func (s *SemiJoin) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 1)
+	}
+	return NewSemiJoin(children[0], s.Key, s.Subquery, s.JoinType), nil
+}
+
+// Expressions implements the Expressioner interface.
+func (s *SemiJoin) Expressions() []sql.Expression {
+	return []sql.Expression{s.Key, s.Subquery}
+}
+
+// WithExpressions implements the Expressioner interface.
+func (s *SemiJoin) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(exprs), 2)
+	}
+	subquery, ok := exprs[1].(*Subquery)
+	if !ok {
+		return nil, fmt.Errorf("SemiJoin: expected a subquery, got %T", exprs[1])
+	}
+	return NewSemiJoin(s.Child, exprs[0], subquery, s.JoinType), nil
+}
+
+func (s *SemiJoin) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("%sJoin(%s IN %s)", s.JoinType, s.Key, s.Subquery)
+	_ = pr.WriteChildren(s.Child.String())
+	return pr.String()
+}
+
+func (s *SemiJoin) DebugString() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("%sJoin(%s IN %s)", s.JoinType, sql.DebugString(s.Key), sql.DebugString(s.Subquery))
+	_ = pr.WriteChildren(sql.DebugString(s.Child))
+	return pr.String()
+}
+
+type semiJoinIter struct {
+	childIter sql.RowIter
+	key       sql.Expression
+	keyTyp    sql.Type
+	valuesTyp sql.Type
+	values    sql.KeyValueCache
+	hasNull   bool
+	joinType  SemiJoinType
+}
+
+func (i *semiJoinIter) Next(ctx *sql.Context) (sql.Row, error) {
+	for {
+		row, err := i.childIter.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		ok, err := i.matches(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return row, nil
+		}
+	}
+}
+
+// matches returns whether the row given should be returned.
+func (i *semiJoinIter) matches(ctx *sql.Context, row sql.Row) (bool, error) {
+	if i.joinType == SemiJoinTypeNullAwareAnti {
+		if i.values.Size() == 0 {
+			return true, nil
+		}
+		if i.hasNull {
+			return false, nil
+		}
+	}
+
+	key, err := i.key.Eval(ctx, row)
+	if err != nil {
+		return false, err
+	}
+	if key == nil {
+		return i.joinType == SemiJoinTypeAnti, nil
+	}
+
+	key, err = i.keyTyp.Convert(key)
+	if err != nil {
+		return false, err
+	}
+
+	found, err := hashedResultsContain(i.values, i.keyTyp, i.valuesTyp, key)
+	if err != nil {
+		return false, err
+	}
+	return found == (i.joinType == SemiJoinTypeSemi), nil
+}
+
+func (i *semiJoinIter) Close(ctx *sql.Context) error {
+	return i.childIter.Close(ctx)
+}
@@ -0,0 +1,86 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+func TestSemiJoin(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+
+	newTable := func(name string, vals ...interface{}) *plan.ResolvedTable {
+		table := memory.NewTable(name, sql.NewPrimaryKeySchema(sql.Schema{
+			{Name: "i", Source: name, Type: sql.Int64, Nullable: true},
+		}))
+		for _, v := range vals {
+			require.NoError(t, table.Insert(ctx, sql.NewRow(v)))
+		}
+		return plan.NewResolvedTable(table, nil, nil)
+	}
+
+	outer := newTable("outer", int64(1), int64(2), int64(3), nil)
+	outerKey := expression.NewGetFieldWithTable(0, sql.Int64, "outer", "i", true)
+	// Subqueries are evaluated with the columns of the outer row before their own
+	innerKey := expression.NewGetFieldWithTable(1, sql.Int64, "inner", "i", true)
+
+	inners := map[string]*plan.ResolvedTable{
+		"no nulls": newTable("inner", int64(2), int64(3), int64(4)),
+		"nulls":    newTable("inner", int64(2), nil),
+		"empty":    newTable("inner"),
+	}
+
+	for name, inner := range inners {
+		t.Run(name, func(t *testing.T) {
+			in := plan.NewSubquery(plan.NewProject([]sql.Expression{innerKey}, inner), "")
+			// The correlated subquery the semijoin replaces for EXISTS
+			correlated := plan.NewSubquery(plan.NewProject(
+				[]sql.Expression{innerKey},
+				plan.NewFilter(expression.NewEquals(innerKey, outerKey), inner),
+			), "")
+
+			testCases := []struct {
+				name     string
+				naive    sql.Expression
+				joinType plan.SemiJoinType
+			}{
+				{"in", plan.NewInSubquery(outerKey, in), plan.SemiJoinTypeSemi},
+				{"not in", plan.NewNotInSubquery(outerKey, in), plan.SemiJoinTypeNullAwareAnti},
+				{"exists", plan.NewExistsSubquery(correlated), plan.SemiJoinTypeSemi},
+				{"not exists", expression.NewNot(plan.NewExistsSubquery(correlated)), plan.SemiJoinTypeAnti},
+			}
+
+			for _, tt := range testCases {
+				t.Run(tt.name, func(t *testing.T) {
+					require := require.New(t)
+
+					expected, err := sql.NodeToRows(ctx, plan.NewFilter(tt.naive, outer))
+					require.NoError(err)
+
+					rows, err := sql.NodeToRows(ctx, plan.NewSemiJoin(outer, outerKey, in, tt.joinType))
+					require.NoError(err)
+					require.ElementsMatch(expected, rows)
+				})
+			}
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 )
 
 var ErrNotView = errors.NewKind("'%' is not VIEW")
@@ -193,7 +194,7 @@ func (i *showCreateTablesIter) produceCreateTableStatement(ctx *sql.Context, tab
 	// Statement creation parts for each column
 	// TODO: rather than lower-casing here, we should do it in the String() method of types
 	for i, col := range schema {
-		stmt := fmt.Sprintf("  `%s` %s", col.Name, strings.ToLower(col.Type.String()))
+		stmt := fmt.Sprintf("  %s %s", quoteIdentifier(col.Name), strings.ToLower(col.Type.String()))
 
 		if !col.Nullable {
 			stmt = fmt.Sprintf("%s NOT NULL", stmt)
@@ -203,13 +204,16 @@ func (i *showCreateTablesIter) produceCreateTableStatement(ctx *sql.Context, tab
 			stmt = fmt.Sprintf("%s AUTO_INCREMENT", stmt)
 		}
 
-		// TODO: The columns that are rendered in defaults should be backticked
 		if col.Default != nil {
-			stmt = fmt.Sprintf("%s DEFAULT %s", stmt, col.Default.String())
+			def, err := columnDefaultString(col.Default)
+			if err != nil {
+				return "", err
+			}
+			stmt = fmt.Sprintf("%s DEFAULT %s", stmt, def)
 		}
 
 		if col.Comment != "" {
-			stmt = fmt.Sprintf("%s COMMENT '%s'", stmt, col.Comment)
+			stmt = fmt.Sprintf("%s COMMENT %s", stmt, quoteString(col.Comment))
 		}
 
 		if col.PrimaryKey {
@@ -219,8 +223,17 @@ func (i *showCreateTablesIter) produceCreateTableStatement(ctx *sql.Context, tab
 		colStmts[i] = stmt
 	}
 
-	// TODO: the order of the primary key columns might not match their order in the schema. The current interface can't
-	//  represent this. We will need a new sql.Table extension to support this cleanly.
+	// The order of the primary key columns might not match their order in the schema, tables that know it tell it
+	if pkt := getPrimaryKeyTable(table); pkt != nil {
+		pkSchema := pkt.PrimaryKeySchema()
+		if len(pkSchema.PkOrdinals) == len(primaryKeyCols) {
+			primaryKeyCols = primaryKeyCols[:0]
+			for _, ord := range pkSchema.PkOrdinals {
+				primaryKeyCols = append(primaryKeyCols, pkSchema.Schema[ord].Name)
+			}
+		}
+	}
+
 	if len(primaryKeyCols) > 0 {
 		primaryKey := fmt.Sprintf("  PRIMARY KEY (%s)", strings.Join(quoteIdentifiers(primaryKeyCols), ","))
 		colStmts = append(colStmts, primaryKey)
@@ -236,7 +249,7 @@ func (i *showCreateTablesIter) produceCreateTableStatement(ctx *sql.Context, tab
 		for _, expr := range index.Expressions() {
 			col := GetColumnFromIndexExpr(expr, table)
 			if col != nil {
-				indexCols = append(indexCols, fmt.Sprintf("`%s`", col.Name))
+				indexCols = append(indexCols, quoteIdentifier(col.Name))
 			}
 		}
 
@@ -245,9 +258,9 @@ func (i *showCreateTablesIter) produceCreateTableStatement(ctx *sql.Context, tab
 			unique = "UNIQUE "
 		}
 
-		key := fmt.Sprintf("  %sKEY `%s` (%s)", unique, index.ID(), strings.Join(indexCols, ","))
+		key := fmt.Sprintf("  %sKEY %s (%s)", unique, quoteIdentifier(index.ID()), strings.Join(indexCols, ","))
 		if index.Comment() != "" {
-			key = fmt.Sprintf("%s COMMENT '%s'", key, index.Comment())
+			key = fmt.Sprintf("%s COMMENT %s", key, quoteString(index.Comment()))
 		}
 
 		colStmts = append(colStmts, key)
@@ -270,13 +283,13 @@ func (i *showCreateTablesIter) produceCreateTableStatement(ctx *sql.Context, tab
 			if len(fk.OnUpdate) > 0 && fk.OnUpdate != sql.ForeignKeyReferenceOption_DefaultAction {
 				onUpdate = " ON UPDATE " + string(fk.OnUpdate)
 			}
-			colStmts = append(colStmts, fmt.Sprintf("  CONSTRAINT `%s` FOREIGN KEY (%s) REFERENCES `%s` (%s)%s%s", fk.Name, keyCols, fk.ReferencedTable, refCols, onDelete, onUpdate))
+			colStmts = append(colStmts, fmt.Sprintf("  CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s (%s)%s%s", quoteIdentifier(fk.Name), keyCols, quoteIdentifier(fk.ReferencedTable), refCols, onDelete, onUpdate))
 		}
 	}
 
 	if i.checks != nil {
 		for _, check := range i.checks {
-			fmted := fmt.Sprintf("  CONSTRAINT `%s` CHECK (%s)", check.Name, check.Expr.String())
+			fmted := fmt.Sprintf("  CONSTRAINT %s CHECK (%s)", quoteIdentifier(check.Name), check.Expr.String())
 
 			if !check.Enforced {
 				fmted += " /*!80016 NOT ENFORCED */"
@@ -286,10 +299,16 @@ func (i *showCreateTablesIter) produceCreateTableStatement(ctx *sql.Context, tab
 		}
 	}
 
+	tableOptions := fmt.Sprintf("ENGINE=InnoDB DEFAULT CHARSET=%s COLLATE=%s", sql.Collation_Default.CharacterSet(), sql.Collation_Default)
+	if sql.IsSystemVersioned(table) {
+		tableOptions += " WITH SYSTEM VERSIONING"
+	}
+
 	return fmt.Sprintf(
-		"CREATE TABLE `%s` (\n%s\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
-		table.Name(),
+		"CREATE TABLE %s (\n%s\n) %s",
+		quoteIdentifier(table.Name()),
 		strings.Join(colStmts, ",\n"),
+		tableOptions,
 	), nil
 }
 
@@ -305,14 +324,58 @@ func getForeignKeyTable(t sql.Table) sql.ForeignKeyTable {
 	}
 }
 
+// getPrimaryKeyTable returns the underlying PrimaryKeyTable for the table given, or nil if it isn't a PrimaryKeyTable
+func getPrimaryKeyTable(t sql.Table) sql.PrimaryKeyTable {
+	switch t := t.(type) {
+	case sql.PrimaryKeyTable:
+		return t
+	case sql.TableWrapper:
+		return getPrimaryKeyTable(t.Underlying())
+	default:
+		return nil
+	}
+}
+
 func quoteIdentifiers(ids []string) []string {
 	quoted := make([]string, len(ids))
 	for i, id := range ids {
-		quoted[i] = fmt.Sprintf("`%s`", id)
+		quoted[i] = quoteIdentifier(id)
 	}
 	return quoted
 }
 
+// quoteIdentifier returns the identifier given in backticks, escaping any backticks it contains.
+func quoteIdentifier(id string) string {
+	return "`" + strings.ReplaceAll(id, "`", "``") + "`"
+}
+
+// quoteString returns the string given as a single-quoted string literal, escaping any quotes it contains.
+func quoteString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// columnDefaultString returns the default value given as it's written in a column definition, with the columns it
+// references unqualified and backticked, so that the definition can be run again.
+func columnDefaultString(def *sql.ColumnDefaultValue) (string, error) {
+	if def.IsLiteral() {
+		return def.String(), nil
+	}
+	expr, err := expression.TransformUp(def.Expression, func(e sql.Expression) (sql.Expression, error) {
+		switch e := e.(type) {
+		case *expression.GetField:
+			return expression.NewUnresolvedColumn(quoteIdentifier(e.Name())), nil
+		case *expression.UnresolvedColumn:
+			return expression.NewUnresolvedColumn(quoteIdentifier(strings.Trim(e.Name(), "`"))), nil
+		default:
+			return e, nil
+		}
+	})
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("(%s)", expr), nil
+}
+
 // isPrimaryKeyIndex returns whether the index given matches the table's primary key columns. Order is not considered.
 func isPrimaryKeyIndex(index sql.Index, table sql.Table) bool {
 	var pks []*sql.Column
@@ -59,7 +59,7 @@ func TestShowCreateTable(t *testing.T) {
 			"  `foo` varchar(123),\n"+
 			"  `pok` char(123),\n"+
 			"  PRIMARY KEY (`baz`,`zab`)\n"+
-			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin",
 	)
 
 	require.Equal(expected, row)
@@ -74,6 +74,30 @@ func TestShowCreateTable(t *testing.T) {
 	require.True(ErrNotView.Is(err), "wrong error kind")
 }
 
+func TestShowCreateSystemVersionedTable(t *testing.T) {
+	var require = require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	schema := sql.Schema{
+		&sql.Column{Name: "i", Source: "versioned", Type: sql.Int64, PrimaryKey: true},
+	}
+	table := memory.NewTable("versioned", sql.NewPrimaryKeySchema(schema))
+	db := memory.NewHistoryDatabase("mydb")
+	db.AddTableAsOf("versioned", table, "2019-01-01")
+
+	showCreateTable, err := NewShowCreateTable(NewResolvedTable(table, db, nil), false).WithTargetSchema(schema)
+	require.NoError(err)
+
+	rows, err := sql.NodeToRows(ctx, showCreateTable)
+	require.NoError(err)
+	require.Equal([]sql.Row{{
+		"versioned",
+		"CREATE TABLE `versioned` (\n  `i` bigint NOT NULL,\n" +
+			"  PRIMARY KEY (`i`)\n" +
+			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin WITH SYSTEM VERSIONING",
+	}}, rows)
+}
+
 func TestShowCreateTableWithIndexAndForeignKeysAndChecks(t *testing.T) {
 	var require = require.New(t)
 	ctx := sql.NewEmptyContext()
@@ -147,12 +171,43 @@ func TestShowCreateTableWithIndexAndForeignKeysAndChecks(t *testing.T) {
 			"  CONSTRAINT `fk2` FOREIGN KEY (`foo`) REFERENCES `otherTable` (`b`) ON UPDATE RESTRICT,\n"+
 			"  CONSTRAINT `fk3` FOREIGN KEY (`bza`) REFERENCES `otherTable` (`c`),\n"+
 			"  CONSTRAINT `mycheck` CHECK ((`zab` > 0))\n"+
-			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin",
 	)
 
 	require.Equal(expected, row)
 }
 
+func TestShowCreateTablePrimaryKeyOrderAndQuoting(t *testing.T) {
+	var require = require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	def, err := sql.NewColumnDefaultValue(
+		expression.NewPlus(expression.NewGetFieldWithTable(0, sql.Int64, "t`1", "a", false), expression.NewLiteral(int8(1), sql.Int8)),
+		sql.Int64, false, true)
+	require.NoError(err)
+
+	schema := sql.Schema{
+		&sql.Column{Name: "a", Source: "t`1", Type: sql.Int64, PrimaryKey: true},
+		&sql.Column{Name: "b", Source: "t`1", Type: sql.Int64, PrimaryKey: true, Comment: "it's"},
+		&sql.Column{Name: "c", Source: "t`1", Type: sql.Int64, Nullable: true, Default: def},
+	}
+	table := memory.NewTable("t`1", sql.NewPrimaryKeySchema(schema, 1, 0))
+
+	showCreateTable, err := NewShowCreateTable(NewResolvedTable(table, nil, nil), false).WithTargetSchema(schema)
+	require.NoError(err)
+
+	rows, err := sql.NodeToRows(ctx, showCreateTable)
+	require.NoError(err)
+	require.Equal([]sql.Row{{
+		"t`1",
+		"CREATE TABLE `t``1` (\n  `a` bigint NOT NULL,\n" +
+			"  `b` bigint NOT NULL COMMENT 'it''s',\n" +
+			"  `c` bigint DEFAULT ((`a` + 1)),\n" +
+			"  PRIMARY KEY (`b`,`a`)\n" +
+			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin",
+	}}, rows)
+}
+
 func TestShowCreateView(t *testing.T) {
 	var require = require.New(t)
 	ctx := sql.NewEmptyContext()
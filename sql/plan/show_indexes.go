@@ -17,6 +17,7 @@ package plan
 import (
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
 )
@@ -62,7 +63,7 @@ func (n *ShowIndexes) Schema() sql.Schema {
 		&sql.Column{Name: "Seq_in_index", Type: sql.Int32},
 		&sql.Column{Name: "Column_name", Type: sql.LongText, Nullable: true},
 		&sql.Column{Name: "Collation", Type: sql.LongText, Nullable: true},
-		&sql.Column{Name: "Cardinality", Type: sql.Int64},
+		&sql.Column{Name: "Cardinality", Type: sql.Int64, Nullable: true},
 		&sql.Column{Name: "Sub_part", Type: sql.Int64, Nullable: true},
 		&sql.Column{Name: "Packed", Type: sql.LongText, Nullable: true},
 		&sql.Column{Name: "Null", Type: sql.LongText},
@@ -81,9 +82,20 @@ func (n *ShowIndexes) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error
 		panic(fmt.Sprintf("unexpected type %T", n.Child))
 	}
 
+	// The cardinality is only known for unique indexes, which have as many distinct keys as rows.
+	var numRows interface{}
+	if st, ok := statisticsTable(table.Table); ok {
+		n, err := st.NumRows(ctx)
+		if err != nil {
+			return nil, err
+		}
+		numRows = int64(n)
+	}
+
 	return &showIndexesIter{
-		table: table,
-		idxs:  newIndexesToShow(n.IndexesToShow),
+		table:   table,
+		idxs:    newIndexesToShow(n.IndexesToShow),
+		numRows: numRows,
 	}, nil
 }
 
@@ -94,8 +106,9 @@ func newIndexesToShow(indexes []sql.Index) *indexesToShow {
 }
 
 type showIndexesIter struct {
-	table *ResolvedTable
-	idxs  *indexesToShow
+	table   *ResolvedTable
+	idxs    *indexesToShow
+	numRows interface{}
 }
 
 func (i *showIndexesIter) Next(ctx *sql.Context) (sql.Row, error) {
@@ -132,20 +145,30 @@ func (i *showIndexesIter) Next(ctx *sql.Context) (sql.Row, error) {
 		nonUnique = 1
 	}
 
+	var collation interface{}
+	if strings.EqualFold(show.index.IndexType(), "BTREE") {
+		collation = "A"
+	}
+
+	var cardinality interface{}
+	if show.index.IsUnique() && show.exPosition == len(show.index.Expressions())-1 {
+		cardinality = i.numRows
+	}
+
 	return sql.NewRow(
 		show.index.Table(),     // "Table" string
 		nonUnique,              // "Non_unique" int32, Values [0, 1]
 		show.index.ID(),        // "Key_name" string
 		show.exPosition+1,      // "Seq_in_index" int32
 		columnName,             // "Column_name" string
-		nil,                    // "Collation" string, Values [A, D, NULL]
-		int64(0),               // "Cardinality" int64 (not calculated)
+		collation,              // "Collation" string, Values [A, D, NULL]
+		cardinality,            // "Cardinality" int64
 		nil,                    // "Sub_part" int64
 		nil,                    // "Packed" string
 		nullable,               // "Null" string, Values [YES, '']
 		show.index.IndexType(), // "Index_type" string
-		show.index.Comment(),   // "Comment" string
-		"",                     // "Index_comment" string
+		"",                     // "Comment" string
+		show.index.Comment(),   // "Index_comment" string
 		visible,                // "Visible" string, Values [YES, NO]
 		expression,             // "Expression" string
 	), nil
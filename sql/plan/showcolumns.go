@@ -116,7 +116,7 @@ func (s *ShowColumns) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error
 		case *ResolvedTable:
 			if col.PrimaryKey {
 				key = "PRI"
-			} else if s.isFirstColInUniqueKey(col, table) {
+			} else if s.isUniqueKeyCol(col, table) {
 				key = "UNI"
 			} else if s.isFirstColInNonUniqueKey(col, table) {
 				key = "MUL"
@@ -141,7 +141,7 @@ func (s *ShowColumns) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error
 				null,
 				key,
 				defaultVal,
-				col.Extra,
+				columnExtra(col),
 				"", // Privileges
 				col.Comment,
 			}
@@ -152,7 +152,7 @@ func (s *ShowColumns) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error
 				null,
 				key,
 				defaultVal,
-				col.Extra,
+				columnExtra(col),
 			}
 		}
 
@@ -202,14 +202,16 @@ func (s *ShowColumns) DebugString() string {
 	return tp.String()
 }
 
-func (s *ShowColumns) isFirstColInUniqueKey(col *sql.Column, table sql.Table) bool {
+// isUniqueKeyCol returns whether the column given is the only column of a unique index. Like MySQL, the first column
+// of a unique index over several columns is shown as MUL, since its values alone may repeat.
+func (s *ShowColumns) isUniqueKeyCol(col *sql.Column, table sql.Table) bool {
 	for _, idx := range s.Indexes {
-		if !idx.IsUnique() {
+		if !idx.IsUnique() || len(idx.Expressions()) != 1 {
 			continue
 		}
 
-		firstIndexCol := GetColumnFromIndexExpr(idx.Expressions()[0], table)
-		if firstIndexCol != nil && firstIndexCol.Name == col.Name {
+		indexCol := GetColumnFromIndexExpr(idx.Expressions()[0], table)
+		if indexCol != nil && indexCol.Name == col.Name {
 			return true
 		}
 	}
@@ -217,9 +219,11 @@ func (s *ShowColumns) isFirstColInUniqueKey(col *sql.Column, table sql.Table) bo
 	return false
 }
 
+// isFirstColInNonUniqueKey returns whether the column given is the first column of an index whose values may repeat
+// for it: a non-unique index, or a unique index over several columns.
 func (s *ShowColumns) isFirstColInNonUniqueKey(col *sql.Column, table sql.Table) bool {
 	for _, idx := range s.Indexes {
-		if idx.IsUnique() {
+		if idx.IsUnique() && len(idx.Expressions()) == 1 {
 			continue
 		}
 
@@ -231,3 +235,22 @@ func (s *ShowColumns) isFirstColInNonUniqueKey(col *sql.Column, table sql.Table)
 
 	return false
 }
+
+// columnExtra returns the Extra column of SHOW COLUMNS for the column given: auto_increment for auto increment
+// columns, and DEFAULT_GENERATED for columns whose default is an expression, along with any other information the
+// column was given.
+func columnExtra(col *sql.Column) string {
+	var extras []string
+	if col.AutoIncrement {
+		extras = append(extras, "auto_increment")
+	}
+	if col.Default != nil && !col.Default.IsLiteral() {
+		extras = append(extras, "DEFAULT_GENERATED")
+	}
+	for _, extra := range strings.Fields(col.Extra) {
+		if !strings.EqualFold(extra, "auto_increment") && extra != "DEFAULT_GENERATED" {
+			extras = append(extras, extra)
+		}
+	}
+	return strings.Join(extras, " ")
+}
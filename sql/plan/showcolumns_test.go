@@ -101,9 +101,10 @@ func TestShowColumnsWithIndexes(t *testing.T) {
 	rows, err := sql.RowIterToRows(ctx, iter)
 	require.NoError(err)
 
+	// The first column of a unique index over several columns is MUL, as in MySQL
 	expected := []sql.Row{
 		{"a", "text", "NO", "PRI", "", ""},
-		{"b", "bigint", "YES", "UNI", "", ""},
+		{"b", "bigint", "YES", "MUL", "", ""},
 		{"c", "bigint", "NO", "", "1", ""},
 		{"d", "bigint", "YES", "MUL", "", ""},
 		{"e", "bigint", "NO", "", "1", ""},
@@ -113,6 +114,15 @@ func TestShowColumnsWithIndexes(t *testing.T) {
 
 	// Test the precedence of key type. PRI > UNI > MUL
 	showColumns.(*ShowColumns).Indexes = append(showColumns.(*ShowColumns).Indexes,
+		&mockIndex{
+			db:    "mydb",
+			table: "foo",
+			id:    "e",
+			exprs: []sql.Expression{
+				expression.NewGetFieldWithTable(0, sql.Int64, "foo", "b", true),
+			},
+			unique: true,
+		},
 		&mockIndex{
 			db:    "mydb",
 			table: "foo",
@@ -141,6 +151,38 @@ func TestShowColumnsWithIndexes(t *testing.T) {
 	rows, err = sql.RowIterToRows(ctx, iter)
 	require.NoError(err)
 
+	expected[1] = sql.Row{"b", "bigint", "YES", "UNI", "", ""}
+	require.Equal(expected, rows)
+}
+
+func TestShowColumnsExtra(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	schema := sql.Schema{
+		{Name: "a", Source: "foo", Type: sql.Int64, PrimaryKey: true, AutoIncrement: true, Extra: "auto_increment"},
+		{Name: "b", Source: "foo", Type: sql.Int64, PrimaryKey: true},
+		{Name: "c", Source: "foo", Type: sql.Int64, Nullable: true, Default: parse.MustStringToColumnDefaultValue(ctx, "(1 + 2)", sql.Int64, true)},
+		{Name: "d", Source: "foo", Type: sql.Int64, Nullable: true, Default: parse.MustStringToColumnDefaultValue(ctx, "1", sql.Int64, true)},
+	}
+	table := NewResolvedTable(memory.NewTable("foo", sql.NewPrimaryKeySchema(schema)), nil, nil)
+
+	showColumns, err := NewShowColumns(false, table).WithTargetSchema(schema)
+	require.NoError(err)
+
+	iter, err := showColumns.RowIter(ctx, nil)
+	require.NoError(err)
+
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+
+	expected := []sql.Row{
+		{"a", "bigint", "NO", "PRI", "", "auto_increment"},
+		{"b", "bigint", "NO", "PRI", "", ""},
+		{"c", "bigint", "YES", "", "((1 + 2))", "DEFAULT_GENERATED"},
+		{"d", "bigint", "YES", "", "1", ""},
+	}
+
 	require.Equal(expected, rows)
 }
 
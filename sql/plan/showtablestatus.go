@@ -61,7 +61,7 @@ var showTableStatusSchema = sql.Schema{
 	{Name: "Collation", Type: sql.LongText},
 	{Name: "Checksum", Type: sql.LongText, Nullable: true},
 	{Name: "Create_options", Type: sql.LongText, Nullable: true},
-	{Name: "Comments", Type: sql.LongText, Nullable: true},
+	{Name: "Comment", Type: sql.LongText, Nullable: true},
 }
 
 // Children implements the sql.Node interface.
@@ -88,18 +88,9 @@ func (s *ShowTableStatus) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, e
 			return nil, err
 		}
 
-		var numRows uint64 = 0
-		var dataLength uint64 = 0
-		if st, ok := table.(sql.StatisticsTable); ok {
-			numRows, err = st.NumRows(ctx)
-			if err != nil {
-				return nil, err
-			}
-
-			dataLength, err = st.DataLength(ctx)
-			if err != nil {
-				return nil, err
-			}
+		numRows, dataLength, _, err := TableSizes(ctx, table)
+		if err != nil {
+			return nil, err
 		}
 
 		nextAIVal, err := getAutoIncrementValue(ctx, table)
@@ -126,6 +117,45 @@ func (s *ShowTableStatus) WithChildren(children ...sql.Node) (sql.Node, error) {
 	return s, nil
 }
 
+// TableSizes returns the number of rows of the table given, and the length of its data, or false if the table can't
+// tell them. The number of rows is the exact count kept by the table's partitions when the table reports it, and the
+// estimate of the table's statistics otherwise.
+func TableSizes(ctx *sql.Context, table sql.Table) (numRows uint64, dataLength uint64, ok bool, err error) {
+	st, ok := statisticsTable(table)
+	if !ok {
+		return 0, 0, false, nil
+	}
+
+	counted := false
+	if rct, isRowCountTable := rowCountTable(table); isRowCountTable {
+		numRows, counted = rct.RowCount(ctx)
+	}
+	if !counted {
+		numRows, err = st.NumRows(ctx)
+		if err != nil {
+			return 0, 0, false, err
+		}
+	}
+
+	dataLength, err = st.DataLength(ctx)
+	if err != nil {
+		return 0, 0, false, err
+	}
+	return numRows, dataLength, true, nil
+}
+
+// rowCountTable returns the table given, or the table it wraps, if it keeps track of its number of rows.
+func rowCountTable(t sql.Table) (sql.RowCountTable, bool) {
+	switch t := t.(type) {
+	case sql.RowCountTable:
+		return t, true
+	case sql.TableWrapper:
+		return rowCountTable(t.Underlying())
+	default:
+		return nil, false
+	}
+}
+
 // getAutoIncrementValue takes in a ctx and table and returns the next autoincrement value.
 func getAutoIncrementValue(ctx *sql.Context, table sql.Table) (interface{}, error) {
 	if autoTbl, ok := table.(sql.AutoIncrementTable); ok {
@@ -199,6 +229,6 @@ func tableToStatusRow(table string, numRows uint64, nextAIVal interface{}, dataL
 		sql.Collation_Default.String(), // Collation
 		nil,                            // Checksum
 		nil,                            // Create_options
-		nil,                            // Comments
+		"",                             // Comment
 	)
 }
@@ -126,6 +126,9 @@ type sortIter struct {
 	childIter  sql.RowIter
 	sortedRows []sql.Row
 	idx        int
+	// runs are the sorted runs of rows, if there were too many to sort in memory, which merger merges
+	runs   []sortedRun
+	merger *sortedRunsMerger
 }
 
 func newSortIter(ctx *sql.Context, s *Sort, child sql.RowIter) *sortIter {
@@ -145,6 +148,10 @@ func (i *sortIter) Next(ctx *sql.Context) (sql.Row, error) {
 		i.idx = 0
 	}
 
+	if i.merger != nil {
+		return i.merger.next()
+	}
+
 	if i.idx >= len(i.sortedRows) {
 		return nil, io.EOF
 	}
@@ -155,13 +162,28 @@ func (i *sortIter) Next(ctx *sql.Context) (sql.Row, error) {
 
 func (i *sortIter) Close(ctx *sql.Context) error {
 	i.sortedRows = nil
+	i.merger = nil
+	for _, run := range i.runs {
+		if err := run.close(); err != nil {
+			_ = i.childIter.Close(ctx)
+			return err
+		}
+	}
+	i.runs = nil
 	return i.childIter.Close(ctx)
 }
 
+// computeSortedRows reads all the rows of the child and sorts them. If they take more memory than the sort buffer size
+// allows, they are written to temporary files in sorted runs of that size as they are read, and the runs are merged.
 func (i *sortIter) computeSortedRows(ctx *sql.Context) error {
 	cache, dispose := ctx.Memory.NewRowsCache()
-	defer dispose()
+	defer func() {
+		dispose()
+	}()
 
+	schema := i.s.Child.Schema()
+	bufferSize, spill := sortBufferSize(ctx, schema)
+	var size uint64
 	for {
 		row, err := i.childIter.Next(ctx)
 
@@ -172,12 +194,53 @@ func (i *sortIter) computeSortedRows(ctx *sql.Context) error {
 			return err
 		}
 
+		if spill && len(row) == len(schema) {
+			rowSize := estimatedRowSize(row)
+			if rows := cache.Get(); size+rowSize > bufferSize && len(rows) > 0 {
+				if err := i.spillRun(ctx, schema, rows); err != nil {
+					return err
+				}
+				dispose()
+				cache, dispose = ctx.Memory.NewRowsCache()
+				size = 0
+			}
+			size += rowSize
+		}
+
 		if err := cache.Add(row); err != nil {
 			return err
 		}
 	}
 
 	rows := cache.Get()
+	if err := i.sortRows(ctx, rows); err != nil {
+		return err
+	}
+	if len(i.runs) == 0 {
+		i.sortedRows = rows
+		return nil
+	}
+
+	i.runs = append(i.runs, &memoryRun{rows: rows})
+	var err error
+	i.merger, err = newSortedRunsMerger(ctx, i.s.SortFields, i.runs)
+	return err
+}
+
+// spillRun sorts the rows given and writes them to a temporary file as a sorted run.
+func (i *sortIter) spillRun(ctx *sql.Context, schema sql.Schema, rows []sql.Row) error {
+	if err := i.sortRows(ctx, rows); err != nil {
+		return err
+	}
+	run, err := newFileRun(schema, rows)
+	if err != nil {
+		return err
+	}
+	i.runs = append(i.runs, run)
+	return nil
+}
+
+func (i *sortIter) sortRows(ctx *sql.Context, rows []sql.Row) error {
 	sorter := &expression.Sorter{
 		SortFields: i.s.SortFields,
 		Rows:       rows,
@@ -185,11 +248,7 @@ func (i *sortIter) computeSortedRows(ctx *sql.Context) error {
 		Ctx:        ctx,
 	}
 	sort.Stable(sorter)
-	if sorter.LastError != nil {
-		return sorter.LastError
-	}
-	i.sortedRows = rows
-	return nil
+	return sorter.LastError
 }
 
 // TopN was a sort node that has a limit. It doesn't need to buffer everything,
@@ -0,0 +1,325 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"bufio"
+	"container/heap"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// sortSpillBufferSizeSessionVar is the system variable with the number of bytes of rows a Sort holds in memory. When the
+// rows of its child take more than that, the Sort writes them to temporary files in sorted runs, and merges the runs
+// as it returns the rows. It is 0 by default, which keeps all the rows in memory.
+const sortSpillBufferSizeSessionVar = "sort_spill_buffer_size"
+
+// sortBufferSize returns the number of bytes of rows that a Sort of rows of the schema given holds in memory, or false
+// if the rows are never written to disk, either because spilling is disabled or because some column has a type whose
+// values can't be read back.
+func sortBufferSize(ctx *sql.Context, schema sql.Schema) (uint64, bool) {
+	for _, col := range schema {
+		if !isSpillable(col.Type) {
+			return 0, false
+		}
+	}
+
+	val, err := ctx.GetSessionVariable(ctx, sortSpillBufferSizeSessionVar)
+	if err != nil {
+		return 0, false
+	}
+	size, ok := val.(uint64)
+	return size, ok && size > 0
+}
+
+// isSpillable returns whether the values of the type given can be encoded with its SQL method and read back with its
+// Convert method.
+func isSpillable(t sql.Type) bool {
+	switch t.(type) {
+	case sql.BitType, sql.DatetimeType, sql.DecimalType, sql.EnumType, sql.JsonType, sql.NullType, sql.NumberType,
+		sql.SetType, sql.StringType, sql.TimeType, sql.YearType:
+		return true
+	default:
+		return false
+	}
+}
+
+// estimatedRowSize returns an estimate of the number of bytes of memory that the row given takes.
+func estimatedRowSize(row sql.Row) uint64 {
+	// every value takes an interface, plus the value itself for those that aren't pointer sized
+	size := uint64(len(row)) * 24
+	for _, v := range row {
+		switch v := v.(type) {
+		case string:
+			size += uint64(len(v))
+		case []byte:
+			size += uint64(len(v))
+		}
+	}
+	return size
+}
+
+// sortedRun is a run of rows in sorted order.
+type sortedRun interface {
+	// next returns the next row of the run, or io.EOF after the last one.
+	next() (sql.Row, error)
+	close() error
+}
+
+// memoryRun is a sorted run held in memory.
+type memoryRun struct {
+	rows []sql.Row
+}
+
+func (r *memoryRun) next() (sql.Row, error) {
+	if len(r.rows) == 0 {
+		return nil, io.EOF
+	}
+	row := r.rows[0]
+	r.rows = r.rows[1:]
+	return row, nil
+}
+
+func (r *memoryRun) close() error {
+	r.rows = nil
+	return nil
+}
+
+// fileRun is a sorted run written to a temporary file. Each value is encoded as its length plus one as a uvarint,
+// followed by the bytes its type's SQL method encodes it to. NULL values are encoded as a length of zero.
+type fileRun struct {
+	schema sql.Schema
+	f      *os.File
+	r      *bufio.Reader
+}
+
+// newFileRun writes the rows given, which must be in sorted order and have the schema given, to a new temporary file,
+// and returns the run to read them back from it.
+func newFileRun(schema sql.Schema, rows []sql.Row) (*fileRun, error) {
+	_, dir, _ := sql.SystemVariables.GetGlobal("tmpdir")
+	tmpdir, _ := dir.(string)
+	f, err := ioutil.TempFile(tmpdir, "sort-")
+	if err != nil {
+		return nil, err
+	}
+	run := &fileRun{schema: schema, f: f}
+
+	w := bufio.NewWriter(f)
+	var buf [binary.MaxVarintLen64]byte
+	for _, row := range rows {
+		for j, v := range row {
+			raw, err := encodeSpilledValue(schema[j].Type, v)
+			if err != nil {
+				run.close()
+				return nil, err
+			}
+			var n int
+			if raw == nil {
+				n = binary.PutUvarint(buf[:], 0)
+			} else {
+				n = binary.PutUvarint(buf[:], uint64(len(raw))+1)
+			}
+			_, _ = w.Write(buf[:n])
+			_, _ = w.Write(raw)
+		}
+	}
+
+	if err = w.Flush(); err == nil {
+		_, err = f.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		run.close()
+		return nil, err
+	}
+
+	run.r = bufio.NewReader(f)
+	return run, nil
+}
+
+// encodeSpilledValue encodes the value given with the SQL method of the type given, or returns nil if it's NULL.
+func encodeSpilledValue(t sql.Type, v interface{}) ([]byte, error) {
+	v, err := t.Convert(v)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	val, err := t.SQL(v)
+	if err != nil || val.IsNull() {
+		return nil, err
+	}
+	// A value that encodes to no bytes must still be told apart from NULL
+	if raw := val.Raw(); raw != nil {
+		return raw, nil
+	}
+	return []byte{}, nil
+}
+
+// decodeSpilledValue returns the value of the type given that encodeSpilledValue encoded to the bytes given.
+func decodeSpilledValue(t sql.Type, raw []byte) (interface{}, error) {
+	if _, ok := t.(sql.DecimalType); ok {
+		return t.Convert(string(raw))
+	}
+
+	switch qt := t.Type(); {
+	case qt == sqltypes.Bit || sqltypes.IsUnsigned(qt):
+		u, err := strconv.ParseUint(string(raw), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return t.Convert(u)
+	case sqltypes.IsSigned(qt):
+		i, err := strconv.ParseInt(string(raw), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return t.Convert(i)
+	case sqltypes.IsFloat(qt):
+		f, err := strconv.ParseFloat(string(raw), 64)
+		if err != nil {
+			return nil, err
+		}
+		return t.Convert(f)
+	default:
+		return t.Convert(string(raw))
+	}
+}
+
+func (r *fileRun) next() (sql.Row, error) {
+	row := make(sql.Row, len(r.schema))
+	for j, col := range r.schema {
+		n, err := binary.ReadUvarint(r.r)
+		if err == io.EOF && j > 0 {
+			err = io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			continue
+		}
+
+		raw := make([]byte, n-1)
+		if _, err = io.ReadFull(r.r, raw); err != nil {
+			return nil, err
+		}
+		if row[j], err = decodeSpilledValue(col.Type, raw); err != nil {
+			return nil, err
+		}
+	}
+	return row, nil
+}
+
+func (r *fileRun) close() error {
+	err := r.f.Close()
+	if rerr := os.Remove(r.f.Name()); err == nil {
+		err = rerr
+	}
+	return err
+}
+
+// sortedRunsHeap is a heap of the next rows of a number of sorted runs, used to merge them. Rows that sort the same
+// are ordered by the position of their run, so that merging runs of consecutive rows keeps the sort stable.
+type sortedRunsHeap struct {
+	expression.Sorter
+	runs []int
+}
+
+type sortedRunsHeapEntry struct {
+	row sql.Row
+	run int
+}
+
+var _ heap.Interface = (*sortedRunsHeap)(nil)
+
+func (h *sortedRunsHeap) Less(i, j int) bool {
+	if h.Sorter.Less(i, j) {
+		return true
+	}
+	if h.Sorter.Less(j, i) {
+		return false
+	}
+	return h.runs[i] < h.runs[j]
+}
+
+func (h *sortedRunsHeap) Swap(i, j int) {
+	h.Sorter.Swap(i, j)
+	h.runs[i], h.runs[j] = h.runs[j], h.runs[i]
+}
+
+func (h *sortedRunsHeap) Push(x interface{}) {
+	e := x.(sortedRunsHeapEntry)
+	h.Rows = append(h.Rows, e.row)
+	h.runs = append(h.runs, e.run)
+}
+
+func (h *sortedRunsHeap) Pop() interface{} {
+	n := len(h.Rows) - 1
+	e := sortedRunsHeapEntry{row: h.Rows[n], run: h.runs[n]}
+	h.Rows, h.runs = h.Rows[:n], h.runs[:n]
+	return e
+}
+
+// sortedRunsMerger returns the rows of a number of sorted runs in sorted order.
+type sortedRunsMerger struct {
+	runs []sortedRun
+	heap *sortedRunsHeap
+}
+
+func newSortedRunsMerger(ctx *sql.Context, sortFields sql.SortFields, runs []sortedRun) (*sortedRunsMerger, error) {
+	m := &sortedRunsMerger{
+		runs: runs,
+		heap: &sortedRunsHeap{Sorter: expression.Sorter{SortFields: sortFields, Ctx: ctx}},
+	}
+	for j := range runs {
+		if err := m.pushNext(j); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// pushNext pushes the next row of the run given onto the heap, if there's any.
+func (m *sortedRunsMerger) pushNext(run int) error {
+	row, err := m.runs[run].next()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	heap.Push(m.heap, sortedRunsHeapEntry{row: row, run: run})
+	return m.heap.LastError
+}
+
+func (m *sortedRunsMerger) next() (sql.Row, error) {
+	if m.heap.Len() == 0 {
+		return nil, io.EOF
+	}
+	e := heap.Pop(m.heap).(sortedRunsHeapEntry)
+	if m.heap.LastError != nil {
+		return nil, m.heap.LastError
+	}
+	if err := m.pushNext(e.run); err != nil {
+		return nil, err
+	}
+	return e.row, nil
+}
@@ -16,6 +16,7 @@ package plan
 
 import (
 	"fmt"
+	"io"
 	"testing"
 
 	"github.com/dolthub/go-mysql-server/memory"
@@ -294,3 +295,59 @@ func TestSortDescending(t *testing.T) {
 	require.NoError(err)
 	require.Equal(expected, actual)
 }
+
+func TestSortSpill(t *testing.T) {
+	require := require.New(t)
+
+	schema := sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "col1", Type: sql.Text, Nullable: true},
+		{Name: "col2", Type: sql.Int32, Nullable: true},
+		{Name: "col3", Type: sql.Float64, Nullable: true},
+	})
+
+	child := memory.NewTable("test", schema)
+	for i := 0; i < 5000; i++ {
+		row := sql.NewRow(fmt.Sprintf("row %d", i), int32(i*7919%101), float64(i%13)/4)
+		if i%17 == 0 {
+			row[1] = nil
+		}
+		require.NoError(child.Insert(sql.NewEmptyContext(), row))
+	}
+
+	sf := []sql.SortField{
+		{Column: expression.NewGetField(1, sql.Int32, "col2", true), Order: sql.Descending, NullOrdering: sql.NullsFirst},
+		{Column: expression.NewGetField(2, sql.Float64, "col3", true), Order: sql.Ascending, NullOrdering: sql.NullsFirst},
+	}
+	s := NewSort(sf, NewResolvedTable(child, nil, nil))
+
+	expected, err := sql.NodeToRows(sql.NewEmptyContext(), s)
+	require.NoError(err)
+	require.Len(expected, 5000)
+
+	// spilling is disabled by default
+	_, spill := sortBufferSize(sql.NewEmptyContext(), s.Child.Schema())
+	require.False(spill)
+
+	ctx := sql.NewEmptyContext()
+	require.NoError(ctx.SetSessionVariable(ctx, sortSpillBufferSizeSessionVar, uint64(32768)))
+
+	iter, err := s.RowIter(ctx, nil)
+	require.NoError(err)
+	sortIter, ok := iter.(*sortIter)
+	require.True(ok)
+
+	var actual []sql.Row
+	for {
+		row, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		require.NoError(err)
+		actual = append(actual, row)
+	}
+	require.Equal(expected, actual)
+	require.Greater(len(sortIter.runs), 1)
+
+	require.NoError(iter.Close(ctx))
+	require.Nil(sortIter.runs)
+}
@@ -0,0 +1,120 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	opentracing "github.com/opentracing/opentracing-go"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
+)
+
+// StreamGroupBy is a GroupBy whose child returns the rows of each group next to each other, such as when it reads them
+// in the order of an index on the grouping expressions. Instead of reading all the rows of its child before returning
+// any group, it returns each group as soon as the rows of the next one start, so it only holds the rows of one group
+// in memory at a time. Groups are returned in the same order as GroupBy returns them.
+type StreamGroupBy struct {
+	UnaryNode
+	SelectedExprs []sql.Expression
+	GroupByExprs  []sql.Expression
+}
+
+var _ sql.Node = (*StreamGroupBy)(nil)
+var _ sql.Expressioner = (*StreamGroupBy)(nil)
+
+// NewStreamGroupBy creates a new StreamGroupBy node. See NewGroupBy for the meaning of the expressions.
+func NewStreamGroupBy(selectedExprs, groupByExprs []sql.Expression, child sql.Node) *StreamGroupBy {
+	return &StreamGroupBy{
+		UnaryNode:     UnaryNode{Child: child},
+		SelectedExprs: selectedExprs,
+		GroupByExprs:  groupByExprs,
+	}
+}
+
+// Resolved implements the Resolvable interface.
+func (g *StreamGroupBy) Resolved() bool {
+	return g.UnaryNode.Child.Resolved() &&
+		expression.ExpressionsResolved(g.SelectedExprs...) &&
+		expression.ExpressionsResolved(g.GroupByExprs...)
+}
+
+// Schema implements the Node interface.
+func (g *StreamGroupBy) Schema() sql.Schema {
+	return groupBySchema(g.SelectedExprs)
+}
+
+// RowIter implements the Node interface.
+func (g *StreamGroupBy) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	span, ctx := ctx.Span("plan.StreamGroupBy", opentracing.Tags{
+		"groupings":  len(g.GroupByExprs),
+		"aggregates": len(g.SelectedExprs),
+	})
+
+	aggs, err := groupByAggregations(g.SelectedExprs)
+	if err != nil {
+		span.Finish()
+		return nil, err
+	}
+
+	i, err := g.Child.RowIter(ctx, row)
+	if err != nil {
+		span.Finish()
+		return nil, err
+	}
+
+	return sql.NewSpanIter(span, aggregation.NewStreamGroupIter(g.GroupByExprs, aggs, i)), nil
+}
+
+// WithChildren implements the Node interface.
+func (g *StreamGroupBy) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(g, len(children), 1)
+	}
+
+	return NewStreamGroupBy(g.SelectedExprs, g.GroupByExprs, children[0]), nil
+}
+
+// WithExpressions implements the Node interface.
+func (g *StreamGroupBy) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	expected := len(g.SelectedExprs) + len(g.GroupByExprs)
+	if len(exprs) != expected {
+		return nil, sql.ErrInvalidChildrenNumber.New(g, len(exprs), expected)
+	}
+
+	agg := make([]sql.Expression, len(g.SelectedExprs))
+	copy(agg, exprs[:len(g.SelectedExprs)])
+
+	grouping := make([]sql.Expression, len(g.GroupByExprs))
+	copy(grouping, exprs[len(g.SelectedExprs):])
+
+	return NewStreamGroupBy(agg, grouping, g.Child), nil
+}
+
+func (g *StreamGroupBy) String() string {
+	return groupByString("StreamGroupBy", g.SelectedExprs, g.GroupByExprs, g.Child, false)
+}
+
+func (g *StreamGroupBy) DebugString() string {
+	return groupByString("StreamGroupBy", g.SelectedExprs, g.GroupByExprs, g.Child, true)
+}
+
+// Expressions implements the Expressioner interface.
+func (g *StreamGroupBy) Expressions() []sql.Expression {
+	var exprs []sql.Expression
+	exprs = append(exprs, g.SelectedExprs...)
+	exprs = append(exprs, g.GroupByExprs...)
+	return exprs
+}
@@ -0,0 +1,71 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
+)
+
+func TestStreamGroupByRowIter(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	childSchema := sql.Schema{
+		{Name: "col1", Type: sql.LongText},
+		{Name: "col2", Type: sql.Int64},
+	}
+	child := memory.NewTable("test", sql.NewPrimaryKeySchema(childSchema))
+
+	rows := []sql.Row{
+		sql.NewRow("col1_2", int64(4444)),
+		sql.NewRow("col1_2", int64(1)),
+		sql.NewRow("col1_1", int64(1111)),
+		sql.NewRow("col1_3", int64(3)),
+		sql.NewRow("col1_3", int64(33)),
+	}
+
+	for _, r := range rows {
+		require.NoError(child.Insert(sql.NewEmptyContext(), r))
+	}
+
+	col1 := expression.NewGetField(0, sql.LongText, "col1", true)
+	col2 := expression.NewGetField(1, sql.Int64, "col2", true)
+	selected := []sql.Expression{col1, aggregation.NewCount(col2), aggregation.NewMax(col2)}
+
+	p := NewStreamGroupBy(selected, []sql.Expression{col1}, NewResolvedTable(child, nil, nil))
+	require.Equal(NewGroupBy(selected, []sql.Expression{col1}, p.Child).Schema(), p.Schema())
+
+	expected := []sql.Row{
+		{"col1_2", int64(2), int64(4444)},
+		{"col1_1", int64(1), int64(1111)},
+		{"col1_3", int64(2), int64(33)},
+	}
+
+	res, err := sql.NodeToRows(ctx, p)
+	require.NoError(err)
+	require.Equal(expected, res)
+
+	// GroupBy returns the same groups in the same order
+	res, err = sql.NodeToRows(ctx, NewGroupBy(selected, []sql.Expression{col1}, p.Child))
+	require.NoError(err)
+	require.Equal(expected, res)
+}
@@ -303,7 +303,7 @@ func (s *Subquery) HashMultiple(ctx *sql.Context, row sql.Row) (sql.KeyValueCach
 		defer s.cacheMu.Unlock()
 		if !s.resultsCached || s.hashCache == nil {
 			hashCache, disposeFn := ctx.Memory.NewHistoryCache()
-			err = putAllRows(hashCache, result)
+			err = putAllRows(hashCache, s.Type(), result)
 			if err != nil {
 				return nil, err
 			}
@@ -313,7 +313,7 @@ func (s *Subquery) HashMultiple(ctx *sql.Context, row sql.Row) (sql.KeyValueCach
 	}
 
 	cache := sql.NewMapCache()
-	return cache, putAllRows(cache, result)
+	return cache, putAllRows(cache, s.Type(), result)
 }
 
 // HasResultRow returns whether the subquery has a result set > 0.
@@ -357,9 +357,15 @@ func (s *Subquery) HasResultRow(ctx *sql.Context, row sql.Row) (bool, error) {
 	return true, nil
 }
 
-func putAllRows(cache sql.KeyValueCache, vals []interface{}) error {
+// putAllRows puts the values given into the cache, keyed by the hash of each value converted to |typ|. Values that
+// can't be converted are keyed by the hash of the value as is.
+func putAllRows(cache sql.KeyValueCache, typ sql.Type, vals []interface{}) error {
 	for _, val := range vals {
-		rowKey, err := sql.HashOf(sql.NewRow(val))
+		converted, err := typ.Convert(val)
+		if err != nil {
+			converted = val
+		}
+		rowKey, err := sql.HashOf(sql.NewRow(converted))
 		if err != nil {
 			return err
 		}
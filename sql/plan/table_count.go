@@ -0,0 +1,105 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// TableCount is a node that returns a single row holding the number of rows of a table, as reported by the table's
+// sql.RowCountTable implementation. It replaces a COUNT(*) over an unfiltered table, so that the table's rows don't
+// need to be scanned.
+type TableCount struct {
+	Table  sql.RowCountTable
+	schema sql.Schema
+}
+
+var _ sql.Node = (*TableCount)(nil)
+
+// NewTableCount returns a new TableCount node for the table given. The schema is that of the single column returned.
+func NewTableCount(table sql.RowCountTable, schema sql.Schema) *TableCount {
+	return &TableCount{Table: table, schema: schema}
+}
+
+// Resolved implements the Resolvable interface.
+func (t *TableCount) Resolved() bool {
+	return true
+}
+
+func (t *TableCount) String() string {
+	return fmt.Sprintf("TableCount(%s)", t.Table.Name())
+}
+
+// Schema implements the Node interface.
+func (t *TableCount) Schema() sql.Schema {
+	return t.schema
+}
+
+// Children implements the Node interface.
+func (t *TableCount) Children() []sql.Node {
+	return nil
+}
+
+// RowIter implements the Node interface.
+func (t *TableCount) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	span, ctx := ctx.Span("plan.TableCount")
+	defer span.Finish()
+
+	count, ok := t.Table.RowCount(ctx)
+	if !ok {
+		// The count was available during analysis, but may not be anymore. Fall back to counting the rows.
+		var err error
+		count, err = t.scanRowCount(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return sql.RowsToRowIter(sql.NewRow(int64(count))), nil
+}
+
+func (t *TableCount) scanRowCount(ctx *sql.Context) (uint64, error) {
+	partitions, err := t.Table.Partitions(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	iter := sql.NewTableRowIter(ctx, t.Table, partitions)
+	var count uint64
+	for {
+		_, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			iter.Close(ctx)
+			return 0, err
+		}
+		count++
+	}
+	return count, iter.Close(ctx)
+}
+
+// WithChildren implements the Node interface.
+func (t *TableCount) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(t, len(children), 0)
+	}
+
+	return t, nil
+}
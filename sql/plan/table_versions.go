@@ -0,0 +1,143 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// TableVersions is a table resolved with FOR SYSTEM_TIME BETWEEN or FOR SYSTEM_TIME FROM. It returns the rows of
+// every version of the table valid in the interval of the query. A row that didn't change between versions is
+// returned once.
+type TableVersions struct {
+	name     string
+	schema   sql.Schema
+	Database sql.Database
+	// Versions are the versions of the table in the interval, ordered from oldest to newest.
+	Versions []sql.Table
+}
+
+var _ sql.Node = (*TableVersions)(nil)
+var _ sql.Nameable = (*TableVersions)(nil)
+
+// NewTableVersions creates a new TableVersions node for the table with the name and schema given. The schema is
+// needed because the interval may not contain any version of the table.
+func NewTableVersions(name string, schema sql.Schema, db sql.Database, versions []sql.Table) *TableVersions {
+	return &TableVersions{
+		name:     name,
+		schema:   schema,
+		Database: db,
+		Versions: versions,
+	}
+}
+
+// Name implements the sql.Nameable interface.
+func (t *TableVersions) Name() string {
+	return t.name
+}
+
+// Schema implements the Node interface.
+func (t *TableVersions) Schema() sql.Schema {
+	return t.schema
+}
+
+// Resolved implements the Resolvable interface.
+func (*TableVersions) Resolved() bool {
+	return true
+}
+
+// Children implements the Node interface.
+func (*TableVersions) Children() []sql.Node {
+	return nil
+}
+
+func (t *TableVersions) String() string {
+	return fmt.Sprintf("TableVersions(%s, %d versions)", t.name, len(t.Versions))
+}
+
+// RowIter implements the Node interface.
+func (t *TableVersions) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	span, ctx := ctx.Span("plan.TableVersions")
+	return sql.NewSpanIter(span, &tableVersionsIter{
+		versions: t.Versions,
+		seen:     make(map[uint64]struct{}),
+	}), nil
+}
+
+// WithChildren implements the Node interface.
+func (t *TableVersions) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(t, len(children), 0)
+	}
+
+	return t, nil
+}
+
+type tableVersionsIter struct {
+	versions []sql.Table
+	cur      sql.RowIter
+	seen     map[uint64]struct{}
+}
+
+func (i *tableVersionsIter) Next(ctx *sql.Context) (sql.Row, error) {
+	for {
+		if i.cur == nil {
+			if len(i.versions) == 0 {
+				return nil, io.EOF
+			}
+
+			partitions, err := i.versions[0].Partitions(ctx)
+			if err != nil {
+				return nil, err
+			}
+			i.cur = sql.NewTableRowIter(ctx, i.versions[0], partitions)
+			i.versions = i.versions[1:]
+		}
+
+		row, err := i.cur.Next(ctx)
+		if err == io.EOF {
+			err = i.cur.Close(ctx)
+			i.cur = nil
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		hash, err := sql.HashOf(row)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := i.seen[hash]; ok {
+			continue
+		}
+		i.seen[hash] = struct{}{}
+
+		return row, nil
+	}
+}
+
+func (i *tableVersionsIter) Close(ctx *sql.Context) error {
+	if i.cur != nil {
+		return i.cur.Close(ctx)
+	}
+	return nil
+}
@@ -15,6 +15,8 @@
 package plan
 
 import (
+	"io"
+
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -22,10 +24,14 @@ import (
 
 var ErrTruncateNotSupported = errors.NewKind("table doesn't support TRUNCATE")
 
-// Truncate is a node describing the deletion of all rows from some table.
+// Truncate is a node describing the deletion of all rows from some table. Tables that don't implement
+// sql.TruncateableTable are truncated by deleting all of their rows.
 type Truncate struct {
 	db string
 	UnaryNode
+	// DeletedRows is whether the number of rows removed is returned as the number of affected rows, as for a DELETE
+	// run as a TRUNCATE. TRUNCATE statements affect 0 rows, like in MySQL.
+	DeletedRows bool
 }
 
 var _ sql.Node = (*Truncate)(nil)
@@ -77,20 +83,16 @@ func (p *Truncate) DatabaseName() string {
 
 // RowIter implements the Node interface.
 func (p *Truncate) RowIter(ctx *sql.Context, _ sql.Row) (sql.RowIter, error) {
-	truncatable, err := GetTruncatable(p.Child)
-	if err != nil {
-		return nil, err
-	}
 	//TODO: when performance schema summary tables are added, reset the columns to 0/NULL rather than remove rows
 	//TODO: close all handlers that were opened with "HANDLER OPEN"
 
-	removed, err := truncatable.Truncate(ctx)
+	table, removed, err := p.truncate(ctx)
 	if err != nil {
 		return nil, err
 	}
-	for _, col := range truncatable.Schema() {
+	for _, col := range table.Schema() {
 		if col.AutoIncrement {
-			aiTable, ok := truncatable.(sql.AutoIncrementTable)
+			aiTable, ok := table.(sql.AutoIncrementTable)
 			if ok {
 				setter := aiTable.AutoIncrementSetter(ctx)
 				err = setter.SetAutoIncrementValue(ctx, p.incrementAutoIncrementZero(col.Type.Zero()))
@@ -105,9 +107,65 @@ func (p *Truncate) RowIter(ctx *sql.Context, _ sql.Row) (sql.RowIter, error) {
 			break
 		}
 	}
+	if !p.DeletedRows {
+		removed = 0
+	}
 	return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(removed))), nil
 }
 
+// truncate removes all rows from the table of the node, and returns it along with the number of rows removed.
+func (p *Truncate) truncate(ctx *sql.Context) (sql.Table, int, error) {
+	truncatable, err := GetTruncatable(p.Child)
+	if err == nil {
+		removed, err := truncatable.Truncate(ctx)
+		return truncatable, removed, err
+	}
+
+	deletable, deleteErr := getDeletable(p.Child)
+	if deleteErr != nil {
+		return nil, 0, err
+	}
+	removed, err := deleteAllRows(ctx, deletable)
+	return deletable, removed, err
+}
+
+// deleteAllRows deletes all rows of the table given through its deleter, and returns the number of rows deleted.
+func deleteAllRows(ctx *sql.Context, table sql.DeletableTable) (removed int, err error) {
+	partitions, err := table.Partitions(ctx)
+	if err != nil {
+		return 0, err
+	}
+	iter := sql.NewTableRowIter(ctx, table, partitions)
+	defer iter.Close(ctx)
+
+	deleter := table.Deleter(ctx)
+	deleter.StatementBegin(ctx)
+	defer func() {
+		if err != nil {
+			_ = deleter.DiscardChanges(ctx, err)
+		} else {
+			err = deleter.StatementComplete(ctx)
+		}
+		if closeErr := deleter.Close(ctx); err == nil {
+			err = closeErr
+		}
+	}()
+
+	for {
+		row, err := iter.Next(ctx)
+		if err == io.EOF {
+			return removed, nil
+		}
+		if err != nil {
+			return removed, err
+		}
+		if err = deleter.Delete(ctx, row); err != nil {
+			return removed, err
+		}
+		removed++
+	}
+}
+
 // incrementAutoIncrementZero returns the starting value for an auto_increment column once truncated.
 func (p *Truncate) incrementAutoIncrementZero(v interface{}) interface{} {
 	switch val := v.(type) {
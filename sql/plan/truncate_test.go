@@ -0,0 +1,95 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// deletableTable hides the Truncate method of the table it wraps.
+type deletableTable struct {
+	sql.DeletableTable
+}
+
+func TestTruncate(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+
+	newTable := func() *memory.Table {
+		table := memory.NewTable("foo", sql.NewPrimaryKeySchema(sql.Schema{
+			{Name: "pk", Source: "foo", Type: sql.Int64, PrimaryKey: true},
+		}))
+		for i := int64(1); i <= 3; i++ {
+			require.NoError(t, table.Insert(ctx, sql.NewRow(i)))
+		}
+		return table
+	}
+
+	testCases := []struct {
+		name        string
+		table       func(*memory.Table) sql.Table
+		deletedRows bool
+		expected    sql.OkResult
+	}{
+		{
+			name:     "truncatable table",
+			table:    func(t *memory.Table) sql.Table { return t },
+			expected: sql.NewOkResult(0),
+		},
+		{
+			name:        "truncatable table reporting deleted rows",
+			table:       func(t *memory.Table) sql.Table { return t },
+			deletedRows: true,
+			expected:    sql.NewOkResult(3),
+		},
+		{
+			name:     "deletable table",
+			table:    func(t *memory.Table) sql.Table { return deletableTable{t} },
+			expected: sql.NewOkResult(0),
+		},
+		{
+			name:        "deletable table reporting deleted rows",
+			table:       func(t *memory.Table) sql.Table { return deletableTable{t} },
+			deletedRows: true,
+			expected:    sql.NewOkResult(3),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+
+			table := newTable()
+			truncate := NewTruncate("", NewResolvedTable(tc.table(table), nil, nil))
+			truncate.DeletedRows = tc.deletedRows
+
+			iter, err := truncate.RowIter(ctx, nil)
+			require.NoError(err)
+			rows, err := sql.RowIterToRows(ctx, iter)
+			require.NoError(err)
+			require.Equal([]sql.Row{{tc.expected}}, rows)
+
+			iter, err = NewResolvedTable(table, nil, nil).RowIter(ctx, nil)
+			require.NoError(err)
+			rows, err = sql.RowIterToRows(ctx, iter)
+			require.NoError(err)
+			require.Empty(rows)
+		})
+	}
+}
@@ -20,7 +20,9 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 )
 
-// Union is a node that returns everything in Left and then everything in Right
+// Union is a node that returns everything in Left and then everything in Right. Rows are streamed as they're read, in
+// the order of each child: the right child isn't opened until the left one is exhausted, and no rows are buffered. For
+// UNION DISTINCT, the parser puts a Distinct node on top, which keeps only the hashes of the rows it has returned.
 type Union struct {
 	BinaryNode
 }
@@ -104,3 +104,53 @@ func TestUnion(t *testing.T) {
 		require.Equal(c.expected, results)
 	}
 }
+
+func TestUnionStreams(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	schema := sql.Schema{{Name: "a", Type: sql.Int64, Nullable: true}}
+	left := &countingIter{RowIter: sql.RowsToRowIter(
+		sql.NewRow(int64(1)),
+		sql.NewRow(int64(2)),
+		sql.NewRow(int64(1)),
+	)}
+	var right *countingIter
+	ui := &unionIter{
+		cur: left,
+		nextIter: func(ctx *sql.Context) (sql.RowIter, error) {
+			right = &countingIter{RowIter: sql.RowsToRowIter(
+				sql.NewRow(int64(3)),
+				sql.NewRow(int64(2)),
+				sql.NewRow(nil),
+			)}
+			return right, nil
+		},
+	}
+	distinct := newDistinctIter(ctx, ui, schema)
+
+	// Each row is returned as soon as it's read, and the right child isn't opened until the left one is exhausted
+	row, err := distinct.Next(ctx)
+	require.NoError(err)
+	require.Equal(sql.NewRow(int64(1)), row)
+	require.Equal(1, left.count)
+	require.Nil(right)
+
+	row, err = distinct.Next(ctx)
+	require.NoError(err)
+	require.Equal(sql.NewRow(int64(2)), row)
+	require.Equal(2, left.count)
+	require.Nil(right)
+
+	// Duplicates are skipped across children, keeping the first occurrence of each row in order
+	row, err = distinct.Next(ctx)
+	require.NoError(err)
+	require.Equal(sql.NewRow(int64(3)), row)
+	require.Equal(3, left.count)
+	require.Equal(1, right.count)
+
+	rest, err := sql.RowIterToRows(ctx, distinct)
+	require.NoError(err)
+	require.Equal([]sql.Row{sql.NewRow(nil)}, rest)
+	require.Equal(3, right.count)
+}
@@ -30,16 +30,31 @@ type UnresolvedTable struct {
 	name     string
 	Database string
 	AsOf     sql.Expression
+	Versions *VersionRange
+}
+
+// VersionRange is the interval of revisions of a FOR SYSTEM_TIME BETWEEN or FOR SYSTEM_TIME FROM clause.
+type VersionRange struct {
+	From sql.Expression
+	To   sql.Expression
+	// Inclusive is true if the interval includes its upper bound, as with BETWEEN.
+	Inclusive bool
 }
 
 // NewUnresolvedTable creates a new Unresolved table.
 func NewUnresolvedTable(name, db string) *UnresolvedTable {
-	return &UnresolvedTable{name, db, nil}
+	return &UnresolvedTable{name: name, Database: db}
 }
 
 // NewUnresolvedTableAsOf creates a new Unresolved table with an AS OF expression.
 func NewUnresolvedTableAsOf(name, db string, asOf sql.Expression) *UnresolvedTable {
-	return &UnresolvedTable{name, db, asOf}
+	return &UnresolvedTable{name: name, Database: db, AsOf: asOf}
+}
+
+// NewUnresolvedTableVersions creates a new Unresolved table with the interval of revisions of a FOR SYSTEM_TIME
+// BETWEEN or FOR SYSTEM_TIME FROM clause.
+func NewUnresolvedTableVersions(name, db string, from, to sql.Expression, inclusive bool) *UnresolvedTable {
+	return &UnresolvedTable{name: name, Database: db, Versions: &VersionRange{From: from, To: to, Inclusive: inclusive}}
 }
 
 var _ sql.Expressioner = (*UnresolvedTable)(nil)
@@ -94,17 +109,29 @@ func (t *UnresolvedTable) Expressions() []sql.Expression {
 	if t.AsOf != nil {
 		return []sql.Expression{t.AsOf}
 	}
+	if t.Versions != nil {
+		return []sql.Expression{t.Versions.From, t.Versions.To}
+	}
 	return nil
 }
 
 func (t *UnresolvedTable) WithExpressions(expressions ...sql.Expression) (sql.Node, error) {
-	if t.AsOf == nil {
+	if t.AsOf == nil && t.Versions == nil {
 		if len(expressions) != 0 {
 			return nil, sql.ErrInvalidChildrenNumber.New(t, len(expressions), 0)
 		}
 		return t, nil
 	}
 
+	if t.Versions != nil {
+		if len(expressions) != 2 {
+			return nil, sql.ErrInvalidChildrenNumber.New(t, len(expressions), 2)
+		}
+		t2 := *t
+		t2.Versions = &VersionRange{From: expressions[0], To: expressions[1], Inclusive: t.Versions.Inclusive}
+		return &t2, nil
+	}
+
 	if len(expressions) != 1 {
 		return nil, sql.ErrInvalidChildrenNumber.New(t, len(expressions), 1)
 	}
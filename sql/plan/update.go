@@ -29,7 +29,8 @@ var ErrUpdateUnexpectedSetResult = errors.NewKind("attempted to set field but ex
 // Update is a node for updating rows on tables.
 type Update struct {
 	UnaryNode
-	Checks sql.CheckConstraints
+	Checks      sql.CheckConstraints
+	ForeignKeys *ForeignKeyHandler
 }
 
 // NewUpdate creates an Update node.
@@ -232,6 +233,9 @@ func (u *Update) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 		return nil, err
 	}
 	updater := updatable.Updater(ctx)
+	if u.ForeignKeys != nil && foreignKeyChecksEnabled(ctx) {
+		updater = newForeignKeyStatement(u.ForeignKeys).wrap(u.ForeignKeys, updater)
+	}
 
 	iter, err := u.Child.RowIter(ctx, row)
 	if err != nil {
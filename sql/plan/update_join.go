@@ -25,6 +25,8 @@ import (
 type UpdateJoin struct {
 	updaters map[string]sql.RowUpdater
 	UnaryNode
+	// ForeignKeys are the foreign keys enforced on the rows updated in each table, by the same name as its updater.
+	ForeignKeys map[string]*ForeignKeyHandler
 }
 
 // NewUpdateJoin returns an *UpdateJoin node.
@@ -65,8 +67,9 @@ func (u *UpdateJoin) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error)
 // GetUpdatable returns an updateJoinTable which implements sql.UpdatableTable.
 func (u *UpdateJoin) GetUpdatable() sql.UpdatableTable {
 	return &updatableJoinTable{
-		updaters: u.updaters,
-		joinNode: u.Child.(*UpdateSource).Child,
+		updaters:    u.updaters,
+		foreignKeys: u.ForeignKeys,
+		joinNode:    u.Child.(*UpdateSource).Child,
 	}
 }
 
@@ -76,7 +79,9 @@ func (u *UpdateJoin) WithChildren(children ...sql.Node) (sql.Node, error) {
 		return nil, sql.ErrInvalidChildrenNumber.New(u, len(children), 1)
 	}
 
-	return NewUpdateJoin(u.updaters, children[0]), nil
+	nu := NewUpdateJoin(u.updaters, children[0])
+	nu.ForeignKeys = u.ForeignKeys
+	return nu, nil
 }
 
 // updateJoinIter wraps the child UpdateSource iter and returns join row in such a way that updates per table row are
@@ -210,8 +215,9 @@ func (u *updateJoinIter) getOrCreateCache(ctx *sql.Context, tableName string) sq
 
 // updatableJoinTable manages the update of multiple tables.
 type updatableJoinTable struct {
-	updaters map[string]sql.RowUpdater
-	joinNode sql.Node
+	updaters    map[string]sql.RowUpdater
+	foreignKeys map[string]*ForeignKeyHandler
+	joinNode    sql.Node
 }
 
 var _ sql.UpdatableTable = (*updatableJoinTable)(nil)
@@ -243,8 +249,25 @@ func (u *updatableJoinTable) Schema() sql.Schema {
 
 // Updater implements the sql.UpdatableTable interface.
 func (u *updatableJoinTable) Updater(ctx *sql.Context) sql.RowUpdater {
+	updaters := u.updaters
+	if len(u.foreignKeys) > 0 && foreignKeyChecksEnabled(ctx) {
+		// The updates of all the tables go through the same foreign key statement, so that each table sees the rows
+		// updated in the others
+		var statement *foreignKeyStatement
+		updaters = make(map[string]sql.RowUpdater, len(u.updaters))
+		for name, updater := range u.updaters {
+			if fks := u.foreignKeys[name]; fks != nil {
+				if statement == nil {
+					statement = newForeignKeyStatement(fks)
+				}
+				updater = statement.wrap(fks, updater)
+			}
+			updaters[name] = updater
+		}
+	}
+
 	return &updatableJoinUpdater{
-		updaterMap: u.updaters,
+		updaterMap: updaters,
 		schemaMap:  recreateTableSchemaFromJoinSchema(u.joinNode.Schema()),
 		joinSchema: u.joinNode.Schema(),
 	}
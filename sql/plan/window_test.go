@@ -61,6 +61,60 @@ func TestWindow(t *testing.T) {
 
 		require.Equal(t, res, []sql.Row{sql.NewRow(1, "a"), sql.NewRow(1, "b"), sql.NewRow(1, "c")})
 	})
+
+	t.Run("row number ties are broken by input order", func(t *testing.T) {
+		w := sql.NewWindow(
+			[]sql.Expression{
+				expression.NewGetField(0, sql.Int32, "a", false),
+			},
+			sql.SortFields{
+				{
+					Column: expression.NewGetField(1, sql.Int32, "b", false),
+				},
+			},
+		)
+		rowNumber := mustExpr(window.NewRowNumber().(*window.RowNumber).WithWindow(w))
+		ctx := sql.NewEmptyContext()
+
+		run := func() []sql.Row {
+			wIter := &windowIter{
+				selectExprs: []sql.Expression{
+					rowNumber,
+					expression.NewGetField(2, sql.TinyText, "c", false),
+				},
+				childIter: &dummyIter{rows: []sql.Row{
+					sql.NewRow(1, 1, "a"),
+					sql.NewRow(2, 1, "b"),
+					sql.NewRow(1, 1, "c"),
+					sql.NewRow(1, 0, "d"),
+					sql.NewRow(1, 1, "e"),
+					sql.NewRow(2, 1, "f"),
+				}},
+			}
+			res := make([]sql.Row, 0)
+			for {
+				r, err := wIter.Next(ctx)
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+				res = append(res, r)
+			}
+			return res
+		}
+
+		expected := []sql.Row{
+			sql.NewRow(2, "a"),
+			sql.NewRow(1, "b"),
+			sql.NewRow(3, "c"),
+			sql.NewRow(1, "d"),
+			sql.NewRow(4, "e"),
+			sql.NewRow(2, "f"),
+		}
+		for i := 0; i < 5; i++ {
+			require.Equal(t, expected, run())
+		}
+	})
 }
 
 type dummyIter struct {
@@ -58,11 +58,37 @@ type ProcessList interface {
 	RemovePartitionProgress(pid uint64, tableName, partitionName string)
 }
 
+// ConnectionProcessList is a ProcessList that also lists the connections to the server. Process lists that don't
+// implement it only list the queries being run.
+type ConnectionProcessList interface {
+	ProcessList
+
+	// AddConnection adds a new connection from the given address to the list. The
+	// connection is listed as sleeping while it's not running any query.
+	AddConnection(connID uint32, addr string)
+
+	// RemoveConnection removes the connection with the given id from the list.
+	RemoveConnection(connID uint32)
+}
+
+// ProcessCommand is the kind of command a process is running.
+type ProcessCommand string
+
+const (
+	// ProcessCommandQuery is the command of processes running a query.
+	ProcessCommandQuery ProcessCommand = "Query"
+	// ProcessCommandSleep is the command of connections waiting for a query.
+	ProcessCommandSleep ProcessCommand = "Sleep"
+)
+
 // Process represents a process in the SQL server.
 type Process struct {
 	Pid        uint64
 	Connection uint32
+	Host       string
+	Database   string
 	User       string
+	Command    ProcessCommand
 	Query      string
 	Progress   map[string]TableProgress
 	StartedAt  time.Time
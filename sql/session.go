@@ -42,6 +42,9 @@ const (
 	AutoCommitSessionVar = "autocommit"
 )
 
+// QueryTagLogField is the field of the query tag of a context in its logs.
+const QueryTagLogField = "queryTag"
+
 // Client holds session user information.
 type Client struct {
 	// User of the session.
@@ -535,6 +538,7 @@ type Context struct {
 	services    Services
 	pid         uint64
 	query       string
+	queryTag    string
 	queryTime   time.Time
 	tracer      opentracing.Tracer
 	rootSpan    opentracing.Span
@@ -571,6 +575,13 @@ func WithQuery(q string) ContextOption {
 	}
 }
 
+// WithQueryTag adds the given query tag, such as a trace id, to the context.
+func WithQueryTag(tag string) ContextOption {
+	return func(ctx *Context) {
+		ctx.queryTag = tag
+	}
+}
+
 // WithMemoryManager adds the given memory manager to the context.
 func WithMemoryManager(m *MemoryManager) ContextOption {
 	return func(ctx *Context) {
@@ -667,6 +678,23 @@ func (c Context) WithQuery(q string) *Context {
 	return &c
 }
 
+// QueryTag returns the query tag associated with this context, or an empty string if there is none.
+func (c *Context) QueryTag() string { return c.queryTag }
+
+func (c Context) WithQueryTag(tag string) *Context {
+	c.queryTag = tag
+	return &c
+}
+
+// GetLogger returns the logger of the session, with the query tag of the context as a field if there is one.
+func (c *Context) GetLogger() *logrus.Entry {
+	logger := c.Session.GetLogger()
+	if c.queryTag != "" {
+		logger = logger.WithField(QueryTagLogField, c.queryTag)
+	}
+	return logger
+}
+
 // QueryTime returns the time.Time when the context associated with this query was created
 func (c *Context) QueryTime() time.Time {
 	return c.queryTime
@@ -363,7 +363,8 @@ func (t setType) convertStringToBitField(str string) (uint64, error) {
 				if asUint == 0 {
 					continue
 				}
-				if _, ok := t.bitToVal[asUint]; ok {
+				/// A number given as a string is treated as a bitmask, the same as the equivalent integer would be.
+				if asUint <= t.allValuesBitField() {
 					bitField |= asUint
 					continue
 				}
@@ -168,10 +168,15 @@ func TestSetConvert(t *testing.T) {
 		{[]string{"a", "b", "c"}, Collation_Default, "b,c  ,a", "a,b,c", false},
 		{[]string{"one", "two"}, Collation_Default, "ONE", "one", false},
 		{[]string{"ONE", "two"}, Collation_Default, "one", "ONE", false},
+		{[]string{"one", "two"}, Collation_Default, "3", "one,two", false},
+		{[]string{"one", "two"}, Collation_Default, "2,one", "one,two", false},
+		{[]string{"a", "b", "c"}, Collation_Default, uint64(5), "a,c", false},
 
 		{[]string{"one", "two"}, Collation_Default, 4, nil, true},
 		{[]string{"one", "two"}, Collation_Default, "three", nil, true},
 		{[]string{"one", "two"}, Collation_Default, "one,two,three", nil, true},
+		{[]string{"one", "two"}, Collation_Default, "4", nil, true},
+		{[]string{"one", "two"}, Collation_Default, -1, nil, true},
 		{[]string{"a", "b", "c"}, Collation_binary, "b,c  ,a", nil, true},
 		{[]string{"one", "two"}, Collation_binary, "ONE", nil, true},
 		{[]string{"one", "two"}, Collation_Default, time.Date(2019, 12, 12, 12, 12, 12, 0, time.UTC), nil, true},
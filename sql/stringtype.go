@@ -213,12 +213,7 @@ func (t stringType) Compare(a interface{}, b interface{}) (int, error) {
 		bs = bi.(string)
 	}
 
-	// TODO: should be comparing based on the collation for many cases, but the way this function is used throughout the
-	// codebase causes problems if made case insensitive.  Need to revisit usings strings.Compare for now.
-	//
-	// return Collations[t.collationName].Compare(as, bs), nil
-
-	return strings.Compare(as, bs), nil
+	return t.Collation().Compare(as, bs), nil
 }
 
 // Convert implements Type interface.
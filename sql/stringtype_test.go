@@ -74,6 +74,12 @@ func TestStringCompare(t *testing.T) {
 		{MustCreateBinary(sqltypes.VarBinary, 10), false, 1, 1},
 		{MustCreateBinary(sqltypes.VarBinary, 10), 0, 1, -1},
 		{MustCreateBinary(sqltypes.VarBinary, 10), []byte("254"), 254, 0},
+
+		// Strings are compared under the collation of the type
+		{MustCreateString(sqltypes.VarChar, 10, Collation_utf8mb4_general_ci), "ABC", "abc", 0},
+		{MustCreateString(sqltypes.VarChar, 10, Collation_utf8mb4_unicode_ci), "abc", "ABD", -1},
+		{MustCreateString(sqltypes.VarChar, 10, Collation_utf8mb4_bin), "ABC", "abc", -1},
+		{MustCreateBinary(sqltypes.VarBinary, 10), "ABC", "abc", -1},
 	}
 
 	for _, test := range tests {
@@ -289,6 +289,14 @@ var systemVars = map[string]SystemVariable{
 		Type:              NewSystemStringType("admin_tls_version"),
 		Default:           "TLSv1,TLSv1.1,TLSv1.2,TLSv1.3",
 	},
+	"aliases_in_where": {
+		Name:              "aliases_in_where",
+		Scope:             SystemVariableScope_Session,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              NewSystemBoolType("aliases_in_where"),
+		Default:           int8(0),
+	},
 	"authentication_windows_log_level": {
 		Name:              "authentication_windows_log_level",
 		Scope:             SystemVariableScope_Global,
@@ -562,6 +570,22 @@ var systemVars = map[string]SystemVariable{
 		Type:              NewSystemIntType("connect_timeout", 2, 31536000, false),
 		Default:           int64(10),
 	},
+	"count_distinct_approximation_threshold": {
+		Name:              "count_distinct_approximation_threshold",
+		Scope:             SystemVariableScope_Both,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              NewSystemIntType("count_distinct_approximation_threshold", 0, math.MaxInt64, false),
+		Default:           int64(0),
+	},
+	"count_distinct_exact": {
+		Name:              "count_distinct_exact",
+		Scope:             SystemVariableScope_Session,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              NewSystemBoolType("count_distinct_exact"),
+		Default:           int8(0),
+	},
 	"core_file": {
 		Name:              "core_file",
 		Scope:             SystemVariableScope_Global,
@@ -2229,6 +2253,14 @@ var systemVars = map[string]SystemVariable{
 		Type:              NewSystemUintType("sort_buffer_size", 32768, 18446744073709551615),
 		Default:           uint64(262144),
 	},
+	"sort_spill_buffer_size": {
+		Name:              "sort_spill_buffer_size",
+		Scope:             SystemVariableScope_Both,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              NewSystemUintType("sort_spill_buffer_size", 0, 18446744073709551615),
+		Default:           uint64(0),
+	},
 	"sql_auto_is_null": {
 		Name:              "sql_auto_is_null",
 		Scope:             SystemVariableScope_Both,
@@ -296,10 +296,14 @@ func ColumnTypeToType(ct *sqlparser.ColumnType) (Type, error) {
 		length := int64(1)
 		if ct.Length != nil {
 			var err error
-			length, err = strconv.ParseInt(string(ct.Length.Val), 10, 8)
+			length, err = strconv.ParseInt(string(ct.Length.Val), 10, 64)
 			if err != nil {
 				return nil, err
 			}
+			// Checked here so that widths such as 257 are not truncated into a valid uint8
+			if length < BitTypeMinBits || length > BitTypeMaxBits {
+				return nil, fmt.Errorf("%v is an invalid number of bits", length)
+			}
 		}
 		return CreateBitType(uint8(length))
 	case "tinyblob":
@@ -439,15 +443,19 @@ func ColumnTypeToType(ct *sqlparser.ColumnType) (Type, error) {
 		return JSON, nil
 	case "geometry":
 	case "geometrycollection":
+		return GeometryCollectionType{}, nil
 	case "linestring":
 		return LinestringType{}, nil
 	case "multilinestring":
+		return MultiLinestringType{}, nil
 	case "point":
 		return PointType{}, nil
 	case "multipoint":
+		return MultiPointType{}, nil
 	case "polygon":
 		return PolygonType{}, nil
 	case "multipolygon":
+		return MultiPolygonType{}, nil
 	default:
 		return nil, fmt.Errorf("unknown type: %v", ct.Type)
 	}
@@ -619,6 +627,12 @@ func IsUnsigned(t Type) bool {
 	return t == Uint8 || t == Uint16 || t == Uint32 || t == Uint64
 }
 
+// IsYear checks if t is a YEAR type.
+func IsYear(t Type) bool {
+	_, ok := t.(yearType)
+	return ok
+}
+
 // NumColumns returns the number of columns in a type. This is one for all
 // types, except tuples.
 func NumColumns(t Type) int {
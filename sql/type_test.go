@@ -72,3 +72,43 @@ func TestFloatCovert(t *testing.T) {
 		})
 	}
 }
+
+func TestBitColumnType(t *testing.T) {
+	tests := []struct {
+		length   string
+		expected Type
+		err      bool
+	}{
+		{"", MustCreateBitType(1), false},
+		{"1", MustCreateBitType(1), false},
+		{"8", MustCreateBitType(8), false},
+		{"64", MustCreateBitType(64), false},
+		{"0", nil, true},
+		{"65", nil, true},
+		{"257", nil, true},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v %v", test.length, test.err), func(t *testing.T) {
+			var length *sqlparser.SQLVal = nil
+			if test.length != "" {
+				length = &sqlparser.SQLVal{
+					Type: sqlparser.IntVal,
+					Val:  []byte(test.length),
+				}
+			}
+
+			ct := &sqlparser.ColumnType{
+				Type:   "BIT",
+				Length: length,
+			}
+			res, err := ColumnTypeToType(ct)
+			if test.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
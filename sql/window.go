@@ -22,13 +22,31 @@ import (
 type Window struct {
 	PartitionBy []Expression
 	OrderBy     SortFields
-	// TODO: window frame
+	// Frame is the frame clause of the window, or nil if it doesn't have one
+	Frame *WindowFrame
+	// Ref is the name of the named window this window refers to or extends, if any. Windows with a reference are
+	// replaced by the analyzer with the definition of the named window they refer to, combined with their own clauses.
+	Ref string
 }
 
 func NewWindow(partitionBy []Expression, orderBy []SortField) *Window {
 	return &Window{PartitionBy: partitionBy, OrderBy: orderBy}
 }
 
+// WithRef returns a copy of this window referring to the named window given.
+func (w *Window) WithRef(ref string) *Window {
+	nw := *w
+	nw.Ref = ref
+	return &nw
+}
+
+// WithFrame returns a copy of this window with the frame given.
+func (w *Window) WithFrame(frame *WindowFrame) *Window {
+	nw := *w
+	nw.Frame = frame
+	return &nw
+}
+
 // ToExpressions converts the PartitionBy and OrderBy expressions to a single slice of expressions suitable for
 // manipulation by analyzer rules.
 func (w *Window) ToExpressions() []Expression {
@@ -61,6 +79,9 @@ func (w *Window) String() string {
 	}
 	sb := strings.Builder{}
 	sb.WriteString("over (")
+	if w.Ref != "" {
+		sb.WriteString(w.Ref)
+	}
 	if len(w.PartitionBy) > 0 {
 		sb.WriteString(" partition by ")
 		for i, expression := range w.PartitionBy {
@@ -79,6 +100,10 @@ func (w *Window) String() string {
 			sb.WriteString(ob.String())
 		}
 	}
+	if w.Frame != nil {
+		sb.WriteString(" ")
+		sb.WriteString(w.Frame.String())
+	}
 	sb.WriteString(")")
 	return sb.String()
 }
@@ -89,6 +114,9 @@ func (w *Window) DebugString() string {
 	}
 	sb := strings.Builder{}
 	sb.WriteString("over (")
+	if w.Ref != "" {
+		sb.WriteString(w.Ref)
+	}
 	if len(w.PartitionBy) > 0 {
 		sb.WriteString(" partition by ")
 		for i, expression := range w.PartitionBy {
@@ -107,6 +135,10 @@ func (w *Window) DebugString() string {
 			sb.WriteString(DebugString(ob))
 		}
 	}
+	if w.Frame != nil {
+		sb.WriteString(" ")
+		sb.WriteString(w.Frame.String())
+	}
 	sb.WriteString(")")
 	return sb.String()
 }
@@ -0,0 +1,125 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"fmt"
+)
+
+// WindowFrameUnit is the unit in which the offsets of a window frame are measured.
+type WindowFrameUnit byte
+
+const (
+	// WindowFrameUnitRows frames are bounded by a number of rows before or after the current row.
+	WindowFrameUnitRows WindowFrameUnit = iota
+	// WindowFrameUnitRange frames are bounded by a distance from the ORDER BY value of the current row, and a CURRENT
+	// ROW bound includes all the peers of the current row.
+	WindowFrameUnitRange
+)
+
+func (u WindowFrameUnit) String() string {
+	if u == WindowFrameUnitRange {
+		return "range"
+	}
+	return "rows"
+}
+
+// WindowFrameBoundType is the kind of a window frame bound.
+type WindowFrameBoundType byte
+
+// The bound types are declared in the order the rows they refer to appear in the partition, which a frame's start must
+// not come after its end in.
+const (
+	WindowFrameUnboundedPreceding WindowFrameBoundType = iota
+	WindowFramePreceding
+	WindowFrameCurrentRow
+	WindowFrameFollowing
+	WindowFrameUnboundedFollowing
+)
+
+// WindowFrameBound is the start or end of a window frame.
+type WindowFrameBound struct {
+	Type WindowFrameBoundType
+	// Offset is the N of an N PRECEDING or N FOLLOWING bound: a number of rows for ROWS frames, and a number or an
+	// interval to subtract from or add to the ORDER BY value of the current row for RANGE frames. It's nil for other
+	// bound types.
+	Offset Expression
+}
+
+func (b WindowFrameBound) String() string {
+	switch b.Type {
+	case WindowFrameUnboundedPreceding:
+		return "unbounded preceding"
+	case WindowFramePreceding:
+		return fmt.Sprintf("%s preceding", b.Offset)
+	case WindowFrameCurrentRow:
+		return "current row"
+	case WindowFrameFollowing:
+		return fmt.Sprintf("%s following", b.Offset)
+	default:
+		return "unbounded following"
+	}
+}
+
+// WindowFrameExclusion is the set of rows around the current row that an EXCLUDE clause removes from its frame.
+type WindowFrameExclusion byte
+
+const (
+	// WindowFrameExcludeNoOthers doesn't remove any rows, and is the default.
+	WindowFrameExcludeNoOthers WindowFrameExclusion = iota
+	// WindowFrameExcludeCurrentRow removes the current row.
+	WindowFrameExcludeCurrentRow
+	// WindowFrameExcludeGroup removes the current row and its peers.
+	WindowFrameExcludeGroup
+	// WindowFrameExcludeTies removes the peers of the current row, but not the current row itself.
+	WindowFrameExcludeTies
+)
+
+func (e WindowFrameExclusion) String() string {
+	switch e {
+	case WindowFrameExcludeCurrentRow:
+		return "exclude current row"
+	case WindowFrameExcludeGroup:
+		return "exclude group"
+	case WindowFrameExcludeTies:
+		return "exclude ties"
+	default:
+		return "exclude no others"
+	}
+}
+
+// WindowFrame is the frame clause of a window, which selects the rows of the partition that a window function is
+// computed over for each row.
+type WindowFrame struct {
+	Unit       WindowFrameUnit
+	Start, End WindowFrameBound
+	Exclusion  WindowFrameExclusion
+}
+
+// NewWindowFrame returns a window frame from |start| to |end| in the unit given.
+func NewWindowFrame(unit WindowFrameUnit, start, end WindowFrameBound, exclusion WindowFrameExclusion) *WindowFrame {
+	return &WindowFrame{Unit: unit, Start: start, End: end, Exclusion: exclusion}
+}
+
+func (f *WindowFrame) String() string {
+	if f == nil {
+		return ""
+	}
+	s := fmt.Sprintf("%s between %s and %s", f.Unit, f.Start, f.End)
+	if f.Exclusion != WindowFrameExcludeNoOthers {
+		s += " " + f.Exclusion.String()
+	}
+	return s
+}
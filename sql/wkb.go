@@ -0,0 +1,144 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+// WKBHeaderLength is the length of the header of a geometry in WKB format: its endianness (1 byte) and its type (4
+// bytes)
+const WKBHeaderLength = 5
+
+// Type IDs of the geometries in WKB format
+const (
+	WKBUnknown = iota
+	WKBPointID
+	WKBLineID
+	WKBPolyID
+	WKBMultiPointID
+	WKBMultiLineID
+	WKBMultiPolyID
+	WKBGeomCollID
+)
+
+var ErrNotGeometry = errors.NewKind("value of type %T is not a geometry")
+
+// SerializeWKB converts any geometry to a byte array in WKB format, including the header
+func SerializeWKB(v interface{}) ([]byte, error) {
+	// MySQL seems to always use Little Endian
+	buf := make([]byte, WKBHeaderLength)
+	buf[0] = 1
+
+	switch v := v.(type) {
+	case Point:
+		binary.LittleEndian.PutUint32(buf[1:5], WKBPointID)
+		return serializePoint(buf, v), nil
+	case Linestring:
+		binary.LittleEndian.PutUint32(buf[1:5], WKBLineID)
+		return serializeLine(buf, v), nil
+	case Polygon:
+		binary.LittleEndian.PutUint32(buf[1:5], WKBPolyID)
+		return serializePoly(buf, v), nil
+	case MultiPoint:
+		binary.LittleEndian.PutUint32(buf[1:5], WKBMultiPointID)
+		geoms := make([]interface{}, len(v.Points))
+		for i, p := range v.Points {
+			geoms[i] = p
+		}
+		return serializeGeometries(buf, geoms)
+	case MultiLinestring:
+		binary.LittleEndian.PutUint32(buf[1:5], WKBMultiLineID)
+		geoms := make([]interface{}, len(v.Lines))
+		for i, l := range v.Lines {
+			geoms[i] = l
+		}
+		return serializeGeometries(buf, geoms)
+	case MultiPolygon:
+		binary.LittleEndian.PutUint32(buf[1:5], WKBMultiPolyID)
+		geoms := make([]interface{}, len(v.Polygons))
+		for i, p := range v.Polygons {
+			geoms[i] = p
+		}
+		return serializeGeometries(buf, geoms)
+	case GeometryCollection:
+		binary.LittleEndian.PutUint32(buf[1:5], WKBGeomCollID)
+		return serializeGeometries(buf, v.Geometries)
+	default:
+		return nil, ErrNotGeometry.New(v)
+	}
+}
+
+// serializePoint appends the coordinates of the point to buf
+func serializePoint(buf []byte, p Point) []byte {
+	var data [16]byte
+	binary.LittleEndian.PutUint64(data[0:8], math.Float64bits(p.X))
+	binary.LittleEndian.PutUint64(data[8:16], math.Float64bits(p.Y))
+	return append(buf, data[:]...)
+}
+
+// serializeLine appends the number of points of the linestring to buf, followed by each of them
+func serializeLine(buf []byte, l Linestring) []byte {
+	buf = appendUint32(buf, uint32(len(l.Points)))
+	for _, p := range l.Points {
+		buf = serializePoint(buf, p)
+	}
+	return buf
+}
+
+// serializePoly appends the number of lines of the polygon to buf, followed by each of them
+func serializePoly(buf []byte, p Polygon) []byte {
+	buf = appendUint32(buf, uint32(len(p.Lines)))
+	for _, l := range p.Lines {
+		buf = serializeLine(buf, l)
+	}
+	return buf
+}
+
+// serializeGeometries appends the number of geometries given to buf, followed by the complete WKB, including the
+// header, of each of them
+func serializeGeometries(buf []byte, geoms []interface{}) ([]byte, error) {
+	buf = appendUint32(buf, uint32(len(geoms)))
+	for _, g := range geoms {
+		data, err := SerializeWKB(g)
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, data...)
+	}
+	return buf, nil
+}
+
+// appendUint32 appends the little endian encoding of n to buf
+func appendUint32(buf []byte, n uint32) []byte {
+	var data [4]byte
+	binary.LittleEndian.PutUint32(data[:], n)
+	return append(buf, data[:]...)
+}
+
+// geometrySQL returns the value of a geometry in the format MySQL sends over the wire: its SRID (4 bytes), followed by
+// its WKB.
+func geometrySQL(srid uint32, v interface{}) (sqltypes.Value, error) {
+	data, err := SerializeWKB(v)
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+	buf := appendUint32(make([]byte, 0, 4+len(data)), srid)
+	return sqltypes.MakeTrusted(sqltypes.Geometry, append(buf, data...)), nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeometrySQL(t *testing.T) {
+	point := "0101000000000000000000F03F0000000000000040"
+	line := "02000000000000000000F03F000000000000004000000000000008400000000000001040"
+	tests := []struct {
+		name     string
+		typ      Type
+		val      interface{}
+		expected string
+	}{
+		{
+			"multipoint",
+			MultiPointType{},
+			MultiPoint{SRID: 4326, Points: []Point{{X: 1, Y: 2}}},
+			"E6100000" + "0104000000" + "01000000" + point,
+		},
+		{
+			"multilinestring",
+			MultiLinestringType{},
+			MultiLinestring{Lines: []Linestring{{Points: []Point{{X: 1, Y: 2}, {X: 3, Y: 4}}}}},
+			"00000000" + "0105000000" + "01000000" + "0102000000" + line,
+		},
+		{
+			"multipolygon",
+			MultiPolygonType{},
+			MultiPolygon{Polygons: []Polygon{}},
+			"00000000" + "0106000000" + "00000000",
+		},
+		{
+			"geometrycollection",
+			GeometryCollectionType{},
+			GeometryCollection{Geometries: []interface{}{Point{X: 1, Y: 2}}},
+			"00000000" + "0107000000" + "01000000" + point,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			expected, err := hex.DecodeString(tt.expected)
+			require.NoError(err)
+
+			v, err := tt.typ.SQL(tt.val)
+			require.NoError(err)
+			require.Equal(sqltypes.Geometry, v.Type())
+			require.Equal(expected, v.Raw())
+
+			_, err = tt.typ.SQL("not a geometry")
+			require.Error(err)
+		})
+	}
+
+	_, err := GeometryCollectionType{}.SQL(GeometryCollection{Geometries: []interface{}{"not a geometry"}})
+	require.True(t, ErrNotGeometry.Is(err))
+}
@@ -110,9 +110,10 @@ func (t yearType) Convert(v interface{}) (interface{}, error) {
 		if valueLength == 1 || valueLength == 2 || valueLength == 4 {
 			i, err := strconv.ParseInt(value, 10, 64)
 			if err != nil {
-				return nil, err
+				return nil, ErrConvertingToYear.New(v)
 			}
-			if i == 0 {
+			/// As 1- or 2-digit strings, '0' and '00' are converted to 2000, while '0000' remains the zero year.
+			if i == 0 && valueLength != 4 {
 				return int16(2000), nil
 			}
 			return t.Convert(i)
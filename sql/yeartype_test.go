@@ -77,12 +77,15 @@ func TestYearConvert(t *testing.T) {
 		{"2000", int16(2000), false},
 		{"2100", int16(2100), false},
 		{"2155", int16(2155), false},
+		{"00", int16(2000), false},
+		{"0000", int16(0), false},
 		{time.Date(2010, 1, 2, 3, 4, 5, 0, time.UTC), int16(2010), false},
 
 		{100, nil, true},
 		{"100", nil, true},
 		{1850, nil, true},
 		{"1850", nil, true},
+		{"19x9", nil, true},
 		{[]byte{0}, nil, true},
 		{false, nil, true},
 	}
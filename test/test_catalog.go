@@ -105,6 +105,27 @@ func (c *Catalog) TableAsOf(ctx *sql.Context, dbName, tableName string, asOf int
 	return tbl, versionedDb, nil
 }
 
+func (c *Catalog) TableVersions(ctx *sql.Context, dbName, tableName string, from, to interface{}, inclusive bool) ([]sql.Table, sql.Database, error) {
+	db, err := c.Database(dbName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	versionedDb, ok := db.(sql.VersionRangeDatabase)
+	if !ok {
+		return nil, nil, sql.ErrVersionRangeNotSupported.New(dbName)
+	}
+
+	tables, ok, err := versionedDb.GetTableInsensitiveVersions(ctx, tableName, from, to, inclusive)
+	if err != nil {
+		return nil, nil, err
+	} else if !ok {
+		return nil, nil, sql.ErrTableNotFound.New(tableName)
+	}
+
+	return tables, versionedDb, nil
+}
+
 func (c *Catalog) RegisterFunction(fns ...sql.Function) {}
 
 func (c *Catalog) Function(name string) (sql.Function, error) {